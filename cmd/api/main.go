@@ -10,6 +10,8 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"log"
 	"net/http"
 	"os"
@@ -19,6 +21,7 @@ import (
 
 	"github.com/joho/godotenv"
 
+	calculosdto "github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
 	"github.com/garfex/calculadora-filtros/internal/calculos/application/usecase"
 	"github.com/garfex/calculadora-filtros/internal/calculos/infrastructure"
 	"github.com/garfex/calculadora-filtros/internal/calculos/infrastructure/adapter/driven/csv"
@@ -30,11 +33,26 @@ import (
 	equipospostgres "github.com/garfex/calculadora-filtros/internal/equipos/infrastructure/adapter/driven/postgres"
 	equipohttp "github.com/garfex/calculadora-filtros/internal/equipos/infrastructure/adapter/driver/http"
 
+	memoriasdto "github.com/garfex/calculadora-filtros/internal/memorias/application/dto"
+	memoriasusecase "github.com/garfex/calculadora-filtros/internal/memorias/application/usecase"
+	memoriasinfra "github.com/garfex/calculadora-filtros/internal/memorias/infrastructure"
+	memoriaspostgres "github.com/garfex/calculadora-filtros/internal/memorias/infrastructure/adapter/driven/postgres"
+	memoriahttp "github.com/garfex/calculadora-filtros/internal/memorias/infrastructure/adapter/driver/http"
+
+	preciosusecase "github.com/garfex/calculadora-filtros/internal/precios/application/usecase"
+	preciosinfra "github.com/garfex/calculadora-filtros/internal/precios/infrastructure"
+	preciospostgres "github.com/garfex/calculadora-filtros/internal/precios/infrastructure/adapter/driven/postgres"
+	preciohttp "github.com/garfex/calculadora-filtros/internal/precios/infrastructure/adapter/driver/http"
+
 	pdfpkg "github.com/garfex/calculadora-filtros/internal/pdf"
+	pdfdto "github.com/garfex/calculadora-filtros/internal/pdf/application/dto"
 	pdfusecase "github.com/garfex/calculadora-filtros/internal/pdf/application/usecase"
+	pdfdomain "github.com/garfex/calculadora-filtros/internal/pdf/domain"
 	pdfinfra "github.com/garfex/calculadora-filtros/internal/pdf/infrastructure"
+	pdfdocx "github.com/garfex/calculadora-filtros/internal/pdf/infrastructure/adapter/driven/docx"
 	pdfgotenberg "github.com/garfex/calculadora-filtros/internal/pdf/infrastructure/adapter/driven/gotenberg"
 	htmltemplate "github.com/garfex/calculadora-filtros/internal/pdf/infrastructure/adapter/driven/template"
+	pdfxlsx "github.com/garfex/calculadora-filtros/internal/pdf/infrastructure/adapter/driven/xlsx"
 	pdfhttp "github.com/garfex/calculadora-filtros/internal/pdf/infrastructure/adapter/driver/http"
 
 	sharedpostgres "github.com/garfex/calculadora-filtros/internal/shared/infrastructure/postgres"
@@ -69,10 +87,13 @@ func main() {
 
 	calcEquipoRepo := calculospostgres.NewCalcEquipoFiltroRepository(pool)
 	equipoFiltroRepo := equipospostgres.NewPostgresEquipoFiltroRepository(pool)
+	memoriaRepo := memoriaspostgres.NewPostgresMemoriaRepository(pool)
+	precioRepo := preciospostgres.NewPostgresPrecioRepository(pool)
+	catalogoPreciosRepo := calculospostgres.NewCalcCatalogoPreciosRepository(pool)
 
 	// ─── Calculos: use cases ──────────────────────────────────────────────────
 
-	calcularCorrienteUC := usecase.NewCalcularCorrienteUseCase(calcEquipoRepo)
+	calcularCorrienteUC := usecase.NewCalcularCorrienteUseCase(calcEquipoRepo, tablaRepo)
 	ajustarCorrienteUC := usecase.NewAjustarCorrienteUseCase(tablaRepo)
 	seleccionarConductorUC := usecase.NewSeleccionarConductorUseCase(tablaRepo)
 	seleccionarConductorAlimentacionUC := usecase.NewSeleccionarConductorAlimentacionUseCase(tablaRepo)
@@ -82,6 +103,12 @@ func main() {
 	calcularCharolaTriangularUC := usecase.NewCalcularCharolaTriangularUseCase(tablaRepo)
 	calcularCaidaTensionUC := usecase.NewCalcularCaidaTensionUseCase(tablaRepo)
 	seleccionarConductorCaidaTensionUC := usecase.NewSeleccionarConductorPorCaidaTensionUseCase(calcularCaidaTensionUC, tablaRepo)
+	calcularCortocircuitoUC := usecase.NewCalcularCortocircuitoUseCase(tablaRepo)
+	calcularFactorKUC := usecase.NewCalcularFactorKUseCase()
+	verificarSoporteTermicoUC := usecase.NewVerificarSoporteTermicoUseCase(tablaRepo)
+	seleccionarITMUC := usecase.NewSeleccionarITMUseCase(tablaRepo)
+	verificarCoordinacionProteccionUC := usecase.NewVerificarCoordinacionProteccionUseCase(tablaRepo)
+	dimensionarNeutroUC := usecase.NewDimensionarNeutroUseCase(tablaRepo)
 
 	// Geometry generator adapter for SVG diagrams
 	geometryGenerator := geometryadapter.NewGeometryGeneratorAdapter()
@@ -95,10 +122,21 @@ func main() {
 		calcularCharolaTriangularUC,
 		calcularCaidaTensionUC,
 		seleccionarConductorCaidaTensionUC,
+		calcularCortocircuitoUC,
+		verificarSoporteTermicoUC,
+		seleccionarITMUC,
+		verificarCoordinacionProteccionUC,
+		dimensionarNeutroUC,
 		tablaRepo,
 		geometryGenerator,
 	)
 
+	calcularProyectoUC := usecase.NewCalcularProyectoUseCase(orquestadorMemoriaUC)
+	optimizarCostoUC := usecase.NewOptimizarCostoUseCase(orquestadorMemoriaUC, catalogoPreciosRepo)
+	generarListaMaterialesUC := usecase.NewGenerarListaMaterialesUseCase(orquestadorMemoriaUC, catalogoPreciosRepo)
+	exportarDiagramaDxfUC := usecase.NewExportarDiagramaDxfUseCase(orquestadorMemoriaUC)
+	calcularTensionJaladoUC := usecase.NewCalcularTensionJaladoUseCase(tablaRepo)
+
 	// ─── Equipos: use cases ───────────────────────────────────────────────────
 
 	crearEquipoUC := equiposusecase.NewCrearEquipoUseCase(equipoFiltroRepo)
@@ -115,6 +153,16 @@ func main() {
 		eliminarEquipoUC,
 	)
 
+	// ─── Precios: use cases ───────────────────────────────────────────────────
+
+	precioHandler := preciohttp.NewPrecioHandler(
+		preciosusecase.NewCrearPrecioUseCase(precioRepo),
+		preciosusecase.NewObtenerPrecioUseCase(precioRepo),
+		preciosusecase.NewListarPreciosUseCase(precioRepo),
+		preciosusecase.NewActualizarPrecioUseCase(precioRepo),
+		preciosusecase.NewEliminarPrecioUseCase(precioRepo),
+	)
+
 	// ─── PDF: adapters, use case y handler ──────────────────────────────────
 
 	htmlRenderer, err := htmltemplate.NewHtmlRenderer(pdfpkg.TemplatesFS)
@@ -127,8 +175,34 @@ func main() {
 		log.Fatalf("Error inicializando generador PDF (Gotenberg): %v", err)
 	}
 
-	generarMemoriaUC := pdfusecase.NewGenerarMemoriaPdf(htmlRenderer, pdfGenerator, 3)
-	pdfHandler := pdfhttp.NewPdfHandler(generarMemoriaUC)
+	// DOCX editable: mismo HTML, diagramas SVG rasterizados con Gotenberg
+	docxGenerator := pdfdocx.NewDocxGenerator(pdfgotenberg.NewSvgRasterizer())
+
+	generarMemoriaUC := pdfusecase.NewGenerarMemoriaPdf(htmlRenderer, pdfGenerator, docxGenerator, 3)
+	generarProyectoPdfUC := pdfusecase.NewGenerarProyectoPdf(htmlRenderer, pdfGenerator, 3)
+	pdfHandler := pdfhttp.NewPdfHandler(generarMemoriaUC, generarProyectoPdfUC)
+
+	// Exportación a Excel: mismo resultado de cálculo, sin plantilla HTML
+	xlsxGenerator := pdfxlsx.NewXlsxGenerator()
+	exportHandler := pdfhttp.NewExportHandler(
+		pdfusecase.NewExportarMemoriaXlsx(xlsxGenerator),
+		pdfusecase.NewExportarProyectoXlsx(xlsxGenerator),
+	)
+
+	// ─── Memorias: use cases ──────────────────────────────────────────────────
+	// El orquestador de calculos y el generador de PDF se adaptan a los ports de memorias.
+
+	calculadorMemoria := calculadorMemoriaAdapter{orquestador: orquestadorMemoriaUC}
+	generadorPdfMemoria := generadorPdfMemoriaAdapter{generador: generarMemoriaUC}
+
+	memoriaHandler := memoriahttp.NewMemoriaHandler(
+		memoriasusecase.NewCrearMemoriaUseCase(memoriaRepo, calculadorMemoria),
+		memoriasusecase.NewObtenerMemoriaUseCase(memoriaRepo),
+		memoriasusecase.NewListarMemoriasUseCase(memoriaRepo),
+		memoriasusecase.NewActualizarMemoriaUseCase(memoriaRepo, calculadorMemoria),
+		memoriasusecase.NewEliminarMemoriaUseCase(memoriaRepo),
+		memoriasusecase.NewGenerarPdfMemoriaUseCase(memoriaRepo, generadorPdfMemoria),
+	)
 
 	// ─── Router principal ────────────────────────────────────────────────────
 
@@ -142,13 +216,23 @@ func main() {
 		calcularCharolaEspaciadoUC,
 		calcularCharolaTriangularUC,
 		calcularCaidaTensionUC,
+		calcularCortocircuitoUC,
+		calcularFactorKUC,
 		orquestadorMemoriaUC,
+		calcularProyectoUC,
+		optimizarCostoUC,
+		generarListaMaterialesUC,
+		exportarDiagramaDxfUC,
+		calcularTensionJaladoUC,
 	)
 
-	// Montar rutas de equipos y PDF bajo /api/v1
+	// Montar rutas de equipos, precios, PDF, exportación y memorias bajo /api/v1
 	v1 := router.Group("/api/v1")
 	equiposinfra.RegisterEquiposRoutes(v1, equipoHandler)
+	preciosinfra.RegisterPreciosRoutes(v1, precioHandler)
 	pdfinfra.RegisterPdfRoutes(v1, pdfHandler)
+	pdfinfra.RegisterExportRoutes(v1, exportHandler)
+	memoriasinfra.RegisterMemoriasRoutes(v1, memoriaHandler)
 
 	// ─── Servidor HTTP ───────────────────────────────────────────────────────
 
@@ -184,3 +268,55 @@ func main() {
 
 	log.Println("Servidor cerrado correctamente")
 }
+
+// ─── Adaptadores entre features ─────────────────────────────────────────────
+// memorias guarda documentos JSON opacos; aquí se traducen a los DTOs de calculos y pdf.
+
+// calculadorMemoriaAdapter implementa el port CalculadorMemoria de memorias con el
+// orquestador de calculos. El input tiene el formato de POST /api/v1/calculos/memoria,
+// incluido el itm de nivel superior de los modos MANUAL_*.
+type calculadorMemoriaAdapter struct {
+	orquestador *usecase.OrquestadorMemoriaCalculoUseCase
+}
+
+func (a calculadorMemoriaAdapter) Calcular(ctx context.Context, input json.RawMessage) (json.RawMessage, error) {
+	var solicitud calculosdto.SolicitudMemoria
+	if err := json.Unmarshal(input, &solicitud); err != nil {
+		return nil, fmt.Errorf("%w: input: %v", memoriasdto.ErrInputInvalido, err)
+	}
+
+	resultado, err := a.orquestador.Execute(ctx, solicitud.ToEquipoInput())
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(resultado)
+}
+
+// generadorPdfMemoriaAdapter implementa el port GeneradorPdf de memorias con el
+// use case de PDF y el catálogo de empresas de la feature pdf.
+type generadorPdfMemoriaAdapter struct {
+	generador *pdfusecase.GenerarMemoriaPdfUseCase
+}
+
+func (a generadorPdfMemoriaAdapter) ExisteEmpresa(empresaID string) bool {
+	_, ok := pdfdomain.BuscarEmpresaPorID(empresaID)
+	return ok
+}
+
+func (a generadorPdfMemoriaAdapter) Generar(ctx context.Context, memoria json.RawMessage, presentacion memoriasdto.PresentacionPdf) ([]byte, error) {
+	var memoriaOutput calculosdto.MemoriaOutput
+	if err := json.Unmarshal(memoria, &memoriaOutput); err != nil {
+		return nil, fmt.Errorf("%w: %v", memoriasdto.ErrDocumentoCorrupto, err)
+	}
+
+	return a.generador.Execute(ctx, pdfdto.PdfMemoriaRequest{
+		Memoria: memoriaOutput,
+		Presentacion: pdfdto.PresentacionInput{
+			EmpresaID:            presentacion.EmpresaID,
+			NombreProyecto:       presentacion.NombreProyecto,
+			DireccionProyecto:    presentacion.DireccionProyecto,
+			Responsable:          presentacion.Responsable,
+			NombreEquipoOverride: presentacion.NombreEquipoOverride,
+		},
+	})
+}
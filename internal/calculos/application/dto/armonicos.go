@@ -0,0 +1,15 @@
+// internal/calculos/application/dto/armonicos.go
+package dto
+
+// ResultadoArmonicos contiene el ajuste por contenido armónico de la corriente:
+// corriente eficaz (RMS) y criterio de IEC 60364-5-52 Anexo E para el neutro.
+type ResultadoArmonicos struct {
+	THDi               float64 `json:"thd_i"`            // [%]
+	TerceraArmonica    float64 `json:"tercera_armonica"` // armónicas triples [% de la corriente de fase RMS]
+	CorrienteRMS       float64 `json:"corriente_rms"`    // [A]
+	FactorRMS          float64 `json:"factor_rms"`
+	FactorReduccion    float64 `json:"factor_reduccion"` // 1.0 o 0.86 (Anexo E)
+	DimensionPorNeutro bool    `json:"dimension_por_neutro"`
+	FactorAnexoE       float64 `json:"factor_anexo_e"`
+	Factor             float64 `json:"factor"` // multiplicador total sobre la corriente nominal
+}
@@ -0,0 +1,22 @@
+// internal/calculos/application/dto/banco_capacitores.go
+package dto
+
+// ResultadoBancoCapacitores contiene los datos del banco de capacitores y los
+// requisitos de la NOM 460 (conductor, medio de desconexión y descarga).
+type ResultadoBancoCapacitores struct {
+	KVAR             float64 `json:"kvar,omitempty"` // 0 si la corriente se proporcionó directamente
+	Pasos            int     `json:"pasos"`
+	KVARPorPaso      float64 `json:"kvar_por_paso,omitempty"`
+	Tension          int     `json:"tension"`
+	CorrienteNominal float64 `json:"corriente_nominal"`
+	CorrientePorPaso float64 `json:"corriente_por_paso"`
+
+	// 135 % de la corriente nominal (460-8(a) y 460-8(c))
+	FactorConductor              float64 `json:"factor_conductor"`
+	CorrienteMinimaConductor     float64 `json:"corriente_minima_conductor"`
+	CorrienteMinimaDesconectador float64 `json:"corriente_minima_desconectador"`
+
+	// Descarga: tensión residual máxima y tiempo (460-6(a) / 460-28(a))
+	TensionResidualMaxima float64 `json:"tension_residual_maxima"`
+	TiempoDescarga        float64 `json:"tiempo_descarga"`
+}
@@ -1,7 +1,11 @@
 // internal/calculos/application/dto/charola_espaciado.go
 package dto
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+)
 
 // CharolaEspaciadoInput representa los datos de entrada para calcular charola con espaciado.
 type CharolaEspaciadoInput struct {
@@ -10,6 +14,10 @@ type CharolaEspaciadoInput struct {
 	DiametroFaseMM    float64  `json:"diametro_fase_mm"`
 	DiametroTierraMM  float64  `json:"diametro_tierra_mm"`
 	DiametroControlMM *float64 `json:"diametro_control_mm,omitempty"`
+	DiametroNeutroMM  *float64 `json:"diametro_neutro_mm,omitempty"` // nil = mismo diámetro que la fase
+	SeccionFaseMM2    float64  `json:"seccion_fase_mm2,omitempty"`   // 0 = desconocida (sin regla por área ≥ 1000 kcmil)
+	TipoCharola       string   `json:"tipo_charola,omitempty"`       // ESCALERA (default), FONDO_SOLIDO, CANAL
+	PeralteMM         float64  `json:"peralte_mm,omitempty"`         // 0 = 101.6 mm (4")
 }
 
 // Validate valida los campos de entrada.
@@ -26,6 +34,15 @@ func (i CharolaEspaciadoInput) Validate() error {
 	if i.DiametroControlMM != nil && *i.DiametroControlMM <= 0 {
 		return fmt.Errorf("diametro_control_mm debe ser mayor que cero si se proporciona")
 	}
+	if i.DiametroNeutroMM != nil && *i.DiametroNeutroMM <= 0 {
+		return fmt.Errorf("diametro_neutro_mm debe ser mayor que cero si se proporciona")
+	}
+	if i.SeccionFaseMM2 < 0 {
+		return fmt.Errorf("seccion_fase_mm2 no puede ser negativa")
+	}
+	if _, err := entity.NewEspecificacionCharola(i.TipoCharola, i.PeralteMM); err != nil {
+		return fmt.Errorf("tipo_charola/peralte_mm: %w", err)
+	}
 	return nil
 }
 
@@ -36,11 +53,17 @@ type CharolaEspaciadoOutput struct {
 	TamanoPulgadas   string  `json:"tamano_pulgadas"`
 	AnchoRequerido   float64 `json:"ancho_requerido_mm"`
 	AnchoComercialMM float64 `json:"ancho_comercial_mm"`
+	TipoCharola      string  `json:"tipo_charola"`
+	PeralteMM        float64 `json:"peralte_mm,omitempty"`
+
+	// Verificación de llenado NOM 392-22 del tamaño seleccionado
+	Llenado LlenadoCharola `json:"llenado"`
 
 	// Valores intermedios del desarrollo — para mostrar en memoria de cálculo
 	DiametroFaseMM    float64  `json:"diametro_fase_mm"`
 	DiametroTierraMM  float64  `json:"diametro_tierra_mm"`
 	DiametroControlMM *float64 `json:"diametro_control_mm,omitempty"`
+	DiametroNeutroMM  float64  `json:"diametro_neutro_mm,omitempty"`
 	NumHilosTotal     int      `json:"num_hilos_total"`
 	EspacioFuerzaMM   float64  `json:"espacio_fuerza_mm"`
 	AnchoFuerzaMM     float64  `json:"ancho_fuerza_mm"`
@@ -49,3 +72,13 @@ type CharolaEspaciadoOutput struct {
 	AnchoTierraMM     float64  `json:"ancho_tierra_mm"`
 	FactorControl     float64  `json:"factor_control"`
 }
+
+// LlenadoCharola es la verificación de llenado NOM 392-22 de la charola seleccionada.
+type LlenadoCharola struct {
+	AnchoUtilMM                      float64 `json:"ancho_util_mm"`
+	SumaDiametrosMM                  float64 `json:"suma_diametros_mm"`
+	AreaMonoconductoresMM2           float64 `json:"area_monoconductores_mm2,omitempty"`
+	AreaMonoconductoresPermitidaMM2  float64 `json:"area_monoconductores_permitida_mm2,omitempty"`
+	AreaMulticonductoresMM2          float64 `json:"area_multiconductores_mm2,omitempty"`
+	AreaMulticonductoresPermitidaMM2 float64 `json:"area_multiconductores_permitida_mm2,omitempty"`
+}
@@ -1,7 +1,11 @@
 // internal/calculos/application/dto/charola_triangular.go
 package dto
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+)
 
 // CharolaTriangularInput representa los datos de entrada para calcular charola con configuración triangular.
 type CharolaTriangularInput struct {
@@ -9,6 +13,9 @@ type CharolaTriangularInput struct {
 	DiametroFaseMM    float64  `json:"diametro_fase_mm"`
 	DiametroTierraMM  float64  `json:"diametro_tierra_mm"`
 	DiametroControlMM *float64 `json:"diametro_control_mm,omitempty"`
+	SeccionFaseMM2    float64  `json:"seccion_fase_mm2,omitempty"` // 0 = desconocida (sin regla por área ≥ 1000 kcmil)
+	TipoCharola       string   `json:"tipo_charola,omitempty"`     // ESCALERA (default), FONDO_SOLIDO, CANAL
+	PeralteMM         float64  `json:"peralte_mm,omitempty"`       // 0 = 101.6 mm (4")
 }
 
 // Validate valida los campos de entrada.
@@ -25,6 +32,12 @@ func (i CharolaTriangularInput) Validate() error {
 	if i.DiametroControlMM != nil && *i.DiametroControlMM <= 0 {
 		return fmt.Errorf("diametro_control_mm debe ser mayor que cero si se proporciona")
 	}
+	if i.SeccionFaseMM2 < 0 {
+		return fmt.Errorf("seccion_fase_mm2 no puede ser negativa")
+	}
+	if _, err := entity.NewEspecificacionCharola(i.TipoCharola, i.PeralteMM); err != nil {
+		return fmt.Errorf("tipo_charola/peralte_mm: %w", err)
+	}
 	return nil
 }
 
@@ -35,6 +48,11 @@ type CharolaTriangularOutput struct {
 	TamanoPulgadas   string  `json:"tamano_pulgadas"`
 	AnchoRequerido   float64 `json:"ancho_requerido_mm"`
 	AnchoComercialMM float64 `json:"ancho_comercial_mm"`
+	TipoCharola      string  `json:"tipo_charola"`
+	PeralteMM        float64 `json:"peralte_mm,omitempty"`
+
+	// Verificación de llenado NOM 392-22 del tamaño seleccionado
+	Llenado LlenadoCharola `json:"llenado"`
 
 	// Valores intermedios del desarrollo — para mostrar en memoria de cálculo
 	DiametroFaseMM    float64  `json:"diametro_fase_mm"`
@@ -0,0 +1,86 @@
+// internal/calculos/application/dto/cortocircuito.go
+package dto
+
+import "fmt"
+
+// CortocircuitoInput contiene los datos para calcular la corriente de cortocircuito
+// en el extremo de carga de un circuito. La fuente se define con los MVA de
+// cortocircuito de la red, con kVA y %Z del transformador, o con ambos en serie.
+type CortocircuitoInput struct {
+	CalibreFase              string  `json:"calibre_fase" binding:"required"`
+	CalibreTierra            string  `json:"calibre_tierra"`   // retorno de la falla; vacío = mismo calibre que fase
+	NumHilosTierra           int     `json:"num_hilos_tierra"` // default: 1
+	Material                 string  `json:"material"`         // "Cu" o "Al"; default: Cu
+	TipoCanalizacion         string  `json:"tipo_canalizacion" binding:"required"`
+	SistemaElectrico         string  `json:"sistema_electrico" binding:"required"`
+	TipoVoltaje              string  `json:"tipo_voltaje" binding:"required"`
+	Tension                  float64 `json:"tension" binding:"required,gt=0"`
+	LongitudCircuito         float64 `json:"longitud_circuito" binding:"required,gt=0"`
+	HilosPorFase             int     `json:"hilos_por_fase"` // default: 1
+	PotenciaCortocircuitoMVA float64 `json:"potencia_cortocircuito_mva"`
+	TransformadorKVA         float64 `json:"transformador_kva"`
+	TransformadorZPorcentaje float64 `json:"transformador_z_porcentaje"`
+}
+
+// Validate verifica los campos requeridos.
+func (i CortocircuitoInput) Validate() error {
+	if i.CalibreFase == "" {
+		return fmt.Errorf("%w: calibre_fase requerido", ErrEquipoInputInvalido)
+	}
+	if i.Tension <= 0 {
+		return fmt.Errorf("%w: tension debe ser mayor que cero", ErrEquipoInputInvalido)
+	}
+	if i.LongitudCircuito <= 0 {
+		return fmt.Errorf("%w: longitud_circuito debe ser mayor que cero", ErrEquipoInputInvalido)
+	}
+	if i.HilosPorFase < 0 || i.NumHilosTierra < 0 {
+		return fmt.Errorf("%w: hilos_por_fase y num_hilos_tierra no pueden ser negativos", ErrEquipoInputInvalido)
+	}
+	if i.PotenciaCortocircuitoMVA <= 0 && i.TransformadorKVA <= 0 {
+		return fmt.Errorf("%w: potencia_cortocircuito_mva o transformador_kva requerido", ErrFuenteCortocircuitoInvalida)
+	}
+	return nil
+}
+
+// ResultadoCortocircuito es el resultado del cálculo de corriente de cortocircuito.
+type ResultadoCortocircuito struct {
+	PotenciaCortocircuitoMVA float64 `json:"potencia_cortocircuito_mva,omitempty"`
+	TransformadorKVA         float64 `json:"transformador_kva,omitempty"`
+	TransformadorZPorcentaje float64 `json:"transformador_z_porcentaje,omitempty"`
+	CalibreFase              string  `json:"calibre_fase"`
+	CalibreTierra            string  `json:"calibre_tierra"`
+	LongitudCircuito         float64 `json:"longitud_circuito"`
+
+	TensionFaseFase          float64 `json:"tension_fase_fase"`
+	ImpedanciaFuenteOhm      float64 `json:"impedancia_fuente_ohm"`
+	ResistenciaConductorOhm  float64 `json:"resistencia_conductor_ohm"`
+	ReactanciaConductorOhm   float64 `json:"reactancia_conductor_ohm"`
+	ImpedanciaTrifasicaOhm   float64 `json:"impedancia_trifasica_ohm"`
+	ImpedanciaLineaTierraOhm float64 `json:"impedancia_linea_tierra_ohm"`
+	IccBornesKA              float64 `json:"icc_bornes_ka"`
+	IccTrifasicaKA           float64 `json:"icc_trifasica_ka"`
+	IccLineaTierraKA         float64 `json:"icc_linea_tierra_ka"`
+}
+
+// ResultadoSoporteTermico es el resultado de la verificación I²t de un conductor.
+type ResultadoSoporteTermico struct {
+	CalibreOriginal      string  `json:"calibre_original"`
+	CalibreSeleccionado  string  `json:"calibre_seleccionado"`
+	SeccionMM2           float64 `json:"seccion_mm2"`
+	SeccionMinimaMM2     float64 `json:"seccion_minima_mm2"`
+	CorrienteFallaKA     float64 `json:"corriente_falla_ka"`
+	CorrienteSoportadaKA float64 `json:"corriente_soportada_ka"`
+	TiempoLiberacion     float64 `json:"tiempo_liberacion"`
+	TemperaturaInicial   float64 `json:"temperatura_inicial"`
+	TemperaturaMaxima    float64 `json:"temperatura_maxima"`
+	// Cumple indica si se encontró un calibre que soporta la falla. False si se agotaron los intentos.
+	Cumple bool `json:"cumple"`
+	// Nota describe el aumento: "Calibre aumentado de X a Y por soporte térmico al cortocircuito (I²t)"
+	Nota string `json:"nota,omitempty"`
+}
+
+// DatosSoporteCortocircuito agrupa la verificación I²t de los conductores del circuito.
+type DatosSoporteCortocircuito struct {
+	Fase   ResultadoSoporteTermico `json:"fase"`
+	Tierra ResultadoSoporteTermico `json:"tierra"`
+}
@@ -0,0 +1,12 @@
+// internal/calculos/application/dto/diagrama_dxf.go
+package dto
+
+// DiagramaDXF es el diagrama de canalización de un circuito listo para AutoCAD.
+type DiagramaDXF struct {
+	// Clave del equipo, usada para nombrar el archivo.
+	Clave string `json:"clave"`
+	// TipoCanalizacion indica si el dibujo es de charola o de tubería.
+	TipoCanalizacion string `json:"tipo_canalizacion"`
+	// Contenido es el archivo DXF (R12, ASCII).
+	Contenido string `json:"contenido"`
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/service"
 	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
 )
 
@@ -75,7 +76,7 @@ func (d DatosEquipo) ToTipoEquipo() (entity.TipoEquipo, error) {
 // EquipoInput contiene todos los datos necesarios para calcular una memoria.
 type EquipoInput struct {
 	// Modo indica cómo se proporcionan los datos del equipo
-	Modo ModoCalculo
+	Modo ModoCalculo `json:"modo"`
 
 	// ═══════════════════════════════════════════════════════════════════════
 	// DATOS DEL EQUIPO
@@ -83,31 +84,61 @@ type EquipoInput struct {
 	// LISTADO: El frontend envía DatosEquipo tal cual de GET /equipos
 	// MANUAL_AMPERAJE: Solo se usa TipoEquipo y AmperajeNominal
 	// MANUAL_POTENCIA: Se usa TipoEquipo, PotenciaNominal, PotenciaUnidad, FactorPotencia
-	Equipo          DatosEquipo // Datos del equipo (LISTADO)
-	TipoEquipo      string      // MANUAL_*: FILTRO_ACTIVO, TRANSFORMADOR, FILTRO_RECHAZO, CARGA
-	AmperajeNominal float64     // MANUAL_AMPERAJE: amperaje directo
-	PotenciaNominal float64     // MANUAL_POTENCIA: valor de potencia
-	PotenciaUnidad  string      // MANUAL_POTENCIA: W, KW, KVA, KVAR
-	FactorPotencia  float64     // MANUAL_POTENCIA: solo para CARGA
+	Equipo           DatosEquipo `json:"equipo"`                      // Datos del equipo (LISTADO)
+	TipoEquipo       string      `json:"tipo_equipo"`                 // MANUAL_*: FILTRO_ACTIVO, TRANSFORMADOR, FILTRO_RECHAZO, CARGA, MOTOR, BANCO_CAPACITORES
+	AmperajeNominal  float64     `json:"amperaje_nominal"`            // MANUAL_AMPERAJE: amperaje directo
+	PotenciaNominal  float64     `json:"potencia_nominal"`            // MANUAL_POTENCIA: valor de potencia
+	PotenciaUnidad   string      `json:"potencia_unidad"`             // MANUAL_POTENCIA: W, KW, KVA, KVAR, HP (MOTOR: HP o KW)
+	FactorPotencia   float64     `json:"factor_potencia"`             // MANUAL_POTENCIA: solo para CARGA
+	PasosCapacitores int         `json:"pasos_capacitores,omitempty"` // BANCO_CAPACITORES: pasos del controlador; default: 1
 
 	// ═══════════════════════════════════════════════════════════════════════
 	// DATOS DE INSTALACIÓN (comunes a todos los modos)
 	// ═══════════════════════════════════════════════════════════════════════
-	Tension               float64  // Voltaje de referencia para cálculos
-	TensionUnidad         string   `json:"tension_unidad"` // "V" o "kV" (default: "V")
-	TipoCanalizacion      string   // "TUBERIA_PVC", "CHAROLA_CABLE_ESPACIADO", etc.
-	TemperaturaOverride   *int     // nil = usar lógica por defecto
-	HilosPorFase          int      // default: 1
-	NumTuberias           int      // default: 1
-	Material              string   // "Cu" o "Al"; default: Cu
-	LongitudCircuito      float64  // metros
-	PorcentajeCaidaMaximo float64  // default: 3.0%
-	DiametroControlMM     *float64 // opcional, para cables de control en charola
+	Tension               float64  `json:"tension"`                        // Voltaje de referencia para cálculos
+	TensionUnidad         string   `json:"tension_unidad"`                 // "V" o "kV" (default: "V")
+	TipoCanalizacion      string   `json:"tipo_canalizacion"`              // "TUBERIA_PVC", "CHAROLA_CABLE_ESPACIADO", etc.
+	TemperaturaOverride   *int     `json:"temperatura_override,omitempty"` // nil = usar lógica por defecto
+	HilosPorFase          int      `json:"hilos_por_fase"`                 // default: 1
+	NumTuberias           int      `json:"num_tuberias"`                   // default: 1
+	Material              string   `json:"material"`                       // "Cu" o "Al"; default: Cu
+	TipoAislamiento       string   `json:"tipo_aislamiento"`               // THW, THHN, XHHW, RHH, USE-2; default: THW
+	LongitudCircuito      float64  `json:"longitud_circuito"`              // metros
+	PorcentajeCaidaMaximo float64  `json:"porcentaje_caida_maximo"`        // default: 3.0%
+	DiametroControlMM     *float64 `json:"diametro_control_mm,omitempty"`  // opcional, para cables de control en charola
+	TipoCharola           string   `json:"tipo_charola,omitempty"`         // ESCALERA, FONDO_SOLIDO, CANAL; default: ESCALERA
+	PeralteCharolaMM      float64  `json:"peralte_charola_mm,omitempty"`   // 76.2, 101.6 o 152.4; default: 101.6
+	TipoCable             string   `json:"tipo_cable,omitempty"`           // TC o MC (multiconductor); vacío = monoconductores
+
+	// Caída de tensión acumulada (alimentador + derivado, NOM-001-SEDE 215-2(A))
+	CaidaTensionAlimentador    float64 `json:"caida_tension_alimentador,omitempty"`     // % acumulado acometida → tablero que alimenta este circuito
+	PorcentajeCaidaTotalMaximo float64 `json:"porcentaje_caida_total_maximo,omitempty"` // default: 5.0%
+
+	// Cortocircuito (opcional): si se define la fuente se calcula Icc en el extremo de carga
+	PotenciaCortocircuitoMVA float64 `json:"potencia_cortocircuito_mva,omitempty"` // MVAcc de la red
+	TransformadorKVA         float64 `json:"transformador_kva,omitempty"`          // kVA del transformador
+	TransformadorZPorcentaje float64 `json:"transformador_z_porcentaje,omitempty"` // %Z del transformador
+	TiempoLiberacionFalla    float64 `json:"tiempo_liberacion_falla,omitempty"`    // s; default: 0.05 (3 ciclos)
+
+	// Armónicos (opcional): THDi total o porcentaje por orden (los órdenes tienen prioridad)
+	THDi             float64         `json:"thd_i,omitempty"`             // % de la fundamental
+	EspectroArmonico map[int]float64 `json:"espectro_armonico,omitempty"` // orden → % de la fundamental
+
+	// Neutro (opcional): desbalance de carga entre fases [%]; nil = neutro igual a la fase
+	DesbalanceCarga *float64 `json:"desbalance_carga,omitempty"`
+
+	// Lista de materiales (opcional): desperdicio sobre materiales lineales [%]; nil = 5%
+	DesperdicioPorcentaje *float64 `json:"desperdicio_porcentaje,omitempty"`
+
+	// Instalación subterránea (DUCTO_SUBTERRANEO, DIRECTAMENTE_ENTERRADO); 0/nil = condiciones del Anexo B
+	ResistividadTermicaSuelo   float64 `json:"resistividad_termica_suelo,omitempty"`   // °C·cm/W; default: 90
+	ProfundidadEnterramientoMM float64 `json:"profundidad_enterramiento_mm,omitempty"` // mm; default: 750
+	TemperaturaTerreno         *int    `json:"temperatura_terreno,omitempty"`          // °C; default: 20
 
 	// Sistema eléctrico
-	SistemaElectrico SistemaElectrico
-	Estado           string
-	TipoVoltaje      string // "FASE_NEUTRO" o "FASE_FASE"
+	SistemaElectrico SistemaElectrico `json:"sistema_electrico"`
+	Estado           string           `json:"estado"`
+	TipoVoltaje      string           `json:"tipo_voltaje"` // "FASE_NEUTRO" o "FASE_FASE"
 }
 
 // Validate verifica que el input tenga los campos requeridos según el modo.
@@ -204,11 +235,90 @@ func (e EquipoInput) ValidateForMemoria() error {
 		return fmt.Errorf("%w: porcentaje_caida_maximo no puede ser negativo", ErrEquipoInputInvalido)
 	}
 
+	// Validate caída acumulada
+	if e.CaidaTensionAlimentador < 0 {
+		return fmt.Errorf("%w: caida_tension_alimentador no puede ser negativa", ErrEquipoInputInvalido)
+	}
+	if e.PorcentajeCaidaTotalMaximo < 0 {
+		return fmt.Errorf("%w: porcentaje_caida_total_maximo no puede ser negativo", ErrEquipoInputInvalido)
+	}
+
+	// Validate fuente de cortocircuito (opcional)
+	if e.PotenciaCortocircuitoMVA < 0 || e.TransformadorKVA < 0 || e.TransformadorZPorcentaje < 0 {
+		return fmt.Errorf("%w: datos de cortocircuito no pueden ser negativos", ErrEquipoInputInvalido)
+	}
+	if e.TransformadorKVA > 0 && e.TransformadorZPorcentaje <= 0 {
+		return fmt.Errorf("%w: transformador_z_porcentaje requerido cuando se indica transformador_kva", ErrEquipoInputInvalido)
+	}
+	if e.TiempoLiberacionFalla < 0 {
+		return fmt.Errorf("%w: tiempo_liberacion_falla no puede ser negativo", ErrEquipoInputInvalido)
+	}
+
+	// Validate espectro armónico (opcional)
+	if _, err := e.ToDomainEspectroArmonico(); err != nil {
+		return err
+	}
+
+	// Validate desbalance de carga (opcional)
+	if e.DesbalanceCarga != nil && (*e.DesbalanceCarga < 0 || *e.DesbalanceCarga > 100) {
+		return fmt.Errorf("%w: desbalance_carga debe estar entre 0 y 100", ErrEquipoInputInvalido)
+	}
+
+	// Validate aislamiento: la columna de temperatura no puede exceder su rating
+	aislamiento, err := e.ToDomainTipoAislamiento()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrEquipoInputInvalido, err)
+	}
+	if e.TemperaturaOverride != nil && *e.TemperaturaOverride > aislamiento.TemperaturaMaxima().Valor() {
+		return fmt.Errorf("%w: temperatura_override de %d °C excede el rating del aislamiento %s (%d °C)",
+			ErrEquipoInputInvalido, *e.TemperaturaOverride, aislamiento, aislamiento.TemperaturaMaxima().Valor())
+	}
+
+	// Validate instalación subterránea: profundidad mínima (Tabla 300-5) y un
+	// circuito completo por ducto del banco
+	if _, err := e.ToDomainCondicionesSubterraneas(); err != nil {
+		return fmt.Errorf("%w: %v", ErrEquipoInputInvalido, err)
+	}
+	if tipoCanalizacion == entity.TipoCanalizacionDuctoSubterraneo && e.NumTuberias > 0 && e.NumTuberias != max(e.HilosPorFase, 1) {
+		return fmt.Errorf("%w: en ducto subterráneo cada ducto lleva un circuito completo; num_tuberias (%d) debe ser igual a hilos_por_fase (%d)",
+			ErrEquipoInputInvalido, e.NumTuberias, max(e.HilosPorFase, 1))
+	}
+	if tipoCanalizacion == entity.TipoCanalizacionDirectamenteEnterrado && e.NumTuberias > 1 {
+		return fmt.Errorf("%w: num_tuberias no aplica a cables directamente enterrados", ErrEquipoInputInvalido)
+	}
+
+	// Validate tipo y peralte de charola (NOM 392-22)
+	if tipoCanalizacion.EsCharola() {
+		if _, err := e.ToDomainEspecificacionCharola(); err != nil {
+			return fmt.Errorf("%w: %v", ErrEquipoInputInvalido, err)
+		}
+	}
+
+	// Validate cable multiconductor (opcional)
+	if _, err := e.ToDomainTipoCable(); err != nil {
+		return fmt.Errorf("%w: %v", ErrEquipoInputInvalido, err)
+	}
+
+	// Validate desperdicio de materiales (opcional)
+	if e.DesperdicioPorcentaje != nil && (*e.DesperdicioPorcentaje < 0 || *e.DesperdicioPorcentaje > 100) {
+		return fmt.Errorf("%w: desperdicio_porcentaje debe estar entre 0 y 100", ErrEquipoInputInvalido)
+	}
+
+	// Validate ITM (opcional en MANUAL_*: si se omite se propone automáticamente)
+	if e.Equipo.ITM < 0 {
+		return fmt.Errorf("%w: itm no puede ser negativo", ErrEquipoInputInvalido)
+	}
+
 	// Validate HilosPorFase
 	if e.HilosPorFase < 0 {
 		return fmt.Errorf("%w: hilos_por_fase no puede ser negativo", ErrEquipoInputInvalido)
 	}
 
+	// Validate PasosCapacitores
+	if e.PasosCapacitores < 0 {
+		return fmt.Errorf("%w: pasos_capacitores no puede ser negativo", ErrEquipoInputInvalido)
+	}
+
 	// Validate NumTuberias
 	if e.NumTuberias < 0 {
 		return fmt.Errorf("%w: num_tuberias no puede ser negativo", ErrEquipoInputInvalido)
@@ -229,13 +339,29 @@ func (e *EquipoInput) ApplyDefaults() {
 	}
 	if e.NumTuberias <= 0 {
 		e.NumTuberias = 1
+		// Banco de ductos: un ducto por circuito completo
+		if e.ToEntityTipoCanalizacion() == entity.TipoCanalizacionDuctoSubterraneo {
+			e.NumTuberias = e.HilosPorFase
+		}
+	}
+	if e.PasosCapacitores <= 0 {
+		e.PasosCapacitores = 1
 	}
 	if e.PorcentajeCaidaMaximo <= 0 {
 		e.PorcentajeCaidaMaximo = 3.0
 	}
+	if e.PorcentajeCaidaTotalMaximo <= 0 {
+		e.PorcentajeCaidaTotalMaximo = 5.0
+	}
+	if e.TiempoLiberacionFalla <= 0 {
+		e.TiempoLiberacionFalla = service.TiempoLiberacionFallaDefault
+	}
 	if e.Material == "" {
 		e.Material = "Cu"
 	}
+	if e.TipoAislamiento == "" {
+		e.TipoAislamiento = valueobject.AislamientoDefault.String()
+	}
 	if e.Modo == ModoManualPotencia && e.PotenciaUnidad == "" {
 		e.PotenciaUnidad = "KW"
 	}
@@ -247,6 +373,68 @@ func (e *EquipoInput) ApplyDefaults() {
 	}
 }
 
+// TieneFuenteCortocircuito indica si se proporcionaron datos para calcular cortocircuito.
+func (e EquipoInput) TieneFuenteCortocircuito() bool {
+	return e.PotenciaCortocircuitoMVA > 0 || e.TransformadorKVA > 0
+}
+
+// GetDesperdicioPorcentaje retorna el desperdicio de materiales; default 5%.
+func (e EquipoInput) GetDesperdicioPorcentaje() float64 {
+	if e.DesperdicioPorcentaje == nil {
+		return DesperdicioPorcentajeDefault
+	}
+	return *e.DesperdicioPorcentaje
+}
+
+// ToDomainEspectroArmonico construye el espectro armónico del dominio.
+// Retorna nil cuando no se proporcionó contenido armónico.
+func (e EquipoInput) ToDomainEspectroArmonico() (*entity.EspectroArmonico, error) {
+	espectro, err := entity.NewEspectroArmonico(e.THDi, e.EspectroArmonico)
+	if err != nil {
+		return nil, err
+	}
+	if !espectro.TieneContenido() {
+		return nil, nil
+	}
+	return &espectro, nil
+}
+
+// ToDomainCondicionesSubterraneas construye las condiciones del terreno para
+// instalaciones subterráneas. Retorna nil cuando la canalización no es subterránea.
+func (e EquipoInput) ToDomainCondicionesSubterraneas() (*entity.CondicionesSubterraneas, error) {
+	tipo := e.ToEntityTipoCanalizacion()
+	if !tipo.EsSubterranea() {
+		return nil, nil
+	}
+	condiciones, err := entity.NewCondicionesSubterraneas(tipo, e.ResistividadTermicaSuelo, e.ProfundidadEnterramientoMM, e.TemperaturaTerreno)
+	if err != nil {
+		return nil, err
+	}
+	return &condiciones, nil
+}
+
+// ToDomainEspecificacionCharola construye el tipo y peralte de la charola.
+func (e EquipoInput) ToDomainEspecificacionCharola() (entity.EspecificacionCharola, error) {
+	return entity.NewEspecificacionCharola(e.TipoCharola, e.PeralteCharolaMM)
+}
+
+// ToDomainTipoCable convierte el tipo de cable multiconductor; nil = monoconductores.
+func (e EquipoInput) ToDomainTipoCable() (*valueobject.TipoCableMulticonductor, error) {
+	if e.TipoCable == "" {
+		return nil, nil
+	}
+	tipo, err := valueobject.ParseTipoCableMulticonductor(e.TipoCable)
+	if err != nil {
+		return nil, err
+	}
+	return &tipo, nil
+}
+
+// EsMulticonductor indica si el circuito usa cable multiconductor (TC/MC).
+func (e EquipoInput) EsMulticonductor() bool {
+	return e.TipoCable != ""
+}
+
 // GetTipoEquipo retorna el TipoEquipo según el modo.
 func (e EquipoInput) GetTipoEquipo() (entity.TipoEquipo, error) {
 	switch e.Modo {
@@ -323,6 +511,14 @@ func (e EquipoInput) ToDomainMaterial() (valueobject.MaterialConductor, error) {
 	return valueobject.ParseMaterialConductor(e.Material)
 }
 
+// ToDomainTipoAislamiento convierte el string a valueobject.TipoAislamiento; vacío = THW.
+func (e EquipoInput) ToDomainTipoAislamiento() (valueobject.TipoAislamiento, error) {
+	if e.TipoAislamiento == "" {
+		return valueobject.AislamientoDefault, nil
+	}
+	return valueobject.ParseTipoAislamiento(e.TipoAislamiento)
+}
+
 // ToDomainTipoVoltaje convierte el string a entity.TipoVoltaje.
 func (e EquipoInput) ToDomainTipoVoltaje() (entity.TipoVoltaje, error) {
 	return entity.ParseTipoVoltaje(e.TipoVoltaje)
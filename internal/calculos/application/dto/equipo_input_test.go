@@ -6,7 +6,9 @@ import (
 
 	"github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
 	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func tension220(t *testing.T) float64 {
@@ -198,3 +200,94 @@ func TestEquipoInput_GetTipoEquipo_ModoManual(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, entity.TipoEquipoFiltroActivo, tipo)
 }
+
+// --- TipoAislamiento ---
+
+func inputBaseMemoria(t *testing.T) dto.EquipoInput {
+	t.Helper()
+	input := inputBaseManualAmperaje(t)
+	input.TipoCanalizacion = "TUBERIA_PVC"
+	input.LongitudCircuito = 30
+	input.TipoVoltaje = "FASE_FASE"
+	return input
+}
+
+func TestEquipoInput_ValidateForMemoria_TipoAislamientoInvalido(t *testing.T) {
+	input := inputBaseMemoria(t)
+	input.TipoAislamiento = "TW"
+	err := input.ValidateForMemoria()
+	assert.ErrorIs(t, err, dto.ErrEquipoInputInvalido)
+}
+
+func TestEquipoInput_ValidateForMemoria_OverrideExcedeAislamiento(t *testing.T) {
+	input := inputBaseMemoria(t)
+	override := 90
+	input.TemperaturaOverride = &override
+
+	// THW (default) es 75°C → 90°C rechazado
+	assert.ErrorIs(t, input.ValidateForMemoria(), dto.ErrEquipoInputInvalido)
+
+	// THHN es 90°C → aceptado
+	input.TipoAislamiento = "THHN"
+	assert.NoError(t, input.ValidateForMemoria())
+}
+
+func TestEquipoInput_ValidateForMemoria_DuctoSubterraneo(t *testing.T) {
+	input := inputBaseMemoria(t)
+	input.TipoCanalizacion = "DUCTO_SUBTERRANEO"
+	input.HilosPorFase = 2
+	input.ApplyDefaults()
+
+	// Un ducto por circuito completo: num_tuberias toma hilos_por_fase
+	assert.Equal(t, 2, input.NumTuberias)
+	assert.NoError(t, input.ValidateForMemoria())
+
+	input.NumTuberias = 1
+	assert.ErrorIs(t, input.ValidateForMemoria(), dto.ErrEquipoInputInvalido)
+}
+
+func TestEquipoInput_ValidateForMemoria_ProfundidadMinima(t *testing.T) {
+	input := inputBaseMemoria(t)
+	input.TipoCanalizacion = "DIRECTAMENTE_ENTERRADO"
+	input.ProfundidadEnterramientoMM = 450 // Tabla 300-5: mínimo 600 mm
+
+	assert.ErrorIs(t, input.ValidateForMemoria(), dto.ErrEquipoInputInvalido)
+
+	input.ProfundidadEnterramientoMM = 600
+	assert.NoError(t, input.ValidateForMemoria())
+}
+
+func TestEquipoInput_ToDomainCondicionesSubterraneas(t *testing.T) {
+	input := inputBaseMemoria(t)
+
+	condiciones, err := input.ToDomainCondicionesSubterraneas()
+	assert.NoError(t, err)
+	assert.Nil(t, condiciones)
+
+	input.TipoCanalizacion = "DUCTO_SUBTERRANEO"
+	input.ResistividadTermicaSuelo = 120
+	condiciones, err = input.ToDomainCondicionesSubterraneas()
+	assert.NoError(t, err)
+	if assert.NotNil(t, condiciones) {
+		assert.Equal(t, 120.0, condiciones.ResistividadTermica)
+		assert.Equal(t, 750.0, condiciones.ProfundidadMM)
+		assert.Equal(t, 20, condiciones.TemperaturaTerreno)
+	}
+}
+
+// --- Cable multiconductor ---
+
+func TestEquipoInput_ValidateForMemoria_TipoCable(t *testing.T) {
+	input := inputBaseMemoria(t)
+	assert.False(t, input.EsMulticonductor())
+
+	input.TipoCable = "tc-er"
+	require.NoError(t, input.ValidateForMemoria())
+	tipo, err := input.ToDomainTipoCable()
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.CableTC, *tipo)
+	assert.True(t, input.EsMulticonductor())
+
+	input.TipoCable = "AC"
+	assert.ErrorIs(t, input.ValidateForMemoria(), dto.ErrEquipoInputInvalido)
+}
@@ -4,6 +4,7 @@ package dto
 import (
 	"errors"
 
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
 	"github.com/garfex/calculadora-filtros/internal/calculos/domain/service"
 )
 
@@ -12,6 +13,18 @@ var (
 	ErrEquipoInputInvalido = errors.New("datos de equipo inválidos")
 	ErrModoInvalido        = errors.New("modo de cálculo inválido")
 	ErrEquipoNoEncontrado  = errors.New("equipo no encontrado")
+
+	ErrOptimizacionInvalida        = errors.New("parámetros de optimización inválidos")
+	ErrCatalogoPreciosNoDisponible = errors.New("catálogo de precios no disponible")
+	ErrCatalogoPreciosInvalido     = errors.New("catálogo de precios inválido")
+	ErrDiagramaNoDisponible        = errors.New("diagrama de canalización no disponible")
+)
+
+// Re-exportar errores de domain/entity.
+var (
+	ErrProyectoInvalido    = entity.ErrProyectoInvalido
+	ErrPrecioNoEncontrado  = entity.ErrPrecioNoEncontrado
+	ErrRutaTuberiaInvalida = entity.ErrRutaTuberiaInvalida
 )
 
 // Re-exportar errores de domain/service para que presentation no importe domain directamente.
@@ -22,4 +35,10 @@ var (
 	ErrDistanciaInvalida        = service.ErrDistanciaInvalida
 	ErrHilosPorFaseInvalido     = service.ErrHilosPorFaseInvalido
 	ErrFactorPotenciaInvalido   = service.ErrFactorPotenciaInvalido
+
+	ErrFuenteCortocircuitoInvalida = service.ErrFuenteCortocircuitoInvalida
+	ErrSoporteTermicoInvalido      = service.ErrSoporteTermicoInvalido
+	ErrMotorNoEncontrado           = service.ErrMotorNoEncontrado
+	ErrFactorKInvalido             = service.ErrFactorKInvalido
+	ErrTensionJaladoInvalida       = service.ErrTensionJaladoInvalida
 )
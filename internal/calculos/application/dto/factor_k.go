@@ -0,0 +1,40 @@
+// internal/calculos/application/dto/factor_k.go
+package dto
+
+import "fmt"
+
+// FactorKInput contiene los datos para calcular el factor K (UL 1561 / IEEE C57.110)
+// de la carga no lineal que alimenta un transformador.
+type FactorKInput struct {
+	KVA              float64         `json:"kva" binding:"required,gt=0"`
+	EspectroArmonico map[int]float64 `json:"espectro_armonico" binding:"required"` // orden → [% de la fundamental]
+	// PerdidasCorrientesParasitas es P_EC-R [% de las pérdidas I²R]; default: 15.
+	PerdidasCorrientesParasitas float64 `json:"perdidas_corrientes_parasitas"`
+}
+
+// Validate verifica los campos requeridos.
+func (i FactorKInput) Validate() error {
+	if i.KVA <= 0 {
+		return fmt.Errorf("%w: kva debe ser mayor que cero", ErrEquipoInputInvalido)
+	}
+	if len(i.EspectroArmonico) == 0 {
+		return fmt.Errorf("%w: espectro_armonico requerido", ErrFactorKInvalido)
+	}
+	if i.PerdidasCorrientesParasitas < 0 {
+		return fmt.Errorf("%w: perdidas_corrientes_parasitas no puede ser negativo", ErrFactorKInvalido)
+	}
+	return nil
+}
+
+// ResultadoFactorK es el resultado del cálculo de factor K y capacidad derrateada.
+type ResultadoFactorK struct {
+	KVA                         float64 `json:"kva"`
+	THDi                        float64 `json:"thd_i"` // [%] calculado desde los órdenes
+	FactorK                     float64 `json:"factor_k"`
+	KNominal                    int     `json:"k_nominal"` // K-rating requerido; 0 si excede K-50
+	ExcedeNominal               bool    `json:"excede_nominal"`
+	FHL                         float64 `json:"fhl"`
+	PerdidasCorrientesParasitas float64 `json:"perdidas_corrientes_parasitas"` // [%]
+	CorrienteMaximaPU           float64 `json:"corriente_maxima_pu"`
+	KVADerrateado               float64 `json:"kva_derrateado"`
+}
@@ -0,0 +1,58 @@
+// internal/calculos/application/dto/lista_materiales.go
+package dto
+
+// DesperdicioPorcentajeDefault es el desperdicio aplicado a los materiales lineales si no se indica.
+const DesperdicioPorcentajeDefault = 5.0
+
+// Longitudes comerciales de tramo usadas para contar piezas de canalización.
+const (
+	LongitudTramoTuberiaM = 3.05 // tubo conduit de 10 ft
+	LongitudTramoCharolaM = 3.66 // charola de 12 ft (NEMA VE 1)
+)
+
+// Categorías de partida de la lista de materiales.
+const (
+	CategoriaConductor    = "CONDUCTOR"
+	CategoriaCanalizacion = "CANALIZACION"
+	CategoriaAccesorio    = "ACCESORIO"
+)
+
+// ListaMaterialesInput contiene el circuito y, opcionalmente, cómo valorizar la lista.
+type ListaMaterialesInput struct {
+	Input EquipoInput `json:"input"`
+
+	// Precios es un catálogo proporcionado en la petición; tiene prioridad sobre Valorizar.
+	Precios *CatalogoPreciosInput `json:"precios,omitempty"`
+	// Valorizar indica que se use el catálogo vigente persistido en la moneda indicada.
+	Valorizar bool   `json:"valorizar,omitempty"`
+	Moneda    string `json:"moneda,omitempty"` // default: MXN
+}
+
+// PartidaMaterial es un renglón de la lista de materiales.
+// PrecioUnitario e Importe son nil si la lista no se valorizó o el catálogo no tiene el material.
+type PartidaMaterial struct {
+	Partida        int      `json:"partida"`
+	Categoria      string   `json:"categoria"` // CONDUCTOR, CANALIZACION, ACCESORIO
+	Descripcion    string   `json:"descripcion"`
+	Detalle        string   `json:"detalle,omitempty"` // desarrollo de la cantidad
+	Cantidad       float64  `json:"cantidad"`
+	Unidad         string   `json:"unidad"` // "m" o "pza"
+	PrecioUnitario *float64 `json:"precio_unitario,omitempty"`
+	Importe        *float64 `json:"importe,omitempty"`
+}
+
+// ResultadoListaMateriales es la lista de materiales (BOM) derivada de una memoria de cálculo.
+//
+//	conductores = L × hilos × (fases + neutros) + L × hilos de tierra, más desperdicio
+//	tubería     = tramos de 3.05 m por tubo, coples entre tramos y 2 conectores por tubo
+//	charola     = tramos de 3.66 m y un juego de placas de unión entre tramos
+type ResultadoListaMateriales struct {
+	LongitudCircuito      float64           `json:"longitud_circuito"`      // [m]
+	DesperdicioPorcentaje float64           `json:"desperdicio_porcentaje"` // [%]
+	Partidas              []PartidaMaterial `json:"partidas"`
+
+	// Valorización (solo si se usó un catálogo de precios)
+	Moneda    string   `json:"moneda,omitempty"`
+	Total     float64  `json:"total,omitempty"`      // suma de las partidas con precio
+	SinPrecio []string `json:"sin_precio,omitempty"` // conductores y canalización sin precio en el catálogo
+}
@@ -49,6 +49,18 @@ type DatosDesarrolloCorriente struct {
 	ValoresReferencia map[string]string `json:"valores_referencia"`
 }
 
+// CableMulticonductor describe el cable multiconductor de fuerza (TC/MC) usado en lugar
+// de monoconductores. Dimensiones de la tabla de fabricante.
+type CableMulticonductor struct {
+	Tipo               string  `json:"tipo"` // TC o MC
+	Calibre            string  `json:"calibre"`
+	Conductores        int     `json:"conductores"` // conductores aislados, más la tierra de fábrica
+	DiametroExteriorMM float64 `json:"diametro_exterior_mm"`
+	AreaExteriorMM2    float64 `json:"area_exterior_mm2"`
+	NumCables          int     `json:"num_cables"` // cables en paralelo (= hilos por fase)
+	Designacion        string  `json:"designacion"`
+}
+
 // ResultadoConductor contiene la información del conductor seleccionado.
 type ResultadoConductor struct {
 	Calibre         string  `json:"calibre"`
@@ -65,6 +77,18 @@ type ResultadoConductor struct {
 	CalibreOriginalAmpacidad string `json:"calibre_original_ampacidad,omitempty"`
 	// NotaSeleccion explica el motivo del aumento de calibre
 	NotaSeleccion string `json:"nota_seleccion,omitempty"`
+
+	// Selección por soporte térmico al cortocircuito (I²t)
+	// SeleccionPorCortocircuito indica si el calibre fue aumentado para soportar la falla
+	SeleccionPorCortocircuito bool `json:"seleccion_por_cortocircuito"`
+	// NotaCortocircuito explica el motivo del aumento de calibre por I²t
+	NotaCortocircuito string `json:"nota_cortocircuito,omitempty"`
+
+	// Selección por coordinación con la protección (NOM 240-4)
+	// SeleccionPorProteccion indica si el calibre fue aumentado para quedar protegido por el ITM
+	SeleccionPorProteccion bool `json:"seleccion_por_proteccion"`
+	// NotaProteccion explica el motivo del aumento de calibre por coordinación
+	NotaProteccion string `json:"nota_proteccion,omitempty"`
 }
 
 // ResultadoConductores contiene los conductores seleccionados.
@@ -72,6 +96,28 @@ type ResultadoConductores struct {
 	Alimentacion ResultadoConductor
 	Tierra       ResultadoConductor
 	TablaUsada   string
+
+	// LimiteTerminal documenta el límite de 110-14(c); nil si el aislamiento no
+	// supera la temperatura de las terminales.
+	LimiteTerminal *ResultadoLimiteTerminal
+}
+
+// ResultadoLimiteTerminal documenta la selección de un conductor de 90 °C cuya
+// ampacidad se limita a la temperatura de las terminales (NOM 110-14(c)).
+// Las capacidades son por hilo.
+type ResultadoLimiteTerminal struct {
+	TemperaturaConductor int     `json:"temperatura_conductor"`
+	TemperaturaTerminal  int     `json:"temperatura_terminal"`
+	TablaTerminal        string  `json:"tabla_terminal"`
+	CapacidadConductor   float64 `json:"capacidad_conductor"`   // tabla en la columna del aislamiento
+	FactorCorreccion     float64 `json:"factor_correccion"`     // F_temperatura × F_agrupamiento de la columna del aislamiento
+	AmpacidadCorregida   float64 `json:"ampacidad_corregida"`   // CapacidadConductor × FactorCorreccion
+	CapacidadTerminal    float64 `json:"capacidad_terminal"`    // tabla en la columna de la terminal
+	AmpacidadFinal       float64 `json:"ampacidad_final"`       // mín(AmpacidadCorregida, CapacidadTerminal)
+	LimitadaPorTerminal  bool    `json:"limitada_por_terminal"` // AmpacidadFinal la define la terminal
+	// CalibreAumentado indica que la terminal obligó a un calibre mayor que el del derrateo a 90 °C
+	CalibreAumentado bool   `json:"calibre_aumentado"`
+	Nota             string `json:"nota,omitempty"`
 }
 
 // ResultadoConductorCaidaTension contiene el resultado de la selección de conductor
@@ -111,6 +157,16 @@ type DetalleCharola struct {
 	DiametroFaseMM    float64  `json:"diametro_fase_mm"`
 	DiametroTierraMM  float64  `json:"diametro_tierra_mm"`
 	DiametroControlMM *float64 `json:"diametro_control_mm,omitempty"`
+	DiametroNeutroMM  float64  `json:"diametro_neutro_mm,omitempty"` // 0 si el neutro es igual a la fase
+
+	// Tipo de charola y verificación de llenado NOM 392-22
+	TipoCharola string         `json:"tipo_charola"`
+	PeralteMM   float64        `json:"peralte_mm,omitempty"` // 0 en canal ventilado
+	Llenado     LlenadoCharola `json:"llenado"`
+
+	// Cables multiconductores (0 con monoconductores)
+	NumCables       int     `json:"num_cables,omitempty"`
+	DiametroCableMM float64 `json:"diametro_cable_mm,omitempty"`
 
 	// Charola espaciado
 	NumHilosTotal    int     `json:"num_hilos_total,omitempty"`
@@ -142,6 +198,9 @@ type DiagramaCharola struct {
 	Cotas []LineaCotaDTO `json:"cotas"`
 	// SVG es el string completo del SVG generado
 	SVG string `json:"svg"`
+	// DXF es el mismo diagrama en formato AutoCAD R12 (capas CANALIZACION,
+	// CONDUCTORES, ETIQUETAS y COTAS; unidades en mm)
+	DXF string `json:"dxf,omitempty"`
 }
 
 // ConductorPosicionDTO es la versión DTO de la posición de conductor para JSON.
@@ -186,6 +245,15 @@ type DetalleTuberia struct {
 	DesignacionMetrica   string  `json:"designacion_metrica"`     // ej: "63" → mostrar como "63 mm"
 	FillFactor           float64 `json:"fill_factor"`             // 0.40 para >2 conductores
 
+	// Relación de atascamiento con 3 conductores iguales por tubo (0 si no aplica)
+	// y nota cuando el tubo se aumentó para salir del rango 2.8 – 3.2.
+	RelacionAtascamiento float64 `json:"relacion_atascamiento,omitempty"`
+	Nota                 string  `json:"nota,omitempty"`
+
+	// Cable multiconductor: cada cable ocupa como un solo conductor (Cap. 10, Tabla 1, Nota 9)
+	NumCablesPorTubo    int                  `json:"num_cables_por_tubo,omitempty"`
+	CableMulticonductor *CableMulticonductor `json:"cable_multiconductor,omitempty"`
+
 	// Dimensiones físicas del tubo (para visualización SVG del diagrama de arreglo)
 	// Leídos de tuberia-pvc-dimensiones-fisicas.csv — referencia visual, no para cálculo NOM.
 	DiametroInteriorMM float64 `json:"diametro_interior_mm"`
@@ -208,6 +276,9 @@ type DiagramaTuberia struct {
 	ViewBox string `json:"viewBox"`
 	// SVG es el string completo del SVG generado
 	SVG string `json:"svg"`
+	// DXF es el mismo diagrama en formato AutoCAD R12 (capas CANALIZACION,
+	// CONDUCTORES, ETIQUETAS y COTAS; unidades en mm)
+	DXF string `json:"dxf,omitempty"`
 }
 
 // ResultadoCaidaTension contiene el resultado del cálculo de caída.
@@ -219,6 +290,12 @@ type ResultadoCaidaTension struct {
 	Impedancia       float64 `json:"impedancia"`
 	Resistencia      float64 `json:"resistencia"`
 	Reactancia       float64 `json:"reactancia"`
+
+	// Caída acumulada desde la acometida (alimentador + este circuito)
+	CaidaAlimentador      float64 `json:"caida_alimentador"`
+	PorcentajeAcumulado   float64 `json:"porcentaje_acumulado"`
+	LimiteTotalPorcentaje float64 `json:"limite_total_porcentaje"`
+	CumpleAcumulado       bool    `json:"cumple_acumulado"`
 }
 
 // EntradaDimensionarCanalizacion es el DTO de entrada para DimensionarCanalizacionUseCase.
@@ -240,11 +317,48 @@ type ResultadoAjusteCorriente struct {
 	ConductoresPorTubo       int     `json:"conductores_por_tubo"`
 	CantidadConductoresTotal int     `json:"cantidad_conductores_total"`
 	TemperaturaAmbiente      int     `json:"temperatura_ambiente"`
+
+	// Conductores portadores de corriente (310-15(b)(5)): base del factor de agrupamiento
+	NeutroPortador               bool `json:"neutro_portador"`
+	ConductoresPortadoresPorTubo int  `json:"conductores_portadores_por_tubo"`
+
+	// Ajuste por armónicos (opcional): 1.0 si no se proporcionó espectro
+	FactorArmonico float64             `json:"factor_armonico"`
+	Armonicos      *ResultadoArmonicos `json:"armonicos,omitempty"`
+
+	// Límite por terminales (NOM 110-14(c)): con aislamiento de 90 °C el derrateo parte
+	// de la columna de 90 °C (Temperatura) y la ampacidad final se limita a la columna
+	// de la terminal. CorrienteDiseno = I_nominal × F_uso × F_armónico, sin corrección.
+	TemperaturaTerminal int     `json:"temperatura_terminal"`
+	CorrienteDiseno     float64 `json:"corriente_diseno"`
+
+	// Instalación subterránea (Anexo B): nil en instalaciones sobre el terreno
+	Subterranea *ResultadoInstalacionSubterranea `json:"subterranea,omitempty"`
+}
+
+// ResultadoInstalacionSubterranea documenta la corrección de las tablas del Anexo B
+// (B-310-7 ducto subterráneo, B-310-10 directamente enterrado) a las condiciones
+// reales del terreno. El factor de temperatura usa la temperatura del terreno y el
+// de agrupamiento el número de circuitos adyacentes.
+type ResultadoInstalacionSubterranea struct {
+	ResistividadTermica float64 `json:"resistividad_termica"` // °C·cm/W
+	ProfundidadMM       float64 `json:"profundidad_mm"`
+	TemperaturaTerreno  int     `json:"temperatura_terreno"` // °C
+	NumeroCircuitos     int     `json:"numero_circuitos"`
+	FactorResistividad  float64 `json:"factor_resistividad"`
+	FactorProfundidad   float64 `json:"factor_profundidad"`
 }
 
 // ResultadoCorriente contains the result of the current calculation.
 type ResultadoCorriente struct {
 	CorrienteNominal float64 `json:"corriente_nominal"`
+
+	// Motor contiene el detalle de la Tabla 430-248/430-250; nil si el equipo no es motor
+	// o si la corriente se proporcionó directamente.
+	Motor *ResultadoMotor `json:"motor,omitempty"`
+
+	// BancoCapacitores contiene los datos del banco calculado desde kVAR; nil en otro caso.
+	BancoCapacitores *ResultadoBancoCapacitores `json:"banco_capacitores,omitempty"`
 }
 
 // ═══════════════════════════════════════════════════════════════════════════
@@ -270,6 +384,14 @@ type DatosInstalacion struct {
 	// Valor de entrada del usuario.
 	Material string `json:"material"`
 
+	// TipoAislamiento es el aislamiento de los conductores (THW, THHN, XHHW, RHH, USE-2).
+	// Valor de entrada del usuario (default: THW).
+	TipoAislamiento string `json:"tipo_aislamiento"`
+
+	// TipoCable es el tipo de cable multiconductor (TC o MC).
+	// Valor de entrada del usuario; vacío con monoconductores.
+	TipoCable string `json:"tipo_cable,omitempty"`
+
 	// LongitudCircuito es la longitud del circuito en metros.
 	// Valor de entrada del usuario.
 	LongitudCircuito float64 `json:"longitud_circuito"`
@@ -310,6 +432,13 @@ type DatosCorrientes struct {
 	// Calculado: FactorTemperatura × FactorAgrupamiento × FactorUso
 	FactorTotalAjuste float64 `json:"factor_total_ajuste"`
 
+	// FactorArmonico es el multiplicador por contenido armónico (I_rms y Anexo E).
+	// Es 0 si no se proporcionó espectro armónico.
+	FactorArmonico float64 `json:"factor_armonico,omitempty"`
+
+	// Armonicos contiene el detalle del ajuste por armónicos; nil si no aplica.
+	Armonicos *ResultadoArmonicos `json:"armonicos,omitempty"`
+
 	// TemperaturaAmbiente es la temperatura ambiente en grados Celsius.
 	// Valor de entrada del usuario (determinado por el estado de la República).
 	TemperaturaAmbiente int `json:"temperatura_ambiente"`
@@ -322,6 +451,13 @@ type DatosCorrientes struct {
 	// Valor de entrada del usuario o calculado según el sistema eléctrico.
 	ConductoresPorTubo int `json:"conductores_por_tubo"`
 
+	// ConductoresPortadores es el número de conductores portadores de corriente por tubo
+	// usado para el factor de agrupamiento; el neutro solo cuenta si es portador (310-15(b)(5)).
+	ConductoresPortadores int `json:"conductores_portadores"`
+
+	// NeutroPortador indica si el neutro se contó como conductor portador de corriente.
+	NeutroPortador bool `json:"neutro_portador"`
+
 	// CantidadConductores es el total de conductores en el sistema.
 	// Calculado según el sistema eléctrico (DELTA, ESTRELLA, etc.) y hilos por fase.
 	CantidadConductores int `json:"cantidad_conductores"`
@@ -329,6 +465,14 @@ type DatosCorrientes struct {
 	// TablaAmpacidadUsada es la tabla NOM utilizada para la selección de ampacidad.
 	// Ejemplo: "Tabla 310-16" o "Tabla 310-17".
 	TablaAmpacidadUsada string `json:"tabla_ampacidad_usada"`
+
+	// LimiteTerminal documenta el derrateo desde la columna de 90 °C limitado por la
+	// temperatura de las terminales (NOM 110-14(c)). nil si no aplica.
+	LimiteTerminal *ResultadoLimiteTerminal `json:"limite_terminal,omitempty"`
+
+	// Subterranea contiene los factores por resistividad térmica y profundidad de
+	// las instalaciones subterráneas. nil si la instalación es sobre el terreno.
+	Subterranea *ResultadoInstalacionSubterranea `json:"subterranea,omitempty"`
 }
 
 // DatosCanalizacionCompleta agrupa el resultado del dimensionamiento de canalización
@@ -353,8 +497,53 @@ type DatosCanalizacionCompleta struct {
 // DatosProteccion agrupa los datos de protección eléctrica del circuito.
 type DatosProteccion struct {
 	// ITM es el interruptor termomagnético en Amperes.
-	// Valor de entrada del usuario o obtenido del catálogo de equipos.
+	// Valor de entrada del usuario, obtenido del catálogo de equipos
+	// o propuesto automáticamente (ver SeleccionAutomatica).
 	ITM int `json:"itm"`
+
+	// SeleccionAutomatica indica que el ITM no fue proporcionado y se propuso
+	// a partir de la corriente nominal y el factor de uso.
+	SeleccionAutomatica bool `json:"seleccion_automatica"`
+
+	// Seleccion contiene el desarrollo de la propuesta. Es nil si el ITM lo dio el usuario.
+	Seleccion *ResultadoSeleccionITM `json:"seleccion,omitempty"`
+
+	// Coordinacion contiene la verificación conductor–ITM (NOM 240-4).
+	Coordinacion *ResultadoCoordinacionProteccion `json:"coordinacion,omitempty"`
+}
+
+// ResultadoCoordinacionProteccion contiene la verificación de que el ITM protege
+// al conductor de fase (NOM 240-4).
+type ResultadoCoordinacionProteccion struct {
+	CalibreOriginal     string  `json:"calibre_original"`
+	CalibreSeleccionado string  `json:"calibre_seleccionado"`
+	SeccionMM2          float64 `json:"seccion_mm2"`
+	Capacidad           float64 `json:"capacidad"`
+	// FactorCorreccion es F_temperatura × F_agrupamiento aplicado a la ampacidad de tabla
+	FactorCorreccion        float64 `json:"factor_correccion"`
+	HilosPorFase            int     `json:"hilos_por_fase"`
+	AmpacidadCorregida      float64 `json:"ampacidad_corregida"`
+	ITM                     int     `json:"itm"`
+	ITMMaximoPermitido      int     `json:"itm_maximo_permitido"`
+	AplicaSiguienteSuperior bool    `json:"aplica_siguiente_superior"`
+	// Cumple indica si se encontró un calibre coordinado. False si se agotaron los intentos.
+	Cumple bool `json:"cumple"`
+	// CapacidadTerminal es la ampacidad de la columna de la terminal (110-14(c)) que limita
+	// AmpacidadCorregida con aislamiento de 90 °C; 0 si no aplica.
+	CapacidadTerminal float64 `json:"capacidad_terminal,omitempty"`
+	// Nota describe el aumento: "Calibre aumentado de X a Y por coordinación con el ITM (NOM 240-4)"
+	Nota string `json:"nota,omitempty"`
+}
+
+// ResultadoSeleccionITM contiene la propuesta de ITM según NOM 240-6(a).
+type ResultadoSeleccionITM struct {
+	CorrienteNominal float64 `json:"corriente_nominal"`
+	FactorUso        float64 `json:"factor_uso"`
+	CorrienteMinima  float64 `json:"corriente_minima"`
+	ITM              int     `json:"itm"`
+	Marco            int     `json:"marco"`
+	// Justificacion describe la selección: "I_n × F_uso = ... A → ITM ... A (marco ... AF)"
+	Justificacion string `json:"justificacion"`
 }
 
 // MemoriaOutput contiene el resultado completo de la memoria de cálculo.
@@ -368,7 +557,7 @@ type MemoriaOutput struct {
 	// Valor de entrada del usuario (modo LISTADO).
 	Equipo DatosEquipo `json:"equipo"`
 
-	// TipoEquipo indica el tipo de equipo (FILTRO_ACTIVO, TRANSFORMADOR, FILTRO_RECHAZO, CARGA).
+	// TipoEquipo indica el tipo de equipo (FILTRO_ACTIVO, TRANSFORMADOR, FILTRO_RECHAZO, CARGA, MOTOR, BANCO_CAPACITORES).
 	// Valor de entrada del usuario.
 	TipoEquipo string `json:"tipo_equipo"`
 
@@ -398,6 +587,18 @@ type MemoriaOutput struct {
 	// Calculado en el orquestador después de determinar la corriente nominal.
 	DesarrolloCorriente *DatosDesarrolloCorriente `json:"desarrollo_corriente,omitempty"`
 
+	// Motor contiene la corriente a plena carga tabulada y los límites de la NOM 430.
+	// Es nil si el equipo no es MOTOR.
+	Motor *ResultadoMotor `json:"motor,omitempty"`
+
+	// BancoCapacitores contiene los pasos y los requisitos de la NOM 460.
+	// Es nil si el equipo no es BANCO_CAPACITORES.
+	BancoCapacitores *ResultadoBancoCapacitores `json:"banco_capacitores,omitempty"`
+
+	// FactorK contiene el factor K de la carga y la capacidad derrateada del transformador.
+	// Es nil si el equipo no es TRANSFORMADOR o no se proporcionaron los órdenes armónicos.
+	FactorK *ResultadoFactorK `json:"factor_k,omitempty"`
+
 	// ═══════════════════════════════════════════════════════════════════════
 	// CONDUCTORES
 	// ═══════════════════════════════════════════════════════════════════════
@@ -410,10 +611,18 @@ type MemoriaOutput struct {
 	// Es nil para sistemas DELTA (sin neutro).
 	CableNeutro *ResultadoConductor `json:"cable_neutro,omitempty"`
 
+	// Neutro contiene el análisis de corriente del neutro y el criterio de su calibre.
+	// Es nil para sistemas DELTA (sin neutro).
+	Neutro *ResultadoNeutro `json:"neutro,omitempty"`
+
 	// CableTierra es el conductor de tierra.
 	// Resultado del paso 5 de selección de conductor de tierra.
 	CableTierra ResultadoConductor `json:"cable_tierra"`
 
+	// CableMulticonductor es el cable TC/MC que agrupa fases, neutro y tierra.
+	// Es nil cuando el circuito usa monoconductores.
+	CableMulticonductor *CableMulticonductor `json:"cable_multiconductor,omitempty"`
+
 	// ═══════════════════════════════════════════════════════════════════════
 	// CANALIZACIÓN
 	// ═══════════════════════════════════════════════════════════════════════
@@ -436,6 +645,26 @@ type MemoriaOutput struct {
 	// Resultado del paso 7.
 	CaidaTension ResultadoCaidaTension `json:"caida_tension"`
 
+	// ═══════════════════════════════════════════════════════════════════════
+	// CORTOCIRCUITO
+	// ═══════════════════════════════════════════════════════════════════════
+
+	// Cortocircuito contiene las corrientes de falla en el extremo de carga.
+	// Es nil si no se proporcionó la fuente (MVAcc o transformador kVA/%Z).
+	Cortocircuito *ResultadoCortocircuito `json:"cortocircuito,omitempty"`
+
+	// SoporteCortocircuito contiene la verificación I²t de los conductores de fase y tierra.
+	// Es nil si no se proporcionó la fuente de cortocircuito.
+	SoporteCortocircuito *DatosSoporteCortocircuito `json:"soporte_cortocircuito,omitempty"`
+
+	// ═══════════════════════════════════════════════════════════════════════
+	// LISTA DE MATERIALES
+	// ═══════════════════════════════════════════════════════════════════════
+
+	// ListaMateriales contiene los conductores, tramos de canalización y accesorios
+	// del circuito. Se genera sin precios; se valoriza con POST /calculos/lista-materiales.
+	ListaMateriales *ResultadoListaMateriales `json:"lista_materiales,omitempty"`
+
 	// ═══════════════════════════════════════════════════════════════════════
 	// RESUMEN Y METADATOS
 	// ═══════════════════════════════════════════════════════════════════════
@@ -0,0 +1,25 @@
+// internal/calculos/application/dto/motor.go
+package dto
+
+// ResultadoMotor contiene la corriente a plena carga de un motor (NOM 430-6(a)(1))
+// y los límites derivados para conductor (430-22) y protección (Tabla 430-52).
+type ResultadoMotor struct {
+	PotenciaHP          float64 `json:"potencia_hp"`
+	PotenciaHPTabla     float64 `json:"potencia_hp_tabla"` // renglón usado (igual o inmediato superior)
+	Tension             int     `json:"tension"`
+	TensionTabla        int     `json:"tension_tabla"` // columna de tensión usada
+	Fases               int     `json:"fases"`
+	TablaNOM            string  `json:"tabla_nom"` // "430-248" o "430-250"
+	CorrientePlenaCarga float64 `json:"corriente_plena_carga"`
+
+	// Conductor: 125 % de la corriente a plena carga (430-22)
+	FactorConductor    float64 `json:"factor_conductor"`
+	CorrienteConductor float64 `json:"corriente_conductor"`
+
+	// Protección: interruptor de tiempo inverso, 250 % máximo (Tabla 430-52),
+	// con el estándar inmediato superior permitido por 430-52(c)(1) Excepción 1.
+	// Se completan en el orquestador una vez conocido el ITM.
+	FactorProteccion float64 `json:"factor_proteccion"`
+	ITMMaximo        int     `json:"itm_maximo,omitempty"`
+	CumpleProteccion bool    `json:"cumple_proteccion"`
+}
@@ -0,0 +1,20 @@
+// internal/calculos/application/dto/neutro.go
+package dto
+
+// ResultadoNeutro contiene el dimensionamiento del conductor neutro:
+// corriente por desbalance y armónicas triples, criterio de agrupamiento
+// (310-15(b)(5)) y calibre seleccionado.
+type ResultadoNeutro struct {
+	CorrienteFase       float64 `json:"corriente_fase"`       // [A]
+	Desbalance          float64 `json:"desbalance"`           // [%]
+	CorrienteDesbalance float64 `json:"corriente_desbalance"` // [A]
+	CorrienteArmonica   float64 `json:"corriente_armonica"`   // [A] armónicas triples
+	CorrienteNeutro     float64 `json:"corriente_neutro"`     // [A] RMS total
+	RelacionFase        float64 `json:"relacion_fase"`        // I_N / I_fase
+	CorrienteAjustada   float64 `json:"corriente_ajustada"`   // [A] I_N / (F_temp × F_agr)
+	Portador            bool    `json:"portador"`
+	IgualAFase          bool    `json:"igual_a_fase"` // sin datos de desbalance/armónicos se iguala a la fase
+	Criterio            string  `json:"criterio"`
+
+	Conductor ResultadoConductor `json:"conductor"`
+}
@@ -0,0 +1,151 @@
+// internal/calculos/application/dto/optimizacion_costo.go
+package dto
+
+import (
+	"fmt"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+)
+
+// MaxHilosPorFaseOptimizacionDefault es el máximo de conductores en paralelo por fase evaluado.
+const MaxHilosPorFaseOptimizacionDefault = 4
+
+// MonedaDefault es la moneda del catálogo de precios si no se indica.
+const MonedaDefault = "MXN"
+
+// OptimizacionCostoInput contiene el circuito base y el espacio de alternativas a evaluar.
+// Material, HilosPorFase y TipoCanalizacion del Input se reemplazan en cada alternativa.
+type OptimizacionCostoInput struct {
+	Input EquipoInput `json:"input"`
+
+	Materiales        []string `json:"materiales,omitempty"`         // default: Cu y Al
+	MaxHilosPorFase   int      `json:"max_hilos_por_fase,omitempty"` // default: 4
+	TiposCanalizacion []string `json:"tipos_canalizacion,omitempty"` // default: todas
+
+	// Precios es un catálogo proporcionado en la petición; si es nil se usa el catálogo vigente.
+	Precios *CatalogoPreciosInput `json:"precios,omitempty"`
+	// Moneda del catálogo vigente a consultar cuando no se envían precios (default: MXN).
+	Moneda string `json:"moneda,omitempty"`
+}
+
+// CatalogoPreciosInput es un catálogo de precios por metro proporcionado por el usuario.
+type CatalogoPreciosInput struct {
+	Moneda      string                 `json:"moneda"`
+	Conductores []PrecioConductorInput `json:"conductores"`
+	Tuberias    []PrecioTuberiaInput   `json:"tuberias"`
+	Charolas    []PrecioCharolaInput   `json:"charolas"`
+}
+
+// PrecioConductorInput es el precio por metro de un conductor.
+type PrecioConductorInput struct {
+	Calibre     string  `json:"calibre"`
+	Material    string  `json:"material"`
+	Aislamiento string  `json:"aislamiento,omitempty"` // vacío = cualquier aislamiento
+	PrecioMetro float64 `json:"precio_metro"`
+}
+
+// PrecioTuberiaInput es el precio por metro de una tubería.
+type PrecioTuberiaInput struct {
+	TipoCanalizacion string  `json:"tipo_canalizacion"`
+	Tamano           string  `json:"tamano"`
+	PrecioMetro      float64 `json:"precio_metro"`
+}
+
+// PrecioCharolaInput es el precio por metro de una charola por ancho comercial.
+type PrecioCharolaInput struct {
+	AnchoMM     float64 `json:"ancho_mm"`
+	PrecioMetro float64 `json:"precio_metro"`
+}
+
+// Validate verifica el espacio de búsqueda. El EquipoInput lo valida el orquestador.
+func (i OptimizacionCostoInput) Validate() error {
+	if i.MaxHilosPorFase < 0 {
+		return fmt.Errorf("%w: max_hilos_por_fase no puede ser negativo", ErrOptimizacionInvalida)
+	}
+	for _, m := range i.Materiales {
+		if _, err := valueobject.ParseMaterialConductor(m); err != nil {
+			return fmt.Errorf("%w: %w", ErrOptimizacionInvalida, err)
+		}
+	}
+	for _, tc := range i.TiposCanalizacion {
+		if _, err := entity.ParseTipoCanalizacion(tc); err != nil {
+			return fmt.Errorf("%w: %w", ErrOptimizacionInvalida, err)
+		}
+	}
+	return nil
+}
+
+// ToDomain construye el catálogo de precios de dominio.
+func (c CatalogoPreciosInput) ToDomain() (*entity.CatalogoPrecios, error) {
+	moneda := c.Moneda
+	if moneda == "" {
+		moneda = MonedaDefault
+	}
+	catalogo := entity.NewCatalogoPrecios(moneda)
+	for _, p := range c.Conductores {
+		material, err := valueobject.ParseMaterialConductor(p.Material)
+		if err != nil {
+			return nil, fmt.Errorf("%w: conductor %s: %w", ErrCatalogoPreciosInvalido, p.Calibre, err)
+		}
+		if err := catalogo.AgregarConductor(p.Calibre, material, p.Aislamiento, p.PrecioMetro); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrCatalogoPreciosInvalido, err)
+		}
+	}
+	for _, p := range c.Tuberias {
+		tipo, err := entity.ParseTipoCanalizacion(p.TipoCanalizacion)
+		if err != nil {
+			return nil, fmt.Errorf("%w: tubería %s: %w", ErrCatalogoPreciosInvalido, p.Tamano, err)
+		}
+		if err := catalogo.AgregarTuberia(tipo, p.Tamano, p.PrecioMetro); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrCatalogoPreciosInvalido, err)
+		}
+	}
+	for _, p := range c.Charolas {
+		if err := catalogo.AgregarCharola(p.AnchoMM, p.PrecioMetro); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrCatalogoPreciosInvalido, err)
+		}
+	}
+	return catalogo, nil
+}
+
+// PartidaCosto es un renglón valorizado de material.
+type PartidaCosto struct {
+	Concepto       string  `json:"concepto"`
+	Cantidad       float64 `json:"cantidad"`
+	Unidad         string  `json:"unidad"`
+	PrecioUnitario float64 `json:"precio_unitario"`
+	Importe        float64 `json:"importe"`
+}
+
+// AlternativaCosto es una solución que cumple la normativa, con su costo de materiales.
+type AlternativaCosto struct {
+	Ranking          int            `json:"ranking"`
+	Material         string         `json:"material"`
+	HilosPorFase     int            `json:"hilos_por_fase"`
+	TipoCanalizacion string         `json:"tipo_canalizacion"`
+	CalibreFase      string         `json:"calibre_fase"`
+	CalibreNeutro    string         `json:"calibre_neutro,omitempty"`
+	CalibreTierra    string         `json:"calibre_tierra"`
+	Canalizacion     string         `json:"canalizacion"` // tamaño de tubería o ancho de charola
+	NumeroDeTubos    int            `json:"numero_de_tubos"`
+	CaidaTension     float64        `json:"caida_tension"` // [%]
+	Partidas         []PartidaCosto `json:"partidas"`
+	CostoTotal       float64        `json:"costo_total"`
+}
+
+// AlternativaDescartada es una combinación evaluada que no se pudo valorizar o no cumple.
+type AlternativaDescartada struct {
+	Material         string `json:"material"`
+	HilosPorFase     int    `json:"hilos_por_fase"`
+	TipoCanalizacion string `json:"tipo_canalizacion"`
+	Motivo           string `json:"motivo"`
+}
+
+// OptimizacionCostoOutput contiene las alternativas ordenadas de menor a mayor costo.
+type OptimizacionCostoOutput struct {
+	Moneda       string                  `json:"moneda"`
+	Evaluadas    int                     `json:"evaluadas"`
+	Alternativas []AlternativaCosto      `json:"alternativas"`
+	Descartadas  []AlternativaDescartada `json:"descartadas"`
+}
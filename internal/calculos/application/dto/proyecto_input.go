@@ -0,0 +1,46 @@
+// internal/calculos/application/dto/proyecto_input.go
+package dto
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CircuitoInput es un circuito del proyecto: un nombre (ej. "FA-01") y el
+// EquipoInput completo que se pasa al orquestador de memoria.
+//
+// Alimentador es el nombre del circuito del proyecto que alimenta al tablero de
+// este circuito (tablero → alimentador → derivados). Vacío = sale del tablero general.
+type CircuitoInput struct {
+	Nombre      string      `json:"nombre"`
+	Alimentador string      `json:"alimentador,omitempty"`
+	Input       EquipoInput `json:"input"`
+}
+
+// ProyectoInput contiene los circuitos alimentados desde un mismo tablero.
+// PorcentajeCaidaTotalMaximo, si se indica, reemplaza el límite de caída acumulada
+// de cada circuito (default del circuito: 5%).
+type ProyectoInput struct {
+	Nombre                     string          `json:"nombre"`
+	PorcentajeCaidaTotalMaximo float64         `json:"porcentaje_caida_total_maximo,omitempty"`
+	Circuitos                  []CircuitoInput `json:"circuitos"`
+}
+
+// Validate verifica los datos del proyecto. Cada EquipoInput lo valida el orquestador.
+func (p ProyectoInput) Validate() error {
+	if strings.TrimSpace(p.Nombre) == "" {
+		return fmt.Errorf("%w: nombre del proyecto requerido", ErrProyectoInvalido)
+	}
+	if p.PorcentajeCaidaTotalMaximo < 0 {
+		return fmt.Errorf("%w: porcentaje_caida_total_maximo no puede ser negativo", ErrProyectoInvalido)
+	}
+	if len(p.Circuitos) == 0 {
+		return fmt.Errorf("%w: se requiere al menos un circuito", ErrProyectoInvalido)
+	}
+	for i, c := range p.Circuitos {
+		if strings.TrimSpace(c.Nombre) == "" {
+			return fmt.Errorf("%w: circuito %d sin nombre", ErrProyectoInvalido, i+1)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+// internal/calculos/application/dto/proyecto_output.go
+package dto
+
+// ResumenCircuito es la fila del resumen de proyecto para un circuito.
+type ResumenCircuito struct {
+	Nombre                 string  `json:"nombre"`
+	Alimentador            string  `json:"alimentador,omitempty"`
+	Clave                  string  `json:"clave"`
+	TipoEquipo             string  `json:"tipo_equipo"`
+	CorrienteNominal       float64 `json:"corriente_nominal"`
+	PotenciaKVA            float64 `json:"potencia_kva"`
+	ITM                    int     `json:"itm"`
+	HilosPorFase           int     `json:"hilos_por_fase"`
+	CalibreFase            string  `json:"calibre_fase"`
+	CalibreTierra          string  `json:"calibre_tierra"`
+	Material               string  `json:"material"`
+	TipoCanalizacion       string  `json:"tipo_canalizacion"`
+	TamanoCanalizacion     string  `json:"tamano_canalizacion"`
+	NumeroDeTubos          int     `json:"numero_de_tubos"`
+	CaidaTensionPorcentaje float64 `json:"caida_tension_porcentaje"`
+	CaidaTensionAcumulada  float64 `json:"caida_tension_acumulada"`
+	CumpleNormativa        bool    `json:"cumple_normativa"`
+}
+
+// ResumenProyecto consolida los resultados de todos los circuitos del proyecto.
+type ResumenProyecto struct {
+	TotalKVA             float64           `json:"total_kva"`
+	NumCircuitos         int               `json:"num_circuitos"`
+	CircuitosNoConformes []string          `json:"circuitos_no_conformes"`
+	CumpleNormativa      bool              `json:"cumple_normativa"`
+	Circuitos            []ResumenCircuito `json:"circuitos"`
+}
+
+// CircuitoOutput es la memoria de cálculo completa de un circuito del proyecto.
+type CircuitoOutput struct {
+	Nombre  string        `json:"nombre"`
+	Memoria MemoriaOutput `json:"memoria"`
+}
+
+// ProyectoOutput es el resultado del cálculo de un proyecto de N circuitos.
+type ProyectoOutput struct {
+	Nombre    string           `json:"nombre"`
+	Resumen   ResumenProyecto  `json:"resumen"`
+	Circuitos []CircuitoOutput `json:"circuitos"`
+}
+
+// NewResumenCircuito extrae la fila de resumen de la memoria de un circuito.
+func NewResumenCircuito(nombre, alimentador string, potenciaKVA float64, m MemoriaOutput) ResumenCircuito {
+	return ResumenCircuito{
+		Nombre:                 nombre,
+		Alimentador:            alimentador,
+		Clave:                  m.Equipo.Clave,
+		TipoEquipo:             m.TipoEquipo,
+		CorrienteNominal:       m.Corrientes.CorrienteNominal,
+		PotenciaKVA:            potenciaKVA,
+		ITM:                    m.Proteccion.ITM,
+		HilosPorFase:           m.Instalacion.HilosPorFase,
+		CalibreFase:            m.CableFase.Calibre,
+		CalibreTierra:          m.CableTierra.Calibre,
+		Material:               m.CableFase.Material,
+		TipoCanalizacion:       m.Instalacion.TipoCanalizacion,
+		TamanoCanalizacion:     m.Canalizacion.Resultado.Tamano,
+		NumeroDeTubos:          m.Canalizacion.Resultado.NumeroDeTubos,
+		CaidaTensionPorcentaje: m.CaidaTension.Porcentaje,
+		CaidaTensionAcumulada:  m.CaidaTension.PorcentajeAcumulado,
+		CumpleNormativa:        m.CumpleNormativa,
+	}
+}
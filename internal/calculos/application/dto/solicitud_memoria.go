@@ -0,0 +1,21 @@
+// internal/calculos/application/dto/solicitud_memoria.go
+package dto
+
+// SolicitudMemoria es el cuerpo JSON de POST /api/v1/calculos/memoria: los campos de
+// EquipoInput más el ITM de nivel superior que usan los modos MANUAL_*. Permite que
+// otros consumidores (p. ej. memorias guardadas) reciban el mismo formato que el endpoint.
+type SolicitudMemoria struct {
+	EquipoInput
+	ITM int `json:"itm,omitempty"` // MANUAL_*: ITM del circuito; en LISTADO se usa equipo.itm
+}
+
+// ToEquipoInput copia el ITM de nivel superior a Equipo.ITM en los modos MANUAL_*,
+// igual que el handler de calculos/memoria. Sin ITM de nivel superior se conserva
+// equipo.itm (si tampoco viene, el cálculo propone uno).
+func (s SolicitudMemoria) ToEquipoInput() EquipoInput {
+	input := s.EquipoInput
+	if input.Modo != ModoListado && s.ITM > 0 {
+		input.Equipo.ITM = s.ITM
+	}
+	return input
+}
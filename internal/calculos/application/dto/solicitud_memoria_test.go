@@ -0,0 +1,43 @@
+// internal/calculos/application/dto/solicitud_memoria_test.go
+package dto_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSolicitudMemoria_ToEquipoInput(t *testing.T) {
+	t.Run("MANUAL_AMPERAJE con itm de nivel superior", func(t *testing.T) {
+		body := `{"modo":"MANUAL_AMPERAJE","tipo_equipo":"CARGA","amperaje_nominal":50,"tension":220,"tipo_canalizacion":"TUBERIA_PVC","longitud_circuito":30,"sistema_electrico":"DELTA","estado":"Jalisco","tipo_voltaje":"FASE_FASE","itm":70}`
+		var solicitud dto.SolicitudMemoria
+		require.NoError(t, json.Unmarshal([]byte(body), &solicitud))
+
+		input := solicitud.ToEquipoInput()
+		assert.Equal(t, dto.ModoManualAmperaje, input.Modo)
+		assert.Equal(t, 70, input.Equipo.ITM)
+
+		// Ida y vuelta: el itm sigue en el nivel superior
+		raw, err := json.Marshal(solicitud)
+		require.NoError(t, err)
+		var vuelta dto.SolicitudMemoria
+		require.NoError(t, json.Unmarshal(raw, &vuelta))
+		assert.Equal(t, 70, vuelta.ITM)
+		assert.Equal(t, 70, vuelta.ToEquipoInput().Equipo.ITM)
+	})
+
+	t.Run("MANUAL_AMPERAJE con equipo.itm se conserva", func(t *testing.T) {
+		var solicitud dto.SolicitudMemoria
+		require.NoError(t, json.Unmarshal([]byte(`{"modo":"MANUAL_AMPERAJE","equipo":{"itm":40}}`), &solicitud))
+		assert.Equal(t, 40, solicitud.ToEquipoInput().Equipo.ITM)
+	})
+
+	t.Run("LISTADO usa el ITM del equipo", func(t *testing.T) {
+		var solicitud dto.SolicitudMemoria
+		require.NoError(t, json.Unmarshal([]byte(`{"modo":"LISTADO","equipo":{"itm":100},"itm":70}`), &solicitud))
+		assert.Equal(t, 100, solicitud.ToEquipoInput().Equipo.ITM)
+	})
+}
@@ -0,0 +1,117 @@
+// internal/calculos/application/dto/tension_jalado.go
+package dto
+
+import (
+	"fmt"
+
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+)
+
+// TramoRutaInput es un tramo de la ruta en el orden en que se jala el cable.
+type TramoRutaInput struct {
+	Tipo         string  `json:"tipo" binding:"required"` // RECTO o CURVA
+	LongitudM    float64 `json:"longitud_m,omitempty"`    // tramo recto
+	AnguloGrados float64 `json:"angulo_grados,omitempty"` // curva
+	RadioM       float64 `json:"radio_m,omitempty"`       // curva: radio al eje del tubo
+}
+
+// TensionJaladoInput contiene los conductores, la tubería y la ruta entre dos puntos de jalado.
+type TensionJaladoInput struct {
+	Calibre         string `json:"calibre" binding:"required"`
+	Material        string `json:"material"`         // Cu (default) o Al
+	TipoAislamiento string `json:"tipo_aislamiento"` // THW (default), THHN, XHHW, RHH, USE-2
+	NumConductores  int    `json:"num_conductores" binding:"required,gt=0"`
+
+	// TamanoTuberia es el tamaño comercial (ej. "2"); el diámetro interior se toma de
+	// las dimensiones físicas de tubo cédula 40. DiametroInteriorMM tiene prioridad.
+	TamanoTuberia      string  `json:"tamano_tuberia"`
+	DiametroInteriorMM float64 `json:"diametro_interior_mm,omitempty"`
+
+	Tramos []TramoRutaInput `json:"tramos" binding:"required,min=1,dive"`
+
+	// Opcionales: 0 usa el valor típico.
+	PesoConductorKgM       float64 `json:"peso_conductor_kg_m,omitempty"`        // estimado desde la sección y el diámetro
+	CoeficienteFriccion    float64 `json:"coeficiente_friccion,omitempty"`       // default: 0.5
+	PresionLateralMaximaNM float64 `json:"presion_lateral_maxima_n_m,omitempty"` // default: 7300 N/m
+	TensionInicialN        float64 `json:"tension_inicial_n,omitempty"`          // tensión de entrada (carrete)
+}
+
+// Validate verifica los campos requeridos.
+func (i TensionJaladoInput) Validate() error {
+	if i.Calibre == "" {
+		return fmt.Errorf("%w: calibre es requerido", ErrEquipoInputInvalido)
+	}
+	if i.NumConductores <= 0 {
+		return fmt.Errorf("%w: num_conductores debe ser mayor que cero", ErrTensionJaladoInvalida)
+	}
+	if i.TamanoTuberia == "" && i.DiametroInteriorMM <= 0 {
+		return fmt.Errorf("%w: se requiere tamano_tuberia o diametro_interior_mm", ErrTensionJaladoInvalida)
+	}
+	if len(i.Tramos) == 0 {
+		return fmt.Errorf("%w: se requiere al menos un tramo", ErrRutaTuberiaInvalida)
+	}
+	if i.PesoConductorKgM < 0 || i.CoeficienteFriccion < 0 || i.PresionLateralMaximaNM < 0 || i.TensionInicialN < 0 {
+		return fmt.Errorf("%w: los parámetros opcionales no pueden ser negativos", ErrTensionJaladoInvalida)
+	}
+	return nil
+}
+
+// GetTipoAislamiento retorna el aislamiento; default THW.
+func (i TensionJaladoInput) GetTipoAislamiento() string {
+	if i.TipoAislamiento == "" {
+		return valueobject.AislamientoDefault.String()
+	}
+	return i.TipoAislamiento
+}
+
+// GetMaterial retorna el material; default Cu.
+func (i TensionJaladoInput) GetMaterial() string {
+	if i.Material == "" {
+		return "Cu"
+	}
+	return i.Material
+}
+
+// ResultadoTramoJalado es la tensión de un tramo y, en curvas, la presión lateral.
+type ResultadoTramoJalado struct {
+	Numero              int     `json:"numero"`
+	Tipo                string  `json:"tipo"`
+	TensionEntradaN     float64 `json:"tension_entrada_n"`
+	TensionSalidaN      float64 `json:"tension_salida_n"`
+	PresionLateralNPorM float64 `json:"presion_lateral_n_m,omitempty"`
+	CumplePresion       bool    `json:"cumple_presion"`
+}
+
+// ResultadoTensionJalado es el análisis de jalado de la ruta.
+type ResultadoTensionJalado struct {
+	Calibre                string  `json:"calibre"`
+	Material               string  `json:"material"`
+	NumConductores         int     `json:"num_conductores"`
+	SeccionMM2             float64 `json:"seccion_mm2"`
+	DiametroConductorMM    float64 `json:"diametro_conductor_mm"`
+	DiametroInteriorTuboMM float64 `json:"diametro_interior_tubo_mm"`
+	PesoConductorKgM       float64 `json:"peso_conductor_kg_m"`
+
+	Configuracion        string  `json:"configuracion"` // UNICO, TRIANGULAR, ACUNADO, MULTIPLE
+	FactorCorreccionPeso float64 `json:"factor_correccion_peso"`
+	CoeficienteFriccion  float64 `json:"coeficiente_friccion"`
+	LongitudTotalM       float64 `json:"longitud_total_m"`
+	TotalCurvasGrados    float64 `json:"total_curvas_grados"`
+
+	Tramos []ResultadoTramoJalado `json:"tramos"`
+
+	TensionFinalN                float64 `json:"tension_final_n"`
+	TensionMaximaPermitidaN      float64 `json:"tension_maxima_permitida_n"`
+	PresionLateralMaximaNPorM    float64 `json:"presion_lateral_maxima_n_m"`
+	PresionLateralPermitidaNPorM float64 `json:"presion_lateral_permitida_n_m"`
+	RelacionAtascamiento         float64 `json:"relacion_atascamiento,omitempty"` // solo 3 conductores
+
+	CumpleTension        bool `json:"cumple_tension"`
+	CumplePresionLateral bool `json:"cumple_presion_lateral"`
+	RiesgoAtascamiento   bool `json:"riesgo_atascamiento"`
+	ExcedeCurvasNOM      bool `json:"excede_curvas_nom"`
+	Cumple               bool `json:"cumple"`
+
+	// Observaciones explica cada verificación que no se cumple.
+	Observaciones []string `json:"observaciones,omitempty"`
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
 )
 
 // TuberiaInput contiene los datos necesarios para calcular el tamaño de tubería.
@@ -17,8 +18,12 @@ type TuberiaInput struct {
 	CalibreTierra    string `json:"calibre_tierra" binding:"required"`
 	TipoCanalizacion string `json:"tipo_canalizacion" binding:"required"`
 	NumTuberias      int    `json:"num_tuberias" binding:"required,gt=0"`
+	TipoAislamiento  string `json:"tipo_aislamiento"` // THW (default), THHN, XHHW, RHH, USE-2
+	TipoCable        string `json:"tipo_cable"`       // TC o MC (multiconductor); vacío = monoconductores
+	NumCables        int    `json:"num_cables"`       // cables multiconductores; 0 = hilos por fase
 	NumTierras       int    `json:"-"`
 	HilosPorFase     int    `json:"-"` // Conductores por fase (≥1); default 1 si no se especifica
+	Material         string `json:"-"` // "CU" o "AL"; para el diámetro en la relación de atascamiento
 }
 
 // GetHilosPorFase retorna el número de hilos por fase.
@@ -57,9 +62,46 @@ func (t TuberiaInput) Validate() error {
 	if t.NumTierras < 0 {
 		return fmt.Errorf("num_tierras no puede ser negativo")
 	}
+	if _, err := t.ToDomainTipoAislamiento(); err != nil {
+		return err
+	}
+	if t.NumCables < 0 {
+		return fmt.Errorf("num_cables no puede ser negativo")
+	}
+	if _, err := t.ToDomainTipoCable(); err != nil {
+		return err
+	}
 	return nil
 }
 
+// ToDomainTipoCable convierte el tipo de cable multiconductor; nil = monoconductores.
+func (t TuberiaInput) ToDomainTipoCable() (*valueobject.TipoCableMulticonductor, error) {
+	if t.TipoCable == "" {
+		return nil, nil
+	}
+	tipo, err := valueobject.ParseTipoCableMulticonductor(t.TipoCable)
+	if err != nil {
+		return nil, err
+	}
+	return &tipo, nil
+}
+
+// GetNumCables retorna el número de cables multiconductores; default: hilos por fase.
+func (t TuberiaInput) GetNumCables() int {
+	if t.NumCables <= 0 {
+		return t.GetHilosPorFase()
+	}
+	return t.NumCables
+}
+
+// ToDomainTipoAislamiento convierte el string a valueobject.TipoAislamiento; vacío = THW.
+func (t TuberiaInput) ToDomainTipoAislamiento() (valueobject.TipoAislamiento, error) {
+	if t.TipoAislamiento == "" {
+		return valueobject.AislamientoDefault, nil
+	}
+	return valueobject.ParseTipoAislamiento(t.TipoAislamiento)
+}
+
 // ToDomainTipoCanalizacion convierte el string a entity.TipoCanalizacion.
 func (t TuberiaInput) ToDomainTipoCanalizacion() (entity.TipoCanalizacion, error) {
 	return entity.ParseTipoCanalizacion(t.TipoCanalizacion)
@@ -19,4 +19,13 @@ type TuberiaOutput struct {
 	NumTierras           int      `json:"num_tierras"`
 	AreaOcupacionTuboMM2 float64  `json:"area_ocupacion_tubo_mm2"`
 	FillFactor           float64  `json:"fill_factor"`
+
+	// Relación de atascamiento (1.05 × D / d) con 3 conductores iguales por tubo; 0 si no aplica.
+	RelacionAtascamiento float64 `json:"relacion_atascamiento,omitempty"`
+	// NotaAtascamiento explica el aumento de tamaño cuando el tubo por área caía en 2.8 – 3.2.
+	NotaAtascamiento string `json:"nota_atascamiento,omitempty"`
+
+	// Cable multiconductor (TC/MC): cables por tubo y dimensiones; nil con monoconductores.
+	NumCablesPorTubo    int                  `json:"num_cables_por_tubo,omitempty"`
+	CableMulticonductor *CableMulticonductor `json:"cable_multiconductor,omitempty"`
 }
@@ -0,0 +1,14 @@
+// internal/calculos/application/port/catalogo_precios_repository.go
+package port
+
+import (
+	"context"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+)
+
+// CatalogoPreciosRepository defines the contract for reading material unit prices.
+type CatalogoPreciosRepository interface {
+	// ObtenerCatalogoVigente returns the prices in effect today for the given currency.
+	ObtenerCatalogoVigente(ctx context.Context, moneda string) (*entity.CatalogoPrecios, error)
+}
@@ -1,11 +1,12 @@
 // internal/calculos/application/port/geometry_generator.go
 package port
 
-// GeometryGeneratorPort es el puerto para generar diagramas SVG de canalización.
+// GeometryGeneratorPort es el puerto para generar diagramas SVG y DXF de canalización.
 // La implementación está en infrastructure y usa el paquete pdf/geometry.
 type GeometryGeneratorPort interface {
 	// GenerarDiagramaCharola genera el SVG para una charola con distribución de cables.
 	// tipoCanalizacion debe ser "CHAROLA_CABLE_ESPACIADO" o "CHAROLA_CABLE_TRIANGULAR".
+	// peralteMM 0 usa el peralte estándar del dibujo.
 	GenerarDiagramaCharola(
 		diametroFaseMM float64,
 		diametroTierraMM float64,
@@ -16,6 +17,7 @@ type GeometryGeneratorPort interface {
 		anchoComercialMM float64,
 		areaRequeridaMM2 float64,
 		tipoCanalizacion string,
+		peralteMM float64,
 	) (*GeometryDiagramaCharola, error)
 
 	// GenerarDiagramaTuberia genera el SVG para una tubería con conductores.
@@ -31,6 +33,29 @@ type GeometryGeneratorPort interface {
 		diametroExteriorMM float64,
 		numTubos int,
 	) (*GeometryDiagramaTuberia, error)
+
+	// GenerarDiagramaCharolaMulticonductor genera el SVG para cables multiconductores
+	// (TC/MC) en charola: un cable por juego en paralelo con sus conductores y tierra dentro.
+	GenerarDiagramaCharolaMulticonductor(
+		diametroCableMM float64,
+		numCables int,
+		diametroControlMM *float64,
+		sistemaElectrico string,
+		anchoComercialMM float64,
+		areaRequeridaMM2 float64,
+		tipoCanalizacion string,
+		peralteMM float64,
+	) (*GeometryDiagramaCharola, error)
+
+	// GenerarDiagramaTuberiaMulticonductor genera el SVG para cables multiconductores en tubería.
+	GenerarDiagramaTuberiaMulticonductor(
+		diametroCableMM float64,
+		numCablesPorTubo int,
+		sistemaElectrico string,
+		diametroInteriorMM float64,
+		diametroExteriorMM float64,
+		numTubos int,
+	) (*GeometryDiagramaTuberia, error)
 }
 
 // GeometryDiagramaCharola contiene el resultado de generar un diagrama de charola.
@@ -40,6 +65,8 @@ type GeometryDiagramaCharola struct {
 	ViewBox      string
 	Cotas        []GeometryLineaCota
 	SVG          string
+	// DXF es la misma geometría en formato AutoCAD R12 (mm, eje Y hacia arriba).
+	DXF string
 }
 
 // GeometryDiagramaTuberia contiene el resultado de generar un diagrama de tubería.
@@ -49,6 +76,8 @@ type GeometryDiagramaTuberia struct {
 	DiametroExterior float64
 	ViewBox          string
 	SVG              string
+	// DXF es la misma geometría en formato AutoCAD R12 (mm, eje Y hacia arriba).
+	DXF string
 }
 
 // GeometryConductorPosicion representa la posición de un conductor en el diagrama.
@@ -39,6 +39,13 @@ type TablaNOMRepository interface {
 	// ObtenerTablaTierra returns the ground conductor table (250-122).
 	ObtenerTablaTierra(ctx context.Context) ([]valueobject.EntradaTablaTierra, error)
 
+	// ObtenerTablaITM returns the standard breaker ratings table (NOM 240-6(a)), sorted ascending.
+	ObtenerTablaITM(ctx context.Context) ([]valueobject.EntradaTablaITM, error)
+
+	// ObtenerTablaCorrienteMotor returns the motor full-load current table:
+	// 430-250 for three-phase motors (fases == 3), 430-248 otherwise. Sorted by HP ascending.
+	ObtenerTablaCorrienteMotor(ctx context.Context, fases int) ([]valueobject.EntradaTablaMotor, error)
+
 	// ObtenerImpedancia returns R and X values for the given calibre and conduit type.
 	ObtenerImpedancia(
 		ctx context.Context,
@@ -58,16 +65,29 @@ type TablaNOMRepository interface {
 	ObtenerFactorTemperatura(ctx context.Context, tempAmbiente int, tempConductor valueobject.Temperatura) (float64, error)
 	ObtenerFactorAgrupamiento(ctx context.Context, cantidadConductores int) (float64, error)
 
+	// Factores de instalación subterránea (ducto subterráneo / directamente enterrado)
+	// respecto a las condiciones de referencia del Anexo B (RHO 90, 750 mm).
+	ObtenerFactorResistividadTermica(ctx context.Context, canalizacion entity.TipoCanalizacion, resistividad float64) (float64, error)
+	ObtenerFactorProfundidad(ctx context.Context, canalizacion entity.TipoCanalizacion, profundidadMM float64) (float64, error)
+	ObtenerFactorAgrupamientoSubterraneo(ctx context.Context, canalizacion entity.TipoCanalizacion, numCircuitos int) (float64, error)
+
 	// Dimensiones para canalización
-	ObtenerDiametroConductor(ctx context.Context, calibre string, material string, conAislamiento bool) (float64, error)
+	// ObtenerDiametroConductor usa la columna de la Tabla 5 del aislamiento; SinAislamiento = desnudo (Tabla 8).
+	ObtenerDiametroConductor(ctx context.Context, calibre string, material string, aislamiento valueobject.TipoAislamiento) (float64, error)
 	ObtenerCharolaPorAncho(ctx context.Context, anchoRequeridoMM float64) (valueobject.EntradaTablaCanalizacion, error)
 
 	// ObtenerTablaCharola returns the complete charola sizing table for the given type.
 	ObtenerTablaCharola(ctx context.Context, tipo entity.TipoCanalizacion) ([]valueobject.EntradaTablaCanalizacion, error)
 
+	// ObtenerTablaLlenadoCharola returns the commercial widths and NOM 392-22 fill limits for a tray type.
+	ObtenerTablaLlenadoCharola(ctx context.Context, tipo entity.TipoCharola) ([]valueobject.EntradaTablaLlenadoCharola, error)
+
+	// ObtenerCableMulticonductor returns the manufacturer dimensions of a TC/MC multiconductor cable.
+	ObtenerCableMulticonductor(ctx context.Context, tipo valueobject.TipoCableMulticonductor, calibre string, conductores int) (valueobject.CableMulticonductor, error)
+
 	// Área de conductores para cálculo de tubería
-	// ObtenerAreaConductor returns the area with insulation (area_tw_thw) for a given calibre.
-	ObtenerAreaConductor(ctx context.Context, calibre string) (float64, error)
+	// ObtenerAreaConductor returns the area with insulation (Tabla 5 column of the insulation type) for a given calibre.
+	ObtenerAreaConductor(ctx context.Context, calibre string, aislamiento valueobject.TipoAislamiento) (float64, error)
 
 	// ObtenerAreaConductorDesnudo returns the area for bare conductor (Tabla 8) - used for ground conductors.
 	ObtenerAreaConductorDesnudo(ctx context.Context, calibre string) (float64, error)
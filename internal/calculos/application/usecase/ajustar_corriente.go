@@ -26,8 +26,26 @@ func NewAjustarCorrienteUseCase(
 	}
 }
 
+// OpcionesAjusteCorriente agrupa las entradas opcionales del ajuste de corriente.
+// El valor cero equivale a un circuito sin armónicos, con aislamiento THW, no
+// subterráneo y de cables monoconductores.
+type OpcionesAjusteCorriente struct {
+	// Armonicos es el espectro de la carga (nil = sin ajuste por armónicos).
+	Armonicos *entity.EspectroArmonico
+	// Aislamiento limita la columna de temperatura (vacío = AislamientoDefault).
+	Aislamiento valueobject.TipoAislamiento
+	// Subterranea aplica a ducto subterráneo y directamente enterrado; si falta se
+	// usan las condiciones por defecto y en otras canalizaciones se ignora.
+	Subterranea *entity.CondicionesSubterraneas
+	// Multiconductor indica cables TC/MC: en charola toman la columna de tubería
+	// (392-80(a)(1)) y el agrupamiento por conductores portadores del cable.
+	Multiconductor bool
+}
+
 // Execute applies correction factors to the nominal current.
 // Accepts tipoEquipo for usage factor, hilosPorFase and numTuberias for grouping calculation.
+// For underground installations the temperature factor uses the earth temperature,
+// grouping counts adjacent circuits and soil resistivity and depth add their own factors.
 func (uc *AjustarCorrienteUseCase) Execute(
 	ctx context.Context,
 	corrienteNominal valueobject.Corriente,
@@ -37,6 +55,7 @@ func (uc *AjustarCorrienteUseCase) Execute(
 	tipoEquipo entity.TipoEquipo,
 	hilosPorFase int,
 	numTuberias int,
+	opciones OpcionesAjusteCorriente,
 ) (dto.ResultadoAjusteCorriente, error) {
 	// Validate inputs
 	if hilosPorFase < 1 {
@@ -45,23 +64,64 @@ func (uc *AjustarCorrienteUseCase) Execute(
 	if numTuberias < 1 {
 		numTuberias = 1
 	}
+	armonicos := opciones.Armonicos
+	aislamiento := opciones.Aislamiento
+	if aislamiento == valueobject.SinAislamiento {
+		aislamiento = valueobject.AislamientoDefault
+	}
+	subterranea := opciones.Subterranea
+	multiconductor := opciones.Multiconductor
 
-	// Get ambient temperature
-	tempAmbiente, err := uc.tablaRepo.ObtenerTemperaturaPorEstado(ctx, estado)
-	if err != nil {
-		return dto.ResultadoAjusteCorriente{}, fmt.Errorf("obtener temperatura: %w", err)
+	esSubterranea := tipoCanalizacion.EsSubterranea()
+	if esSubterranea && subterranea == nil {
+		condiciones, err := entity.NewCondicionesSubterraneas(tipoCanalizacion, 0, 0, nil)
+		if err != nil {
+			return dto.ResultadoAjusteCorriente{}, fmt.Errorf("condiciones subterráneas: %w", err)
+		}
+		subterranea = &condiciones
+	}
+
+	// Get ambient temperature (subterránea: temperatura del terreno)
+	var tempAmbiente int
+	if esSubterranea {
+		tempAmbiente = subterranea.TemperaturaTerreno
+	} else {
+		var err error
+		tempAmbiente, err = uc.tablaRepo.ObtenerTemperaturaPorEstado(ctx, estado)
+		if err != nil {
+			return dto.ResultadoAjusteCorriente{}, fmt.Errorf("obtener temperatura: %w", err)
+		}
+	}
+
+	// Canalización cuya tabla de ampacidad aplica (multiconductor en charola: 310-15(b)(16))
+	canalizacionAmpacidad := tipoCanalizacion
+	if multiconductor {
+		canalizacionAmpacidad = tipoCanalizacion.CanalizacionAmpacidadMulticonductor()
 	}
 
 	// Select temperature using domain service (pure logic, no I/O)
-	// No override for this use case
-	temperatura := service.SeleccionarTemperatura(
-		corrienteNominal,
-		tipoCanalizacion,
-		nil,
+	// No override for this use case; the insulation rating caps the column
+	temperaturaTerminal := service.LimitarTemperaturaAislamiento(
+		service.SeleccionarTemperatura(corrienteNominal, canalizacionAmpacidad, nil),
+		aislamiento,
 	)
 
-	// Get temperature factor from repository
-	factorTemp, err := uc.tablaRepo.ObtenerFactorTemperatura(ctx, tempAmbiente, temperatura)
+	// 110-14(c): con aislamiento de 90 °C los factores de corrección se aplican
+	// a la columna de 90 °C; la columna de la terminal limita la ampacidad final
+	temperatura := temperaturaTerminal
+	if service.AplicaLimiteTerminal(aislamiento, temperaturaTerminal) {
+		temperatura = aislamiento.TemperaturaMaxima()
+	}
+
+	// Get temperature factor: tabla 310-15(b)(2)(a) sobre el terreno; las tablas del
+	// Anexo B están referidas a 20 °C de terreno y se corrigen por fórmula
+	var factorTemp float64
+	var err error
+	if esSubterranea {
+		factorTemp, err = service.CalcularFactorTemperaturaTerreno(tempAmbiente, temperatura)
+	} else {
+		factorTemp, err = uc.tablaRepo.ObtenerFactorTemperatura(ctx, tempAmbiente, temperatura)
+	}
 	if err != nil {
 		return dto.ResultadoAjusteCorriente{}, fmt.Errorf("calcular factor temperatura: %w", err)
 	}
@@ -74,9 +134,10 @@ func (uc *AjustarCorrienteUseCase) Execute(
 	// Determine if grouping factor applies
 	// CHAROLA: no aplica factor de agrupamiento (cables separados o en configuración triangular)
 	// TUBERIA: aplica factor de agrupamiento
+	// SUBTERRÁNEA: agrupamiento por circuitos adyacentes (un circuito por ducto o por posición en la zanja)
 	esCharola := tipoCanalizacion.EsCharola()
 
-	if !esCharola {
+	if tipoCanalizacion.RequiereTuberia() {
 		// Validate that conductors can be evenly distributed (only for tuberia)
 		if cantidadTotal%numTuberias != 0 {
 			return dto.ResultadoAjusteCorriente{}, fmt.Errorf(
@@ -88,14 +149,40 @@ func (uc *AjustarCorrienteUseCase) Execute(
 
 	conductoresPorTubo := cantidadTotal / numTuberias
 
+	// Conductores portadores de corriente (310-15(b)(5)): el neutro solo cuenta
+	// cuando transporta corriente significativa (desbalance 2F-3H o armónicas triples)
+	neutroPortador := service.NeutroEsPortador(sistemaElectrico, armonicos)
+	portadores := sistemaElectrico.CantidadFases()
+	if neutroPortador {
+		portadores += sistemaElectrico.CantidadNeutros()
+	}
+	portadoresPorTubo := (portadores*hilosPorFase + numTuberias - 1) / numTuberias
+
 	// Get grouping factor - ONLY for tuberia, not for charola
 	var factorAgr float64
-	if esCharola {
+	var resultadoSubterranea *dto.ResultadoInstalacionSubterranea
+	if esCharola && multiconductor {
+		// Multiconductor en charola: agrupamiento por conductores portadores dentro de
+		// cada cable, no por número de cables (392-80(a)(1)(a))
+		factorAgr, err = uc.tablaRepo.ObtenerFactorAgrupamiento(ctx, portadores)
+		if err != nil {
+			return dto.ResultadoAjusteCorriente{}, fmt.Errorf("calcular factor agrupamiento: %w", err)
+		}
+	} else if esCharola {
 		// Charola: no aplica factor de agrupamiento
 		factorAgr = 1.0
+	} else if esSubterranea {
+		resultadoSubterranea, err = uc.factoresSubterraneos(ctx, tipoCanalizacion, *subterranea, hilosPorFase)
+		if err != nil {
+			return dto.ResultadoAjusteCorriente{}, err
+		}
+		factorAgr, err = uc.tablaRepo.ObtenerFactorAgrupamientoSubterraneo(ctx, tipoCanalizacion, hilosPorFase)
+		if err != nil {
+			return dto.ResultadoAjusteCorriente{}, fmt.Errorf("calcular factor agrupamiento subterráneo: %w", err)
+		}
 	} else {
-		// Tubería: aplica factor de agrupamiento basado en conductores por tubo
-		factorAgr, err = uc.tablaRepo.ObtenerFactorAgrupamiento(ctx, conductoresPorTubo)
+		// Tubería: aplica factor de agrupamiento basado en conductores portadores por tubo
+		factorAgr, err = uc.tablaRepo.ObtenerFactorAgrupamiento(ctx, portadoresPorTubo)
 		if err != nil {
 			return dto.ResultadoAjusteCorriente{}, fmt.Errorf("calcular factor agrupamiento: %w", err)
 		}
@@ -108,7 +195,7 @@ func (uc *AjustarCorrienteUseCase) Execute(
 	}
 
 	// Calculate adjusted current using domain service
-	// Fórmula: corrienteAjustada = corrienteNominal * factorUso / (factorTemp * factorAgr)
+	// Fórmula: corrienteAjustada = corrienteNominal * factorUso [* factorArm] / (factorTemp * factorAgr)
 	// El servicio multiplica todos los factores, entonces pasamos las inversas
 	// para temperatura y agrupamiento
 	factores := map[string]float64{
@@ -116,6 +203,32 @@ func (uc *AjustarCorrienteUseCase) Execute(
 		"temperatura":  1.0 / factorTemp,
 		"agrupamiento": 1.0 / factorAgr,
 	}
+	if resultadoSubterranea != nil {
+		factores["resistividad"] = 1.0 / resultadoSubterranea.FactorResistividad
+		factores["profundidad"] = 1.0 / resultadoSubterranea.FactorProfundidad
+	}
+
+	// Armónicos (opcional): corriente RMS y criterio del Anexo E en circuitos
+	// trifásicos de 4 hilos, cuyo neutro transporta las armónicas triples. El conteo
+	// del neutro como portador (310-15(b)(5)(c)) se resuelve aparte, en el agrupamiento.
+	factorArmonico := 1.0
+	var resultadoArmonicos *dto.ResultadoArmonicos
+	if armonicos != nil && armonicos.TieneContenido() {
+		neutroConCarga := sistemaElectrico.CantidadNeutros() > 0 && sistemaElectrico.CantidadFases() == 3
+		fa := service.CalcularFactorArmonico(*armonicos, neutroConCarga)
+		factorArmonico = fa.Factor
+		factores["armonicos"] = fa.Factor
+		resultadoArmonicos = &dto.ResultadoArmonicos{
+			THDi:               fa.THDi,
+			TerceraArmonica:    fa.TerceraArmonica,
+			CorrienteRMS:       corrienteNominal.Valor() * fa.FactorRMS,
+			FactorRMS:          fa.FactorRMS,
+			FactorReduccion:    fa.FactorReduccion,
+			DimensionPorNeutro: fa.DimensionPorNeutro,
+			FactorAnexoE:       fa.FactorAnexoE,
+			Factor:             fa.Factor,
+		}
+	}
 
 	resultado, err := service.AjustarCorriente(corrienteNominal, factores)
 	if err != nil {
@@ -133,5 +246,42 @@ func (uc *AjustarCorrienteUseCase) Execute(
 		ConductoresPorTubo:       conductoresPorTubo,
 		CantidadConductoresTotal: cantidadTotal,
 		TemperaturaAmbiente:      tempAmbiente,
+
+		NeutroPortador:               neutroPortador,
+		ConductoresPortadoresPorTubo: portadoresPorTubo,
+
+		FactorArmonico: factorArmonico,
+		Armonicos:      resultadoArmonicos,
+
+		TemperaturaTerminal: temperaturaTerminal.Valor(),
+		CorrienteDiseno:     corrienteNominal.Valor() * factorUso * factorArmonico,
+
+		Subterranea: resultadoSubterranea,
+	}, nil
+}
+
+// factoresSubterraneos obtiene los factores por resistividad térmica del suelo y
+// profundidad de enterramiento respecto a las condiciones del Anexo B.
+func (uc *AjustarCorrienteUseCase) factoresSubterraneos(
+	ctx context.Context,
+	tipoCanalizacion entity.TipoCanalizacion,
+	condiciones entity.CondicionesSubterraneas,
+	numCircuitos int,
+) (*dto.ResultadoInstalacionSubterranea, error) {
+	factorRho, err := uc.tablaRepo.ObtenerFactorResistividadTermica(ctx, tipoCanalizacion, condiciones.ResistividadTermica)
+	if err != nil {
+		return nil, fmt.Errorf("calcular factor resistividad térmica: %w", err)
+	}
+	factorProf, err := uc.tablaRepo.ObtenerFactorProfundidad(ctx, tipoCanalizacion, condiciones.ProfundidadMM)
+	if err != nil {
+		return nil, fmt.Errorf("calcular factor profundidad: %w", err)
+	}
+	return &dto.ResultadoInstalacionSubterranea{
+		ResistividadTermica: condiciones.ResistividadTermica,
+		ProfundidadMM:       condiciones.ProfundidadMM,
+		TemperaturaTerreno:  condiciones.TemperaturaTerreno,
+		NumeroCircuitos:     numCircuitos,
+		FactorResistividad:  factorRho,
+		FactorProfundidad:   factorProf,
 	}, nil
 }
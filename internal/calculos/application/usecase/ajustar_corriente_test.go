@@ -10,6 +10,7 @@ import (
 	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
 	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // Mock implementations using concrete types
@@ -18,10 +19,15 @@ type mockTablaRepo struct {
 	tempAmbienteErr       error
 	factorTemp60          float64 // factor for 60°C conductor
 	factorTemp75          float64 // factor for 75°C conductor
+	factorTemp90          float64 // factor for 90°C conductor (0 = use factorTemp75)
 	factorTempErr         error
 	factorAgrupamiento    float64
 	factorAgrupamientoErr error
 	factorTemp            float64 // factor for generic temperature (used in table-driven tests)
+	// Underground factors (0 = 1.0)
+	factorResistividad     float64
+	factorProfundidad      float64
+	factorAgrupSubterraneo float64
 }
 
 func (m *mockTablaRepo) ObtenerTablaAmpacidad(ctx context.Context, canalizacion entity.TipoCanalizacion, material valueobject.MaterialConductor, temperatura valueobject.Temperatura) ([]valueobject.EntradaTablaConductor, error) {
@@ -36,6 +42,14 @@ func (m *mockTablaRepo) ObtenerTablaTierra(ctx context.Context) ([]valueobject.E
 	return nil, nil
 }
 
+func (m *mockTablaRepo) ObtenerTablaITM(ctx context.Context) ([]valueobject.EntradaTablaITM, error) {
+	return nil, nil
+}
+
+func (m *mockTablaRepo) ObtenerTablaCorrienteMotor(ctx context.Context, fases int) ([]valueobject.EntradaTablaMotor, error) {
+	return nil, nil
+}
+
 func (m *mockTablaRepo) ObtenerImpedancia(ctx context.Context, calibre string, canalizacion entity.TipoCanalizacion, material valueobject.MaterialConductor) (valueobject.ResistenciaReactancia, error) {
 	return valueobject.ResistenciaReactancia{}, nil
 }
@@ -63,6 +77,9 @@ func (m *mockTablaRepo) ObtenerFactorTemperatura(ctx context.Context, tempAmbien
 	case valueobject.Temp75:
 		return m.factorTemp75, nil
 	case valueobject.Temp90:
+		if m.factorTemp90 != 0 {
+			return m.factorTemp90, nil
+		}
 		// Return factorTemp75 as fallback for 90°C
 		return m.factorTemp75, nil
 	default:
@@ -75,7 +92,27 @@ func (m *mockTablaRepo) ObtenerFactorAgrupamiento(ctx context.Context, cantidadC
 	return m.factorAgrupamiento, m.factorAgrupamientoErr
 }
 
-func (m *mockTablaRepo) ObtenerDiametroConductor(ctx context.Context, calibre string, material string, conAislamiento bool) (float64, error) {
+func (m *mockTablaRepo) ObtenerFactorResistividadTermica(ctx context.Context, canalizacion entity.TipoCanalizacion, resistividad float64) (float64, error) {
+	return valorOUno(m.factorResistividad), nil
+}
+
+func (m *mockTablaRepo) ObtenerFactorProfundidad(ctx context.Context, canalizacion entity.TipoCanalizacion, profundidadMM float64) (float64, error) {
+	return valorOUno(m.factorProfundidad), nil
+}
+
+func (m *mockTablaRepo) ObtenerFactorAgrupamientoSubterraneo(ctx context.Context, canalizacion entity.TipoCanalizacion, numCircuitos int) (float64, error) {
+	return valorOUno(m.factorAgrupSubterraneo), nil
+}
+
+// valorOUno returns v, or 1.0 when the mock factor was not set.
+func valorOUno(v float64) float64 {
+	if v == 0 {
+		return 1.0
+	}
+	return v
+}
+
+func (m *mockTablaRepo) ObtenerDiametroConductor(ctx context.Context, calibre string, material string, aislamiento valueobject.TipoAislamiento) (float64, error) {
 	return 0, nil
 }
 
@@ -87,7 +124,15 @@ func (m *mockTablaRepo) ObtenerTablaCharola(ctx context.Context, tipo entity.Tip
 	return nil, nil
 }
 
-func (m *mockTablaRepo) ObtenerAreaConductor(ctx context.Context, calibre string) (float64, error) {
+func (m *mockTablaRepo) ObtenerTablaLlenadoCharola(ctx context.Context, tipo entity.TipoCharola) ([]valueobject.EntradaTablaLlenadoCharola, error) {
+	return nil, nil
+}
+
+func (m *mockTablaRepo) ObtenerCableMulticonductor(ctx context.Context, tipo valueobject.TipoCableMulticonductor, calibre string, conductores int) (valueobject.CableMulticonductor, error) {
+	return valueobject.CableMulticonductor{}, nil
+}
+
+func (m *mockTablaRepo) ObtenerAreaConductor(ctx context.Context, calibre string, aislamiento valueobject.TipoAislamiento) (float64, error) {
 	return 0, nil
 }
 
@@ -136,7 +181,7 @@ func TestAjustarCorrienteUseCase_Execute_FiltroActivo(t *testing.T) {
 	numTuberias := 1
 
 	// Execute
-	result, err := uc.Execute(ctx, corrienteNominal, "Sonora", tipoCanalizacion, sistemaElectrico, tipoEquipo, hilosPorFase, numTuberias)
+	result, err := uc.Execute(ctx, corrienteNominal, "Sonora", tipoCanalizacion, sistemaElectrico, tipoEquipo, hilosPorFase, numTuberias, OpcionesAjusteCorriente{})
 
 	// Assert
 	assert.NoError(t, err)
@@ -172,7 +217,7 @@ func TestAjustarCorrienteUseCase_Execute_Transformador(t *testing.T) {
 	numTuberias := 2
 
 	// Execute
-	result, err := uc.Execute(ctx, corrienteNominal, "Jalisco", tipoCanalizacion, sistemaElectrico, tipoEquipo, hilosPorFase, numTuberias)
+	result, err := uc.Execute(ctx, corrienteNominal, "Jalisco", tipoCanalizacion, sistemaElectrico, tipoEquipo, hilosPorFase, numTuberias, OpcionesAjusteCorriente{})
 
 	// Assert
 	assert.NoError(t, err)
@@ -206,7 +251,7 @@ func TestAjustarCorrienteUseCase_Execute_Carga(t *testing.T) {
 	numTuberias := 1
 
 	// Execute
-	result, err := uc.Execute(ctx, corrienteNominal, "CDMX", tipoCanalizacion, sistemaElectrico, tipoEquipo, hilosPorFase, numTuberias)
+	result, err := uc.Execute(ctx, corrienteNominal, "CDMX", tipoCanalizacion, sistemaElectrico, tipoEquipo, hilosPorFase, numTuberias, OpcionesAjusteCorriente{})
 
 	// Assert
 	assert.NoError(t, err)
@@ -238,7 +283,7 @@ func TestAjustarCorrienteUseCase_Execute_FiltroRechazo(t *testing.T) {
 	numTuberias := 1
 
 	// Execute
-	result, err := uc.Execute(ctx, corrienteNominal, "NuevoLeon", tipoCanalizacion, sistemaElectrico, tipoEquipo, hilosPorFase, numTuberias)
+	result, err := uc.Execute(ctx, corrienteNominal, "NuevoLeon", tipoCanalizacion, sistemaElectrico, tipoEquipo, hilosPorFase, numTuberias, OpcionesAjusteCorriente{})
 
 	// Assert
 	assert.NoError(t, err)
@@ -268,7 +313,7 @@ func TestAjustarCorrienteUseCase_Execute_TipoEquipoInvalido(t *testing.T) {
 	numTuberias := 1
 
 	// Execute
-	_, err := uc.Execute(ctx, corrienteNominal, "Sonora", tipoCanalizacion, sistemaElectrico, tipoEquipo, hilosPorFase, numTuberias)
+	_, err := uc.Execute(ctx, corrienteNominal, "Sonora", tipoCanalizacion, sistemaElectrico, tipoEquipo, hilosPorFase, numTuberias, OpcionesAjusteCorriente{})
 
 	// Assert
 	assert.Error(t, err)
@@ -293,7 +338,7 @@ func TestAjustarCorrienteUseCase_Execute_DistribucionNoDivisible(t *testing.T) {
 	numTuberias := 4  // 6 / 4 = 1.5 → no divisible
 
 	// Execute
-	_, err := uc.Execute(ctx, corrienteNominal, "Sonora", tipoCanalizacion, sistemaElectrico, tipoEquipo, hilosPorFase, numTuberias)
+	_, err := uc.Execute(ctx, corrienteNominal, "Sonora", tipoCanalizacion, sistemaElectrico, tipoEquipo, hilosPorFase, numTuberias, OpcionesAjusteCorriente{})
 
 	// Assert
 	assert.Error(t, err)
@@ -319,7 +364,7 @@ func TestAjustarCorrienteUseCase_Execute_Defaults(t *testing.T) {
 	numTuberias := 0  // Debe default a 1
 
 	// Execute
-	result, err := uc.Execute(ctx, corrienteNominal, "Sonora", tipoCanalizacion, sistemaElectrico, tipoEquipo, hilosPorFase, numTuberias)
+	result, err := uc.Execute(ctx, corrienteNominal, "Sonora", tipoCanalizacion, sistemaElectrico, tipoEquipo, hilosPorFase, numTuberias, OpcionesAjusteCorriente{})
 
 	// Assert
 	assert.NoError(t, err)
@@ -344,7 +389,7 @@ func TestAjustarCorrienteUseCase_Execute_RepositoryError(t *testing.T) {
 	numTuberias := 1
 
 	// Execute
-	_, err := uc.Execute(ctx, corrienteNominal, "EstadoInvalido", tipoCanalizacion, sistemaElectrico, tipoEquipo, hilosPorFase, numTuberias)
+	_, err := uc.Execute(ctx, corrienteNominal, "EstadoInvalido", tipoCanalizacion, sistemaElectrico, tipoEquipo, hilosPorFase, numTuberias, OpcionesAjusteCorriente{})
 
 	// Assert
 	assert.Error(t, err)
@@ -368,7 +413,7 @@ func TestAjustarCorrienteUseCase_Execute_FactorTemperaturaError(t *testing.T) {
 	numTuberias := 1
 
 	// Execute
-	_, err := uc.Execute(ctx, corrienteNominal, "Sonora", tipoCanalizacion, sistemaElectrico, tipoEquipo, hilosPorFase, numTuberias)
+	_, err := uc.Execute(ctx, corrienteNominal, "Sonora", tipoCanalizacion, sistemaElectrico, tipoEquipo, hilosPorFase, numTuberias, OpcionesAjusteCorriente{})
 
 	// Assert
 	assert.Error(t, err)
@@ -394,7 +439,7 @@ func TestAjustarCorrienteUseCase_Execute_FactorAgrupamientoError(t *testing.T) {
 	numTuberias := 1
 
 	// Execute
-	_, err := uc.Execute(ctx, corrienteNominal, "Sonora", tipoCanalizacion, sistemaElectrico, tipoEquipo, hilosPorFase, numTuberias)
+	_, err := uc.Execute(ctx, corrienteNominal, "Sonora", tipoCanalizacion, sistemaElectrico, tipoEquipo, hilosPorFase, numTuberias, OpcionesAjusteCorriente{})
 
 	// Assert
 	assert.Error(t, err)
@@ -423,7 +468,7 @@ func TestAjustarCorrienteUseCase_Temperatura60WhenBothCurrentsBelow100(t *testin
 	numTuberias := 1
 
 	// Execute
-	result, err := uc.Execute(ctx, corrienteNominal, "CDMX", tipoCanalizacion, sistemaElectrico, tipoEquipo, hilosPorFase, numTuberias)
+	result, err := uc.Execute(ctx, corrienteNominal, "CDMX", tipoCanalizacion, sistemaElectrico, tipoEquipo, hilosPorFase, numTuberias, OpcionesAjusteCorriente{})
 
 	// Assert
 	assert.NoError(t, err)
@@ -453,7 +498,7 @@ func TestAjustarCorrienteUseCase_Temperatura75WhenNominalAbove100(t *testing.T)
 	numTuberias := 1
 
 	// Execute
-	result, err := uc.Execute(ctx, corrienteNominal, "Sonora", tipoCanalizacion, sistemaElectrico, tipoEquipo, hilosPorFase, numTuberias)
+	result, err := uc.Execute(ctx, corrienteNominal, "Sonora", tipoCanalizacion, sistemaElectrico, tipoEquipo, hilosPorFase, numTuberias, OpcionesAjusteCorriente{})
 
 	// Assert
 	assert.NoError(t, err)
@@ -487,7 +532,7 @@ func TestAjustarCorrienteUseCase_Temperatura75WhenNominalBelowButAdjustedAbove10
 	numTuberias := 1
 
 	// Execute
-	result, err := uc.Execute(ctx, corrienteNominal, "Sonora", tipoCanalizacion, sistemaElectrico, tipoEquipo, hilosPorFase, numTuberias)
+	result, err := uc.Execute(ctx, corrienteNominal, "Sonora", tipoCanalizacion, sistemaElectrico, tipoEquipo, hilosPorFase, numTuberias, OpcionesAjusteCorriente{})
 
 	// Assert
 	assert.NoError(t, err)
@@ -568,7 +613,7 @@ func TestAjustarCorrienteUseCase_TemperatureSelectionTableDriven(t *testing.T) {
 			numTuberias := 1
 
 			// Execute
-			result, err := uc.Execute(ctx, corrienteNominal, "Sonora", tipoCanalizacion, sistemaElectrico, tipoEquipo, hilosPorFase, numTuberias)
+			result, err := uc.Execute(ctx, corrienteNominal, "Sonora", tipoCanalizacion, sistemaElectrico, tipoEquipo, hilosPorFase, numTuberias, OpcionesAjusteCorriente{})
 
 			// Assert
 			assert.NoError(t, err, tt.description)
@@ -576,3 +621,256 @@ func TestAjustarCorrienteUseCase_TemperatureSelectionTableDriven(t *testing.T) {
 		})
 	}
 }
+
+func TestAjustarCorrienteUseCase_Execute_Armonicos(t *testing.T) {
+	mockRepo := &mockTablaRepo{
+		tempAmbiente:       30,
+		factorTemp60:       1.0,
+		factorTemp75:       1.0,
+		factorAgrupamiento: 0.80,
+	}
+	uc := NewAjustarCorrienteUseCase(mockRepo)
+
+	ctx := context.Background()
+	corrienteNominal, _ := valueobject.NewCorriente(100.0)
+	espectro := &entity.EspectroArmonico{Ordenes: map[int]float64{3: 25, 5: 15, 7: 8}}
+
+	// ESTRELLA: neutro con carga → Anexo E (3ª = 23.9 % → factor 0.86)
+	// I_adj = 100 × 1.35 × (1.0447 / 0.86) / (1.0 × 0.80) = 204.99 A
+	result, err := uc.Execute(ctx, corrienteNominal, "Sonora", entity.TipoCanalizacionTuberiaPVC, entity.SistemaElectricoEstrella, entity.TipoEquipoFiltroActivo, 1, 1, OpcionesAjusteCorriente{Armonicos: espectro})
+	assert.NoError(t, err)
+	assert.True(t, result.NeutroPortador)
+	assert.InDelta(t, 1.2148, result.FactorArmonico, 0.001)
+	assert.InDelta(t, 204.99, result.CorrienteAjustada, 0.05)
+	if assert.NotNil(t, result.Armonicos) {
+		assert.InDelta(t, 104.47, result.Armonicos.CorrienteRMS, 0.01)
+		assert.InDelta(t, 0.86, result.Armonicos.FactorReduccion, 0.001)
+	}
+
+	// Sin espectro: factor armónico neutro
+	result, err = uc.Execute(ctx, corrienteNominal, "Sonora", entity.TipoCanalizacionTuberiaPVC, entity.SistemaElectricoEstrella, entity.TipoEquipoFiltroActivo, 1, 1, OpcionesAjusteCorriente{})
+	assert.NoError(t, err)
+	assert.InDelta(t, 1.0, result.FactorArmonico, 0.001)
+	assert.Nil(t, result.Armonicos)
+}
+
+func TestAjustarCorrienteUseCase_Execute_EstrellaTriplenesAnexoE(t *testing.T) {
+	mockRepo := &mockTablaRepo{
+		tempAmbiente:       30,
+		factorTemp60:       1.0,
+		factorTemp75:       1.0,
+		factorAgrupamiento: 0.80,
+	}
+	uc := NewAjustarCorrienteUseCase(mockRepo)
+
+	ctx := context.Background()
+	corrienteNominal, _ := valueobject.NewCorriente(100.0)
+
+	// 3ª = 25 / 1.0308 = 24.3 % de la fase RMS: el neutro cuenta para el agrupamiento
+	// (4 conductores → 0.80) y, por separado, el Anexo E reduce a 0.86.
+	// I_adj = 100 × 1.25 × (1.0308 / 0.86) / 0.80 = 187.28 A
+	espectro := &entity.EspectroArmonico{Ordenes: map[int]float64{3: 25}}
+	result, err := uc.Execute(ctx, corrienteNominal, "Sonora", entity.TipoCanalizacionTuberiaPVC, entity.SistemaElectricoEstrella, entity.TipoEquipoCarga, 1, 1, OpcionesAjusteCorriente{Armonicos: espectro})
+	require.NoError(t, err)
+	assert.True(t, result.NeutroPortador)
+	assert.Equal(t, 4, result.ConductoresPortadoresPorTubo)
+	assert.Equal(t, 0.80, result.FactorAgrupamiento)
+	if assert.NotNil(t, result.Armonicos) {
+		assert.InDelta(t, 24.25, result.Armonicos.TerceraArmonica, 0.01)
+		assert.InDelta(t, 0.86, result.Armonicos.FactorReduccion, 0.001)
+		assert.False(t, result.Armonicos.DimensionPorNeutro)
+	}
+	assert.InDelta(t, 187.28, result.CorrienteAjustada, 0.01)
+
+	// Misma base y umbral: 3ª de 15.1 % de la fundamental = 14.93 % de la fase RMS →
+	// ni neutro portador ni reducción del Anexo E
+	espectro = &entity.EspectroArmonico{Ordenes: map[int]float64{3: 15.1}}
+	result, err = uc.Execute(ctx, corrienteNominal, "Sonora", entity.TipoCanalizacionTuberiaPVC, entity.SistemaElectricoEstrella, entity.TipoEquipoCarga, 1, 1, OpcionesAjusteCorriente{Armonicos: espectro})
+	require.NoError(t, err)
+	assert.False(t, result.NeutroPortador)
+	assert.Equal(t, 3, result.ConductoresPortadoresPorTubo)
+	if assert.NotNil(t, result.Armonicos) {
+		assert.InDelta(t, 1.0, result.Armonicos.FactorReduccion, 0.001)
+	}
+
+	// DELTA: sin neutro no aplica el Anexo E aunque la 3ª sea alta
+	espectro = &entity.EspectroArmonico{Ordenes: map[int]float64{3: 40}}
+	result, err = uc.Execute(ctx, corrienteNominal, "Sonora", entity.TipoCanalizacionTuberiaPVC, entity.SistemaElectricoDelta, entity.TipoEquipoCarga, 1, 1, OpcionesAjusteCorriente{Armonicos: espectro})
+	require.NoError(t, err)
+	if assert.NotNil(t, result.Armonicos) {
+		assert.InDelta(t, 1.0, result.Armonicos.FactorAnexoE, 0.001)
+		assert.False(t, result.Armonicos.DimensionPorNeutro)
+	}
+}
+
+func TestAjustarCorrienteUseCase_Execute_NeutroPortador(t *testing.T) {
+	mockRepo := &mockTablaRepo{
+		tempAmbiente:       30,
+		factorTemp60:       1.0,
+		factorTemp75:       1.0,
+		factorAgrupamiento: 1.0,
+	}
+	uc := NewAjustarCorrienteUseCase(mockRepo)
+
+	ctx := context.Background()
+	corrienteNominal, _ := valueobject.NewCorriente(100.0)
+
+	// ESTRELLA sin armónicas: el neutro solo lleva el desbalance → 3 portadores
+	result, err := uc.Execute(ctx, corrienteNominal, "Sonora", entity.TipoCanalizacionTuberiaPVC, entity.SistemaElectricoEstrella, entity.TipoEquipoFiltroActivo, 1, 1, OpcionesAjusteCorriente{})
+	assert.NoError(t, err)
+	assert.False(t, result.NeutroPortador)
+	assert.Equal(t, 4, result.ConductoresPorTubo)
+	assert.Equal(t, 3, result.ConductoresPortadoresPorTubo)
+
+	// ESTRELLA con 3ª armónica > 15 % de la fase RMS: el neutro cuenta (310-15(b)(5)(c))
+	espectro := &entity.EspectroArmonico{Ordenes: map[int]float64{3: 25}}
+	result, err = uc.Execute(ctx, corrienteNominal, "Sonora", entity.TipoCanalizacionTuberiaPVC, entity.SistemaElectricoEstrella, entity.TipoEquipoFiltroActivo, 2, 2, OpcionesAjusteCorriente{Armonicos: espectro})
+	assert.NoError(t, err)
+	assert.True(t, result.NeutroPortador)
+	assert.Equal(t, 4, result.ConductoresPortadoresPorTubo)
+
+	// BIFASICO: el neutro siempre cuenta
+	result, err = uc.Execute(ctx, corrienteNominal, "Sonora", entity.TipoCanalizacionTuberiaPVC, entity.SistemaElectricoBifasico, entity.TipoEquipoFiltroActivo, 1, 1, OpcionesAjusteCorriente{})
+	assert.NoError(t, err)
+	assert.True(t, result.NeutroPortador)
+	assert.Equal(t, 3, result.ConductoresPortadoresPorTubo)
+}
+
+func TestAjustarCorrienteUseCase_Aislamiento90DerrateaDesdeColumna90(t *testing.T) {
+	// THHN (90°C) con 150 A: terminal de 75°C, derrateo con el factor de 90°C
+	mockRepo := &mockTablaRepo{
+		tempAmbiente:       40,
+		factorTemp60:       0.82,
+		factorTemp75:       0.88,
+		factorTemp90:       0.91,
+		factorAgrupamiento: 1.0,
+	}
+	uc := NewAjustarCorrienteUseCase(mockRepo)
+
+	ctx := context.Background()
+	corrienteNominal, _ := valueobject.NewCorriente(150.0)
+
+	result, err := uc.Execute(ctx, corrienteNominal, "Sonora", entity.TipoCanalizacionTuberiaPVC, entity.SistemaElectricoDelta, entity.TipoEquipoFiltroActivo, 1, 1, OpcionesAjusteCorriente{Aislamiento: valueobject.AislamientoTHHN})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 90, result.Temperatura)
+	assert.Equal(t, 75, result.TemperaturaTerminal)
+	assert.Equal(t, 0.91, result.FactorTemperatura)
+	// I_diseño = 150 × 1.35 = 202.5 A; I_ajustada = 202.5 / 0.91
+	assert.InDelta(t, 202.5, result.CorrienteDiseno, 0.001)
+	assert.InDelta(t, 202.5/0.91, result.CorrienteAjustada, 0.001)
+}
+
+func TestAjustarCorrienteUseCase_Aislamiento75SinLimiteTerminal(t *testing.T) {
+	mockRepo := &mockTablaRepo{
+		tempAmbiente:       40,
+		factorTemp60:       0.82,
+		factorTemp75:       0.88,
+		factorTemp90:       0.91,
+		factorAgrupamiento: 1.0,
+	}
+	uc := NewAjustarCorrienteUseCase(mockRepo)
+
+	ctx := context.Background()
+	corrienteNominal, _ := valueobject.NewCorriente(150.0)
+
+	result, err := uc.Execute(ctx, corrienteNominal, "Sonora", entity.TipoCanalizacionTuberiaPVC, entity.SistemaElectricoDelta, entity.TipoEquipoFiltroActivo, 1, 1, OpcionesAjusteCorriente{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 75, result.Temperatura)
+	assert.Equal(t, 75, result.TemperaturaTerminal)
+	assert.Equal(t, 0.88, result.FactorTemperatura)
+}
+
+func TestAjustarCorrienteUseCase_DuctoSubterraneo(t *testing.T) {
+	mockRepo := &mockTablaRepo{
+		tempAmbiente:           40, // no se usa: manda la temperatura del terreno
+		factorTemp75:           0.88,
+		factorAgrupamiento:     0.80,
+		factorResistividad:     0.95,
+		factorProfundidad:      0.99,
+		factorAgrupSubterraneo: 0.94,
+	}
+	uc := NewAjustarCorrienteUseCase(mockRepo)
+
+	ctx := context.Background()
+	corrienteNominal, _ := valueobject.NewCorriente(150.0)
+	tempTerreno := 30
+	condiciones, err := entity.NewCondicionesSubterraneas(entity.TipoCanalizacionDuctoSubterraneo, 120, 900, &tempTerreno)
+	assert.NoError(t, err)
+
+	result, err := uc.Execute(ctx, corrienteNominal, "Sonora", entity.TipoCanalizacionDuctoSubterraneo, entity.SistemaElectricoDelta, entity.TipoEquipoFiltroActivo, 2, 2, OpcionesAjusteCorriente{Subterranea: &condiciones})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 75, result.Temperatura)
+	assert.Equal(t, 30, result.TemperaturaAmbiente)
+	// F_temp = √((75 − 30) / (75 − 20))
+	assert.InDelta(t, 0.9045, result.FactorTemperatura, 0.0001)
+	assert.Equal(t, 0.94, result.FactorAgrupamiento)
+	if assert.NotNil(t, result.Subterranea) {
+		assert.Equal(t, 0.95, result.Subterranea.FactorResistividad)
+		assert.Equal(t, 0.99, result.Subterranea.FactorProfundidad)
+		assert.Equal(t, 2, result.Subterranea.NumeroCircuitos)
+		assert.Equal(t, 900.0, result.Subterranea.ProfundidadMM)
+	}
+	// I_ajustada = 150 × 1.35 / (F_temp × 0.94 × 0.95 × 0.99)
+	assert.InDelta(t, 202.5/(result.FactorTemperatura*0.94*0.95*0.99), result.CorrienteAjustada, 0.001)
+}
+
+func TestAjustarCorrienteUseCase_SobreTerrenoSinFactoresSubterraneos(t *testing.T) {
+	mockRepo := &mockTablaRepo{
+		tempAmbiente:       40,
+		factorTemp75:       0.88,
+		factorAgrupamiento: 1.0,
+	}
+	uc := NewAjustarCorrienteUseCase(mockRepo)
+
+	ctx := context.Background()
+	corrienteNominal, _ := valueobject.NewCorriente(150.0)
+
+	result, err := uc.Execute(ctx, corrienteNominal, "Sonora", entity.TipoCanalizacionTuberiaPVC, entity.SistemaElectricoDelta, entity.TipoEquipoFiltroActivo, 1, 1, OpcionesAjusteCorriente{})
+
+	assert.NoError(t, err)
+	assert.Nil(t, result.Subterranea)
+	assert.Equal(t, 40, result.TemperaturaAmbiente)
+}
+
+// mockAgrupamientoRepo registra el número de conductores con que se pide el factor de agrupamiento.
+type mockAgrupamientoRepo struct {
+	mockTablaRepo
+	cantidadConductores int
+}
+
+func (m *mockAgrupamientoRepo) ObtenerFactorAgrupamiento(ctx context.Context, cantidadConductores int) (float64, error) {
+	m.cantidadConductores = cantidadConductores
+	return m.factorAgrupamiento, nil
+}
+
+func TestAjustarCorrienteUseCase_MulticonductorEnCharola(t *testing.T) {
+	ctx := context.Background()
+	corrienteNominal, _ := valueobject.NewCorriente(50.0)
+
+	t.Run("monoconductores en charola triangular: 75 °C sin agrupamiento", func(t *testing.T) {
+		mockRepo := &mockAgrupamientoRepo{mockTablaRepo: mockTablaRepo{tempAmbiente: 30, factorTemp60: 1, factorTemp75: 1, factorAgrupamiento: 0.80}}
+		uc := NewAjustarCorrienteUseCase(mockRepo)
+
+		result, err := uc.Execute(ctx, corrienteNominal, "CDMX", entity.TipoCanalizacionCharolaCableTriangular, entity.SistemaElectricoEstrella,
+			entity.TipoEquipoCarga, 2, 1, OpcionesAjusteCorriente{})
+		require.NoError(t, err)
+		assert.Equal(t, 75, result.Temperatura)
+		assert.Equal(t, 1.0, result.FactorAgrupamiento)
+		assert.Zero(t, mockRepo.cantidadConductores)
+	})
+
+	t.Run("multiconductor: columna de tubería y agrupamiento por conductores del cable", func(t *testing.T) {
+		mockRepo := &mockAgrupamientoRepo{mockTablaRepo: mockTablaRepo{tempAmbiente: 30, factorTemp60: 1, factorTemp75: 1, factorAgrupamiento: 0.80}}
+		uc := NewAjustarCorrienteUseCase(mockRepo)
+
+		result, err := uc.Execute(ctx, corrienteNominal, "CDMX", entity.TipoCanalizacionCharolaCableTriangular, entity.SistemaElectricoEstrella,
+			entity.TipoEquipoCarga, 2, 1, OpcionesAjusteCorriente{Multiconductor: true})
+		require.NoError(t, err)
+		assert.Equal(t, 60, result.Temperatura, "310-15(b)(16) tiene columna de 60 °C")
+		assert.Equal(t, 0.80, result.FactorAgrupamiento)
+		assert.Equal(t, 3, mockRepo.cantidadConductores, "3 portadores por cable, no 6 por los 2 cables")
+	})
+}
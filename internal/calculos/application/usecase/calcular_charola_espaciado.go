@@ -37,6 +37,7 @@ func (uc *CalcularCharolaEspaciadoUseCase) Execute(
 	// 2. Convertir primitivos a value objects
 	conductorFase, err := valueobject.NewConductorCharola(valueobject.ConductorCharolaParams{
 		DiametroMM: input.DiametroFaseMM,
+		SeccionMM2: input.SeccionFaseMM2,
 	})
 	if err != nil {
 		return dto.CharolaEspaciadoOutput{}, fmt.Errorf("crear conductor fase: %w", err)
@@ -49,6 +50,18 @@ func (uc *CalcularCharolaEspaciadoUseCase) Execute(
 		return dto.CharolaEspaciadoOutput{}, fmt.Errorf("crear conductor tierra: %w", err)
 	}
 
+	// Neutro: mismo diámetro que la fase salvo que se indique uno propio
+	diametroNeutro := input.DiametroFaseMM
+	if input.DiametroNeutroMM != nil {
+		diametroNeutro = *input.DiametroNeutroMM
+	}
+	conductorNeutro, err := valueobject.NewConductorCharola(valueobject.ConductorCharolaParams{
+		DiametroMM: diametroNeutro,
+	})
+	if err != nil {
+		return dto.CharolaEspaciadoOutput{}, fmt.Errorf("crear conductor neutro: %w", err)
+	}
+
 	// Parse sistema eléctrico
 	sistema, err := entity.ParseSistemaElectrico(input.SistemaElectrico)
 	if err != nil {
@@ -68,20 +81,25 @@ func (uc *CalcularCharolaEspaciadoUseCase) Execute(
 		cablesControl = append(cablesControl, cableControl)
 	}
 
-	// 3. Obtener tabla de charolas del repo
-	tablaCharola, err := uc.tablaRepo.ObtenerTablaCharola(ctx, entity.TipoCanalizacionCharolaCableEspaciado)
+	// 3. Obtener anchos y límites de llenado NOM 392-22 del tipo de charola
+	especificacion, err := entity.NewEspecificacionCharola(input.TipoCharola, input.PeralteMM)
+	if err != nil {
+		return dto.CharolaEspaciadoOutput{}, fmt.Errorf("especificación de charola: %w", err)
+	}
+	tablaLlenado, err := uc.tablaRepo.ObtenerTablaLlenadoCharola(ctx, especificacion.Tipo)
 	if err != nil {
 		return dto.CharolaEspaciadoOutput{}, fmt.Errorf("obtener tabla charola: %w", err)
 	}
 
 	// 4. Llamar al servicio de dominio
-	resultado, err := service.CalcularCharolaEspaciado(
+	resultado, llenado, err := service.CalcularCharolaEspaciadoConLlenado(
 		input.HilosPorFase,
 		sistema,
 		conductorFase,
+		conductorNeutro,
 		conductorTierra,
-		tablaCharola,
 		cablesControl,
+		service.CriterioLlenadoCharola{Especificacion: especificacion, Tabla: tablaLlenado},
 	)
 	if err != nil {
 		return dto.CharolaEspaciadoOutput{}, fmt.Errorf("calcular charola espaciado: %w", err)
@@ -116,12 +134,13 @@ func (uc *CalcularCharolaEspaciadoUseCase) Execute(
 	}
 
 	hilosFaseTotal := numFases * input.HilosPorFase
+	espacioFuerza := float64(hilosFaseTotal) * input.DiametroFaseMM
 	if tieneNeutro {
 		hilosFaseTotal += input.HilosPorFase
+		espacioFuerza += float64(input.HilosPorFase) * diametroNeutro
 	}
 
 	// Valores de fuerza obtenidos directamente del input
-	espacioFuerza := float64(hilosFaseTotal) * input.DiametroFaseMM
 	anchoFuerza := espacioFuerza
 
 	out := dto.CharolaEspaciadoOutput{
@@ -130,6 +149,9 @@ func (uc *CalcularCharolaEspaciadoUseCase) Execute(
 		TamanoPulgadas:   resultado.Tamano + "\"",
 		AnchoRequerido:   resultado.AnchoRequerido,
 		AnchoComercialMM: resultado.AnchoComercialMM,
+		TipoCharola:      string(especificacion.Tipo),
+		PeralteMM:        especificacion.PeralteMM,
+		Llenado:          llenadoCharolaToDTO(llenado),
 		DiametroFaseMM:   input.DiametroFaseMM,
 		DiametroTierraMM: input.DiametroTierraMM,
 		NumHilosTotal:    hilosFaseTotal,
@@ -143,5 +165,20 @@ func (uc *CalcularCharolaEspaciadoUseCase) Execute(
 	if input.DiametroControlMM != nil && *input.DiametroControlMM > 0 {
 		out.DiametroControlMM = input.DiametroControlMM
 	}
+	if tieneNeutro && input.DiametroNeutroMM != nil {
+		out.DiametroNeutroMM = *input.DiametroNeutroMM
+	}
 	return out, nil
 }
+
+// llenadoCharolaToDTO convierte la verificación de llenado del dominio al DTO de salida.
+func llenadoCharolaToDTO(llenado service.ResultadoLlenadoCharola) dto.LlenadoCharola {
+	return dto.LlenadoCharola{
+		AnchoUtilMM:                      llenado.AnchoUtilMM,
+		SumaDiametrosMM:                  llenado.SumaDiametrosMM,
+		AreaMonoconductoresMM2:           llenado.AreaMonoconductoresMM2,
+		AreaMonoconductoresPermitidaMM2:  llenado.AreaMonoconductoresPermitidaMM2,
+		AreaMulticonductoresMM2:          llenado.AreaMulticonductoresMM2,
+		AreaMulticonductoresPermitidaMM2: llenado.AreaMulticonductoresPermitidaMM2,
+	}
+}
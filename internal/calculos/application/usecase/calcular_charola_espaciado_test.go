@@ -16,6 +16,7 @@ import (
 // mockCharolaRepo es un mock para TablaNOMRepository específico para tests de charola.
 type mockCharolaRepo struct {
 	tablaCharola []valueobject.EntradaTablaCanalizacion
+	tablaLlenado []valueobject.EntradaTablaLlenadoCharola
 	tablaErr     error
 }
 
@@ -31,6 +32,14 @@ func (m *mockCharolaRepo) ObtenerTablaTierra(ctx context.Context) ([]valueobject
 	return nil, nil
 }
 
+func (m *mockCharolaRepo) ObtenerTablaITM(ctx context.Context) ([]valueobject.EntradaTablaITM, error) {
+	return nil, nil
+}
+
+func (m *mockCharolaRepo) ObtenerTablaCorrienteMotor(ctx context.Context, fases int) ([]valueobject.EntradaTablaMotor, error) {
+	return nil, nil
+}
+
 func (m *mockCharolaRepo) ObtenerImpedancia(ctx context.Context, calibre string, canalizacion entity.TipoCanalizacion, material valueobject.MaterialConductor) (valueobject.ResistenciaReactancia, error) {
 	return valueobject.ResistenciaReactancia{}, nil
 }
@@ -51,7 +60,19 @@ func (m *mockCharolaRepo) ObtenerFactorAgrupamiento(ctx context.Context, cantida
 	return 1.0, nil
 }
 
-func (m *mockCharolaRepo) ObtenerDiametroConductor(ctx context.Context, calibre string, material string, conAislamiento bool) (float64, error) {
+func (m *mockCharolaRepo) ObtenerFactorResistividadTermica(ctx context.Context, canalizacion entity.TipoCanalizacion, resistividad float64) (float64, error) {
+	return 1.0, nil
+}
+
+func (m *mockCharolaRepo) ObtenerFactorProfundidad(ctx context.Context, canalizacion entity.TipoCanalizacion, profundidadMM float64) (float64, error) {
+	return 1.0, nil
+}
+
+func (m *mockCharolaRepo) ObtenerFactorAgrupamientoSubterraneo(ctx context.Context, canalizacion entity.TipoCanalizacion, numCircuitos int) (float64, error) {
+	return 1.0, nil
+}
+
+func (m *mockCharolaRepo) ObtenerDiametroConductor(ctx context.Context, calibre string, material string, aislamiento valueobject.TipoAislamiento) (float64, error) {
 	return 0, nil
 }
 
@@ -63,7 +84,29 @@ func (m *mockCharolaRepo) ObtenerTablaCharola(ctx context.Context, tipo entity.T
 	return m.tablaCharola, m.tablaErr
 }
 
-func (m *mockCharolaRepo) ObtenerAreaConductor(ctx context.Context, calibre string) (float64, error) {
+// ObtenerTablaLlenadoCharola returns tablaLlenado when set; otherwise it derives the
+// fill table from tablaCharola widths with the ladder ratios of Tabla 392-22.
+func (m *mockCharolaRepo) ObtenerTablaLlenadoCharola(ctx context.Context, tipo entity.TipoCharola) ([]valueobject.EntradaTablaLlenadoCharola, error) {
+	if m.tablaErr != nil || m.tablaLlenado != nil {
+		return m.tablaLlenado, m.tablaErr
+	}
+	tabla := make([]valueobject.EntradaTablaLlenadoCharola, 0, len(m.tablaCharola))
+	for _, entrada := range m.tablaCharola {
+		tabla = append(tabla, valueobject.EntradaTablaLlenadoCharola{
+			Tamano:                entrada.Tamano,
+			AnchoMM:               entrada.AreaInteriorMM2,
+			AreaMulticonductorMM2: 30 * entrada.AreaInteriorMM2,
+			AreaMonoconductorMM2:  28 * entrada.AreaInteriorMM2,
+		})
+	}
+	return tabla, nil
+}
+
+func (m *mockCharolaRepo) ObtenerCableMulticonductor(ctx context.Context, tipo valueobject.TipoCableMulticonductor, calibre string, conductores int) (valueobject.CableMulticonductor, error) {
+	return valueobject.CableMulticonductor{}, nil
+}
+
+func (m *mockCharolaRepo) ObtenerAreaConductor(ctx context.Context, calibre string, aislamiento valueobject.TipoAislamiento) (float64, error) {
 	return 0, nil
 }
 
@@ -262,3 +305,42 @@ func TestCalcularCharolaEspaciadoUseCase_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestCalcularCharolaEspaciadoUseCase_TipoCharola(t *testing.T) {
+	mockRepo := &mockCharolaRepo{
+		tablaLlenado: []valueobject.EntradaTablaLlenadoCharola{
+			{Tamano: "3", AnchoMM: 76.2, AreaMulticonductorUnicoMM2: 1500, AreaMulticonductorMM2: 850},
+			{Tamano: "4", AnchoMM: 101.6, AreaMulticonductorUnicoMM2: 2900, AreaMulticonductorMM2: 1600},
+			{Tamano: "6", AnchoMM: 152.4, AreaMulticonductorUnicoMM2: 4500, AreaMulticonductorMM2: 2450},
+		},
+	}
+	uc := NewCalcularCharolaEspaciadoUseCase(mockRepo)
+
+	t.Run("canal ventilado", func(t *testing.T) {
+		// Monofásico Ø10 mm: 20 + 20 + 5 = 45 mm → canal 3"
+		output, err := uc.Execute(context.Background(), dto.CharolaEspaciadoInput{
+			HilosPorFase:     1,
+			SistemaElectrico: "MONOFASICO",
+			DiametroFaseMM:   10,
+			DiametroTierraMM: 5,
+			TipoCharola:      "CANAL",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "3", output.Tamano)
+		assert.Equal(t, "CANAL", output.TipoCharola)
+		assert.Zero(t, output.PeralteMM)
+		assert.InDelta(t, 25.0, output.Llenado.SumaDiametrosMM, 0.01)
+	})
+
+	t.Run("tipo de charola inválido", func(t *testing.T) {
+		_, err := uc.Execute(context.Background(), dto.CharolaEspaciadoInput{
+			HilosPorFase:     1,
+			SistemaElectrico: "MONOFASICO",
+			DiametroFaseMM:   10,
+			DiametroTierraMM: 5,
+			TipoCharola:      "MALLA",
+		})
+		assert.ErrorIs(t, err, entity.ErrTipoCharolaInvalido)
+		assert.Contains(t, err.Error(), "tipo_charola")
+	})
+}
@@ -37,6 +37,7 @@ func (uc *CalcularCharolaTriangularUseCase) Execute(
 	// 2. Convertir primitivos a value objects
 	conductorFase, err := valueobject.NewConductorCharola(valueobject.ConductorCharolaParams{
 		DiametroMM: input.DiametroFaseMM,
+		SeccionMM2: input.SeccionFaseMM2,
 	})
 	if err != nil {
 		return dto.CharolaTriangularOutput{}, fmt.Errorf("crear conductor fase: %w", err)
@@ -62,19 +63,23 @@ func (uc *CalcularCharolaTriangularUseCase) Execute(
 		cablesControl = append(cablesControl, cableControl)
 	}
 
-	// 3. Obtener tabla de charolas del repo
-	tablaCharola, err := uc.tablaRepo.ObtenerTablaCharola(ctx, entity.TipoCanalizacionCharolaCableTriangular)
+	// 3. Obtener anchos y límites de llenado NOM 392-22 del tipo de charola
+	especificacion, err := entity.NewEspecificacionCharola(input.TipoCharola, input.PeralteMM)
+	if err != nil {
+		return dto.CharolaTriangularOutput{}, fmt.Errorf("especificación de charola: %w", err)
+	}
+	tablaLlenado, err := uc.tablaRepo.ObtenerTablaLlenadoCharola(ctx, especificacion.Tipo)
 	if err != nil {
 		return dto.CharolaTriangularOutput{}, fmt.Errorf("obtener tabla charola: %w", err)
 	}
 
 	// 4. Llamar al servicio de dominio
-	resultado, err := service.CalcularCharolaTriangular(
+	resultado, llenado, err := service.CalcularCharolaTriangularConLlenado(
 		input.HilosPorFase,
 		conductorFase,
 		conductorTierra,
-		tablaCharola,
 		cablesControl,
+		service.CriterioLlenadoCharola{Especificacion: especificacion, Tabla: tablaLlenado},
 	)
 	if err != nil {
 		return dto.CharolaTriangularOutput{}, fmt.Errorf("calcular charola triangular: %w", err)
@@ -100,6 +105,9 @@ func (uc *CalcularCharolaTriangularUseCase) Execute(
 		TamanoPulgadas:   resultado.Tamano + "\"",
 		AnchoRequerido:   resultado.AnchoRequerido,
 		AnchoComercialMM: resultado.AnchoComercialMM,
+		TipoCharola:      string(especificacion.Tipo),
+		PeralteMM:        especificacion.PeralteMM,
+		Llenado:          llenadoCharolaToDTO(llenado),
 		DiametroFaseMM:   input.DiametroFaseMM,
 		DiametroTierraMM: input.DiametroTierraMM,
 		AnchoPotenciaMM:  anchoPotencia,
@@ -4,6 +4,7 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"math"
 
 	"github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
 	"github.com/garfex/calculadora-filtros/internal/calculos/application/port"
@@ -15,14 +16,18 @@ import (
 // CalcularCorrienteUseCase executes Step 1: Nominal Current.
 type CalcularCorrienteUseCase struct {
 	equipoRepo port.EquipoRepository
+	tablaRepo  port.TablaNOMRepository
 }
 
 // NewCalcularCorrienteUseCase creates a new instance.
+// tablaRepo provides the motor full-load current tables (430-248/430-250).
 func NewCalcularCorrienteUseCase(
 	equipoRepo port.EquipoRepository,
+	tablaRepo port.TablaNOMRepository,
 ) *CalcularCorrienteUseCase {
 	return &CalcularCorrienteUseCase{
 		equipoRepo: equipoRepo,
+		tablaRepo:  tablaRepo,
 	}
 }
 
@@ -36,6 +41,13 @@ func (uc *CalcularCorrienteUseCase) Execute(ctx context.Context, input dto.Equip
 		return uc.calcularManualAmperaje(input)
 
 	case dto.ModoManualPotencia:
+		tipo, _ := input.GetTipoEquipo()
+		switch tipo {
+		case entity.TipoEquipoMotor:
+			return uc.calcularMotor(ctx, input)
+		case entity.TipoEquipoBancoCapacitores:
+			return uc.calcularBancoCapacitores(input)
+		}
 		return uc.calcularManualPotencia(input)
 
 	default:
@@ -139,3 +151,130 @@ func (uc *CalcularCorrienteUseCase) calcularManualPotencia(input dto.EquipoInput
 
 	return dto.ResultadoCorriente{CorrienteNominal: corriente.Valor()}, nil
 }
+
+// calcularMotor obtains the full-load current from Tabla 430-248/430-250
+// (NOM 430-6(a)(1)); the nameplate power is only used to pick the table row.
+func (uc *CalcularCorrienteUseCase) calcularMotor(ctx context.Context, input dto.EquipoInput) (dto.ResultadoCorriente, error) {
+	potencia, err := input.ToDomainPotencia()
+	if err != nil {
+		return dto.ResultadoCorriente{}, fmt.Errorf("potencia inválida: %w", err)
+	}
+
+	tension, err := input.ToDomainTension()
+	if err != nil {
+		return dto.ResultadoCorriente{}, fmt.Errorf("tensión inválida: %w", err)
+	}
+
+	// Motores trifásicos → 430-250; monofásicos (o dos fases de un sistema trifásico) → 430-248
+	fases := 1
+	tablaNOM := "430-248"
+	tensionMotor := tension.Valor()
+	if input.SistemaElectrico.ToEntity().CantidadFases() == 3 {
+		fases = 3
+		tablaNOM = "430-250"
+		// Las columnas de la 430-250 son tensiones entre fases (127 V fase-neutro → 220 V)
+		if tipoVoltaje, err := input.ToDomainTipoVoltaje(); err == nil && tipoVoltaje.EsFaseNeutro() {
+			tensionMotor = int(math.Round(float64(tensionMotor) * math.Sqrt(3)))
+		}
+	}
+
+	tabla, err := uc.tablaRepo.ObtenerTablaCorrienteMotor(ctx, fases)
+	if err != nil {
+		return dto.ResultadoCorriente{}, fmt.Errorf("obtener tabla %s: %w", tablaNOM, err)
+	}
+
+	plenaCarga, err := service.BuscarCorrientePlenaCargaMotor(potencia.HP(), tensionMotor, fases, tabla)
+	if err != nil {
+		return dto.ResultadoCorriente{}, fmt.Errorf("buscar corriente a plena carga: %w", err)
+	}
+
+	motor, err := entity.NewMotor(input.Equipo.Clave, tensionMotor, plenaCarga.PotenciaHP, fases, plenaCarga.Corriente, entity.ITM{Amperaje: input.Equipo.ITM})
+	if err != nil {
+		return dto.ResultadoCorriente{}, fmt.Errorf("crear entidad de motor: %w", err)
+	}
+
+	corriente, err := service.CalcularCorrienteNominal(motor)
+	if err != nil {
+		return dto.ResultadoCorriente{}, fmt.Errorf("calcular corriente: %w", err)
+	}
+
+	factorConductor, err := service.CalcularFactorUso(entity.TipoEquipoMotor)
+	if err != nil {
+		return dto.ResultadoCorriente{}, fmt.Errorf("calcular factor de uso: %w", err)
+	}
+
+	return dto.ResultadoCorriente{
+		CorrienteNominal: corriente.Valor(),
+		Motor: &dto.ResultadoMotor{
+			PotenciaHP:          plenaCarga.PotenciaHP,
+			PotenciaHPTabla:     plenaCarga.PotenciaHPTabla,
+			Tension:             plenaCarga.Tension,
+			TensionTabla:        plenaCarga.TensionTabla,
+			Fases:               fases,
+			TablaNOM:            tablaNOM,
+			CorrientePlenaCarga: corriente.Valor(),
+			FactorConductor:     factorConductor,
+			CorrienteConductor:  corriente.Valor() * factorConductor,
+			FactorProteccion:    service.FactorProteccionMotor,
+		},
+	}, nil
+}
+
+// calcularBancoCapacitores calculates the current of a capacitor bank from its kVAR
+// rating (I = KVAR / (kV × √3)) and the NOM 460 requirements.
+func (uc *CalcularCorrienteUseCase) calcularBancoCapacitores(input dto.EquipoInput) (dto.ResultadoCorriente, error) {
+	potencia, err := input.ToDomainPotencia()
+	if err != nil {
+		return dto.ResultadoCorriente{}, fmt.Errorf("potencia inválida: %w", err)
+	}
+
+	tension, err := input.ToDomainTension()
+	if err != nil {
+		return dto.ResultadoCorriente{}, fmt.Errorf("tensión inválida: %w", err)
+	}
+
+	pasos := input.PasosCapacitores
+	if pasos < 1 {
+		pasos = 1
+	}
+
+	banco, err := entity.NewBancoCapacitores(input.Equipo.Clave, tension.Valor(), potencia.KVAR(), pasos, entity.ITM{Amperaje: input.Equipo.ITM})
+	if err != nil {
+		return dto.ResultadoCorriente{}, fmt.Errorf("crear entidad de banco de capacitores: %w", err)
+	}
+
+	corriente, err := service.CalcularCorrienteNominal(banco)
+	if err != nil {
+		return dto.ResultadoCorriente{}, fmt.Errorf("calcular corriente: %w", err)
+	}
+
+	resultado := nuevoResultadoBancoCapacitores(corriente, tension.Valor(), pasos)
+	resultado.KVAR = banco.KVAR
+	resultado.KVARPorPaso = banco.KVARPorPaso()
+
+	return dto.ResultadoCorriente{
+		CorrienteNominal: corriente.Valor(),
+		BancoCapacitores: &resultado,
+	}, nil
+}
+
+// nuevoResultadoBancoCapacitores arma el resultado NOM 460 a partir de la corriente
+// nominal del banco; también se usa cuando la corriente se proporciona directamente.
+func nuevoResultadoBancoCapacitores(corriente valueobject.Corriente, tension int, pasos int) dto.ResultadoBancoCapacitores {
+	if pasos < 1 {
+		pasos = 1
+	}
+	requisitos := service.CalcularRequisitosBancoCapacitores(corriente, tension)
+
+	return dto.ResultadoBancoCapacitores{
+		Pasos:                        pasos,
+		Tension:                      tension,
+		CorrienteNominal:             corriente.Valor(),
+		CorrientePorPaso:             corriente.Valor() / float64(pasos),
+		FactorConductor:              service.FactorCapacitores,
+		CorrienteMinimaConductor:     requisitos.CorrienteMinimaConductor,
+		CorrienteMinimaDesconectador: requisitos.CorrienteMinimaDesconectador,
+		TensionResidualMaxima:        requisitos.TensionResidualMaxima,
+		TiempoDescarga:               requisitos.TiempoDescarga,
+	}
+}
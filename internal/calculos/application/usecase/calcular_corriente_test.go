@@ -0,0 +1,131 @@
+// internal/calculos/application/usecase/calcular_corriente_test.go
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/service"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockMotorRepo returns an excerpt of Tabla 430-250 for three-phase motors.
+type mockMotorRepo struct {
+	mockTablaRepo
+}
+
+func (m *mockMotorRepo) ObtenerTablaCorrienteMotor(ctx context.Context, fases int) ([]valueobject.EntradaTablaMotor, error) {
+	return []valueobject.EntradaTablaMotor{
+		{PotenciaHP: 15, Corrientes: map[int]float64{208: 46.2, 230: 42, 460: 21}},
+		{PotenciaHP: 20, Corrientes: map[int]float64{208: 59.4, 230: 54, 460: 27}},
+		{PotenciaHP: 250, Corrientes: map[int]float64{460: 302, 575: 242}},
+	}, nil
+}
+
+func TestCalcularCorrienteUseCase_Motor(t *testing.T) {
+	uc := NewCalcularCorrienteUseCase(nil, &mockMotorRepo{})
+	ctx := context.Background()
+
+	t.Run("HP a 480 V usa Tabla 430-250 columna 460 V", func(t *testing.T) {
+		res, err := uc.Execute(ctx, dto.EquipoInput{
+			Modo:             dto.ModoManualPotencia,
+			TipoEquipo:       "MOTOR",
+			PotenciaNominal:  20,
+			PotenciaUnidad:   "HP",
+			Tension:          480,
+			SistemaElectrico: dto.SistemaElectricoDelta,
+		})
+		require.NoError(t, err)
+		assert.InDelta(t, 27.0, res.CorrienteNominal, 0.001)
+		require.NotNil(t, res.Motor)
+		assert.Equal(t, "430-250", res.Motor.TablaNOM)
+		assert.Equal(t, 460, res.Motor.TensionTabla)
+		assert.InDelta(t, 33.75, res.Motor.CorrienteConductor, 0.001)
+		assert.InDelta(t, 2.5, res.Motor.FactorProteccion, 0.001)
+	})
+
+	t.Run("kW se convierte a HP", func(t *testing.T) {
+		// 11.19 kW ≈ 15 HP
+		res, err := uc.Execute(ctx, dto.EquipoInput{
+			Modo:             dto.ModoManualPotencia,
+			TipoEquipo:       "MOTOR",
+			PotenciaNominal:  11.19,
+			PotenciaUnidad:   "KW",
+			Tension:          220,
+			SistemaElectrico: dto.SistemaElectricoDelta,
+		})
+		require.NoError(t, err)
+		assert.InDelta(t, 42.0, res.CorrienteNominal, 0.001)
+		assert.InDelta(t, 15.0, res.Motor.PotenciaHPTabla, 0.001)
+	})
+}
+
+func TestCalcularCorrienteUseCase_MotorTipoVoltaje(t *testing.T) {
+	uc := NewCalcularCorrienteUseCase(nil, &mockMotorRepo{})
+
+	tests := []struct {
+		name             string
+		hp               float64
+		tension          float64
+		tipoVoltaje      string
+		sistema          dto.SistemaElectrico
+		wantErr          bool
+		wantTension      int
+		wantTensionTabla int
+		wantCorriente    float64
+	}{
+		{"127 V fase-neutro en estrella se busca a 220 V", 20, 127, "FASE_NEUTRO", dto.SistemaElectricoEstrella, false, 220, 230, 54},
+		{"220 V fase-fase en delta", 20, 220, "FASE_FASE", dto.SistemaElectricoDelta, false, 220, 230, 54},
+		{"277 V fase-neutro en estrella se busca a 480 V", 20, 277, "FASE_NEUTRO", dto.SistemaElectricoEstrella, false, 480, 460, 27},
+		{"250 HP a 127 V fase-neutro: celda de 230 V en blanco", 250, 127, "FASE_NEUTRO", dto.SistemaElectricoEstrella, true, 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := uc.Execute(context.Background(), dto.EquipoInput{
+				Modo:             dto.ModoManualPotencia,
+				TipoEquipo:       "MOTOR",
+				PotenciaNominal:  tt.hp,
+				PotenciaUnidad:   "HP",
+				Tension:          tt.tension,
+				TipoVoltaje:      tt.tipoVoltaje,
+				SistemaElectrico: tt.sistema,
+			})
+			if tt.wantErr {
+				assert.ErrorIs(t, err, service.ErrMotorNoEncontrado)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, res.Motor)
+			assert.Equal(t, tt.wantTension, res.Motor.Tension)
+			assert.Equal(t, tt.wantTensionTabla, res.Motor.TensionTabla)
+			assert.InDelta(t, tt.wantCorriente, res.CorrienteNominal, 0.001)
+		})
+	}
+}
+
+func TestCalcularCorrienteUseCase_BancoCapacitores(t *testing.T) {
+	uc := NewCalcularCorrienteUseCase(nil, &mockTablaRepo{})
+
+	// I = 150 kVAR / (0.48 kV × √3) = 180.42 A
+	res, err := uc.Execute(context.Background(), dto.EquipoInput{
+		Modo:             dto.ModoManualPotencia,
+		TipoEquipo:       "BANCO_CAPACITORES",
+		PotenciaNominal:  150,
+		PotenciaUnidad:   "KVAR",
+		PasosCapacitores: 6,
+		Tension:          480,
+		SistemaElectrico: dto.SistemaElectricoDelta,
+	})
+	require.NoError(t, err)
+	assert.InDelta(t, 180.42, res.CorrienteNominal, 0.01)
+	require.NotNil(t, res.BancoCapacitores)
+	assert.Equal(t, 6, res.BancoCapacitores.Pasos)
+	assert.InDelta(t, 25.0, res.BancoCapacitores.KVARPorPaso, 0.001)
+	assert.InDelta(t, 30.07, res.BancoCapacitores.CorrientePorPaso, 0.01)
+	assert.InDelta(t, 243.57, res.BancoCapacitores.CorrienteMinimaDesconectador, 0.01)
+	assert.InDelta(t, 60.0, res.BancoCapacitores.TiempoDescarga, 0.001)
+}
@@ -0,0 +1,122 @@
+// internal/calculos/application/usecase/calcular_cortocircuito.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/port"
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/service"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+)
+
+// CalcularCortocircuitoUseCase calcula la corriente de cortocircuito simétrica
+// (trifásica y fase-tierra) en el extremo de carga de un circuito.
+type CalcularCortocircuitoUseCase struct {
+	tablaRepo port.TablaNOMRepository
+}
+
+// NewCalcularCortocircuitoUseCase crea una nueva instancia.
+func NewCalcularCortocircuitoUseCase(
+	tablaRepo port.TablaNOMRepository,
+) *CalcularCortocircuitoUseCase {
+	return &CalcularCortocircuitoUseCase{
+		tablaRepo: tablaRepo,
+	}
+}
+
+// Execute resuelve R y X de Tabla 9 para el conductor de fase y el de tierra
+// y calcula las corrientes de falla.
+func (uc *CalcularCortocircuitoUseCase) Execute(
+	ctx context.Context,
+	input dto.CortocircuitoInput,
+) (dto.ResultadoCortocircuito, error) {
+	if err := input.Validate(); err != nil {
+		return dto.ResultadoCortocircuito{}, err
+	}
+
+	tipoCanalizacion, err := entity.ParseTipoCanalizacion(input.TipoCanalizacion)
+	if err != nil {
+		return dto.ResultadoCortocircuito{}, fmt.Errorf("tipo canalización inválido: %w", err)
+	}
+	sistemaElectrico, err := entity.ParseSistemaElectrico(input.SistemaElectrico)
+	if err != nil {
+		return dto.ResultadoCortocircuito{}, fmt.Errorf("sistema eléctrico inválido: %w", err)
+	}
+	tipoVoltaje, err := entity.ParseTipoVoltaje(input.TipoVoltaje)
+	if err != nil {
+		return dto.ResultadoCortocircuito{}, fmt.Errorf("tipo voltaje inválido: %w", err)
+	}
+	materialStr := input.Material
+	if materialStr == "" {
+		materialStr = "Cu"
+	}
+	material, err := valueobject.ParseMaterialConductor(materialStr)
+	if err != nil {
+		return dto.ResultadoCortocircuito{}, fmt.Errorf("material inválido: %w", err)
+	}
+	tension, err := valueobject.NewTension(input.Tension, "V")
+	if err != nil {
+		return dto.ResultadoCortocircuito{}, fmt.Errorf("tensión inválida: %w", err)
+	}
+
+	hilosPorFase := input.HilosPorFase
+	if hilosPorFase <= 0 {
+		hilosPorFase = 1
+	}
+	calibreTierra := input.CalibreTierra
+	if calibreTierra == "" {
+		calibreTierra = input.CalibreFase
+	}
+
+	impedanciaFase, err := uc.tablaRepo.ObtenerImpedancia(ctx, input.CalibreFase, tipoCanalizacion, material)
+	if err != nil {
+		return dto.ResultadoCortocircuito{}, fmt.Errorf("obtener impedancia fase: %w", err)
+	}
+	impedanciaTierra, err := uc.tablaRepo.ObtenerImpedancia(ctx, calibreTierra, tipoCanalizacion, material)
+	if err != nil {
+		return dto.ResultadoCortocircuito{}, fmt.Errorf("obtener impedancia tierra: %w", err)
+	}
+
+	fuente := entity.FuenteCortocircuito{
+		PotenciaCortocircuitoMVA: input.PotenciaCortocircuitoMVA,
+		TransformadorKVA:         input.TransformadorKVA,
+		TransformadorZPorcentaje: input.TransformadorZPorcentaje,
+	}
+	entrada := service.EntradaCalculoCortocircuito{
+		Fuente:                     fuente,
+		ResistenciaFaseOhmPorKm:    impedanciaFase.R(),
+		ReactanciaFaseOhmPorKm:     impedanciaFase.X(),
+		ResistenciaRetornoOhmPorKm: impedanciaTierra.R(),
+		ReactanciaRetornoOhmPorKm:  impedanciaTierra.X(),
+		HilosPorFase:               hilosPorFase,
+		ConductoresRetorno:         input.NumHilosTierra,
+		SistemaElectrico:           sistemaElectrico,
+		TipoVoltaje:                tipoVoltaje,
+	}
+
+	resultado, err := service.CalcularCortocircuito(entrada, input.LongitudCircuito, tension)
+	if err != nil {
+		return dto.ResultadoCortocircuito{}, fmt.Errorf("calcular cortocircuito: %w", err)
+	}
+
+	return dto.ResultadoCortocircuito{
+		PotenciaCortocircuitoMVA: fuente.PotenciaCortocircuitoMVA,
+		TransformadorKVA:         fuente.TransformadorKVA,
+		TransformadorZPorcentaje: fuente.TransformadorZPorcentaje,
+		CalibreFase:              input.CalibreFase,
+		CalibreTierra:            calibreTierra,
+		LongitudCircuito:         input.LongitudCircuito,
+		TensionFaseFase:          resultado.TensionFaseFase,
+		ImpedanciaFuenteOhm:      resultado.ImpedanciaFuenteOhm,
+		ResistenciaConductorOhm:  resultado.ResistenciaConductorOhm,
+		ReactanciaConductorOhm:   resultado.ReactanciaConductorOhm,
+		ImpedanciaTrifasicaOhm:   resultado.ImpedanciaTrifasicaOhm,
+		ImpedanciaLineaTierraOhm: resultado.ImpedanciaLineaTierraOhm,
+		IccBornesKA:              resultado.IccBornesKA,
+		IccTrifasicaKA:           resultado.IccTrifasicaKA,
+		IccLineaTierraKA:         resultado.IccLineaTierraKA,
+	}, nil
+}
@@ -0,0 +1,68 @@
+// internal/calculos/application/usecase/calcular_factor_k.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/service"
+)
+
+// CalcularFactorKUseCase calcula el factor K requerido y la capacidad derrateada
+// de un transformador que alimenta cargas no lineales.
+type CalcularFactorKUseCase struct{}
+
+// NewCalcularFactorKUseCase crea una nueva instancia.
+func NewCalcularFactorKUseCase() *CalcularFactorKUseCase {
+	return &CalcularFactorKUseCase{}
+}
+
+// Execute valida el espectro y calcula K, K-rating y kVA derrateados.
+func (uc *CalcularFactorKUseCase) Execute(
+	ctx context.Context,
+	input dto.FactorKInput,
+) (dto.ResultadoFactorK, error) {
+	if err := input.Validate(); err != nil {
+		return dto.ResultadoFactorK{}, err
+	}
+
+	espectro, err := entity.NewEspectroArmonico(0, input.EspectroArmonico)
+	if err != nil {
+		return dto.ResultadoFactorK{}, err
+	}
+
+	perdidasEC := service.PerdidasCorrientesParasitasDefault
+	if input.PerdidasCorrientesParasitas > 0 {
+		perdidasEC = input.PerdidasCorrientesParasitas / 100.0
+	}
+
+	factorK, err := service.CalcularFactorK(espectro, input.KVA, perdidasEC)
+	if err != nil {
+		return dto.ResultadoFactorK{}, fmt.Errorf("calcular factor K: %w", err)
+	}
+
+	return nuevoResultadoFactorK(factorK, espectro), nil
+}
+
+// nuevoResultadoFactorK convierte el resultado de dominio al DTO; compartido con el orquestador.
+func nuevoResultadoFactorK(factorK entity.FactorK, espectro entity.EspectroArmonico) dto.ResultadoFactorK {
+	suma := 0.0
+	for _, porcentaje := range espectro.Ordenes {
+		suma += (porcentaje / 100.0) * (porcentaje / 100.0)
+	}
+
+	return dto.ResultadoFactorK{
+		KVA:                         factorK.KVA,
+		THDi:                        math.Sqrt(suma) * 100,
+		FactorK:                     factorK.K,
+		KNominal:                    factorK.KNominal,
+		ExcedeNominal:               factorK.KNominal == 0,
+		FHL:                         factorK.FHL,
+		PerdidasCorrientesParasitas: factorK.PerdidasEC * 100,
+		CorrienteMaximaPU:           factorK.CorrienteMaximaPU,
+		KVADerrateado:               factorK.KVADerrateado,
+	}
+}
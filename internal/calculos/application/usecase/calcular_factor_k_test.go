@@ -0,0 +1,54 @@
+// internal/calculos/application/usecase/calcular_factor_k_test.go
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalcularFactorKUseCase(t *testing.T) {
+	uc := NewCalcularFactorKUseCase()
+	ctx := context.Background()
+
+	t.Run("P_EC-R por defecto", func(t *testing.T) {
+		r, err := uc.Execute(ctx, dto.FactorKInput{
+			KVA:              500,
+			EspectroArmonico: map[int]float64{5: 20, 7: 14, 11: 9, 13: 7},
+		})
+		require.NoError(t, err)
+
+		assert.InDelta(t, 4.446, r.FactorK, 0.001)
+		assert.Equal(t, 9, r.KNominal)
+		assert.False(t, r.ExcedeNominal)
+		assert.InDelta(t, 26.94, r.THDi, 0.01)
+		assert.InDelta(t, 15.0, r.PerdidasCorrientesParasitas, 0.001)
+		assert.InDelta(t, 415.31, r.KVADerrateado, 0.01)
+	})
+
+	t.Run("P_EC-R proporcionado", func(t *testing.T) {
+		// I_max = √(1.08 / (1 + 4.446 × 0.08)) = 0.8926
+		r, err := uc.Execute(ctx, dto.FactorKInput{
+			KVA:                         500,
+			EspectroArmonico:            map[int]float64{5: 20, 7: 14, 11: 9, 13: 7},
+			PerdidasCorrientesParasitas: 8,
+		})
+		require.NoError(t, err)
+
+		assert.InDelta(t, 0.8926, r.CorrienteMaximaPU, 0.0001)
+	})
+
+	t.Run("espectro vacío", func(t *testing.T) {
+		_, err := uc.Execute(ctx, dto.FactorKInput{KVA: 500})
+		assert.ErrorIs(t, err, dto.ErrFactorKInvalido)
+	})
+
+	t.Run("orden inválido", func(t *testing.T) {
+		_, err := uc.Execute(ctx, dto.FactorKInput{KVA: 500, EspectroArmonico: map[int]float64{1: 20}})
+		assert.ErrorIs(t, err, entity.ErrEspectroArmonicoInvalido)
+	})
+}
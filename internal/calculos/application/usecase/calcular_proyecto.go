@@ -0,0 +1,118 @@
+// internal/calculos/application/usecase/calcular_proyecto.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/service"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+)
+
+// calculadorMemoria es el contrato mínimo del orquestador que usa el proyecto.
+type calculadorMemoria interface {
+	Execute(ctx context.Context, input dto.EquipoInput) (dto.MemoriaOutput, error)
+}
+
+// CalcularProyectoUseCase ejecuta el orquestador de memoria para cada circuito
+// de un proyecto y consolida el resumen (kVA total, conductores, no conformes).
+type CalcularProyectoUseCase struct {
+	orquestador calculadorMemoria
+}
+
+// NewCalcularProyectoUseCase creates a new project calculation use case.
+func NewCalcularProyectoUseCase(orquestador *OrquestadorMemoriaCalculoUseCase) *CalcularProyectoUseCase {
+	return &CalcularProyectoUseCase{orquestador: orquestador}
+}
+
+// Execute calcula todos los circuitos siguiendo la red tablero → alimentador → derivados:
+// cada alimentador se calcula antes que sus derivados y su caída acumulada se pasa como
+// CaidaTensionAlimentador al derivado, de modo que el orquestador marque los circuitos que
+// exceden el límite combinado. Si un circuito falla, el error se envuelve con el nombre del
+// circuito y no se devuelve un resultado parcial.
+func (uc *CalcularProyectoUseCase) Execute(ctx context.Context, input dto.ProyectoInput) (dto.ProyectoOutput, error) {
+	if err := input.Validate(); err != nil {
+		return dto.ProyectoOutput{}, err
+	}
+
+	tramos := make([]entity.TramoAlimentacion, len(input.Circuitos))
+	for i, c := range input.Circuitos {
+		tramos[i] = entity.TramoAlimentacion{Nombre: c.Nombre, Alimentador: c.Alimentador}
+	}
+	orden, alimentadores, err := service.OrdenarTramosAlimentacion(tramos)
+	if err != nil {
+		return dto.ProyectoOutput{}, fmt.Errorf("%w: %w", dto.ErrProyectoInvalido, err)
+	}
+
+	memorias := make([]dto.MemoriaOutput, len(input.Circuitos))
+	for _, i := range orden {
+		c := input.Circuitos[i]
+		equipo := c.Input
+		if padre := alimentadores[i]; padre >= 0 {
+			equipo.CaidaTensionAlimentador = memorias[padre].CaidaTension.PorcentajeAcumulado
+		}
+		if input.PorcentajeCaidaTotalMaximo > 0 {
+			equipo.PorcentajeCaidaTotalMaximo = input.PorcentajeCaidaTotalMaximo
+		}
+
+		memoria, err := uc.orquestador.Execute(ctx, equipo)
+		if err != nil {
+			return dto.ProyectoOutput{}, fmt.Errorf("circuito '%s': %w", c.Nombre, err)
+		}
+		memorias[i] = memoria
+	}
+
+	circuitos := make([]dto.CircuitoOutput, 0, len(input.Circuitos))
+	resumenes := make([]dto.ResumenCircuito, 0, len(input.Circuitos))
+	entidades := make([]entity.CircuitoProyecto, 0, len(input.Circuitos))
+
+	for i, c := range input.Circuitos {
+		memoria := memorias[i]
+
+		kva, err := potenciaAparenteMemoria(memoria)
+		if err != nil {
+			return dto.ProyectoOutput{}, fmt.Errorf("circuito '%s': %w", c.Nombre, err)
+		}
+
+		circuitos = append(circuitos, dto.CircuitoOutput{Nombre: c.Nombre, Memoria: memoria})
+		resumenes = append(resumenes, dto.NewResumenCircuito(c.Nombre, c.Alimentador, kva, memoria))
+		entidades = append(entidades, entity.CircuitoProyecto{
+			Nombre:          c.Nombre,
+			Alimentador:     c.Alimentador,
+			PotenciaKVA:     kva,
+			CumpleNormativa: memoria.CumpleNormativa,
+		})
+	}
+
+	proyecto, err := entity.NewProyecto(input.Nombre, entidades)
+	if err != nil {
+		return dto.ProyectoOutput{}, err
+	}
+
+	return dto.ProyectoOutput{
+		Nombre: proyecto.Nombre,
+		Resumen: dto.ResumenProyecto{
+			TotalKVA:             proyecto.TotalKVA(),
+			NumCircuitos:         len(proyecto.Circuitos),
+			CircuitosNoConformes: proyecto.CircuitosNoConformes(),
+			CumpleNormativa:      proyecto.CumpleNormativa(),
+			Circuitos:            resumenes,
+		},
+		Circuitos: circuitos,
+	}, nil
+}
+
+// potenciaAparenteMemoria obtiene los kVA conectados de un circuito ya calculado.
+func potenciaAparenteMemoria(m dto.MemoriaOutput) (float64, error) {
+	corriente, err := valueobject.NewCorriente(m.Corrientes.CorrienteNominal)
+	if err != nil {
+		return 0, fmt.Errorf("corriente nominal: %w", err)
+	}
+	tension, err := valueobject.NewTension(float64(m.Instalacion.Tension), "V")
+	if err != nil {
+		return 0, fmt.Errorf("tensión: %w", err)
+	}
+	return service.CalcularPotenciaAparenteKVA(corriente, tension, m.Instalacion.SistemaElectrico.ToEntity()), nil
+}
@@ -0,0 +1,147 @@
+// internal/calculos/application/usecase/calcular_proyecto_test.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubOrquestador devuelve una memoria predefinida por clave de equipo
+// y registra los inputs recibidos en orden de llamada.
+type stubOrquestador struct {
+	memorias map[string]dto.MemoriaOutput
+	err      error
+	inputs   []dto.EquipoInput
+}
+
+func (s *stubOrquestador) Execute(ctx context.Context, input dto.EquipoInput) (dto.MemoriaOutput, error) {
+	s.inputs = append(s.inputs, input)
+	if s.err != nil {
+		return dto.MemoriaOutput{}, s.err
+	}
+	return s.memorias[input.Equipo.Clave], nil
+}
+
+func memoriaProyecto(clave string, corriente float64, tension int, cumple bool) dto.MemoriaOutput {
+	return dto.MemoriaOutput{
+		Equipo:      dto.DatosEquipo{Clave: clave},
+		Instalacion: dto.DatosInstalacion{Tension: tension, SistemaElectrico: dto.SistemaElectricoDelta, HilosPorFase: 1},
+		Corrientes:  dto.DatosCorrientes{CorrienteNominal: corriente},
+		CableFase:   dto.ResultadoConductor{Calibre: "2 AWG", Material: "Cu"},
+		CableTierra: dto.ResultadoConductor{Calibre: "8 AWG", Material: "Cu"},
+		Canalizacion: dto.DatosCanalizacionCompleta{
+			Resultado: dto.ResultadoCanalizacion{Tamano: "1 1/2", NumeroDeTubos: 1},
+		},
+		CumpleNormativa: cumple,
+	}
+}
+
+func TestCalcularProyectoUseCase_Execute(t *testing.T) {
+	input := dto.ProyectoInput{
+		Nombre: "Tablero TG-1",
+		Circuitos: []dto.CircuitoInput{
+			{Nombre: "TR-01", Input: dto.EquipoInput{Equipo: dto.DatosEquipo{Clave: "TR-01"}}},
+			{Nombre: "FA-01", Input: dto.EquipoInput{Equipo: dto.DatosEquipo{Clave: "FA-01"}}},
+		},
+	}
+
+	t.Run("consolida resumen de todos los circuitos", func(t *testing.T) {
+		uc := &CalcularProyectoUseCase{orquestador: &stubOrquestador{memorias: map[string]dto.MemoriaOutput{
+			"TR-01": memoriaProyecto("TR-01", 90.21, 480, true),
+			"FA-01": memoriaProyecto("FA-01", 100, 480, false),
+		}}}
+
+		out, err := uc.Execute(context.Background(), input)
+		require.NoError(t, err)
+
+		assert.Equal(t, "Tablero TG-1", out.Nombre)
+		require.Len(t, out.Circuitos, 2)
+		assert.Equal(t, 2, out.Resumen.NumCircuitos)
+		// 75 kVA + √3 × 480 × 100 / 1000 = 75 + 83.14
+		assert.InDelta(t, 158.14, out.Resumen.TotalKVA, 0.01)
+		assert.Equal(t, []string{"FA-01"}, out.Resumen.CircuitosNoConformes)
+		assert.False(t, out.Resumen.CumpleNormativa)
+		assert.Equal(t, "2 AWG", out.Resumen.Circuitos[0].CalibreFase)
+		assert.Equal(t, "1 1/2", out.Resumen.Circuitos[0].TamanoCanalizacion)
+	})
+
+	t.Run("derivado recibe la caída acumulada de su alimentador", func(t *testing.T) {
+		alimentador := memoriaProyecto("AL-1", 100, 480, true)
+		alimentador.CaidaTension.PorcentajeAcumulado = 2.4
+		derivado := memoriaProyecto("D-1", 40, 480, false)
+		derivado.CaidaTension.PorcentajeAcumulado = 5.3
+		stub := &stubOrquestador{memorias: map[string]dto.MemoriaOutput{
+			"AL-1": alimentador,
+			"D-1":  derivado,
+		}}
+		uc := &CalcularProyectoUseCase{orquestador: stub}
+
+		out, err := uc.Execute(context.Background(), dto.ProyectoInput{
+			Nombre:                     "Tablero TG-1",
+			PorcentajeCaidaTotalMaximo: 4.0,
+			Circuitos: []dto.CircuitoInput{
+				{Nombre: "D-1", Alimentador: "AL-1", Input: dto.EquipoInput{Equipo: dto.DatosEquipo{Clave: "D-1"}}},
+				{Nombre: "AL-1", Input: dto.EquipoInput{Equipo: dto.DatosEquipo{Clave: "AL-1"}}},
+			},
+		})
+		require.NoError(t, err)
+
+		// El alimentador se calcula primero aunque aparezca después en el input
+		require.Len(t, stub.inputs, 2)
+		assert.Equal(t, "AL-1", stub.inputs[0].Equipo.Clave)
+		assert.Zero(t, stub.inputs[0].CaidaTensionAlimentador)
+		assert.Equal(t, 2.4, stub.inputs[1].CaidaTensionAlimentador)
+		assert.Equal(t, 4.0, stub.inputs[1].PorcentajeCaidaTotalMaximo)
+
+		// La salida conserva el orden del input
+		assert.Equal(t, "D-1", out.Circuitos[0].Nombre)
+		assert.Equal(t, "AL-1", out.Resumen.Circuitos[0].Alimentador)
+		assert.Equal(t, 5.3, out.Resumen.Circuitos[0].CaidaTensionAcumulada)
+		assert.Equal(t, []string{"D-1"}, out.Resumen.CircuitosNoConformes)
+		// Solo el alimentador suma al total: √3 × 480 × 100 / 1000
+		assert.InDelta(t, 83.14, out.Resumen.TotalKVA, 0.01)
+	})
+
+	t.Run("alimentador inexistente es inválido", func(t *testing.T) {
+		uc := &CalcularProyectoUseCase{orquestador: &stubOrquestador{}}
+
+		_, err := uc.Execute(context.Background(), dto.ProyectoInput{
+			Nombre:    "Tablero TG-1",
+			Circuitos: []dto.CircuitoInput{{Nombre: "D-1", Alimentador: "AL-9"}},
+		})
+		assert.ErrorIs(t, err, dto.ErrProyectoInvalido)
+		assert.Contains(t, err.Error(), "AL-9")
+	})
+
+	t.Run("error de un circuito incluye su nombre", func(t *testing.T) {
+		errCalculo := errors.New("conductor no encontrado")
+		uc := &CalcularProyectoUseCase{orquestador: &stubOrquestador{err: errCalculo}}
+
+		_, err := uc.Execute(context.Background(), input)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, errCalculo)
+		assert.Contains(t, err.Error(), "TR-01")
+	})
+
+	t.Run("proyecto sin circuitos es inválido", func(t *testing.T) {
+		uc := &CalcularProyectoUseCase{orquestador: &stubOrquestador{}}
+
+		_, err := uc.Execute(context.Background(), dto.ProyectoInput{Nombre: "Vacío"})
+		assert.ErrorIs(t, err, dto.ErrProyectoInvalido)
+	})
+
+	t.Run("circuitos duplicados son inválidos", func(t *testing.T) {
+		uc := &CalcularProyectoUseCase{orquestador: &stubOrquestador{memorias: map[string]dto.MemoriaOutput{
+			"TR-01": memoriaProyecto("TR-01", 90.21, 480, true),
+		}}}
+		dup := dto.ProyectoInput{Nombre: "Dup", Circuitos: []dto.CircuitoInput{input.Circuitos[0], input.Circuitos[0]}}
+
+		_, err := uc.Execute(context.Background(), dup)
+		assert.ErrorIs(t, err, dto.ErrProyectoInvalido)
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/garfex/calculadora-filtros/internal/calculos/application/port"
 	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
 	"github.com/garfex/calculadora-filtros/internal/calculos/domain/service"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
 )
 
 // CalcularTamanioTuberiaUseCase executes the conduit sizing calculation
@@ -42,8 +43,21 @@ func (uc *CalcularTamanioTuberiaUseCase) Execute(
 		return dto.TuberiaOutput{}, fmt.Errorf("validar tipo canalización: %w", err)
 	}
 
-	// Get areas for each conductor type
-	areaFase, err := uc.tablaRepo.ObtenerAreaConductor(ctx, input.CalibreFase)
+	aislamiento, err := input.ToDomainTipoAislamiento()
+	if err != nil {
+		return dto.TuberiaOutput{}, fmt.Errorf("validar tipo aislamiento: %w", err)
+	}
+
+	tipoCable, err := input.ToDomainTipoCable()
+	if err != nil {
+		return dto.TuberiaOutput{}, fmt.Errorf("validar tipo cable: %w", err)
+	}
+	if tipoCable != nil {
+		return uc.executeMulticonductor(ctx, input, tipoCanalizacion, *tipoCable)
+	}
+
+	// Get areas for each conductor type (Tabla 5, columna del aislamiento)
+	areaFase, err := uc.tablaRepo.ObtenerAreaConductor(ctx, input.CalibreFase, aislamiento)
 	if err != nil {
 		return dto.TuberiaOutput{}, fmt.Errorf("obtener área fase %s: %w", input.CalibreFase, err)
 	}
@@ -51,7 +65,7 @@ func (uc *CalcularTamanioTuberiaUseCase) Execute(
 	// Get area for neutral only if there are neutrals
 	var areaNeutro float64
 	if input.NumNeutros > 0 {
-		areaNeutro, err = uc.tablaRepo.ObtenerAreaConductor(ctx, input.CalibreNeutro)
+		areaNeutro, err = uc.tablaRepo.ObtenerAreaConductor(ctx, input.CalibreNeutro, aislamiento)
 		if err != nil {
 			return dto.TuberiaOutput{}, fmt.Errorf("obtener área neutro %s: %w", input.CalibreNeutro, err)
 		}
@@ -91,6 +105,13 @@ func (uc *CalcularTamanioTuberiaUseCase) Execute(
 		return dto.TuberiaOutput{}, fmt.Errorf("calcular tamaño tubería: %w", err)
 	}
 
+	// Con 3 conductores aislados iguales por tubo se revisa la relación de atascamiento
+	// y, si cae en 2.8 – 3.2, se pasa al siguiente tamaño.
+	relacionAtascamiento, notaAtascamiento, resultado, err := uc.verificarAtascamiento(ctx, input, tipoCanalizacion, aislamiento, resultado, tablaOcupacion)
+	if err != nil {
+		return dto.TuberiaOutput{}, err
+	}
+
 	// Buscar el área de ocupación del tubo seleccionado en la tabla
 	var areaOcupacionSeleccionada float64
 	var designacionMetrica string
@@ -137,5 +158,166 @@ func (uc *CalcularTamanioTuberiaUseCase) Execute(
 		NumTierras:           numTierrasPorTubo, // 1 tierra por tubo (no el total)
 		AreaOcupacionTuboMM2: areaOcupacionSeleccionada,
 		FillFactor:           0.40,
+		RelacionAtascamiento: relacionAtascamiento,
+		NotaAtascamiento:     notaAtascamiento,
 	}, nil
 }
+
+// verificarAtascamiento aplica la revisión de relación de atascamiento cuando cada tubo lleva
+// exactamente 3 conductores aislados del mismo calibre (la tierra desnuda no cuenta).
+// Si la tabla de dimensiones no trae diámetros interiores la revisión se omite.
+func (uc *CalcularTamanioTuberiaUseCase) verificarAtascamiento(
+	ctx context.Context,
+	input dto.TuberiaInput,
+	tipoCanalizacion entity.TipoCanalizacion,
+	aislamiento valueobject.TipoAislamiento,
+	resultado entity.ResultadoTamanioTuberia,
+	tablaOcupacion []valueobject.EntradaTablaOcupacion,
+) (float64, string, entity.ResultadoTamanioTuberia, error) {
+	hilosPorFase := input.GetHilosPorFase()
+	fasesPorTubo := (input.NumFases * hilosPorFase) / input.NumTuberias
+	neutrosPorTubo := (input.NumNeutros * hilosPorFase) / input.NumTuberias
+	if fasesPorTubo+neutrosPorTubo != 3 {
+		return 0, "", resultado, nil
+	}
+	if neutrosPorTubo > 0 && input.CalibreNeutro != input.CalibreFase {
+		return 0, "", resultado, nil
+	}
+
+	tablaDimensiones, err := uc.tablaRepo.ObtenerTablaCanalizacion(ctx, tipoCanalizacion)
+	if err != nil {
+		return 0, "", resultado, fmt.Errorf("obtener dimensiones de tubería: %w", err)
+	}
+	if len(tablaDimensiones) == 0 || tablaDimensiones[0].DiametroInteriorMM <= 0 {
+		return 0, "", resultado, nil
+	}
+
+	material := input.Material
+	if material == "" {
+		material = valueobject.MaterialCobre.String()
+	}
+	diametroConductor, err := uc.tablaRepo.ObtenerDiametroConductor(ctx, input.CalibreFase, material, aislamiento)
+	if err != nil {
+		return 0, "", resultado, fmt.Errorf("obtener diámetro conductor %s: %w", input.CalibreFase, err)
+	}
+	if diametroConductor <= 0 {
+		return 0, "", resultado, nil
+	}
+
+	return ajustarPorAtascamiento(resultado, tablaOcupacion, tablaDimensiones, diametroConductor, "conductores "+input.CalibreFase)
+}
+
+// ajustarPorAtascamiento verifica la relación de atascamiento de 3 conductores o cables
+// iguales de diámetro d y redacta la nota cuando el tubo se aumenta.
+func ajustarPorAtascamiento(
+	resultado entity.ResultadoTamanioTuberia,
+	tablaOcupacion []valueobject.EntradaTablaOcupacion,
+	tablaDimensiones []valueobject.EntradaTablaCanalizacion,
+	diametro float64,
+	descripcion string,
+) (float64, string, entity.ResultadoTamanioTuberia, error) {
+	verificacion, err := service.VerificarAtascamientoTuberia(resultado, tablaOcupacion, tablaDimensiones, diametro)
+	if err != nil {
+		return 0, "", resultado, fmt.Errorf("verificar atascamiento: %w", err)
+	}
+
+	var nota string
+	if verificacion.Ajustada {
+		nota = fmt.Sprintf(
+			"Tubería aumentada de %s\" a %s\": con 3 %s (d = %.2f mm) la relación de atascamiento 1.05 × D / d = %.2f cae en el rango %.1f – %.1f; con %s\" queda en %.2f.",
+			verificacion.TamanoOriginal, verificacion.Resultado.TuberiaRecomendada(), descripcion, diametro,
+			verificacion.RelacionOriginal, service.RelacionAtascamientoMin, service.RelacionAtascamientoMax,
+			verificacion.Resultado.TuberiaRecomendada(), verificacion.Relacion,
+		)
+	}
+	return verificacion.Relacion, nota, verificacion.Resultado, nil
+}
+
+// executeMulticonductor dimensiona la tubería para cables multiconductores (TC/MC): un
+// cable por juego de conductores en paralelo con fases y neutro del calibre de fase y la
+// tierra de fábrica dentro del cable. El llenado se calcula por número de cables por tubo.
+func (uc *CalcularTamanioTuberiaUseCase) executeMulticonductor(
+	ctx context.Context,
+	input dto.TuberiaInput,
+	tipoCanalizacion entity.TipoCanalizacion,
+	tipoCable valueobject.TipoCableMulticonductor,
+) (dto.TuberiaOutput, error) {
+	conductores := input.NumFases + input.NumNeutros
+	cable, err := uc.tablaRepo.ObtenerCableMulticonductor(ctx, tipoCable, input.CalibreFase, conductores)
+	if err != nil {
+		return dto.TuberiaOutput{}, fmt.Errorf("obtener cable multiconductor: %w", err)
+	}
+
+	tablaOcupacion, err := uc.tablaRepo.ObtenerTablaOcupacionTuberia(ctx, tipoCanalizacion)
+	if err != nil {
+		return dto.TuberiaOutput{}, fmt.Errorf("obtener tabla ocupación: %w", err)
+	}
+
+	// Cables por tubo: se reparten entre las tuberías; el último tubo puede llevar menos
+	numCables := input.GetNumCables()
+	cablesPorTubo := (numCables + input.NumTuberias - 1) / input.NumTuberias
+
+	resultado, err := service.CalcularTamanioTuberiaMulticonductor(cable, cablesPorTubo, input.NumTuberias, tipoCanalizacion, tablaOcupacion)
+	if err != nil {
+		return dto.TuberiaOutput{}, fmt.Errorf("calcular tamaño tubería: %w", err)
+	}
+
+	// Con 3 cables por tubo aplica la relación de atascamiento con el diámetro exterior
+	var relacionAtascamiento float64
+	var notaAtascamiento string
+	if cablesPorTubo == 3 {
+		tablaDimensiones, err := uc.tablaRepo.ObtenerTablaCanalizacion(ctx, tipoCanalizacion)
+		if err != nil {
+			return dto.TuberiaOutput{}, fmt.Errorf("obtener dimensiones de tubería: %w", err)
+		}
+		if len(tablaDimensiones) > 0 && tablaDimensiones[0].DiametroInteriorMM > 0 {
+			relacionAtascamiento, notaAtascamiento, resultado, err = ajustarPorAtascamiento(
+				resultado, tablaOcupacion, tablaDimensiones, cable.DiametroMM(), "cables "+cable.Designacion())
+			if err != nil {
+				return dto.TuberiaOutput{}, err
+			}
+		}
+	}
+
+	fillFactor := service.FactorRellenoCables(cablesPorTubo)
+	var areaOcupacionSeleccionada float64
+	var designacionMetrica string
+	for _, entrada := range tablaOcupacion {
+		if entrada.Tamano == resultado.TuberiaRecomendada() {
+			areaInterior := entrada.AreaInteriorMM2
+			if areaInterior <= 0 {
+				areaInterior = entrada.AreaOcupacionMM2 / 0.40
+			}
+			areaOcupacionSeleccionada = areaInterior * fillFactor
+			designacionMetrica = entrada.DesignacionMetrica
+			break
+		}
+	}
+
+	return dto.TuberiaOutput{
+		AreaPorTuboMM2:       resultado.AreaPorTuboMM2(),
+		TuberiaRecomendada:   resultado.TuberiaRecomendada(),
+		DesignacionMetrica:   designacionMetrica,
+		TipoCanalizacion:     string(resultado.TipoCanalizacion()),
+		NumTuberias:          resultado.NumTuberias(),
+		AreaOcupacionTuboMM2: areaOcupacionSeleccionada,
+		FillFactor:           fillFactor,
+		RelacionAtascamiento: relacionAtascamiento,
+		NotaAtascamiento:     notaAtascamiento,
+		NumCablesPorTubo:     cablesPorTubo,
+		CableMulticonductor:  toCableMulticonductorDTO(cable, numCables),
+	}, nil
+}
+
+// toCableMulticonductorDTO mapea el value object del cable a su DTO.
+func toCableMulticonductorDTO(cable valueobject.CableMulticonductor, numCables int) *dto.CableMulticonductor {
+	return &dto.CableMulticonductor{
+		Tipo:               cable.Tipo().String(),
+		Calibre:            cable.Calibre(),
+		Conductores:        cable.NumConductores(),
+		DiametroExteriorMM: cable.DiametroMM(),
+		AreaExteriorMM2:    cable.AreaExteriorMM2(),
+		NumCables:          numCables,
+		Designacion:        cable.Designacion(),
+	}
+}
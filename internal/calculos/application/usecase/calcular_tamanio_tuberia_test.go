@@ -0,0 +1,111 @@
+// internal/calculos/application/usecase/calcular_tamanio_tuberia_test.go
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockTuberiaRepo resuelve áreas, tabla de ocupación, diámetros interiores y diámetro aislado.
+type mockTuberiaRepo struct {
+	mockTablaRepo
+	areas       map[string]float64
+	dimensiones []valueobject.EntradaTablaCanalizacion
+	diametro    float64
+}
+
+func (m *mockTuberiaRepo) ObtenerAreaConductor(ctx context.Context, calibre string, aislamiento valueobject.TipoAislamiento) (float64, error) {
+	return m.areas[calibre], nil
+}
+
+func (m *mockTuberiaRepo) ObtenerAreaConductorDesnudo(ctx context.Context, calibre string) (float64, error) {
+	return m.areas[calibre], nil
+}
+
+func (m *mockTuberiaRepo) ObtenerTablaOcupacionTuberia(ctx context.Context, canalizacion entity.TipoCanalizacion) ([]valueobject.EntradaTablaOcupacion, error) {
+	return []valueobject.EntradaTablaOcupacion{
+		{Tamano: "1 1/4", AreaOcupacionMM2: 374, DesignacionMetrica: "35"},
+		{Tamano: "1 1/2", AreaOcupacionMM2: 510, DesignacionMetrica: "41"},
+		{Tamano: "2", AreaOcupacionMM2: 843, DesignacionMetrica: "53"},
+		{Tamano: "2 1/2", AreaOcupacionMM2: 1201, DesignacionMetrica: "63"},
+	}, nil
+}
+
+func (m *mockTuberiaRepo) ObtenerTablaCanalizacion(ctx context.Context, canalizacion entity.TipoCanalizacion) ([]valueobject.EntradaTablaCanalizacion, error) {
+	return m.dimensiones, nil
+}
+
+func (m *mockTuberiaRepo) ObtenerDiametroConductor(ctx context.Context, calibre string, material string, aislamiento valueobject.TipoAislamiento) (float64, error) {
+	return m.diametro, nil
+}
+
+func TestCalcularTamanioTuberiaUseCase_Atascamiento(t *testing.T) {
+	dimensiones := []valueobject.EntradaTablaCanalizacion{
+		{Tamano: "1 1/4", DiametroInteriorMM: 47.63},
+		{Tamano: "1 1/2", DiametroInteriorMM: 54.61},
+		{Tamano: "2", DiametroInteriorMM: 68.07},
+		{Tamano: "2 1/2", DiametroInteriorMM: 88.9},
+	}
+	ctx := context.Background()
+
+	// 3 × 143.4 + 13.3 = 443.5 mm² → 1 1/2" por área
+	input := dto.TuberiaInput{
+		NumFases:         3,
+		CalibreFase:      "1/0 AWG",
+		CalibreTierra:    "6 AWG",
+		TipoCanalizacion: string(entity.TipoCanalizacionTuberiaPVC),
+		NumTuberias:      1,
+		NumTierras:       1,
+	}
+	areas := map[string]float64{"1/0 AWG": 143.4, "6 AWG": 13.3}
+
+	t.Run("tres conductores iguales en el rango: aumenta el tubo", func(t *testing.T) {
+		uc := NewCalcularTamanioTuberiaUseCase(&mockTuberiaRepo{areas: areas, dimensiones: dimensiones, diametro: 20})
+
+		out, err := uc.Execute(ctx, input)
+		require.NoError(t, err)
+		assert.Equal(t, "2", out.TuberiaRecomendada)
+		assert.Equal(t, "53", out.DesignacionMetrica)
+		assert.Equal(t, 843.0, out.AreaOcupacionTuboMM2)
+		assert.InDelta(t, 3.574, out.RelacionAtascamiento, 0.001)
+		assert.Contains(t, out.NotaAtascamiento, "1 1/2\" a 2\"")
+	})
+
+	t.Run("fuera del rango: conserva el tubo sin nota", func(t *testing.T) {
+		uc := NewCalcularTamanioTuberiaUseCase(&mockTuberiaRepo{areas: areas, dimensiones: dimensiones, diametro: 15})
+
+		out, err := uc.Execute(ctx, input)
+		require.NoError(t, err)
+		assert.Equal(t, "1 1/2", out.TuberiaRecomendada)
+		assert.InDelta(t, 3.823, out.RelacionAtascamiento, 0.001)
+		assert.Empty(t, out.NotaAtascamiento)
+	})
+
+	t.Run("cuatro conductores por tubo: no aplica", func(t *testing.T) {
+		uc := NewCalcularTamanioTuberiaUseCase(&mockTuberiaRepo{areas: areas, dimensiones: dimensiones, diametro: 20})
+
+		conNeutro := input
+		conNeutro.NumNeutros = 1
+		conNeutro.CalibreNeutro = "1/0 AWG"
+		out, err := uc.Execute(ctx, conNeutro)
+		require.NoError(t, err)
+		assert.Equal(t, "2", out.TuberiaRecomendada) // 586.9 mm² por área
+		assert.Zero(t, out.RelacionAtascamiento)
+		assert.Empty(t, out.NotaAtascamiento)
+	})
+
+	t.Run("tabla sin diámetros interiores: omite la revisión", func(t *testing.T) {
+		uc := NewCalcularTamanioTuberiaUseCase(&mockTuberiaRepo{areas: areas, diametro: 20})
+
+		out, err := uc.Execute(ctx, input)
+		require.NoError(t, err)
+		assert.Equal(t, "1 1/2", out.TuberiaRecomendada)
+		assert.Zero(t, out.RelacionAtascamiento)
+	})
+}
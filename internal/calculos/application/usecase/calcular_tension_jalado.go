@@ -0,0 +1,166 @@
+// internal/calculos/application/usecase/calcular_tension_jalado.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/port"
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/service"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+)
+
+// CalcularTensionJaladoUseCase verifica que los conductores se puedan jalar por la
+// ruta de tubería: tensión por tramo, presión lateral en curvas y atascamiento.
+type CalcularTensionJaladoUseCase struct {
+	tablaRepo port.TablaNOMRepository
+}
+
+// NewCalcularTensionJaladoUseCase crea una nueva instancia.
+func NewCalcularTensionJaladoUseCase(tablaRepo port.TablaNOMRepository) *CalcularTensionJaladoUseCase {
+	return &CalcularTensionJaladoUseCase{tablaRepo: tablaRepo}
+}
+
+// Execute obtiene la sección y el diámetro del conductor de las tablas NOM, el diámetro
+// interior del tubo y calcula la tensión de jalado a lo largo de la ruta.
+func (uc *CalcularTensionJaladoUseCase) Execute(
+	ctx context.Context,
+	input dto.TensionJaladoInput,
+) (dto.ResultadoTensionJalado, error) {
+	if err := input.Validate(); err != nil {
+		return dto.ResultadoTensionJalado{}, err
+	}
+
+	material, err := valueobject.ParseMaterialConductor(input.GetMaterial())
+	if err != nil {
+		return dto.ResultadoTensionJalado{}, fmt.Errorf("%w: %v", dto.ErrEquipoInputInvalido, err)
+	}
+	aislamiento, err := valueobject.ParseTipoAislamiento(input.GetTipoAislamiento())
+	if err != nil {
+		return dto.ResultadoTensionJalado{}, fmt.Errorf("%w: %v", dto.ErrEquipoInputInvalido, err)
+	}
+
+	tramos := make([]entity.TramoRuta, len(input.Tramos))
+	for i, t := range input.Tramos {
+		tramos[i] = entity.TramoRuta{
+			Tipo:         entity.TipoTramo(t.Tipo),
+			LongitudM:    t.LongitudM,
+			AnguloGrados: t.AnguloGrados,
+			RadioM:       t.RadioM,
+		}
+	}
+	ruta, err := entity.NewRutaTuberia(tramos)
+	if err != nil {
+		return dto.ResultadoTensionJalado{}, err
+	}
+
+	seccion, err := uc.tablaRepo.ObtenerSeccionConductor(ctx, input.Calibre)
+	if err != nil {
+		return dto.ResultadoTensionJalado{}, fmt.Errorf("obtener sección para calibre %s: %w", input.Calibre, err)
+	}
+	diametroConductor, err := uc.tablaRepo.ObtenerDiametroConductor(ctx, input.Calibre, material.String(), aislamiento)
+	if err != nil {
+		return dto.ResultadoTensionJalado{}, fmt.Errorf("obtener diámetro para calibre %s: %w", input.Calibre, err)
+	}
+
+	diametroTubo := input.DiametroInteriorMM
+	if diametroTubo <= 0 {
+		dimension, err := uc.tablaRepo.GetTuberiaDimensionFisica(ctx, input.TamanoTuberia)
+		if err != nil {
+			return dto.ResultadoTensionJalado{}, fmt.Errorf("%w: %v", dto.ErrTensionJaladoInvalida, err)
+		}
+		diametroTubo = dimension.DiametroInteriorMM
+	}
+
+	pesoConductor := input.PesoConductorKgM
+	if pesoConductor <= 0 {
+		pesoConductor = service.EstimarPesoConductorKgM(material, seccion, diametroConductor)
+	}
+
+	resultado, err := service.CalcularTensionJalado(service.EntradaTensionJalado{
+		Ruta:                    ruta,
+		Material:                material,
+		NumConductores:          input.NumConductores,
+		SeccionMM2:              seccion,
+		DiametroConductorMM:     diametroConductor,
+		DiametroInteriorTuboMM:  diametroTubo,
+		PesoConductorKgM:        pesoConductor,
+		CoeficienteFriccion:     input.CoeficienteFriccion,
+		PresionLateralPermitida: input.PresionLateralMaximaNM,
+		TensionInicialN:         input.TensionInicialN,
+	})
+	if err != nil {
+		return dto.ResultadoTensionJalado{}, fmt.Errorf("calcular tensión de jalado: %w", err)
+	}
+
+	output := dto.ResultadoTensionJalado{
+		Calibre:                      input.Calibre,
+		Material:                     material.String(),
+		NumConductores:               input.NumConductores,
+		SeccionMM2:                   seccion,
+		DiametroConductorMM:          diametroConductor,
+		DiametroInteriorTuboMM:       diametroTubo,
+		PesoConductorKgM:             pesoConductor,
+		Configuracion:                string(resultado.Configuracion),
+		FactorCorreccionPeso:         resultado.FactorCorreccionPeso,
+		CoeficienteFriccion:          resultado.CoeficienteFriccion,
+		LongitudTotalM:               ruta.LongitudTotalM(),
+		TotalCurvasGrados:            resultado.TotalCurvasGrados,
+		Tramos:                       make([]dto.ResultadoTramoJalado, len(resultado.Tramos)),
+		TensionFinalN:                resultado.TensionFinalN,
+		TensionMaximaPermitidaN:      resultado.TensionMaximaPermitidaN,
+		PresionLateralMaximaNPorM:    resultado.PresionLateralMaximaNPorM,
+		PresionLateralPermitidaNPorM: resultado.PresionLateralPermitidaNPorM,
+		RelacionAtascamiento:         resultado.RelacionAtascamiento,
+		CumpleTension:                resultado.CumpleTension,
+		CumplePresionLateral:         resultado.CumplePresionLateral,
+		RiesgoAtascamiento:           resultado.RiesgoAtascamiento,
+		ExcedeCurvasNOM:              resultado.ExcedeCurvasNOM,
+		Cumple:                       resultado.Cumple,
+		Observaciones:                observacionesJalado(resultado),
+	}
+	for i, t := range resultado.Tramos {
+		output.Tramos[i] = dto.ResultadoTramoJalado{
+			Numero:              t.Numero,
+			Tipo:                string(t.Tipo),
+			TensionEntradaN:     t.TensionEntradaN,
+			TensionSalidaN:      t.TensionSalidaN,
+			PresionLateralNPorM: t.PresionLateralNPorM,
+			CumplePresion:       t.CumplePresion,
+		}
+	}
+
+	return output, nil
+}
+
+// observacionesJalado describe cada verificación que la ruta no cumple.
+func observacionesJalado(r entity.ResultadoTensionJalado) []string {
+	var obs []string
+	if !r.CumpleTension {
+		obs = append(obs, fmt.Sprintf(
+			"La tensión de jalado (%.0f N) excede la tensión máxima del conductor (%.0f N): agregue un registro intermedio o jale en sentido inverso",
+			r.TensionFinalN, r.TensionMaximaPermitidaN))
+	}
+	if !r.CumplePresionLateral {
+		for _, t := range r.Tramos {
+			if !t.CumplePresion {
+				obs = append(obs, fmt.Sprintf(
+					"Curva del tramo %d: presión lateral %.0f N/m excede %.0f N/m; aumente el radio de curvatura",
+					t.Numero, t.PresionLateralNPorM, r.PresionLateralPermitidaNPorM))
+			}
+		}
+	}
+	if r.RiesgoAtascamiento {
+		obs = append(obs, fmt.Sprintf(
+			"Relación de atascamiento %.2f dentro de %.1f–%.1f: riesgo de que los tres conductores se acuñen en las curvas",
+			r.RelacionAtascamiento, service.RelacionAtascamientoMin, service.RelacionAtascamientoMax))
+	}
+	if r.ExcedeCurvasNOM {
+		obs = append(obs, fmt.Sprintf(
+			"La ruta suma %.0f° de curvas; NOM-001-SEDE permite máximo %.0f° entre registros",
+			r.TotalCurvasGrados, entity.MaxCurvasEntreRegistrosGrados))
+	}
+	return obs
+}
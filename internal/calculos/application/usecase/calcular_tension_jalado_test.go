@@ -0,0 +1,127 @@
+// internal/calculos/application/usecase/calcular_tension_jalado_test.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/port"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockJaladoRepo resuelve sección, diámetro aislado y diámetro interior de tubo.
+type mockJaladoRepo struct {
+	mockSeccionRepo
+	diametros map[string]float64
+	tubos     map[string]float64
+}
+
+func (m *mockJaladoRepo) ObtenerDiametroConductor(ctx context.Context, calibre string, material string, aislamiento valueobject.TipoAislamiento) (float64, error) {
+	d, ok := m.diametros[calibre]
+	if !ok {
+		return 0, fmt.Errorf("calibre %s no encontrado", calibre)
+	}
+	return d, nil
+}
+
+func (m *mockJaladoRepo) GetTuberiaDimensionFisica(ctx context.Context, tamano string) (*port.TuberiaDimensionFisica, error) {
+	d, ok := m.tubos[tamano]
+	if !ok {
+		return nil, fmt.Errorf("tamaño de tubería no encontrado: %s", tamano)
+	}
+	return &port.TuberiaDimensionFisica{TamanoPulgadas: tamano, DiametroInteriorMM: d}, nil
+}
+
+func TestCalcularTensionJaladoUseCase(t *testing.T) {
+	repo := &mockJaladoRepo{
+		mockSeccionRepo: mockSeccionRepo{secciones: map[string]float64{"4/0": 107.2, "2": 33.6}},
+		diametros:       map[string]float64{"4/0": 15.34, "2": 10.5},
+		tubos:           map[string]float64{"2": 52.5, "3": 77.9},
+	}
+	uc := NewCalcularTensionJaladoUseCase(repo)
+	ctx := context.Background()
+
+	tramos := []dto.TramoRutaInput{
+		{Tipo: "RECTO", LongitudM: 40},
+		{Tipo: "CURVA", AnguloGrados: 90, RadioM: 0.6},
+		{Tipo: "RECTO", LongitudM: 25},
+	}
+
+	t.Run("tres conductores 4/0 en tubo de 2\": cumple, estima el peso", func(t *testing.T) {
+		r, err := uc.Execute(ctx, dto.TensionJaladoInput{
+			Calibre:        "4/0",
+			NumConductores: 3,
+			TamanoTuberia:  "2",
+			Tramos:         tramos,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "CU", r.Material)
+		assert.Equal(t, 52.5, r.DiametroInteriorTuboMM)
+		assert.InDelta(t, 1.062, r.PesoConductorKgM, 0.001)
+		assert.Equal(t, "ACUNADO", r.Configuracion) // D/d = 3.42
+		assert.InDelta(t, 3.59, r.RelacionAtascamiento, 0.01)
+		assert.Equal(t, 65.0, r.LongitudTotalM)
+		require.Len(t, r.Tramos, 3)
+		assert.Equal(t, "CURVA", r.Tramos[1].Tipo)
+		assert.Greater(t, r.Tramos[1].PresionLateralNPorM, 0.0)
+		assert.InDelta(t, 22512, r.TensionMaximaPermitidaN, 0.1) // 70 × 107.2 × 3
+		assert.True(t, r.Cumple)
+		assert.Empty(t, r.Observaciones)
+	})
+
+	t.Run("relación de atascamiento crítica genera observación", func(t *testing.T) {
+		r, err := uc.Execute(ctx, dto.TensionJaladoInput{
+			Calibre:            "2",
+			NumConductores:     3,
+			DiametroInteriorMM: 30, // 1.05 × 30 / 10.5 = 3.0
+			Tramos:             tramos,
+		})
+		require.NoError(t, err)
+
+		assert.True(t, r.RiesgoAtascamiento)
+		assert.False(t, r.Cumple)
+		require.Len(t, r.Observaciones, 1)
+		assert.Contains(t, r.Observaciones[0], "atascamiento 3.00")
+	})
+
+	t.Run("tensión excedida en ruta larga", func(t *testing.T) {
+		r, err := uc.Execute(ctx, dto.TensionJaladoInput{
+			Calibre:          "2",
+			Material:         "Al",
+			NumConductores:   1,
+			TamanoTuberia:    "2",
+			PesoConductorKgM: 0.5,
+			Tramos: []dto.TramoRutaInput{
+				{Tipo: "RECTO", LongitudM: 300},
+				{Tipo: "CURVA", AnguloGrados: 90, RadioM: 1},
+				{Tipo: "RECTO", LongitudM: 300},
+			},
+		})
+		require.NoError(t, err)
+
+		assert.False(t, r.CumpleTension)
+		assert.Contains(t, r.Observaciones[0], "excede la tensión máxima")
+	})
+
+	t.Run("errores de entrada", func(t *testing.T) {
+		_, err := uc.Execute(ctx, dto.TensionJaladoInput{Calibre: "2", NumConductores: 1, Tramos: tramos})
+		assert.ErrorIs(t, err, dto.ErrTensionJaladoInvalida)
+
+		_, err = uc.Execute(ctx, dto.TensionJaladoInput{
+			Calibre: "2", NumConductores: 1, TamanoTuberia: "2",
+			Tramos: []dto.TramoRutaInput{{Tipo: "CURVA", AnguloGrados: 90}},
+		})
+		assert.ErrorIs(t, err, dto.ErrRutaTuberiaInvalida)
+
+		_, err = uc.Execute(ctx, dto.TensionJaladoInput{Calibre: "2", NumConductores: 1, TamanoTuberia: "7", Tramos: tramos})
+		assert.ErrorIs(t, err, dto.ErrTensionJaladoInvalida)
+
+		_, err = uc.Execute(ctx, dto.TensionJaladoInput{Calibre: "2", Material: "Fe", NumConductores: 1, TamanoTuberia: "2", Tramos: tramos})
+		assert.ErrorIs(t, err, dto.ErrEquipoInputInvalido)
+	})
+}
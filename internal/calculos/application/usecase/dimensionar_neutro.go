@@ -0,0 +1,135 @@
+// internal/calculos/application/usecase/dimensionar_neutro.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/port"
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/service"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+)
+
+// DesbalanceNeutroDefault es el desbalance supuesto cuando no se proporciona [%]:
+// el neutro se considera al 100 % de la corriente de fase.
+const DesbalanceNeutroDefault = 100.0
+
+// DimensionarNeutroUseCase selecciona el calibre del conductor neutro a partir
+// de la corriente por desbalance y armónicas triples.
+type DimensionarNeutroUseCase struct {
+	tablaRepo port.TablaNOMRepository
+}
+
+// NewDimensionarNeutroUseCase crea una nueva instancia.
+func NewDimensionarNeutroUseCase(tablaRepo port.TablaNOMRepository) *DimensionarNeutroUseCase {
+	return &DimensionarNeutroUseCase{tablaRepo: tablaRepo}
+}
+
+// Execute dimensiona el neutro.
+//
+// En ESTRELLA y BIFASICO con datos de desbalance o espectro armónico, el neutro se
+// selecciona por ampacidad para I_N / (F_temp × F_agr) — sin factor de uso, el neutro
+// no se conecta a la protección (215-2(a)(1) Exc. 2) — y nunca menor que el conductor
+// de puesta a tierra. Sin esos datos, y en MONOFASICO, el neutro es igual a la fase.
+//
+// desbalance y espectro son opcionales (nil = no proporcionado).
+func (uc *DimensionarNeutroUseCase) Execute(
+	ctx context.Context,
+	corrienteNominal valueobject.Corriente,
+	sistema entity.SistemaElectrico,
+	desbalance *float64,
+	espectro *entity.EspectroArmonico,
+	factorCorreccion float64,
+	hilosPorFase int,
+	material valueobject.MaterialConductor,
+	temperatura valueobject.Temperatura,
+	tipoCanalizacion entity.TipoCanalizacion,
+	calibreFase string,
+	calibreTierra string,
+) (dto.ResultadoNeutro, error) {
+	if hilosPorFase < 1 {
+		hilosPorFase = 1
+	}
+	if factorCorreccion <= 0 {
+		factorCorreccion = 1.0
+	}
+
+	d := DesbalanceNeutroDefault
+	if desbalance != nil {
+		d = *desbalance
+	}
+
+	corrienteNeutro, err := service.CalcularCorrienteNeutro(sistema, corrienteNominal, d, espectro)
+	if err != nil {
+		return dto.ResultadoNeutro{}, fmt.Errorf("calcular corriente de neutro: %w", err)
+	}
+
+	tablaAmpacidad, err := uc.tablaRepo.ObtenerTablaAmpacidad(ctx, tipoCanalizacion, material, temperatura)
+	if err != nil {
+		return dto.ResultadoNeutro{}, fmt.Errorf("obtener tabla ampacidad: %w", err)
+	}
+
+	resultado := dto.ResultadoNeutro{
+		CorrienteFase:       corrienteNeutro.CorrienteFase,
+		Desbalance:          corrienteNeutro.Desbalance,
+		CorrienteDesbalance: corrienteNeutro.CorrienteDesbalance,
+		CorrienteArmonica:   corrienteNeutro.CorrienteArmonica,
+		CorrienteNeutro:     corrienteNeutro.Corriente,
+		RelacionFase:        corrienteNeutro.Relacion(),
+		CorrienteAjustada:   corrienteNeutro.Corriente / factorCorreccion,
+		Portador:            corrienteNeutro.Portador,
+	}
+
+	tieneDatos := desbalance != nil || (espectro != nil && espectro.TieneContenido())
+	independiente := tieneDatos && (sistema == entity.SistemaElectricoEstrella || sistema == entity.SistemaElectricoBifasico)
+
+	var conductor valueobject.Conductor
+	if independiente {
+		conductor, err = service.SeleccionarConductorNeutro(resultado.CorrienteAjustada, hilosPorFase, calibreTierra, tablaAmpacidad)
+		if err != nil {
+			return dto.ResultadoNeutro{}, fmt.Errorf("seleccionar conductor neutro: %w", err)
+		}
+		resultado.Criterio = fmt.Sprintf(
+			"Neutro por ampacidad para I_N = %.2f A (%.0f%% de la corriente de fase), no menor que el conductor de puesta a tierra",
+			resultado.CorrienteNeutro, resultado.RelacionFase*100,
+		)
+	} else {
+		conductor, err = buscarConductorEnTabla(tablaAmpacidad, calibreFase)
+		if err != nil {
+			return dto.ResultadoNeutro{}, fmt.Errorf("conductor neutro: %w", err)
+		}
+		resultado.IgualAFase = true
+		if sistema == entity.SistemaElectricoMonofasico {
+			resultado.Criterio = "Circuito de 2 hilos: el neutro transporta la corriente de la fase y se iguala a ella"
+		} else {
+			resultado.Criterio = "Sin datos de desbalance ni armónicos: el neutro se iguala al conductor de fase"
+		}
+	}
+
+	capacidad, err := uc.tablaRepo.ObtenerCapacidadConductor(ctx, tipoCanalizacion, material, temperatura, conductor.Calibre())
+	if err != nil {
+		return dto.ResultadoNeutro{}, fmt.Errorf("obtener capacidad: %w", err)
+	}
+
+	resultado.Conductor = dto.ResultadoConductor{
+		Calibre:         conductor.Calibre(),
+		Material:        conductor.Material().String(),
+		SeccionMM2:      conductor.SeccionMM2(),
+		TipoAislamiento: conductor.TipoAislamiento(),
+		Capacidad:       capacidad,
+		NumHilos:        hilosPorFase,
+	}
+	return resultado, nil
+}
+
+// buscarConductorEnTabla retorna el conductor de la tabla de ampacidad con el calibre indicado.
+func buscarConductorEnTabla(tabla []valueobject.EntradaTablaConductor, calibre string) (valueobject.Conductor, error) {
+	for _, entrada := range tabla {
+		if service.MismoCalibre(entrada.Conductor.Calibre, calibre) {
+			return valueobject.NewConductor(entrada.Conductor)
+		}
+	}
+	return valueobject.Conductor{}, fmt.Errorf("%w: calibre %s", service.ErrConductorNoEncontrado, calibre)
+}
@@ -0,0 +1,86 @@
+// internal/calculos/application/usecase/dimensionar_neutro_test.go
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockNeutroRepo returns an excerpt of Tabla 310-15(b)(16) Cu 75 °C.
+type mockNeutroRepo struct {
+	mockTablaRepo
+}
+
+var tablaNeutroTest = []valueobject.EntradaTablaConductor{
+	{Capacidad: 50, Conductor: valueobject.ConductorParams{Calibre: "8 AWG", Material: valueobject.MaterialCobre, TipoAislamiento: "THW", SeccionMM2: 8.37}},
+	{Capacidad: 65, Conductor: valueobject.ConductorParams{Calibre: "6 AWG", Material: valueobject.MaterialCobre, TipoAislamiento: "THW", SeccionMM2: 13.3}},
+	{Capacidad: 85, Conductor: valueobject.ConductorParams{Calibre: "4 AWG", Material: valueobject.MaterialCobre, TipoAislamiento: "THW", SeccionMM2: 21.2}},
+	{Capacidad: 115, Conductor: valueobject.ConductorParams{Calibre: "2 AWG", Material: valueobject.MaterialCobre, TipoAislamiento: "THW", SeccionMM2: 33.6}},
+	{Capacidad: 150, Conductor: valueobject.ConductorParams{Calibre: "1/0 AWG", Material: valueobject.MaterialCobre, TipoAislamiento: "THW", SeccionMM2: 53.5}},
+	{Capacidad: 200, Conductor: valueobject.ConductorParams{Calibre: "3/0 AWG", Material: valueobject.MaterialCobre, TipoAislamiento: "THW", SeccionMM2: 85.0}},
+}
+
+func (m *mockNeutroRepo) ObtenerTablaAmpacidad(ctx context.Context, canalizacion entity.TipoCanalizacion, material valueobject.MaterialConductor, temperatura valueobject.Temperatura) ([]valueobject.EntradaTablaConductor, error) {
+	return tablaNeutroTest, nil
+}
+
+func (m *mockNeutroRepo) ObtenerCapacidadConductor(ctx context.Context, canalizacion entity.TipoCanalizacion, material valueobject.MaterialConductor, temperatura valueobject.Temperatura, calibre string) (float64, error) {
+	for _, e := range tablaNeutroTest {
+		if e.Conductor.Calibre == calibre {
+			return e.Capacidad, nil
+		}
+	}
+	return 0, nil
+}
+
+func TestDimensionarNeutroUseCase_Execute(t *testing.T) {
+	uc := NewDimensionarNeutroUseCase(&mockNeutroRepo{})
+	ctx := context.Background()
+	corriente, _ := valueobject.NewCorriente(100)
+
+	t.Run("sin datos: neutro igual a la fase", func(t *testing.T) {
+		res, err := uc.Execute(ctx, corriente, entity.SistemaElectricoEstrella, nil, nil, 1.0, 1,
+			valueobject.MaterialCobre, valueobject.Temp75, entity.TipoCanalizacionTuberiaPVC, "1/0 AWG", "6 AWG")
+		require.NoError(t, err)
+		assert.True(t, res.IgualAFase)
+		assert.Equal(t, "1/0 AWG", res.Conductor.Calibre)
+		assert.Equal(t, 150.0, res.Conductor.Capacidad)
+	})
+
+	t.Run("desbalance reducido: neutro menor no menor que tierra", func(t *testing.T) {
+		desbalance := 20.0
+		res, err := uc.Execute(ctx, corriente, entity.SistemaElectricoEstrella, &desbalance, nil, 1.0, 1,
+			valueobject.MaterialCobre, valueobject.Temp75, entity.TipoCanalizacionTuberiaPVC, "1/0 AWG", "6 AWG")
+		require.NoError(t, err)
+		assert.False(t, res.IgualAFase)
+		assert.InDelta(t, 20.0, res.CorrienteNeutro, 0.01)
+		assert.Equal(t, "6 AWG", res.Conductor.Calibre)
+	})
+
+	t.Run("armónicas triples: neutro mayor que la fase", func(t *testing.T) {
+		// I_N = 3 × 55 % × 100 A = 165 A; ajustada 165 / 0.9 = 183.3 A → 3/0
+		espectro := &entity.EspectroArmonico{Ordenes: map[int]float64{3: 55}}
+		desbalance := 0.0
+		res, err := uc.Execute(ctx, corriente, entity.SistemaElectricoEstrella, &desbalance, espectro, 0.9, 1,
+			valueobject.MaterialCobre, valueobject.Temp75, entity.TipoCanalizacionTuberiaPVC, "1/0 AWG", "6 AWG")
+		require.NoError(t, err)
+		assert.True(t, res.Portador)
+		assert.InDelta(t, 165.0, res.CorrienteNeutro, 0.01)
+		assert.InDelta(t, 183.33, res.CorrienteAjustada, 0.01)
+		assert.Equal(t, "3/0 AWG", res.Conductor.Calibre)
+	})
+
+	t.Run("monofásico: neutro igual a la fase aun con desbalance", func(t *testing.T) {
+		desbalance := 0.0
+		res, err := uc.Execute(ctx, corriente, entity.SistemaElectricoMonofasico, &desbalance, nil, 1.0, 1,
+			valueobject.MaterialCobre, valueobject.Temp75, entity.TipoCanalizacionTuberiaPVC, "2 AWG", "8 AWG")
+		require.NoError(t, err)
+		assert.True(t, res.IgualAFase)
+		assert.Equal(t, "2 AWG", res.Conductor.Calibre)
+	})
+}
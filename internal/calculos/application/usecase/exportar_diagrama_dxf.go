@@ -0,0 +1,51 @@
+// internal/calculos/application/usecase/exportar_diagrama_dxf.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
+)
+
+// ExportarDiagramaDxfUseCase calcula la memoria de un circuito y devuelve el
+// diagrama de su canalización (charola o tubería) en formato DXF.
+type ExportarDiagramaDxfUseCase struct {
+	orquestador calculadorMemoria
+}
+
+// NewExportarDiagramaDxfUseCase crea una nueva instancia.
+func NewExportarDiagramaDxfUseCase(orquestador *OrquestadorMemoriaCalculoUseCase) *ExportarDiagramaDxfUseCase {
+	return &ExportarDiagramaDxfUseCase{orquestador: orquestador}
+}
+
+// Execute calcula la memoria y extrae el DXF del diagrama de canalización.
+// Devuelve dto.ErrDiagramaNoDisponible si la memoria no generó diagrama.
+func (uc *ExportarDiagramaDxfUseCase) Execute(ctx context.Context, input dto.EquipoInput) (dto.DiagramaDXF, error) {
+	memoria, err := uc.orquestador.Execute(ctx, input)
+	if err != nil {
+		return dto.DiagramaDXF{}, err
+	}
+
+	contenido := diagramaDXF(memoria.Canalizacion)
+	if contenido == "" {
+		return dto.DiagramaDXF{}, fmt.Errorf("%w: canalización %s", dto.ErrDiagramaNoDisponible, memoria.Instalacion.TipoCanalizacion)
+	}
+
+	return dto.DiagramaDXF{
+		Clave:            memoria.Equipo.Clave,
+		TipoCanalizacion: memoria.Instalacion.TipoCanalizacion,
+		Contenido:        contenido,
+	}, nil
+}
+
+// diagramaDXF devuelve el DXF del diagrama de charola o de tubería, o "" si no hay.
+func diagramaDXF(c dto.DatosCanalizacionCompleta) string {
+	if c.DetalleCharola != nil && c.DetalleCharola.Diagrama != nil {
+		return c.DetalleCharola.Diagrama.DXF
+	}
+	if c.DetalleTuberia != nil && c.DetalleTuberia.Diagrama != nil {
+		return c.DetalleTuberia.Diagrama.DXF
+	}
+	return ""
+}
@@ -0,0 +1,73 @@
+// internal/calculos/application/usecase/exportar_diagrama_dxf_test.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubMemoriaFija devuelve siempre la misma memoria (o error).
+type stubMemoriaFija struct {
+	memoria dto.MemoriaOutput
+	err     error
+}
+
+func (s stubMemoriaFija) Execute(ctx context.Context, input dto.EquipoInput) (dto.MemoriaOutput, error) {
+	return s.memoria, s.err
+}
+
+func TestExportarDiagramaDxf(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("tubería: devuelve el DXF del diagrama", func(t *testing.T) {
+		memoria := memoriaTuberiaDelta()
+		memoria.Equipo.Clave = "FA-01"
+		memoria.Canalizacion.DetalleTuberia = &dto.DetalleTuberia{
+			Diagrama: &dto.DiagramaTuberia{DXF: "0\nEOF\n"},
+		}
+		uc := &ExportarDiagramaDxfUseCase{orquestador: stubMemoriaFija{memoria: memoria}}
+
+		out, err := uc.Execute(ctx, dto.EquipoInput{})
+
+		require.NoError(t, err)
+		assert.Equal(t, "FA-01", out.Clave)
+		assert.Equal(t, "TUBERIA_PVC", out.TipoCanalizacion)
+		assert.Equal(t, "0\nEOF\n", out.Contenido)
+	})
+
+	t.Run("charola: devuelve el DXF del diagrama", func(t *testing.T) {
+		memoria := dto.MemoriaOutput{}
+		memoria.Instalacion.TipoCanalizacion = "CHAROLA_CABLE_ESPACIADO"
+		memoria.Canalizacion.DetalleCharola = &dto.DetalleCharola{
+			Diagrama: &dto.DiagramaCharola{DXF: "charola"},
+		}
+		uc := &ExportarDiagramaDxfUseCase{orquestador: stubMemoriaFija{memoria: memoria}}
+
+		out, err := uc.Execute(ctx, dto.EquipoInput{})
+
+		require.NoError(t, err)
+		assert.Equal(t, "charola", out.Contenido)
+	})
+
+	t.Run("sin diagrama: ErrDiagramaNoDisponible", func(t *testing.T) {
+		uc := &ExportarDiagramaDxfUseCase{orquestador: stubMemoriaFija{memoria: memoriaTuberiaDelta()}}
+
+		_, err := uc.Execute(ctx, dto.EquipoInput{})
+
+		assert.ErrorIs(t, err, dto.ErrDiagramaNoDisponible)
+	})
+
+	t.Run("propaga el error del orquestador", func(t *testing.T) {
+		errMemoria := errors.New("paso 4 (canalización): sin tamaño")
+		uc := &ExportarDiagramaDxfUseCase{orquestador: stubMemoriaFija{err: errMemoria}}
+
+		_, err := uc.Execute(ctx, dto.EquipoInput{})
+
+		assert.ErrorIs(t, err, errMemoria)
+	})
+}
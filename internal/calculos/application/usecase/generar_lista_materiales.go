@@ -0,0 +1,334 @@
+// internal/calculos/application/usecase/generar_lista_materiales.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/port"
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+)
+
+// GenerarListaMaterialesUseCase calcula la memoria de un circuito y deriva su
+// lista de materiales, valorizada con el catálogo de precios si se solicita.
+type GenerarListaMaterialesUseCase struct {
+	orquestador  calculadorMemoria
+	catalogoRepo port.CatalogoPreciosRepository
+}
+
+// NewGenerarListaMaterialesUseCase crea una nueva instancia. catalogoRepo puede ser nil;
+// en ese caso solo se valoriza con los precios enviados en la petición.
+func NewGenerarListaMaterialesUseCase(
+	orquestador *OrquestadorMemoriaCalculoUseCase,
+	catalogoRepo port.CatalogoPreciosRepository,
+) *GenerarListaMaterialesUseCase {
+	return &GenerarListaMaterialesUseCase{orquestador: orquestador, catalogoRepo: catalogoRepo}
+}
+
+// Execute obtiene el catálogo (si se pidió valorizar), calcula la memoria y genera la lista.
+func (uc *GenerarListaMaterialesUseCase) Execute(
+	ctx context.Context,
+	input dto.ListaMaterialesInput,
+) (dto.ResultadoListaMateriales, error) {
+	var catalogo *entity.CatalogoPrecios
+	if input.Precios != nil || input.Valorizar {
+		c, err := obtenerCatalogoPrecios(ctx, uc.catalogoRepo, input.Precios, input.Moneda)
+		if err != nil {
+			return dto.ResultadoListaMateriales{}, err
+		}
+		catalogo = c
+	}
+
+	memoria, err := uc.orquestador.Execute(ctx, input.Input)
+	if err != nil {
+		return dto.ResultadoListaMateriales{}, err
+	}
+
+	return generarListaMateriales(memoria, input.Input.GetDesperdicioPorcentaje(), catalogo), nil
+}
+
+// obtenerCatalogoPrecios usa el catálogo de la petición o, si no se envió, el vigente del repositorio.
+func obtenerCatalogoPrecios(
+	ctx context.Context,
+	repo port.CatalogoPreciosRepository,
+	precios *dto.CatalogoPreciosInput,
+	moneda string,
+) (*entity.CatalogoPrecios, error) {
+	if precios != nil {
+		return precios.ToDomain()
+	}
+	if repo == nil {
+		return nil, fmt.Errorf("%w: envíe los precios en la petición", dto.ErrCatalogoPreciosNoDisponible)
+	}
+	if moneda == "" {
+		moneda = dto.MonedaDefault
+	}
+	catalogo, err := repo.ObtenerCatalogoVigente(ctx, moneda)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", dto.ErrCatalogoPreciosNoDisponible, err)
+	}
+	return catalogo, nil
+}
+
+// conductorCircuito es un conductor de la memoria con el número de hilos que recorren el circuito.
+type conductorCircuito struct {
+	nombre    string
+	conductor dto.ResultadoConductor
+	cantidad  int
+}
+
+// conductoresMemoria enumera los conductores del circuito:
+//
+//	fase   = fases × hilos por fase
+//	neutro = neutros × hilos por fase (el calibre de fase si no hay CableNeutro)
+//	tierra = hilos de tierra
+//
+// Con cable multiconductor fase y neutro son del calibre del cable.
+func conductoresMemoria(memoria dto.MemoriaOutput) []conductorCircuito {
+	hilos := memoria.Instalacion.HilosPorFase
+	if hilos < 1 {
+		hilos = 1
+	}
+	sistema := memoria.Instalacion.SistemaElectrico.ToEntity()
+
+	fase := memoria.CableFase
+	if cm := memoria.CableMulticonductor; cm != nil {
+		fase.Calibre = cm.Calibre
+	}
+	conductores := []conductorCircuito{
+		{"fase", fase, sistema.CantidadFases() * hilos},
+	}
+	if n := sistema.CantidadNeutros(); n > 0 {
+		neutro := fase
+		if memoria.CableNeutro != nil && memoria.CableMulticonductor == nil {
+			neutro = *memoria.CableNeutro
+		}
+		conductores = append(conductores, conductorCircuito{"neutro", neutro, n * hilos})
+	}
+	hilosTierra := memoria.CableTierra.NumHilos
+	if hilosTierra < 1 {
+		hilosTierra = 1
+	}
+	return append(conductores, conductorCircuito{"tierra", memoria.CableTierra, hilosTierra})
+}
+
+// grupoConductor agrupa los hilos de un mismo cable (p. ej. fase y neutro del mismo calibre).
+type grupoConductor struct {
+	conductor dto.ResultadoConductor
+	usos      []string
+	hilos     int
+}
+
+// generarListaMateriales deriva la lista de materiales de una memoria. Las cantidades
+// lineales se incrementan con el desperdicio; los metros de conductor y los tramos de
+// canalización se redondean hacia arriba. Con catálogo nil la lista no se valoriza; los
+// accesorios nunca tienen precio porque el catálogo no los incluye.
+func generarListaMateriales(
+	memoria dto.MemoriaOutput,
+	desperdicio float64,
+	catalogo *entity.CatalogoPrecios,
+) dto.ResultadoListaMateriales {
+	longitud := memoria.Instalacion.LongitudCircuito
+	factor := 1 + desperdicio/100
+
+	lista := dto.ResultadoListaMateriales{
+		LongitudCircuito:      longitud,
+		DesperdicioPorcentaje: desperdicio,
+		Partidas:              []dto.PartidaMaterial{},
+	}
+	if catalogo != nil {
+		lista.Moneda = catalogo.Moneda
+	}
+
+	// agregar numera la partida y, si hay catálogo, busca su precio unitario.
+	agregar := func(partida dto.PartidaMaterial, buscarPrecio func() (float64, error)) {
+		if partida.Cantidad <= 0 {
+			return
+		}
+		partida.Partida = len(lista.Partidas) + 1
+		if catalogo != nil && buscarPrecio != nil {
+			if precio, err := buscarPrecio(); err == nil {
+				importe := math.Round(partida.Cantidad*precio*100) / 100
+				partida.PrecioUnitario = &precio
+				partida.Importe = &importe
+				lista.Total += importe
+			} else {
+				lista.SinPrecio = append(lista.SinPrecio, partida.Descripcion)
+			}
+		}
+		lista.Partidas = append(lista.Partidas, partida)
+	}
+
+	// Conductores: un renglón por cable distinto
+	var grupos []*grupoConductor
+	for _, c := range conductoresMemoria(memoria) {
+		var grupo *grupoConductor
+		for _, g := range grupos {
+			if mismoCable(g.conductor, c.conductor) {
+				grupo = g
+				break
+			}
+		}
+		if grupo == nil {
+			grupo = &grupoConductor{conductor: c.conductor}
+			grupos = append(grupos, grupo)
+		}
+		grupo.usos = append(grupo.usos, c.nombre)
+		grupo.hilos += c.cantidad
+	}
+
+	// Cable multiconductor: un solo renglón por metro de cable; el catálogo no los incluye
+	if cm := memoria.CableMulticonductor; cm != nil {
+		descripcion := fmt.Sprintf("Cable multiconductor %s %s", cm.Designacion, memoria.CableFase.Material)
+		agregar(dto.PartidaMaterial{
+			Categoria:   dto.CategoriaConductor,
+			Descripcion: descripcion,
+			Detalle:     fmt.Sprintf("%.2f m × %d cables + %.1f%% desperdicio", longitud, cm.NumCables, desperdicio),
+			Cantidad:    math.Ceil(longitud * float64(cm.NumCables) * factor),
+			Unidad:      "m",
+		}, func() (float64, error) {
+			return 0, fmt.Errorf("%w: %s", entity.ErrPrecioNoEncontrado, descripcion)
+		})
+	} else {
+		for _, g := range grupos {
+			c := g.conductor
+			agregar(dto.PartidaMaterial{
+				Categoria:   dto.CategoriaConductor,
+				Descripcion: fmt.Sprintf("Cable %s (%s)", descripcionCable(c), strings.Join(g.usos, ", ")),
+				Detalle:     fmt.Sprintf("%.2f m × %d hilos + %.1f%% desperdicio", longitud, g.hilos, desperdicio),
+				Cantidad:    math.Ceil(longitud * float64(g.hilos) * factor),
+				Unidad:      "m",
+			}, func() (float64, error) {
+				material, err := valueobject.ParseMaterialConductor(c.Material)
+				if err != nil {
+					return 0, err
+				}
+				return catalogo.PrecioConductor(c.Calibre, material, c.TipoAislamiento)
+			})
+		}
+	}
+
+	// Canalización y sus accesorios
+	tipo := entity.TipoCanalizacion(memoria.Instalacion.TipoCanalizacion)
+	resultado := memoria.Canalizacion.Resultado
+	if tipo.EsCharola() {
+		tramos := int(math.Ceil(longitud * factor / dto.LongitudTramoCharolaM))
+		ancho := resultado.AnchoComercialMM
+		charola := "Charola"
+		if detalle := memoria.Canalizacion.DetalleCharola; detalle != nil && detalle.TipoCharola != "" {
+			charola = "Charola " + descripcionCharola(entity.TipoCharola(detalle.TipoCharola))
+		}
+		agregar(dto.PartidaMaterial{
+			Categoria:   dto.CategoriaCanalizacion,
+			Descripcion: fmt.Sprintf("%s de %.0f mm, tramo de %.2f m", charola, ancho, dto.LongitudTramoCharolaM),
+			Detalle:     fmt.Sprintf("%.2f m + %.1f%% desperdicio / %.2f m", longitud, desperdicio, dto.LongitudTramoCharolaM),
+			Cantidad:    float64(tramos),
+			Unidad:      "pza",
+		}, func() (float64, error) {
+			precio, err := catalogo.PrecioCharola(ancho)
+			return precio * dto.LongitudTramoCharolaM, err
+		})
+		agregar(dto.PartidaMaterial{
+			Categoria:   dto.CategoriaAccesorio,
+			Descripcion: fmt.Sprintf("Juego de placas de unión para charola de %.0f mm", ancho),
+			Detalle:     "una unión entre tramos",
+			Cantidad:    float64(tramos - 1),
+			Unidad:      "pza",
+		}, nil)
+	} else if tipo.RequiereTuberia() {
+		tubos := resultado.NumeroDeTubos
+		if tubos < 1 {
+			tubos = 1
+		}
+		tramosPorTubo := int(math.Ceil(longitud * factor / dto.LongitudTramoTuberiaM))
+		agregar(dto.PartidaMaterial{
+			Categoria:   dto.CategoriaCanalizacion,
+			Descripcion: fmt.Sprintf("Tubo %s de %s\", tramo de %.2f m", descripcionTuberia(tipo), resultado.Tamano, dto.LongitudTramoTuberiaM),
+			Detalle:     fmt.Sprintf("%d tubo(s) × %d tramos (%.2f m + %.1f%% desperdicio)", tubos, tramosPorTubo, longitud, desperdicio),
+			Cantidad:    float64(tubos * tramosPorTubo),
+			Unidad:      "pza",
+		}, func() (float64, error) {
+			precio, err := catalogo.PrecioTuberia(tipo, resultado.Tamano)
+			return precio * dto.LongitudTramoTuberiaM, err
+		})
+		agregar(dto.PartidaMaterial{
+			Categoria:   dto.CategoriaAccesorio,
+			Descripcion: fmt.Sprintf("Cople %s de %s\"", descripcionTuberia(tipo), resultado.Tamano),
+			Detalle:     "un cople entre tramos de cada tubo",
+			Cantidad:    float64(tubos * (tramosPorTubo - 1)),
+			Unidad:      "pza",
+		}, nil)
+		agregar(dto.PartidaMaterial{
+			Categoria:   dto.CategoriaAccesorio,
+			Descripcion: fmt.Sprintf("Conector %s de %s\"", descripcionTuberia(tipo), resultado.Tamano),
+			Detalle:     "un conector en cada extremo de cada tubo",
+			Cantidad:    float64(2 * tubos),
+			Unidad:      "pza",
+		}, nil)
+	}
+
+	// Zapatas: una en cada extremo de cada hilo
+	for _, g := range grupos {
+		agregar(dto.PartidaMaterial{
+			Categoria:   dto.CategoriaAccesorio,
+			Descripcion: fmt.Sprintf("Zapata de compresión %s %s", g.conductor.Calibre, g.conductor.Material),
+			Detalle:     fmt.Sprintf("2 extremos × %d hilos", g.hilos),
+			Cantidad:    float64(2 * g.hilos),
+			Unidad:      "pza",
+		}, nil)
+	}
+
+	lista.Total = math.Round(lista.Total*100) / 100
+	return lista
+}
+
+// mismoCable indica si dos conductores se compran como el mismo cable.
+func mismoCable(a, b dto.ResultadoConductor) bool {
+	return a.Calibre == b.Calibre &&
+		strings.EqualFold(a.Material, b.Material) &&
+		strings.EqualFold(a.TipoAislamiento, b.TipoAislamiento)
+}
+
+// descripcionCable arma "2/0 AWG Cu THW"; omite el aislamiento si no se conoce.
+func descripcionCable(c dto.ResultadoConductor) string {
+	partes := []string{c.Calibre, c.Material}
+	if c.TipoAislamiento != "" {
+		partes = append(partes, c.TipoAislamiento)
+	}
+	return strings.Join(partes, " ")
+}
+
+// descripcionTuberia da el nombre comercial del tipo de tubería.
+func descripcionTuberia(tipo entity.TipoCanalizacion) string {
+	switch tipo {
+	case entity.TipoCanalizacionTuberiaPVC:
+		return "conduit PVC"
+	case entity.TipoCanalizacionTuberiaAluminio:
+		return "conduit de aluminio"
+	case entity.TipoCanalizacionTuberiaAceroPG:
+		return "conduit de acero pared gruesa"
+	case entity.TipoCanalizacionTuberiaAceroPD:
+		return "conduit de acero pared delgada"
+	case entity.TipoCanalizacionDuctoSubterraneo:
+		return "ducto PVC"
+	default:
+		return string(tipo)
+	}
+}
+
+func descripcionCharola(tipo entity.TipoCharola) string {
+	switch tipo {
+	case entity.TipoCharolaEscalera:
+		return "tipo escalera"
+	case entity.TipoCharolaFondoSolido:
+		return "de fondo sólido"
+	case entity.TipoCharolaCanal:
+		return "tipo canal ventilado"
+	default:
+		return string(tipo)
+	}
+}
@@ -0,0 +1,177 @@
+// internal/calculos/application/usecase/generar_lista_materiales_test.go
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoriaTuberiaDelta: DELTA, 100 m, 2 hilos por fase en 2 tubos PVC de 2".
+func memoriaTuberiaDelta() dto.MemoriaOutput {
+	return dto.MemoriaOutput{
+		Instalacion: dto.DatosInstalacion{
+			SistemaElectrico: dto.SistemaElectricoDelta,
+			TipoCanalizacion: "TUBERIA_PVC",
+			LongitudCircuito: 100,
+			HilosPorFase:     2,
+		},
+		CableFase:    dto.ResultadoConductor{Calibre: "2 AWG", Material: "Cu", TipoAislamiento: "THW"},
+		CableTierra:  dto.ResultadoConductor{Calibre: "6 AWG", Material: "Cu", NumHilos: 2},
+		Canalizacion: dto.DatosCanalizacionCompleta{Resultado: dto.ResultadoCanalizacion{Tamano: "2", NumeroDeTubos: 2}},
+	}
+}
+
+func TestGenerarListaMateriales(t *testing.T) {
+	t.Run("tubería: conductores por hilo, tramos, coples, conectores y zapatas", func(t *testing.T) {
+		catalogo, err := catalogoPreciosPrueba().ToDomain()
+		require.NoError(t, err)
+
+		lista := generarListaMateriales(memoriaTuberiaDelta(), 5, catalogo)
+
+		require.Len(t, lista.Partidas, 7)
+		fase := lista.Partidas[0]
+		assert.Equal(t, dto.CategoriaConductor, fase.Categoria)
+		assert.Equal(t, "Cable 2 AWG Cu THW (fase)", fase.Descripcion)
+		assert.Equal(t, 630.0, fase.Cantidad) // 100 m × 6 hilos × 1.05
+		require.NotNil(t, fase.Importe)
+		assert.InDelta(t, 88200, *fase.Importe, 0.01)
+
+		tierra := lista.Partidas[1]
+		assert.Equal(t, 210.0, tierra.Cantidad) // 100 m × 2 hilos × 1.05
+
+		tubo := lista.Partidas[2]
+		assert.Equal(t, dto.CategoriaCanalizacion, tubo.Categoria)
+		assert.Equal(t, "pza", tubo.Unidad)
+		assert.Equal(t, 70.0, tubo.Cantidad) // 2 tubos × ⌈105 / 3.05⌉
+		require.NotNil(t, tubo.PrecioUnitario)
+		assert.InDelta(t, 183, *tubo.PrecioUnitario, 0.01) // 60 $/m × 3.05 m
+
+		assert.Equal(t, 68.0, lista.Partidas[3].Cantidad) // coples: 2 × 34
+		assert.Equal(t, 4.0, lista.Partidas[4].Cantidad)  // conectores: 2 por tubo
+		assert.Equal(t, 12.0, lista.Partidas[5].Cantidad) // zapatas fase
+		assert.Equal(t, 4.0, lista.Partidas[6].Cantidad)  // zapatas tierra
+		assert.Nil(t, lista.Partidas[3].PrecioUnitario)
+
+		assert.Equal(t, "MXN", lista.Moneda)
+		assert.InDelta(t, 111510, lista.Total, 0.01)
+		assert.Empty(t, lista.SinPrecio)
+		for i, p := range lista.Partidas {
+			assert.Equal(t, i+1, p.Partida)
+		}
+	})
+
+	t.Run("charola: fase y neutro del mismo calibre en un renglón, sin valorizar", func(t *testing.T) {
+		memoria := dto.MemoriaOutput{
+			Instalacion: dto.DatosInstalacion{
+				SistemaElectrico: dto.SistemaElectricoEstrella,
+				TipoCanalizacion: "CHAROLA_CABLE_ESPACIADO",
+				LongitudCircuito: 50,
+				HilosPorFase:     1,
+			},
+			CableFase:    dto.ResultadoConductor{Calibre: "4/0 AWG", Material: "Al", TipoAislamiento: "THW"},
+			CableTierra:  dto.ResultadoConductor{Calibre: "4 AWG", Material: "Cu", NumHilos: 1},
+			Canalizacion: dto.DatosCanalizacionCompleta{Resultado: dto.ResultadoCanalizacion{AnchoComercialMM: 304.8, NumeroDeTubos: 1}},
+		}
+
+		lista := generarListaMateriales(memoria, 0, nil)
+
+		require.Len(t, lista.Partidas, 6)
+		assert.Equal(t, "Cable 4/0 AWG Al THW (fase, neutro)", lista.Partidas[0].Descripcion)
+		assert.Equal(t, 200.0, lista.Partidas[0].Cantidad)
+		assert.Equal(t, "Charola de 305 mm, tramo de 3.66 m", lista.Partidas[2].Descripcion)
+		assert.Equal(t, 14.0, lista.Partidas[2].Cantidad) // ⌈50 / 3.66⌉
+		assert.Equal(t, 13.0, lista.Partidas[3].Cantidad) // uniones
+		assert.Equal(t, 8.0, lista.Partidas[4].Cantidad)  // zapatas 4/0: 2 × 4 hilos
+		assert.Empty(t, lista.Moneda)
+		assert.Zero(t, lista.Total)
+		assert.Nil(t, lista.Partidas[0].Importe)
+	})
+
+	t.Run("reporta materiales sin precio y los excluye del total", func(t *testing.T) {
+		precios := catalogoPreciosPrueba()
+		precios.Tuberias = nil
+		catalogo, err := precios.ToDomain()
+		require.NoError(t, err)
+
+		lista := generarListaMateriales(memoriaTuberiaDelta(), 5, catalogo)
+
+		require.Len(t, lista.SinPrecio, 1)
+		assert.Contains(t, lista.SinPrecio[0], "Tubo conduit PVC")
+		assert.InDelta(t, 98700, lista.Total, 0.01)
+	})
+}
+
+func TestGenerarListaMaterialesUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	input := dto.EquipoInput{TipoCanalizacion: "TUBERIA_PVC", Material: "Cu", HilosPorFase: 1, NumTuberias: 1}
+
+	t.Run("valoriza con los precios de la petición", func(t *testing.T) {
+		uc := &GenerarListaMaterialesUseCase{orquestador: &stubOrquestadorCosto{}}
+		lista, err := uc.Execute(ctx, dto.ListaMaterialesInput{Input: input, Precios: catalogoPreciosPrueba()})
+		require.NoError(t, err)
+		assert.Equal(t, dto.DesperdicioPorcentajeDefault, lista.DesperdicioPorcentaje)
+		assert.Equal(t, "MXN", lista.Moneda)
+		assert.Positive(t, lista.Total)
+	})
+
+	t.Run("sin valorizar no requiere catálogo", func(t *testing.T) {
+		uc := &GenerarListaMaterialesUseCase{orquestador: &stubOrquestadorCosto{}}
+		lista, err := uc.Execute(ctx, dto.ListaMaterialesInput{Input: input})
+		require.NoError(t, err)
+		assert.Empty(t, lista.Moneda)
+		assert.NotEmpty(t, lista.Partidas)
+	})
+
+	t.Run("valorizar sin catálogo persistido", func(t *testing.T) {
+		uc := &GenerarListaMaterialesUseCase{orquestador: &stubOrquestadorCosto{}}
+		_, err := uc.Execute(ctx, dto.ListaMaterialesInput{Input: input, Valorizar: true})
+		assert.ErrorIs(t, err, dto.ErrCatalogoPreciosNoDisponible)
+	})
+}
+
+func TestGenerarListaMateriales_TipoCharola(t *testing.T) {
+	memoria := dto.MemoriaOutput{
+		Instalacion: dto.DatosInstalacion{
+			SistemaElectrico: dto.SistemaElectricoEstrella,
+			TipoCanalizacion: "CHAROLA_CABLE_TRIANGULAR",
+			LongitudCircuito: 10,
+			HilosPorFase:     1,
+		},
+		CableFase:   dto.ResultadoConductor{Calibre: "500 MCM", Material: "Cu", TipoAislamiento: "THW"},
+		CableTierra: dto.ResultadoConductor{Calibre: "2 AWG", Material: "Cu", NumHilos: 1},
+		Canalizacion: dto.DatosCanalizacionCompleta{
+			Resultado:      dto.ResultadoCanalizacion{AnchoComercialMM: 228.6, NumeroDeTubos: 1},
+			DetalleCharola: &dto.DetalleCharola{TipoCharola: "FONDO_SOLIDO", PeralteMM: 101.6},
+		},
+	}
+
+	lista := generarListaMateriales(memoria, 0, nil)
+
+	assert.Equal(t, "Charola de fondo sólido de 229 mm, tramo de 3.66 m", lista.Partidas[2].Descripcion)
+}
+
+func TestGenerarListaMateriales_CableMulticonductor(t *testing.T) {
+	memoria := memoriaTuberiaDelta()
+	memoria.CableTierra.NumHilos = 2 // una tierra de fábrica por cable
+	memoria.CableMulticonductor = &dto.CableMulticonductor{
+		Tipo: "MC", Calibre: "2 AWG", Conductores: 3, NumCables: 2, Designacion: "MC 3C 2 AWG + T",
+	}
+	catalogo, err := catalogoPreciosPrueba().ToDomain()
+	require.NoError(t, err)
+
+	lista := generarListaMateriales(memoria, 5, catalogo)
+
+	require.Len(t, lista.Partidas, 6)
+	cable := lista.Partidas[0]
+	assert.Equal(t, "Cable multiconductor MC 3C 2 AWG + T Cu", cable.Descripcion)
+	assert.Equal(t, 210.0, cable.Cantidad) // 100 m × 2 cables × 1.05
+	assert.Nil(t, cable.Importe)
+	assert.Equal(t, []string{"Cable multiconductor MC 3C 2 AWG + T Cu"}, lista.SinPrecio)
+
+	assert.Equal(t, 12.0, lista.Partidas[4].Cantidad) // zapatas 2 AWG: 2 × 6 conductores
+	assert.Equal(t, 4.0, lista.Partidas[5].Cantidad)  // zapatas tierra: 2 × 2 cables
+}
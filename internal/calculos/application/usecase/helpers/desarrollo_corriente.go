@@ -10,7 +10,7 @@ import (
 // generarDesarrolloCorriente genera el desarrollo paso a paso del cálculo de corriente nominal.
 // Esta función replica la lógica del frontend en SeccionCorriente.svelte getInfoCalculo().
 // Parámetros:
-//   - tipoEquipo: tipo de equipo (FILTRO_ACTIVO, TRANSFORMADOR, FILTRO_RECHAZO, CARGA)
+//   - tipoEquipo: tipo de equipo (FILTRO_ACTIVO, TRANSFORMADOR, FILTRO_RECHAZO, CARGA, MOTOR, BANCO_CAPACITORES)
 //   - corrienteNominal: corriente nominal calculada
 //   - tension: tensión de operación en volts
 //   - factorPotencia: factor de potencia del equipo
@@ -43,12 +43,21 @@ func GenerarDesarrolloCorriente(
 	case "FILTRO_RECHAZO":
 		return generarFiltroRechazo(corrienteNominal, tension, sqrt3)
 
+	case "BANCO_CAPACITORES":
+		desarrollo := generarFiltroRechazo(corrienteNominal, tension, sqrt3)
+		desarrollo.TipoCalculo = "Desde KVAR (Banco de Capacitores)"
+		return desarrollo
+
 	case "CARGA":
 		if esTrifasico {
 			return generarCargaTrifasico(corrienteNominal, tension, factorPotencia, sqrt3)
 		}
 		return generarCargaMonofasico(corrienteNominal, tension, factorPotencia)
 
+	case "MOTOR":
+		// Motor con corriente proporcionada directamente (sin renglón de tabla)
+		return generarMotorAmperaje(corrienteNominal)
+
 	default:
 		// Default a monofásico para equipos desconocidos
 		return generarCargaMonofasico(corrienteNominal, tension, factorPotencia)
@@ -229,3 +238,62 @@ func generarCargaMonofasico(corrienteNominal float64, tension int, factorPotenci
 		},
 	}
 }
+
+// generarMotorAmperaje genera el desarrollo para MOTOR cuando la corriente a
+// plena carga se proporciona directamente.
+func generarMotorAmperaje(corrienteNominal float64) *dto.DatosDesarrolloCorriente {
+	return &dto.DatosDesarrolloCorriente{
+		TipoCalculo:  "Corriente a plena carga (dato del motor)",
+		FormulaUsada: "I = I_pc",
+		PasosDesarrollo: []dto.PasoDesarrollo{
+			{
+				Numero:      1,
+				Descripcion: fmt.Sprintf("I_pc = %.2f A (dato del motor)", corrienteNominal),
+				Resultado:   fmt.Sprintf("I = %.2f A", corrienteNominal),
+			},
+		},
+		ValoresReferencia: map[string]string{
+			"Corriente a plena carga": fmt.Sprintf("%.2f A", corrienteNominal),
+			"Tipo":                    "Motor",
+		},
+	}
+}
+
+// GenerarDesarrolloMotor genera el desarrollo para MOTOR a partir de la
+// Tabla 430-248/430-250 (NOM 430-6(a)(1)): la corriente no se calcula con la
+// potencia de placa sino que se toma de la tabla.
+func GenerarDesarrolloMotor(motor dto.ResultadoMotor) *dto.DatosDesarrolloCorriente {
+	sistema := "Monofásico"
+	if motor.Fases == 3 {
+		sistema = "Trifásico"
+	}
+
+	return &dto.DatosDesarrolloCorriente{
+		TipoCalculo:  fmt.Sprintf("Desde Tabla %s (Motor %s)", motor.TablaNOM, sistema),
+		FormulaUsada: fmt.Sprintf("I = I_pc (Tabla %s)", motor.TablaNOM),
+		PasosDesarrollo: []dto.PasoDesarrollo{
+			{
+				Numero:      1,
+				Descripcion: fmt.Sprintf("Motor de %.2f HP → renglón %.2f HP de la Tabla %s", motor.PotenciaHP, motor.PotenciaHPTabla, motor.TablaNOM),
+				Resultado:   "",
+			},
+			{
+				Numero:      2,
+				Descripcion: fmt.Sprintf("Tensión %d V → columna %d V", motor.Tension, motor.TensionTabla),
+				Resultado:   "",
+			},
+			{
+				Numero:      3,
+				Descripcion: "",
+				Resultado:   fmt.Sprintf("I = %.2f A", motor.CorrientePlenaCarga),
+			},
+		},
+		ValoresReferencia: map[string]string{
+			"Potencia":         fmt.Sprintf("%.2f HP", motor.PotenciaHP),
+			"Voltaje":          fmt.Sprintf("%d V (columna %d V)", motor.Tension, motor.TensionTabla),
+			"Sistema":          sistema,
+			"Tabla":            motor.TablaNOM,
+			"Conductor (125%)": fmt.Sprintf("%.2f A", motor.CorrienteConductor),
+		},
+	}
+}
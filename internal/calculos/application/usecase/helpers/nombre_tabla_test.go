@@ -50,6 +50,20 @@ func TestNombreTablaAmpacidad(t *testing.T) {
 			temperatura:  valueobject.Temp60,
 			want:         "NOM-310-15-B-20 (Al, 60°C)",
 		},
+		{
+			name:         "DUCTO_SUBTERRANEO con cobre a 75°C",
+			canalizacion: "DUCTO_SUBTERRANEO",
+			material:     valueobject.MaterialCobre,
+			temperatura:  valueobject.Temp75,
+			want:         "NOM-ANEXO-B-310-7 (Cu, 75°C)",
+		},
+		{
+			name:         "DIRECTAMENTE_ENTERRADO con cobre a 90°C",
+			canalizacion: "DIRECTAMENTE_ENTERRADO",
+			material:     valueobject.MaterialCobre,
+			temperatura:  valueobject.Temp90,
+			want:         "NOM-ANEXO-B-310-10 (Cu, 90°C)",
+		},
 		{
 			name:         "canalización desconocida usa default PVC",
 			canalizacion: "DESCONOCIDA",
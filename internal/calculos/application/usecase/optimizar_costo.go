@@ -0,0 +1,230 @@
+// internal/calculos/application/usecase/optimizar_costo.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/port"
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+)
+
+// tiposCanalizacionOptimizacion son las canalizaciones evaluadas si no se indican.
+var tiposCanalizacionOptimizacion = []entity.TipoCanalizacion{
+	entity.TipoCanalizacionTuberiaPVC,
+	entity.TipoCanalizacionTuberiaAluminio,
+	entity.TipoCanalizacionTuberiaAceroPG,
+	entity.TipoCanalizacionTuberiaAceroPD,
+	entity.TipoCanalizacionCharolaCableEspaciado,
+	entity.TipoCanalizacionCharolaCableTriangular,
+}
+
+// OptimizarCostoUseCase ejecuta la memoria de cálculo para cada combinación de material,
+// conductores en paralelo y canalización, y ordena por costo las que cumplen la NOM.
+type OptimizarCostoUseCase struct {
+	orquestador  calculadorMemoria
+	catalogoRepo port.CatalogoPreciosRepository
+}
+
+// NewOptimizarCostoUseCase crea una nueva instancia. catalogoRepo puede ser nil;
+// en ese caso los precios deben enviarse en la petición.
+func NewOptimizarCostoUseCase(
+	orquestador *OrquestadorMemoriaCalculoUseCase,
+	catalogoRepo port.CatalogoPreciosRepository,
+) *OptimizarCostoUseCase {
+	return &OptimizarCostoUseCase{orquestador: orquestador, catalogoRepo: catalogoRepo}
+}
+
+// Execute evalúa Materiales × 1..MaxHilosPorFase × TiposCanalizacion.
+//
+// En tubería y ducto subterráneo cada juego de conductores en paralelo va en
+// su propio tubo (NumTuberias = HilosPorFase). Las combinaciones que fallan, no cumplen la
+// normativa o no tienen precio en el catálogo se reportan como descartadas.
+// Un EquipoInput inválido aborta la búsqueda, ya que falla en todas las combinaciones.
+func (uc *OptimizarCostoUseCase) Execute(
+	ctx context.Context,
+	input dto.OptimizacionCostoInput,
+) (dto.OptimizacionCostoOutput, error) {
+	if err := input.Validate(); err != nil {
+		return dto.OptimizacionCostoOutput{}, err
+	}
+
+	catalogo, err := obtenerCatalogoPrecios(ctx, uc.catalogoRepo, input.Precios, input.Moneda)
+	if err != nil {
+		return dto.OptimizacionCostoOutput{}, err
+	}
+
+	materiales := input.Materiales
+	if len(materiales) == 0 {
+		materiales = []string{"Cu", "Al"}
+	}
+	maxHilos := input.MaxHilosPorFase
+	if maxHilos == 0 {
+		maxHilos = dto.MaxHilosPorFaseOptimizacionDefault
+	}
+	tipos := tiposCanalizacionOptimizacion
+	if len(input.TiposCanalizacion) > 0 {
+		tipos = make([]entity.TipoCanalizacion, len(input.TiposCanalizacion))
+		for i, tc := range input.TiposCanalizacion {
+			tipos[i] = entity.TipoCanalizacion(tc)
+		}
+	}
+
+	output := dto.OptimizacionCostoOutput{
+		Moneda:       catalogo.Moneda,
+		Alternativas: []dto.AlternativaCosto{},
+		Descartadas:  []dto.AlternativaDescartada{},
+	}
+
+	for _, tipo := range tipos {
+		for _, material := range materiales {
+			for hilos := 1; hilos <= maxHilos; hilos++ {
+				if err := ctx.Err(); err != nil {
+					return dto.OptimizacionCostoOutput{}, err
+				}
+
+				equipo := input.Input
+				equipo.Material = material
+				equipo.HilosPorFase = hilos
+				equipo.TipoCanalizacion = string(tipo)
+				equipo.NumTuberias = 1
+				if tipo.RequiereTuberia() {
+					equipo.NumTuberias = hilos
+				}
+				output.Evaluadas++
+
+				descartada := dto.AlternativaDescartada{
+					Material:         material,
+					HilosPorFase:     hilos,
+					TipoCanalizacion: string(tipo),
+				}
+
+				memoria, err := uc.orquestador.Execute(ctx, equipo)
+				if err != nil {
+					if errors.Is(err, dto.ErrEquipoInputInvalido) || errors.Is(err, dto.ErrModoInvalido) {
+						return dto.OptimizacionCostoOutput{}, err
+					}
+					descartada.Motivo = err.Error()
+					output.Descartadas = append(output.Descartadas, descartada)
+					continue
+				}
+				if !memoria.CumpleNormativa {
+					descartada.Motivo = "no cumple la normativa NOM"
+					output.Descartadas = append(output.Descartadas, descartada)
+					continue
+				}
+
+				alternativa, err := costearMemoria(memoria, tipo, catalogo)
+				if err != nil {
+					descartada.Motivo = err.Error()
+					output.Descartadas = append(output.Descartadas, descartada)
+					continue
+				}
+				alternativa.Material = material
+				output.Alternativas = append(output.Alternativas, alternativa)
+			}
+		}
+	}
+
+	sort.SliceStable(output.Alternativas, func(i, j int) bool {
+		a, b := output.Alternativas[i], output.Alternativas[j]
+		if a.CostoTotal != b.CostoTotal {
+			return a.CostoTotal < b.CostoTotal
+		}
+		return a.CaidaTension < b.CaidaTension
+	})
+	for i := range output.Alternativas {
+		output.Alternativas[i].Ranking = i + 1
+	}
+
+	return output, nil
+}
+
+// costearMemoria valoriza los conductores y la canalización de una memoria:
+//
+//	fase   = L × fases × hilos por fase
+//	neutro = L × neutros × hilos por fase
+//	tierra = L × hilos de tierra
+//	tubería = L × número de tubos; charola = L
+func costearMemoria(
+	memoria dto.MemoriaOutput,
+	tipo entity.TipoCanalizacion,
+	catalogo *entity.CatalogoPrecios,
+) (dto.AlternativaCosto, error) {
+	longitud := memoria.Instalacion.LongitudCircuito
+	hilos := memoria.Instalacion.HilosPorFase
+	if hilos < 1 {
+		hilos = 1
+	}
+
+	alternativa := dto.AlternativaCosto{
+		HilosPorFase:     hilos,
+		TipoCanalizacion: string(tipo),
+		CalibreFase:      memoria.CableFase.Calibre,
+		CalibreTierra:    memoria.CableTierra.Calibre,
+		NumeroDeTubos:    memoria.Canalizacion.Resultado.NumeroDeTubos,
+		CaidaTension:     memoria.CaidaTension.Porcentaje,
+	}
+
+	agregar := func(concepto, unidad string, cantidad, precio float64) {
+		partida := dto.PartidaCosto{
+			Concepto:       concepto,
+			Cantidad:       cantidad,
+			Unidad:         unidad,
+			PrecioUnitario: precio,
+			Importe:        math.Round(cantidad*precio*100) / 100,
+		}
+		alternativa.Partidas = append(alternativa.Partidas, partida)
+		alternativa.CostoTotal += partida.Importe
+	}
+
+	for _, c := range conductoresMemoria(memoria) {
+		if c.nombre == "neutro" {
+			alternativa.CalibreNeutro = c.conductor.Calibre
+		}
+		material, err := valueobject.ParseMaterialConductor(c.conductor.Material)
+		if err != nil {
+			return dto.AlternativaCosto{}, fmt.Errorf("conductor de %s: %w", c.nombre, err)
+		}
+		precio, err := catalogo.PrecioConductor(c.conductor.Calibre, material, c.conductor.TipoAislamiento)
+		if err != nil {
+			return dto.AlternativaCosto{}, err
+		}
+		agregar(
+			fmt.Sprintf("Conductor %s %s %s", c.nombre, c.conductor.Calibre, c.conductor.Material),
+			"m", longitud*float64(c.cantidad), precio,
+		)
+	}
+
+	resultado := memoria.Canalizacion.Resultado
+	if tipo.EsCharola() {
+		precio, err := catalogo.PrecioCharola(resultado.AnchoComercialMM)
+		if err != nil {
+			return dto.AlternativaCosto{}, err
+		}
+		alternativa.Canalizacion = fmt.Sprintf("%.0f mm", resultado.AnchoComercialMM)
+		agregar(fmt.Sprintf("Charola %.0f mm", resultado.AnchoComercialMM), "m", longitud, precio)
+	} else if !tipo.RequiereTuberia() {
+		// Directamente enterrado: sin canalización
+		alternativa.Canalizacion = resultado.Tamano
+	} else {
+		precio, err := catalogo.PrecioTuberia(tipo, resultado.Tamano)
+		if err != nil {
+			return dto.AlternativaCosto{}, err
+		}
+		tubos := resultado.NumeroDeTubos
+		if tubos < 1 {
+			tubos = 1
+		}
+		alternativa.Canalizacion = resultado.Tamano
+		agregar(fmt.Sprintf("Tubería %s %s", tipo, resultado.Tamano), "m", longitud*float64(tubos), precio)
+	}
+
+	alternativa.CostoTotal = math.Round(alternativa.CostoTotal*100) / 100
+	return alternativa, nil
+}
@@ -0,0 +1,198 @@
+// internal/calculos/application/usecase/optimizar_costo_test.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubOrquestadorCosto arma una memoria DELTA de 100 m según la combinación recibida:
+// Cu 1 hilo → 2/0, Cu 2 hilos → 2; Al 1 hilo → 4/0, Al 2 hilos no cumple.
+type stubOrquestadorCosto struct {
+	inputs []dto.EquipoInput
+	err    error
+}
+
+func (s *stubOrquestadorCosto) Execute(ctx context.Context, input dto.EquipoInput) (dto.MemoriaOutput, error) {
+	s.inputs = append(s.inputs, input)
+	if s.err != nil {
+		return dto.MemoriaOutput{}, s.err
+	}
+
+	calibres := map[string]string{"Cu-1": "2/0 AWG", "Cu-2": "2 AWG", "Al-1": "4/0 AWG", "Al-2": "1/0 AWG"}
+	clave := fmt.Sprintf("%s-%d", input.Material, input.HilosPorFase)
+	m := dto.MemoriaOutput{
+		Instalacion: dto.DatosInstalacion{
+			SistemaElectrico: dto.SistemaElectricoDelta,
+			TipoCanalizacion: input.TipoCanalizacion,
+			Material:         input.Material,
+			LongitudCircuito: 100,
+			HilosPorFase:     input.HilosPorFase,
+		},
+		CableFase:       dto.ResultadoConductor{Calibre: calibres[clave], Material: input.Material},
+		CableTierra:     dto.ResultadoConductor{Calibre: "6 AWG", Material: "Cu", NumHilos: input.NumTuberias},
+		CaidaTension:    dto.ResultadoCaidaTension{Porcentaje: 2},
+		CumpleNormativa: clave != "Al-2",
+	}
+	if input.TipoCanalizacion == "CHAROLA_CABLE_ESPACIADO" {
+		m.Canalizacion.Resultado = dto.ResultadoCanalizacion{Tamano: "12", AnchoComercialMM: 304.8, NumeroDeTubos: 1}
+	} else {
+		m.Canalizacion.Resultado = dto.ResultadoCanalizacion{Tamano: "2", NumeroDeTubos: input.NumTuberias}
+	}
+	return m, nil
+}
+
+// stubCatalogoPrecios devuelve un catálogo fijo y registra la moneda solicitada.
+type stubCatalogoPrecios struct {
+	catalogo *entity.CatalogoPrecios
+	moneda   string
+}
+
+func (s *stubCatalogoPrecios) ObtenerCatalogoVigente(ctx context.Context, moneda string) (*entity.CatalogoPrecios, error) {
+	s.moneda = moneda
+	return s.catalogo, nil
+}
+
+func catalogoPreciosPrueba() *dto.CatalogoPreciosInput {
+	return &dto.CatalogoPreciosInput{
+		Conductores: []dto.PrecioConductorInput{
+			{Calibre: "2/0", Material: "Cu", PrecioMetro: 300},
+			{Calibre: "2", Material: "Cu", PrecioMetro: 140},
+			{Calibre: "4/0", Material: "Al", PrecioMetro: 120},
+			{Calibre: "6", Material: "Cu", PrecioMetro: 50},
+		},
+		Tuberias: []dto.PrecioTuberiaInput{
+			{TipoCanalizacion: "TUBERIA_PVC", Tamano: "2", PrecioMetro: 60},
+		},
+		Charolas: []dto.PrecioCharolaInput{
+			{AnchoMM: 304.8, PrecioMetro: 400},
+		},
+	}
+}
+
+func TestOptimizarCostoUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ordena alternativas que cumplen por costo total", func(t *testing.T) {
+		stub := &stubOrquestadorCosto{}
+		uc := &OptimizarCostoUseCase{orquestador: stub}
+
+		out, err := uc.Execute(ctx, dto.OptimizacionCostoInput{
+			MaxHilosPorFase:   2,
+			TiposCanalizacion: []string{"TUBERIA_PVC", "CHAROLA_CABLE_ESPACIADO"},
+			Precios:           catalogoPreciosPrueba(),
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "MXN", out.Moneda)
+		assert.Equal(t, 8, out.Evaluadas)
+		require.Len(t, out.Alternativas, 6)
+
+		// Al 4/0 en PVC: 300 m × 120 + 100 m × 50 + 100 m × 60 = 47 000
+		mejor := out.Alternativas[0]
+		assert.Equal(t, 1, mejor.Ranking)
+		assert.Equal(t, "Al", mejor.Material)
+		assert.Equal(t, 1, mejor.HilosPorFase)
+		assert.Equal(t, "TUBERIA_PVC", mejor.TipoCanalizacion)
+		assert.Equal(t, "2", mejor.Canalizacion)
+		assert.InDelta(t, 47000, mejor.CostoTotal, 0.01)
+		require.Len(t, mejor.Partidas, 3)
+
+		// Cu 2 hilos en PVC: 600 m × 140 + 200 m × 50 + 200 m × 60 = 106 000 (un tubo por juego)
+		for _, a := range out.Alternativas {
+			if a.Material == "Cu" && a.HilosPorFase == 2 && a.TipoCanalizacion == "TUBERIA_PVC" {
+				assert.Equal(t, 2, a.NumeroDeTubos)
+				assert.InDelta(t, 106000, a.CostoTotal, 0.01)
+			}
+		}
+		for i := 1; i < len(out.Alternativas); i++ {
+			assert.LessOrEqual(t, out.Alternativas[i-1].CostoTotal, out.Alternativas[i].CostoTotal)
+		}
+
+		motivos := map[string]string{}
+		for _, d := range out.Descartadas {
+			motivos[fmt.Sprintf("%s-%d-%s", d.Material, d.HilosPorFase, d.TipoCanalizacion)] = d.Motivo
+		}
+		assert.Len(t, motivos, 2)
+		assert.Equal(t, "no cumple la normativa NOM", motivos["Al-2-TUBERIA_PVC"])
+		assert.Equal(t, "no cumple la normativa NOM", motivos["Al-2-CHAROLA_CABLE_ESPACIADO"])
+	})
+
+	t.Run("descarta combinaciones sin precio", func(t *testing.T) {
+		stub := &stubOrquestadorCosto{}
+		uc := &OptimizarCostoUseCase{orquestador: stub}
+		precios := catalogoPreciosPrueba()
+		precios.Conductores = precios.Conductores[1:] // sin 2/0 Cu
+
+		out, err := uc.Execute(ctx, dto.OptimizacionCostoInput{
+			Materiales:        []string{"Cu"},
+			MaxHilosPorFase:   1,
+			TiposCanalizacion: []string{"TUBERIA_PVC"},
+			Precios:           precios,
+		})
+		require.NoError(t, err)
+
+		assert.Empty(t, out.Alternativas)
+		require.Len(t, out.Descartadas, 1)
+		assert.Contains(t, out.Descartadas[0].Motivo, "precio no encontrado")
+	})
+
+	t.Run("usa el catálogo vigente del repositorio en la moneda indicada", func(t *testing.T) {
+		catalogo, err := catalogoPreciosPrueba().ToDomain()
+		require.NoError(t, err)
+		repo := &stubCatalogoPrecios{catalogo: catalogo}
+		uc := &OptimizarCostoUseCase{orquestador: &stubOrquestadorCosto{}, catalogoRepo: repo}
+
+		out, err := uc.Execute(ctx, dto.OptimizacionCostoInput{
+			Materiales:        []string{"Al"},
+			MaxHilosPorFase:   1,
+			TiposCanalizacion: []string{"TUBERIA_PVC"},
+			Moneda:            "USD",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "USD", repo.moneda)
+		require.Len(t, out.Alternativas, 1)
+		assert.InDelta(t, 47000, out.Alternativas[0].CostoTotal, 0.01)
+	})
+
+	t.Run("sin catálogo de precios", func(t *testing.T) {
+		uc := &OptimizarCostoUseCase{orquestador: &stubOrquestadorCosto{}}
+		_, err := uc.Execute(ctx, dto.OptimizacionCostoInput{})
+		assert.ErrorIs(t, err, dto.ErrCatalogoPreciosNoDisponible)
+	})
+
+	t.Run("input de equipo inválido aborta la búsqueda", func(t *testing.T) {
+		stub := &stubOrquestadorCosto{err: fmt.Errorf("%w: tensión requerida", dto.ErrEquipoInputInvalido)}
+		uc := &OptimizarCostoUseCase{orquestador: stub}
+		_, err := uc.Execute(ctx, dto.OptimizacionCostoInput{Precios: catalogoPreciosPrueba()})
+		assert.ErrorIs(t, err, dto.ErrEquipoInputInvalido)
+		assert.Len(t, stub.inputs, 1)
+	})
+
+	t.Run("error de cálculo descarta la combinación", func(t *testing.T) {
+		stub := &stubOrquestadorCosto{err: errors.New("paso 4 (canalización): sin tamaño disponible")}
+		uc := &OptimizarCostoUseCase{orquestador: stub}
+		out, err := uc.Execute(ctx, dto.OptimizacionCostoInput{
+			Materiales:        []string{"Cu"},
+			MaxHilosPorFase:   1,
+			TiposCanalizacion: []string{"TUBERIA_PVC"},
+			Precios:           catalogoPreciosPrueba(),
+		})
+		require.NoError(t, err)
+		require.Len(t, out.Descartadas, 1)
+		assert.Contains(t, out.Descartadas[0].Motivo, "sin tamaño disponible")
+	})
+
+	t.Run("material inválido", func(t *testing.T) {
+		uc := &OptimizarCostoUseCase{orquestador: &stubOrquestadorCosto{}}
+		_, err := uc.Execute(ctx, dto.OptimizacionCostoInput{Materiales: []string{"Fe"}, Precios: catalogoPreciosPrueba()})
+		assert.ErrorIs(t, err, dto.ErrOptimizacionInvalida)
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/garfex/calculadora-filtros/internal/calculos/application/port"
 	"github.com/garfex/calculadora-filtros/internal/calculos/application/usecase/helpers"
 	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/service"
 	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
 )
 
@@ -24,6 +25,11 @@ type OrquestadorMemoriaCalculoUseCase struct {
 	calcularCharolaTriangularUC        *CalcularCharolaTriangularUseCase
 	calcularCaidaTensionUC             *CalcularCaidaTensionUseCase
 	seleccionarConductorCaidaTensionUC *SeleccionarConductorPorCaidaTensionUseCase
+	calcularCortocircuitoUC            *CalcularCortocircuitoUseCase
+	verificarSoporteTermicoUC          *VerificarSoporteTermicoUseCase
+	seleccionarITMUC                   *SeleccionarITMUseCase
+	verificarCoordinacionProteccionUC  *VerificarCoordinacionProteccionUseCase
+	dimensionarNeutroUC                *DimensionarNeutroUseCase
 
 	// Repository for diameter lookups (needed for charola)
 	tablaRepo port.TablaNOMRepository
@@ -42,6 +48,11 @@ func NewOrquestadorMemoriaCalculoUseCase(
 	calcularCharolaTriangularUC *CalcularCharolaTriangularUseCase,
 	calcularCaidaTensionUC *CalcularCaidaTensionUseCase,
 	seleccionarConductorCaidaTensionUC *SeleccionarConductorPorCaidaTensionUseCase,
+	calcularCortocircuitoUC *CalcularCortocircuitoUseCase,
+	verificarSoporteTermicoUC *VerificarSoporteTermicoUseCase,
+	seleccionarITMUC *SeleccionarITMUseCase,
+	verificarCoordinacionProteccionUC *VerificarCoordinacionProteccionUseCase,
+	dimensionarNeutroUC *DimensionarNeutroUseCase,
 	tablaRepo port.TablaNOMRepository,
 	geometryGeneratorPort port.GeometryGeneratorPort,
 ) *OrquestadorMemoriaCalculoUseCase {
@@ -54,6 +65,11 @@ func NewOrquestadorMemoriaCalculoUseCase(
 		calcularCharolaTriangularUC:        calcularCharolaTriangularUC,
 		calcularCaidaTensionUC:             calcularCaidaTensionUC,
 		seleccionarConductorCaidaTensionUC: seleccionarConductorCaidaTensionUC,
+		calcularCortocircuitoUC:            calcularCortocircuitoUC,
+		verificarSoporteTermicoUC:          verificarSoporteTermicoUC,
+		seleccionarITMUC:                   seleccionarITMUC,
+		verificarCoordinacionProteccionUC:  verificarCoordinacionProteccionUC,
+		dimensionarNeutroUC:                dimensionarNeutroUC,
 		tablaRepo:                          tablaRepo,
 		geometryGeneratorPort:              geometryGeneratorPort,
 	}
@@ -63,7 +79,8 @@ func NewOrquestadorMemoriaCalculoUseCase(
 //
 // Reglas de negocio:
 //   - Charola (cualquier tipo) → siempre 1 hilo de tierra
-//   - Tubería → 1 hilo de tierra por tubo (= numTuberias)
+//   - Directamente enterrado → 1 hilo de tierra común en la zanja
+//   - Tubería o ducto subterráneo → 1 hilo de tierra por tubo (= numTuberias)
 //
 // Ejemplos de uso:
 //
@@ -72,8 +89,8 @@ func NewOrquestadorMemoriaCalculoUseCase(
 //	calcularNumHilosTierra(entity.TipoCanalizacionTuboPVC, 2)              // returns 2
 //	calcularNumHilosTierra(entity.TipoCanalizacionTuboPVC, 3)              // returns 3
 func calcularNumHilosTierra(tipoCanalizacion entity.TipoCanalizacion, numTuberias int) int {
-	// Charola siempre tiene 1 hilo de tierra
-	if tipoCanalizacion.EsCharola() {
+	// Charola y cables directamente enterrados: 1 hilo de tierra
+	if !tipoCanalizacion.RequiereTuberia() {
 		return 1
 	}
 
@@ -86,6 +103,29 @@ func calcularNumHilosTierra(tipoCanalizacion entity.TipoCanalizacion, numTuberia
 	return numTuberias
 }
 
+// calibreNeutro retorna el calibre del neutro seleccionado, o "" si no hay neutro.
+func calibreNeutro(output dto.MemoriaOutput) string {
+	if output.CableNeutro == nil {
+		return ""
+	}
+	return output.CableNeutro.Calibre
+}
+
+// factorCorreccionAmpacidad retorna el producto de los factores que reducen la
+// ampacidad de tabla: temperatura y agrupamiento, la reducción por armónicas
+// triples (Anexo E), más resistividad térmica y profundidad en instalaciones
+// subterráneas.
+func factorCorreccionAmpacidad(ajuste dto.ResultadoAjusteCorriente) float64 {
+	factor := ajuste.FactorTemperatura * ajuste.FactorAgrupamiento
+	if ajuste.Armonicos != nil && ajuste.Armonicos.FactorReduccion > 0 {
+		factor *= ajuste.Armonicos.FactorReduccion
+	}
+	if ajuste.Subterranea != nil {
+		factor *= ajuste.Subterranea.FactorResistividad * ajuste.Subterranea.FactorProfundidad
+	}
+	return factor
+}
+
 // Execute runs the complete memory calculation pipeline.
 // It orchestrates all 6 steps sequentially.
 func (uc *OrquestadorMemoriaCalculoUseCase) Execute(
@@ -116,6 +156,22 @@ func (uc *OrquestadorMemoriaCalculoUseCase) Execute(
 		return dto.MemoriaOutput{}, fmt.Errorf("material inválido: %w", err)
 	}
 
+	aislamiento, err := input.ToDomainTipoAislamiento()
+	if err != nil {
+		return dto.MemoriaOutput{}, fmt.Errorf("tipo de aislamiento inválido: %w", err)
+	}
+
+	// Cable multiconductor (TC/MC): en charola su ampacidad, Tabla 9 y DMG son los de
+	// conductores agrupados en tubería (392-80(a)(1)); la canalización física no cambia
+	tipoCable, err := input.ToDomainTipoCable()
+	if err != nil {
+		return dto.MemoriaOutput{}, fmt.Errorf("tipo de cable inválido: %w", err)
+	}
+	canalizacionConductor := tipoCanalizacion
+	if tipoCable != nil {
+		canalizacionConductor = tipoCanalizacion.CanalizacionAmpacidadMulticonductor()
+	}
+
 	tension, err := input.ToDomainTension()
 	if err != nil {
 		return dto.MemoriaOutput{}, fmt.Errorf("tensión inválida: %w", err)
@@ -143,6 +199,8 @@ func (uc *OrquestadorMemoriaCalculoUseCase) Execute(
 			SistemaElectrico:      input.SistemaElectrico,
 			TipoCanalizacion:      input.TipoCanalizacion,
 			Material:              input.Material,
+			TipoAislamiento:       aislamiento.String(),
+			TipoCable:             input.TipoCable,
 			LongitudCircuito:      input.LongitudCircuito,
 			HilosPorFase:          input.HilosPorFase,
 			PorcentajeCaidaMaximo: input.PorcentajeCaidaMaximo,
@@ -165,8 +223,19 @@ func (uc *OrquestadorMemoriaCalculoUseCase) Execute(
 		return dto.MemoriaOutput{}, fmt.Errorf("paso 1 (corriente nominal): %w", err)
 	}
 	output.Corrientes.CorrienteNominal = resultadoCorriente.CorrienteNominal
+	output.Motor = resultadoCorriente.Motor
+	output.BancoCapacitores = resultadoCorriente.BancoCapacitores
+	if tipoEquipo == entity.TipoEquipoBancoCapacitores && output.BancoCapacitores == nil {
+		// Corriente proporcionada directamente (MANUAL_AMPERAJE): solo requisitos NOM 460
+		corriente, err := valueobject.NewCorriente(resultadoCorriente.CorrienteNominal)
+		if err != nil {
+			return dto.MemoriaOutput{}, fmt.Errorf("corriente nominal inválida: %w", err)
+		}
+		banco := nuevoResultadoBancoCapacitores(corriente, tension.Valor(), input.PasosCapacitores)
+		output.BancoCapacitores = &banco
+	}
 	output.Pasos = append(output.Pasos, dto.PasoMemoria{
-		Numero:      1,
+		Numero:      len(output.Pasos) + 1,
 		Nombre:      "Corriente Nominal",
 		Descripcion: "Cálculo de corriente nominal desde potencia o amperaje",
 		Resultado:   resultadoCorriente,
@@ -184,14 +253,18 @@ func (uc *OrquestadorMemoriaCalculoUseCase) Execute(
 		amperajeEquipo = float64(input.Equipo.Amperaje)
 	}
 
-	output.DesarrolloCorriente = helpers.GenerarDesarrolloCorriente(
-		string(tipoEquipo),
-		output.Corrientes.CorrienteNominal,
-		tension.Valor(),
-		input.FactorPotencia,
-		esTrifasico,
-		amperajeEquipo,
-	)
+	if output.Motor != nil {
+		output.DesarrolloCorriente = helpers.GenerarDesarrolloMotor(*output.Motor)
+	} else {
+		output.DesarrolloCorriente = helpers.GenerarDesarrolloCorriente(
+			string(tipoEquipo),
+			output.Corrientes.CorrienteNominal,
+			tension.Valor(),
+			input.FactorPotencia,
+			esTrifasico,
+			amperajeEquipo,
+		)
+	}
 
 	// ============================================================
 	// STEP 2: Adjust Current (temperature, grouping, usage factors)
@@ -201,6 +274,16 @@ func (uc *OrquestadorMemoriaCalculoUseCase) Execute(
 		return dto.MemoriaOutput{}, fmt.Errorf("corriente nominal inválida: %w", err)
 	}
 
+	espectroArmonico, err := input.ToDomainEspectroArmonico()
+	if err != nil {
+		return dto.MemoriaOutput{}, fmt.Errorf("paso 2 (ajuste de corriente): %w", err)
+	}
+
+	condicionesSubterraneas, err := input.ToDomainCondicionesSubterraneas()
+	if err != nil {
+		return dto.MemoriaOutput{}, fmt.Errorf("paso 2 (ajuste de corriente): %w", err)
+	}
+
 	resultadoAjuste, err := uc.ajustarCorrienteUC.Execute(
 		ctx,
 		corrienteNominalVO,
@@ -210,6 +293,12 @@ func (uc *OrquestadorMemoriaCalculoUseCase) Execute(
 		tipoEquipo,
 		input.HilosPorFase,
 		input.NumTuberias,
+		OpcionesAjusteCorriente{
+			Armonicos:      espectroArmonico,
+			Aislamiento:    aislamiento,
+			Subterranea:    condicionesSubterraneas,
+			Multiconductor: tipoCable != nil,
+		},
 	)
 	if err != nil {
 		return dto.MemoriaOutput{}, fmt.Errorf("paso 2 (ajuste de corriente): %w", err)
@@ -219,22 +308,106 @@ func (uc *OrquestadorMemoriaCalculoUseCase) Execute(
 	output.Corrientes.FactorTemperatura = resultadoAjuste.FactorTemperatura
 	output.Corrientes.FactorAgrupamiento = resultadoAjuste.FactorAgrupamiento
 	output.Corrientes.FactorTotalAjuste = resultadoAjuste.FactorTotal
+	if resultadoAjuste.Armonicos != nil {
+		output.Corrientes.FactorArmonico = resultadoAjuste.FactorArmonico
+		output.Corrientes.Armonicos = resultadoAjuste.Armonicos
+	}
 	output.Corrientes.TemperaturaAmbiente = resultadoAjuste.TemperaturaAmbiente
-	// Para charola: mostrar total del sistema (no hay concepto de "por tubo")
+	output.Corrientes.Subterranea = resultadoAjuste.Subterranea
+	// Para charola y directamente enterrado: mostrar total del sistema (no hay concepto de "por tubo")
 	// Para tubería: mostrar conductores por tubo (es lo que determina el factor de agrupamiento NOM)
-	if tipoCanalizacion.EsCharola() {
+	if !tipoCanalizacion.RequiereTuberia() {
 		output.Corrientes.CantidadConductores = resultadoAjuste.CantidadConductoresTotal
 	} else {
 		output.Corrientes.CantidadConductores = resultadoAjuste.ConductoresPorTubo
 	}
 	output.Corrientes.ConductoresPorTubo = resultadoAjuste.ConductoresPorTubo
+	output.Corrientes.ConductoresPortadores = resultadoAjuste.ConductoresPortadoresPorTubo
+	output.Corrientes.NeutroPortador = resultadoAjuste.NeutroPortador
 	output.Pasos = append(output.Pasos, dto.PasoMemoria{
-		Numero:      2,
+		Numero:      len(output.Pasos) + 1,
 		Nombre:      "Ajuste de Corriente",
 		Descripcion: "Aplicación de factores de temperatura, agrupamiento y uso",
 		Resultado:   resultadoAjuste,
 	})
 
+	// ============================================================
+	// STEP 2a: Factor K del transformador (UL 1561 / IEEE C57.110, opcional)
+	// Solo si el equipo es TRANSFORMADOR y se proporcionaron los órdenes
+	// armónicos de la carga; los kVA se obtienen de la corriente nominal.
+	// ============================================================
+	if tipoEquipo == entity.TipoEquipoTransformador && espectroArmonico != nil && len(espectroArmonico.Ordenes) > 0 {
+		kva := service.CalcularPotenciaAparenteKVA(corrienteNominalVO, tension, sistemaElectrico)
+		factorK, err := service.CalcularFactorK(*espectroArmonico, kva, service.PerdidasCorrientesParasitasDefault)
+		if err != nil {
+			return dto.MemoriaOutput{}, fmt.Errorf("paso 2a (factor K): %w", err)
+		}
+		resultadoFactorK := nuevoResultadoFactorK(factorK, *espectroArmonico)
+		output.FactorK = &resultadoFactorK
+		output.Pasos = append(output.Pasos, dto.PasoMemoria{
+			Numero:      len(output.Pasos) + 1,
+			Nombre:      "Factor K del Transformador",
+			Descripcion: "Factor K de la carga no lineal, K-rating requerido y capacidad derrateada",
+			Resultado:   resultadoFactorK,
+		})
+	}
+
+	// ============================================================
+	// STEP 2b: Propuesta de ITM (solo si el usuario no lo proporcionó)
+	// El ITM define el conductor de tierra (Tabla 250-122), por lo que
+	// debe conocerse antes de seleccionar conductores.
+	// ============================================================
+	if itm <= 0 {
+		seleccionITM, err := uc.seleccionarITMUC.Execute(ctx, corrienteNominalVO, tipoEquipo)
+		if err != nil {
+			return dto.MemoriaOutput{}, fmt.Errorf("paso 2b (selección de ITM): %w", err)
+		}
+		itm = seleccionITM.ITM
+		output.Equipo.ITM = itm
+		output.Proteccion = dto.DatosProteccion{
+			ITM:                 itm,
+			SeleccionAutomatica: true,
+			Seleccion:           &seleccionITM,
+		}
+		output.Pasos = append(output.Pasos, dto.PasoMemoria{
+			Numero:      len(output.Pasos) + 1,
+			Nombre:      "Selección de Protección (ITM)",
+			Descripcion: "ITM estándar inmediato superior a la corriente nominal por el factor de uso",
+			Resultado:   seleccionITM,
+		})
+	}
+
+	// ============================================================
+	// STEP 2c: Protección de motor (Tabla 430-52)
+	// El ITM no debe exceder el 250 % de la corriente a plena carga,
+	// redondeado al estándar inmediato superior (430-52(c)(1) Exc. 1).
+	// Si el paso 2b propuso el ITM, esa selección ya es el límite; solo se
+	// consulta la tabla cuando el usuario proporcionó el ITM.
+	// ============================================================
+	if tipoEquipo == entity.TipoEquipoMotor {
+		limiteITM := output.Proteccion.Seleccion
+		if limiteITM == nil {
+			seleccion, err := uc.seleccionarITMUC.Execute(ctx, corrienteNominalVO, tipoEquipo)
+			if err != nil {
+				return dto.MemoriaOutput{}, fmt.Errorf("paso 2c (protección de motor): %w", err)
+			}
+			limiteITM = &seleccion
+		}
+		if output.Motor == nil {
+			// Corriente proporcionada directamente (MANUAL_AMPERAJE)
+			output.Motor = &dto.ResultadoMotor{
+				Tension:             tension.Valor(),
+				Fases:               sistemaElectrico.CantidadFases(),
+				CorrientePlenaCarga: output.Corrientes.CorrienteNominal,
+				FactorConductor:     resultadoAjuste.FactorUso,
+				CorrienteConductor:  output.Corrientes.CorrienteNominal * resultadoAjuste.FactorUso,
+				FactorProteccion:    service.FactorProteccionMotor,
+			}
+		}
+		output.Motor.ITMMaximo = limiteITM.ITM
+		output.Motor.CumpleProteccion = itm <= limiteITM.ITM
+	}
+
 	// ============================================================
 	// STEP 3: Select Feed and Ground Conductors
 	// ============================================================
@@ -245,6 +418,12 @@ func (uc *OrquestadorMemoriaCalculoUseCase) Execute(
 
 	// Get temperature used (from adjustment step or default)
 	temperaturaUsada := valueobject.Temperatura(resultadoAjuste.Temperatura)
+	// Columna de las terminales (110-14(c)); menor que temperaturaUsada con aislamiento de 90 °C
+	temperaturaTerminal := valueobject.Temperatura(resultadoAjuste.TemperaturaTerminal)
+	corrienteDiseno, err := valueobject.NewCorriente(resultadoAjuste.CorrienteDiseno)
+	if err != nil {
+		return dto.MemoriaOutput{}, fmt.Errorf("corriente de diseño inválida: %w", err)
+	}
 
 	resultadoConductores, err := uc.seleccionarConductorUC.Execute(
 		ctx,
@@ -253,30 +432,141 @@ func (uc *OrquestadorMemoriaCalculoUseCase) Execute(
 		itm,
 		material,
 		temperaturaUsada,
-		tipoCanalizacion,
+		canalizacionConductor,
+		temperaturaTerminal,
+		corrienteDiseno,
 	)
 	if err != nil {
 		return dto.MemoriaOutput{}, fmt.Errorf("paso 3 (seleccionar conductor): %w", err)
 	}
 	output.CableFase = resultadoConductores.Alimentacion
+	output.CableFase.TipoAislamiento = aislamiento.String()
 	output.CableTierra = resultadoConductores.Tierra
 	output.Corrientes.TablaAmpacidadUsada = resultadoConductores.TablaUsada
 	output.Corrientes.TemperaturaReferencia = temperaturaUsada.Valor()
+	output.Corrientes.LimiteTerminal = resultadoConductores.LimiteTerminal
 	output.Pasos = append(output.Pasos, dto.PasoMemoria{
-		Numero:      3,
+		Numero:      len(output.Pasos) + 1,
 		Nombre:      "Selección de Conductores",
 		Descripcion: "Selección de conductor de alimentación y tierra",
 		Resultado:   resultadoConductores,
 	})
 
+	// ============================================================
+	// STEP 3b: Soporte térmico al cortocircuito (I²t, opcional)
+	// Con la corriente de falla en el origen del circuito (caso más
+	// severo) se verifica que fase y tierra soporten la falla durante
+	// el tiempo de liberación; si no, se aumenta el calibre antes de
+	// dimensionar la canalización.
+	// ============================================================
+	if input.TieneFuenteCortocircuito() {
+		soporte, err := uc.verificarSoporteCortocircuito(
+			ctx, input, &output, material, tension, tipoVoltaje, canalizacionConductor,
+			temperaturaUsada, temperaturaTerminal, corrienteAjustada, corrienteDiseno,
+		)
+		if err != nil {
+			return dto.MemoriaOutput{}, fmt.Errorf("paso 3b (soporte térmico al cortocircuito): %w", err)
+		}
+		output.SoporteCortocircuito = soporte
+
+		output.Pasos = append(output.Pasos, dto.PasoMemoria{
+			Numero:      len(output.Pasos) + 1,
+			Nombre:      "Soporte Térmico al Cortocircuito (I²t)",
+			Descripcion: "Verificación adiabática de conductores de fase y tierra con la corriente de falla y el tiempo de liberación",
+			Resultado:   soporte,
+		})
+	}
+
+	// ============================================================
+	// STEP 3c: Coordinación conductor–protección (NOM 240-4)
+	// La ampacidad corregida del conductor de fase (tabla × F_temp ×
+	// F_agr × F_armónico × hilos) debe quedar protegida por el ITM; si
+	// no, se aumenta el calibre antes de dimensionar la canalización.
+	// Se vuelve a verificar si la caída de tensión cambia el calibre.
+	// Los circuitos de motor quedan exentos (240-4(g)): su protección
+	// contra cortocircuito se rige por la Tabla 430-52 (paso 2c).
+	// ============================================================
+	calibreCoordinado := ""
+	pasoCoordinacion := -1
+	if tipoEquipo != entity.TipoEquipoMotor {
+		coordinacion, err := uc.verificarCoordinacionProteccionUC.Execute(
+			ctx,
+			output.CableFase.Calibre,
+			output.CableFase.Capacidad,
+			material,
+			temperaturaUsada,
+			canalizacionConductor,
+			factorCorreccionAmpacidad(resultadoAjuste),
+			input.HilosPorFase,
+			itm,
+			temperaturaTerminal,
+		)
+		if err != nil {
+			return dto.MemoriaOutput{}, fmt.Errorf("paso 3c (coordinación con la protección): %w", err)
+		}
+		if coordinacion.Cumple && coordinacion.CalibreSeleccionado != output.CableFase.Calibre {
+			output.CableFase.Calibre = coordinacion.CalibreSeleccionado
+			output.CableFase.SeccionMM2 = coordinacion.SeccionMM2
+			output.CableFase.Capacidad = coordinacion.Capacidad
+			output.CableFase.SeleccionPorProteccion = true
+			output.CableFase.NotaProteccion = coordinacion.Nota
+		}
+		output.Proteccion.Coordinacion = &coordinacion
+		calibreCoordinado = output.CableFase.Calibre
+		pasoCoordinacion = len(output.Pasos)
+		output.Pasos = append(output.Pasos, dto.PasoMemoria{
+			Numero:      len(output.Pasos) + 1,
+			Nombre:      "Coordinación con la Protección",
+			Descripcion: "Verificación de que el ITM protege la ampacidad corregida del conductor según NOM 240-4",
+			Resultado:   coordinacion,
+		})
+	}
+
+	// ============================================================
+	// STEP 3d: Conductor neutro (sistemas con neutro)
+	// La corriente del neutro por desbalance y armónicas triples
+	// define un calibre propio que entra al cálculo de canalización.
+	// ============================================================
+	if sistemaElectrico.NecesitaNeutro() {
+		neutro, err := uc.dimensionarNeutroUC.Execute(
+			ctx,
+			corrienteNominalVO,
+			sistemaElectrico,
+			input.DesbalanceCarga,
+			espectroArmonico,
+			factorCorreccionAmpacidad(resultadoAjuste),
+			input.HilosPorFase,
+			material,
+			temperaturaUsada,
+			canalizacionConductor,
+			output.CableFase.Calibre,
+			output.CableTierra.Calibre,
+		)
+		if err != nil {
+			return dto.MemoriaOutput{}, fmt.Errorf("paso 3d (conductor neutro): %w", err)
+		}
+		neutro.Conductor.TipoAislamiento = aislamiento.String()
+		cableNeutro := neutro.Conductor
+		output.CableNeutro = &cableNeutro
+		output.Neutro = &neutro
+		output.Pasos = append(output.Pasos, dto.PasoMemoria{
+			Numero:      len(output.Pasos) + 1,
+			Nombre:      "Conductor Neutro",
+			Descripcion: "Corriente del neutro por desbalance y armónicas triples, y selección de su calibre",
+			Resultado:   neutro,
+		})
+	}
+
 	// ============================================================
 	// STEP 4: Size Conduit/Tray (branch by canalization type)
 	// ============================================================
 	canalizacion, detalleCharola, detalleTuberia, fillFactor, err := uc.calcularCanalizacion(
 		ctx,
 		output.CableFase.Calibre,
+		calibreNeutro(output),
 		output.CableTierra.Calibre,
 		material,
+		aislamiento,
 		tipoCanalizacion,
 		sistemaElectrico,
 		input,
@@ -310,16 +600,24 @@ func (uc *OrquestadorMemoriaCalculoUseCase) Execute(
 	}
 
 	// Append the appropriate paso based on canalization type
-	if tipoCanalizacion.EsCharola() {
+	switch {
+	case tipoCanalizacion.EsCharola():
 		output.Pasos = append(output.Pasos, dto.PasoMemoria{
-			Numero:      4,
+			Numero:      len(output.Pasos) + 1,
 			Nombre:      "Dimensionamiento de Charola",
 			Descripcion: "Cálculo de tamaño de charola según configuración",
 			Resultado:   detalleCharola,
 		})
-	} else {
+	case !tipoCanalizacion.RequiereTuberia():
+		output.Pasos = append(output.Pasos, dto.PasoMemoria{
+			Numero:      len(output.Pasos) + 1,
+			Nombre:      "Instalación Directamente Enterrada",
+			Descripcion: "Cables directamente enterrados sin canalización; profundidad mínima según NOM Tabla 300-5",
+			Resultado:   canalizacion,
+		})
+	default:
 		output.Pasos = append(output.Pasos, dto.PasoMemoria{
-			Numero:      4,
+			Numero:      len(output.Pasos) + 1,
 			Nombre:      "Dimensionamiento de Tubería",
 			Descripcion: "Cálculo de tamaño de tubería según área de conductores",
 			Resultado:   detalleTuberia,
@@ -333,7 +631,11 @@ func (uc *OrquestadorMemoriaCalculoUseCase) Execute(
 	// - Charola: siempre 1 hilo
 	// - Tubería ≤2 tubos: 1 hilo
 	// - Tubería >2 tubos: 2 hilos
+	// - Cable multiconductor: una tierra de fábrica dentro de cada cable
 	numHilosTierra := calcularNumHilosTierra(tipoCanalizacion, input.NumTuberias)
+	if tipoCable != nil {
+		numHilosTierra = input.HilosPorFase
+	}
 	output.CableTierra.NumHilos = numHilosTierra
 
 	// ============================================================
@@ -351,7 +653,7 @@ func (uc *OrquestadorMemoriaCalculoUseCase) Execute(
 		input.LongitudCircuito, // already in meters from input
 		tension,
 		input.PorcentajeCaidaMaximo,
-		tipoCanalizacion,
+		canalizacionConductor,
 		sistemaElectrico,
 		tipoVoltaje,
 		input.HilosPorFase,
@@ -372,7 +674,7 @@ func (uc *OrquestadorMemoriaCalculoUseCase) Execute(
 	}
 
 	output.Pasos = append(output.Pasos, dto.PasoMemoria{
-		Numero:      5,
+		Numero:      len(output.Pasos) + 1,
 		Nombre:      "Caída de Tensión",
 		Descripcion: "Cálculo de caída de tensión según NOM-001",
 		Resultado:   resultadoCaidaTension,
@@ -392,12 +694,13 @@ func (uc *OrquestadorMemoriaCalculoUseCase) Execute(
 			input.LongitudCircuito,
 			tension,
 			input.PorcentajeCaidaMaximo,
-			tipoCanalizacion,
+			canalizacionConductor,
 			sistemaElectrico,
 			tipoVoltaje,
 			input.HilosPorFase,
 			input.FactorPotencia,
 			temperaturaUsada,
+			aislamiento,
 		)
 		if err != nil {
 			output.Observaciones = append(output.Observaciones,
@@ -414,6 +717,15 @@ func (uc *OrquestadorMemoriaCalculoUseCase) Execute(
 			output.CableFase.CalibreOriginalAmpacidad = calibreOriginal
 			output.CableFase.NotaSeleccion = resultadoRecalc.Nota
 
+			// Neutro igualado a la fase: sigue al nuevo calibre
+			if output.Neutro != nil && output.Neutro.IgualAFase {
+				output.Neutro.Conductor.Calibre = resultadoRecalc.CalibreSeleccionado
+				output.Neutro.Conductor.SeccionMM2 = resultadoRecalc.SeccionMM2
+				output.Neutro.Conductor.Capacidad = resultadoRecalc.Capacidad
+				cableNeutro := output.Neutro.Conductor
+				output.CableNeutro = &cableNeutro
+			}
+
 			// Override caída de tensión con el resultado del nuevo calibre
 			output.CaidaTension = dto.ResultadoCaidaTension{
 				Porcentaje:       resultadoRecalc.CaidaTension.Porcentaje,
@@ -429,8 +741,10 @@ func (uc *OrquestadorMemoriaCalculoUseCase) Execute(
 			canalizacionRecalc, detalleCharolaRecalc, detalleTuberiaRecalc, fillFactorRecalc, errCanal := uc.calcularCanalizacion(
 				ctx,
 				resultadoRecalc.CalibreSeleccionado,
+				calibreNeutro(output),
 				output.CableTierra.Calibre,
 				material,
+				aislamiento,
 				tipoCanalizacion,
 				sistemaElectrico,
 				input,
@@ -468,7 +782,7 @@ func (uc *OrquestadorMemoriaCalculoUseCase) Execute(
 			}
 
 			output.Pasos = append(output.Pasos, dto.PasoMemoria{
-				Numero:      6,
+				Numero:      len(output.Pasos) + 1,
 				Nombre:      "Recálculo por Caída de Tensión",
 				Descripcion: "Calibre aumentado al siguiente superior para cumplir caída de tensión NOM-001-SEDE",
 				Resultado:   resultadoRecalc,
@@ -477,10 +791,124 @@ func (uc *OrquestadorMemoriaCalculoUseCase) Execute(
 		// Si resultadoRecalc.Cumple == false: se agotaron calibres, mantener original con Cumple=false
 	}
 
+	// ============================================================
+	// STEP 5b'': Coordinación con el calibre final (NOM 240-4)
+	// Si la caída de tensión aumentó el calibre, la verificación del
+	// paso 3c se repite para que describa el conductor entregado.
+	// ============================================================
+	if pasoCoordinacion >= 0 && output.CableFase.Calibre != calibreCoordinado {
+		coordinacion, err := uc.verificarCoordinacionProteccionUC.Execute(
+			ctx,
+			output.CableFase.Calibre,
+			output.CableFase.Capacidad,
+			material,
+			temperaturaUsada,
+			canalizacionConductor,
+			factorCorreccionAmpacidad(resultadoAjuste),
+			input.HilosPorFase,
+			itm,
+			temperaturaTerminal,
+		)
+		if err != nil {
+			return dto.MemoriaOutput{}, fmt.Errorf("paso 5b (coordinación con el calibre final): %w", err)
+		}
+		output.Proteccion.Coordinacion = &coordinacion
+		output.Pasos[pasoCoordinacion].Resultado = coordinacion
+	}
+
+	// ============================================================
+	// STEP 5b': Cable multiconductor con el calibre final
+	// ============================================================
+	if tipoCable != nil {
+		cable, err := uc.obtenerCableMulticonductor(
+			ctx, *tipoCable, output.CableFase.Calibre, calibreNeutro(output), sistemaElectrico, numNeutros,
+		)
+		if err != nil {
+			return dto.MemoriaOutput{}, fmt.Errorf("cable multiconductor: %w", err)
+		}
+		output.CableMulticonductor = toCableMulticonductorDTO(cable, input.HilosPorFase)
+	}
+
+	// ============================================================
+	// STEP 5c: Caída de tensión acumulada (alimentador + derivado)
+	// NOM-001-SEDE 215-2(A): la caída combinada desde la acometida
+	// hasta la carga más lejana no debe exceder el límite total (5%).
+	// Solo se marca el incumplimiento; el calibre no se recalcula.
+	// ============================================================
+	caidaAcumulada := service.CalcularCaidaTensionAcumulada(
+		input.CaidaTensionAlimentador,
+		output.CaidaTension.Porcentaje,
+		input.PorcentajeCaidaTotalMaximo,
+	)
+	output.CaidaTension.CaidaAlimentador = caidaAcumulada.CaidaAlimentador
+	output.CaidaTension.PorcentajeAcumulado = caidaAcumulada.CaidaAcumulada
+	output.CaidaTension.LimiteTotalPorcentaje = caidaAcumulada.LimiteTotal
+	output.CaidaTension.CumpleAcumulado = caidaAcumulada.Cumple
+
+	if input.CaidaTensionAlimentador > 0 {
+		output.Pasos = append(output.Pasos, dto.PasoMemoria{
+			Numero:      len(output.Pasos) + 1,
+			Nombre:      "Caída de Tensión Acumulada",
+			Descripcion: "Caída combinada alimentador + circuito derivado según NOM-001-SEDE 215-2(A)",
+			Resultado:   caidaAcumulada,
+		})
+	}
+
+	// ============================================================
+	// STEP 8: Cortocircuito (opcional)
+	// Corriente de falla simétrica en el extremo de carga con el
+	// calibre final de fase y el conductor de tierra como retorno.
+	// ============================================================
+	if input.TieneFuenteCortocircuito() {
+		resultadoCortocircuito, err := uc.calcularCortocircuitoUC.Execute(ctx, dto.CortocircuitoInput{
+			CalibreFase:              output.CableFase.Calibre,
+			CalibreTierra:            output.CableTierra.Calibre,
+			NumHilosTierra:           output.CableTierra.NumHilos,
+			Material:                 material.String(),
+			TipoCanalizacion:         string(canalizacionConductor),
+			SistemaElectrico:         string(input.SistemaElectrico),
+			TipoVoltaje:              input.TipoVoltaje,
+			Tension:                  float64(tension.Valor()),
+			LongitudCircuito:         input.LongitudCircuito,
+			HilosPorFase:             input.HilosPorFase,
+			PotenciaCortocircuitoMVA: input.PotenciaCortocircuitoMVA,
+			TransformadorKVA:         input.TransformadorKVA,
+			TransformadorZPorcentaje: input.TransformadorZPorcentaje,
+		})
+		if err != nil {
+			return dto.MemoriaOutput{}, fmt.Errorf("paso 8 (cortocircuito): %w", err)
+		}
+		output.Cortocircuito = &resultadoCortocircuito
+
+		output.Pasos = append(output.Pasos, dto.PasoMemoria{
+			Numero:      len(output.Pasos) + 1,
+			Nombre:      "Cortocircuito",
+			Descripcion: "Corriente de cortocircuito simétrica trifásica y fase-tierra en el extremo de carga",
+			Resultado:   resultadoCortocircuito,
+		})
+	}
+
 	// ============================================================
 	// Final Assembly: CumpleNormativa and Observaciones
 	// ============================================================
-	output.CumpleNormativa = output.CaidaTension.Cumple
+	output.CumpleNormativa = output.CaidaTension.Cumple && output.CaidaTension.CumpleAcumulado
+	if sc := output.SoporteCortocircuito; sc != nil && (!sc.Fase.Cumple || !sc.Tierra.Cumple) {
+		output.CumpleNormativa = false
+	}
+	if co := output.Proteccion.Coordinacion; co != nil && !co.Cumple {
+		output.CumpleNormativa = false
+	}
+	if m := output.Motor; m != nil && !m.CumpleProteccion {
+		output.CumpleNormativa = false
+	}
+
+	// ============================================================
+	// Lista de materiales (sin valorizar)
+	// Conductores, tramos de canalización y accesorios del circuito
+	// con el calibre y canalización finales.
+	// ============================================================
+	listaMateriales := generarListaMateriales(output, input.GetDesperdicioPorcentaje(), nil)
+	output.ListaMateriales = &listaMateriales
 
 	// Generate observations
 	output.Observaciones = uc.generarObservaciones(output)
@@ -505,6 +933,23 @@ func (uc *OrquestadorMemoriaCalculoUseCase) generarObservaciones(memoria dto.Mem
 		))
 	}
 
+	// 1b. Caída acumulada — solo si el circuito es derivado de un alimentador o excede el total
+	if memoria.CaidaTension.CaidaAlimentador > 0 || !memoria.CaidaTension.CumpleAcumulado {
+		if memoria.CaidaTension.CumpleAcumulado {
+			obs = append(obs, fmt.Sprintf(
+				"La caída de tensión acumulada (alimentador %.2f%% + circuito %.2f%% = %.2f%%) cumple con el límite total de %.1f%%",
+				memoria.CaidaTension.CaidaAlimentador, memoria.CaidaTension.Porcentaje,
+				memoria.CaidaTension.PorcentajeAcumulado, memoria.CaidaTension.LimiteTotalPorcentaje,
+			))
+		} else {
+			obs = append(obs, fmt.Sprintf(
+				"ADVERTENCIA: La caída de tensión acumulada (alimentador %.2f%% + circuito %.2f%% = %.2f%%) excede el límite total de %.1f%%. Considere aumentar el calibre del alimentador o del circuito derivado.",
+				memoria.CaidaTension.CaidaAlimentador, memoria.CaidaTension.Porcentaje,
+				memoria.CaidaTension.PorcentajeAcumulado, memoria.CaidaTension.LimiteTotalPorcentaje,
+			))
+		}
+	}
+
 	// 2. Conductor de alimentación — con hilos en paralelo si aplica
 	hilosFase := memoria.Instalacion.HilosPorFase
 	if hilosFase <= 0 {
@@ -529,6 +974,98 @@ func (uc *OrquestadorMemoriaCalculoUseCase) generarObservaciones(memoria dto.Mem
 		))
 	}
 
+	// 2b. Coordinación conductor–protección (NOM 240-4)
+	if co := memoria.Proteccion.Coordinacion; co != nil {
+		switch {
+		case !co.Cumple:
+			obs = append(obs, fmt.Sprintf(
+				"NO CUMPLE NOM 240-4: el ITM de %d A excede el máximo de %d A que protege al conductor %s (ampacidad corregida %.2f A). Reduzca el ITM o aumente el número de hilos por fase.",
+				co.ITM, co.ITMMaximoPermitido, co.CalibreSeleccionado, co.AmpacidadCorregida,
+			))
+		case co.Nota != "":
+			obs = append(obs, co.Nota)
+		case co.AplicaSiguienteSuperior:
+			obs = append(obs, fmt.Sprintf(
+				"ITM de %d A sobre ampacidad corregida de %.2f A, permitido como capacidad estándar inmediata superior (NOM 240-4(b))",
+				co.ITM, co.AmpacidadCorregida,
+			))
+		}
+	}
+
+	// 2c. Motor: corriente de tabla y protección (NOM 430)
+	if m := memoria.Motor; m != nil {
+		if m.TablaNOM != "" {
+			obs = append(obs, fmt.Sprintf(
+				"Motor de %.2f HP: corriente a plena carga %.2f A de Tabla %s (columna %d V); conductor dimensionado al %.0f%% (NOM 430-22)",
+				m.PotenciaHP, m.CorrientePlenaCarga, m.TablaNOM, m.TensionTabla, m.FactorConductor*100,
+			))
+		}
+		if m.CumpleProteccion {
+			obs = append(obs, fmt.Sprintf(
+				"ITM de %d A dentro del máximo de %d A para motor (%.0f%% de la corriente a plena carga, Tabla 430-52); la coordinación NOM 240-4 no aplica (240-4(g))",
+				memoria.Proteccion.ITM, m.ITMMaximo, m.FactorProteccion*100,
+			))
+		} else {
+			obs = append(obs, fmt.Sprintf(
+				"NO CUMPLE NOM 430-52: el ITM de %d A excede el máximo de %d A (%.0f%% de %.2f A). Reduzca el ITM.",
+				memoria.Proteccion.ITM, m.ITMMaximo, m.FactorProteccion*100, m.CorrientePlenaCarga,
+			))
+		}
+	}
+
+	// 2d. Banco de capacitores: desconexión y descarga (NOM 460)
+	if bc := memoria.BancoCapacitores; bc != nil {
+		obs = append(obs, fmt.Sprintf(
+			"Banco de capacitores: el medio de desconexión debe tener capacidad no menor a %.2f A (%.0f%% de %.2f A) y abrir simultáneamente todos los conductores de fase (NOM 460-8(c))",
+			bc.CorrienteMinimaDesconectador, bc.FactorConductor*100, bc.CorrienteNominal,
+		))
+		obs = append(obs, fmt.Sprintf(
+			"Banco de capacitores: requiere resistencias de descarga que reduzcan la tensión residual a %.0f V o menos en %.0f s tras la desconexión (NOM 460-6(a))",
+			bc.TensionResidualMaxima, bc.TiempoDescarga,
+		))
+		if bc.Pasos > 1 {
+			obs = append(obs, fmt.Sprintf(
+				"Banco de %d pasos (%.2f A por paso): cada paso debe contar con su propio dispositivo de maniobra y medio de descarga",
+				bc.Pasos, bc.CorrientePorPaso,
+			))
+		}
+	}
+
+	// 2e. Armónicos: corriente RMS y reducción del Anexo E (IEC 60364-5-52)
+	if ar := memoria.Corrientes.Armonicos; ar != nil {
+		obs = append(obs, fmt.Sprintf(
+			"Contenido armónico THDi %.1f%%: corriente RMS %.2f A (factor %.3f); factor por armónicos aplicado al ajuste: %.3f",
+			ar.THDi, ar.CorrienteRMS, ar.FactorRMS, ar.Factor,
+		))
+		switch {
+		case ar.DimensionPorNeutro:
+			obs = append(obs, fmt.Sprintf(
+				"3ª armónica de %.1f%% (> 33%%): el conductor se dimensiona por la corriente del neutro (IEC 60364-5-52 Anexo E)",
+				ar.TerceraArmonica,
+			))
+		case ar.FactorReduccion < 1:
+			obs = append(obs, fmt.Sprintf(
+				"3ª armónica de %.1f%% (15%%–33%%): se aplica factor de reducción %.2f por neutro con carga (IEC 60364-5-52 Anexo E)",
+				ar.TerceraArmonica, ar.FactorReduccion,
+			))
+		}
+	}
+
+	// 2f. Factor K del transformador
+	if fk := memoria.FactorK; fk != nil {
+		if fk.ExcedeNominal {
+			obs = append(obs, fmt.Sprintf(
+				"Factor K de la carga %.2f excede el K-50: el transformador requiere diseño especial para cargas no lineales",
+				fk.FactorK,
+			))
+		} else {
+			obs = append(obs, fmt.Sprintf(
+				"Factor K de la carga %.2f: se requiere transformador K-%d (UL 1561); un transformador estándar de %.1f kVA solo entrega %.1f kVA (%.1f%%, IEEE C57.110)",
+				fk.FactorK, fk.KNominal, fk.KVA, fk.KVADerrateado, fk.CorrienteMaximaPU*100,
+			))
+		}
+	}
+
 	// 3. Conductor de tierra — con cantidad de hilos si hay múltiples tubos
 	// NumHilos es int (no puntero); valor 0 se trata como 1 (un conductor)
 	numHilosTierra := memoria.CableTierra.NumHilos
@@ -552,6 +1089,37 @@ func (uc *OrquestadorMemoriaCalculoUseCase) generarObservaciones(memoria dto.Mem
 		))
 	}
 
+	// 3b. Conductor neutro — calibre propio por desbalance y armónicas triples
+	if n := memoria.Neutro; n != nil {
+		if n.IgualAFase {
+			obs = append(obs, fmt.Sprintf(
+				"Conductor neutro: %s %s, igual a la fase",
+				n.Conductor.Material, n.Conductor.Calibre,
+			))
+		} else {
+			obs = append(obs, fmt.Sprintf(
+				"Conductor neutro: %s %s — I_N = %.2f A (%.0f%% de la corriente de fase; desbalance %.2f A, armónicas triples %.2f A)",
+				n.Conductor.Material, n.Conductor.Calibre,
+				n.CorrienteNeutro, n.RelacionFase*100, n.CorrienteDesbalance, n.CorrienteArmonica,
+			))
+		}
+		if memoria.Corrientes.NeutroPortador && memoria.Instalacion.SistemaElectrico == dto.SistemaElectricoEstrella {
+			obs = append(obs, "El neutro transporta armónicas triples y se cuenta como conductor portador de corriente para el factor de agrupamiento (NOM 310-15(b)(5)(c))")
+		}
+	}
+
+	// 3c. Cable multiconductor: conductores y tierra de fábrica bajo una cubierta
+	if cm := memoria.CableMulticonductor; cm != nil {
+		ampacidad := "ampacidad de Tabla 310-15(b)(16)"
+		if entity.TipoCanalizacion(memoria.Instalacion.TipoCanalizacion).EsCharola() {
+			ampacidad = "ampacidad de Tabla 310-15(b)(16) en charola (NOM 392-80(a)(1))"
+		}
+		obs = append(obs, fmt.Sprintf(
+			"Cable multiconductor: %d × %s (Ø %.1f mm, tabla de fabricante); %s, tierra de fábrica dentro de cada cable",
+			cm.NumCables, cm.Designacion, cm.DiametroExteriorMM, ampacidad,
+		))
+	}
+
 	// 4. Canalización — con número de tubos si hay más de uno
 	numTubos := memoria.Canalizacion.Resultado.NumeroDeTubos
 	if numTubos <= 0 {
@@ -569,8 +1137,49 @@ func (uc *OrquestadorMemoriaCalculoUseCase) generarObservaciones(memoria dto.Mem
 			memoria.Canalizacion.Resultado.Tamano,
 		))
 	}
+	if dt := memoria.Canalizacion.DetalleTuberia; dt != nil && dt.Nota != "" {
+		obs = append(obs, dt.Nota)
+	}
+
+	// 5. Cortocircuito (solo si se proporcionó la fuente)
+	if cc := memoria.Cortocircuito; cc != nil {
+		if cc.IccTrifasicaKA > 0 {
+			obs = append(obs, fmt.Sprintf(
+				"Cortocircuito en el extremo de carga: Icc trifásica = %.2f kA, Icc fase-tierra = %.2f kA. Verifique la capacidad interruptiva de la protección.",
+				cc.IccTrifasicaKA, cc.IccLineaTierraKA,
+			))
+		} else {
+			obs = append(obs, fmt.Sprintf(
+				"Cortocircuito en el extremo de carga: Icc fase-tierra = %.2f kA. Verifique la capacidad interruptiva de la protección.",
+				cc.IccLineaTierraKA,
+			))
+		}
+	}
+
+	// 5b. Soporte térmico al cortocircuito (I²t)
+	if sc := memoria.SoporteCortocircuito; sc != nil {
+		for _, v := range []struct {
+			conductor string
+			r         dto.ResultadoSoporteTermico
+		}{{"fase", sc.Fase}, {"tierra", sc.Tierra}} {
+			switch {
+			case !v.r.Cumple:
+				obs = append(obs, fmt.Sprintf(
+					"ADVERTENCIA: El conductor de %s no soporta la corriente de falla de %.2f kA durante %.3f s (sección mínima I²t %.2f mm²). Reduzca el tiempo de liberación o aumente el número de hilos.",
+					v.conductor, v.r.CorrienteFallaKA, v.r.TiempoLiberacion, v.r.SeccionMinimaMM2,
+				))
+			case v.r.Nota != "":
+				obs = append(obs, fmt.Sprintf("Conductor de %s: %s", v.conductor, v.r.Nota))
+			}
+		}
+	}
 
-	// 5. Factores aplicados (solo si hay corrección significativa)
+	// 5c. Límite por temperatura de terminales (110-14(c))
+	if lt := memoria.Corrientes.LimiteTerminal; lt != nil && lt.Nota != "" {
+		obs = append(obs, lt.Nota)
+	}
+
+	// 6. Factores aplicados (solo si hay corrección significativa)
 	if memoria.Corrientes.FactorTotalAjuste < 1.0 {
 		obs = append(obs, fmt.Sprintf(
 			"Factores aplicados: Temperatura=%.2f, Agrupamiento=%.2f, Uso=%.2f",
@@ -581,26 +1190,122 @@ func (uc *OrquestadorMemoriaCalculoUseCase) generarObservaciones(memoria dto.Mem
 	return obs
 }
 
+// verificarSoporteCortocircuito ejecuta el paso 3b: verificación I²t de fase y tierra.
+// Si algún conductor se aumenta, actualiza CableFase/CableTierra en la memoria; el
+// límite por terminales (110-14(c)) se vuelve a documentar con el nuevo calibre de fase.
+func (uc *OrquestadorMemoriaCalculoUseCase) verificarSoporteCortocircuito(
+	ctx context.Context,
+	input dto.EquipoInput,
+	output *dto.MemoriaOutput,
+	material valueobject.MaterialConductor,
+	tension valueobject.Tension,
+	tipoVoltaje entity.TipoVoltaje,
+	tipoCanalizacion entity.TipoCanalizacion,
+	temperaturaUsada valueobject.Temperatura,
+	temperaturaTerminal valueobject.Temperatura,
+	corrienteAjustada valueobject.Corriente,
+	corrienteDiseno valueobject.Corriente,
+) (*dto.DatosSoporteCortocircuito, error) {
+	iccKA, err := service.CalcularCorrienteFallaFuente(entity.FuenteCortocircuito{
+		PotenciaCortocircuitoMVA: input.PotenciaCortocircuitoMVA,
+		TransformadorKVA:         input.TransformadorKVA,
+		TransformadorZPorcentaje: input.TransformadorZPorcentaje,
+	}, tension, tipoVoltaje)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fase: la corriente de falla se reparte entre los hilos en paralelo.
+	// Parte de la temperatura de operación del conductor.
+	fase, err := uc.verificarSoporteTermicoUC.Execute(
+		ctx,
+		output.CableFase.Calibre,
+		material,
+		iccKA/float64(input.HilosPorFase),
+		input.TiempoLiberacionFalla,
+		float64(temperaturaUsada.Valor()),
+		service.TemperaturaMaximaCortocircuito(output.CableFase.TipoAislamiento),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("conductor de fase: %w", err)
+	}
+	if fase.Cumple && fase.CalibreSeleccionado != output.CableFase.Calibre {
+		capacidad, err := uc.tablaRepo.ObtenerCapacidadConductor(ctx, tipoCanalizacion, material, temperaturaUsada, fase.CalibreSeleccionado)
+		if err != nil {
+			return nil, fmt.Errorf("obtener capacidad para calibre %s: %w", fase.CalibreSeleccionado, err)
+		}
+		output.CableFase.Calibre = fase.CalibreSeleccionado
+		output.CableFase.SeccionMM2 = fase.SeccionMM2
+		output.CableFase.Capacidad = capacidad
+		output.CableFase.SeleccionPorCortocircuito = true
+		output.CableFase.NotaCortocircuito = fase.Nota
+
+		limite, err := uc.seleccionarConductorUC.LimiteTerminal(
+			ctx, fase.CalibreSeleccionado, corrienteAjustada, corrienteDiseno,
+			material, temperaturaUsada, tipoCanalizacion, temperaturaTerminal,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("límite por terminales: %w", err)
+		}
+		output.Corrientes.LimiteTerminal = limite
+	}
+
+	// Tierra: conduce toda la corriente de falla y no transporta carga,
+	// parte de la temperatura ambiente. Conductor desnudo → 250 °C.
+	tierra, err := uc.verificarSoporteTermicoUC.Execute(
+		ctx,
+		output.CableTierra.Calibre,
+		material,
+		iccKA,
+		input.TiempoLiberacionFalla,
+		float64(output.Corrientes.TemperaturaAmbiente),
+		service.TemperaturaMaximaCortocircuito(""),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("conductor de tierra: %w", err)
+	}
+	if tierra.Cumple && tierra.CalibreSeleccionado != output.CableTierra.Calibre {
+		output.CableTierra.Calibre = tierra.CalibreSeleccionado
+		output.CableTierra.SeccionMM2 = tierra.SeccionMM2
+		output.CableTierra.SeleccionPorCortocircuito = true
+		output.CableTierra.NotaCortocircuito = tierra.Nota
+	}
+
+	return &dto.DatosSoporteCortocircuito{Fase: fase, Tierra: tierra}, nil
+}
+
 // calcularCanalizacion ejecuta el paso 4 de dimensionamiento de canalización.
 // Es llamado tanto en el flujo principal como en el recálculo por caída de tensión.
+// calibreNeutro vacío indica neutro del mismo calibre que la fase.
 func (uc *OrquestadorMemoriaCalculoUseCase) calcularCanalizacion(
 	ctx context.Context,
 	calibreFase string,
+	calibreNeutro string,
 	calibreTierra string,
 	material valueobject.MaterialConductor,
+	aislamiento valueobject.TipoAislamiento,
 	tipoCanalizacion entity.TipoCanalizacion,
 	sistemaElectrico entity.SistemaElectrico,
 	input dto.EquipoInput,
 	numNeutros int,
 ) (canalizacion dto.ResultadoCanalizacion, detalleCharola *dto.DetalleCharola, detalleTuberia *dto.DetalleTuberia, fillFactor float64, err error) {
 
-	if tipoCanalizacion.EsCharola() {
+	tipoCable, err := input.ToDomainTipoCable()
+	if err != nil {
+		return dto.ResultadoCanalizacion{}, nil, nil, 0, fmt.Errorf("tipo cable: %w", err)
+	}
+
+	if tipoCanalizacion.EsCharola() && tipoCable != nil {
+		return uc.calcularCharolaMulticonductor(
+			ctx, *tipoCable, calibreFase, calibreNeutro, tipoCanalizacion, sistemaElectrico, input, numNeutros,
+		)
+	} else if tipoCanalizacion.EsCharola() {
 		// For CHAROLA: need to look up diameters first
 		diametroFase, err := uc.tablaRepo.ObtenerDiametroConductor(
 			ctx,
 			calibreFase,
 			material.String(),
-			true, // with insulation
+			aislamiento,
 		)
 		if err != nil {
 			return dto.ResultadoCanalizacion{}, nil, nil, 0, fmt.Errorf("obtener diámetro fase: %w", err)
@@ -610,18 +1315,28 @@ func (uc *OrquestadorMemoriaCalculoUseCase) calcularCanalizacion(
 			ctx,
 			calibreTierra,
 			material.String(),
-			false, // ground is bare
+			valueobject.SinAislamiento, // ground is bare
 		)
 		if err != nil {
 			return dto.ResultadoCanalizacion{}, nil, nil, 0, fmt.Errorf("obtener diámetro tierra: %w", err)
 		}
 
+		// Sección de la fase: los monoconductores de 1000 kcmil y mayores se
+		// limitan por área en la charola (392-22(b)(1))
+		seccionFase, err := uc.tablaRepo.ObtenerSeccionConductor(ctx, calibreFase)
+		if err != nil {
+			return dto.ResultadoCanalizacion{}, nil, nil, 0, fmt.Errorf("obtener sección fase: %w", err)
+		}
+
 		// Build charola input
 		charolaInput := dto.CharolaEspaciadoInput{
 			HilosPorFase:     input.HilosPorFase,
 			SistemaElectrico: string(sistemaElectrico),
 			DiametroFaseMM:   diametroFase,
 			DiametroTierraMM: diametroTierra,
+			SeccionFaseMM2:   seccionFase,
+			TipoCharola:      input.TipoCharola,
+			PeralteMM:        input.PeralteCharolaMM,
 		}
 
 		// Handle optional control diameter
@@ -629,6 +1344,20 @@ func (uc *OrquestadorMemoriaCalculoUseCase) calcularCanalizacion(
 			charolaInput.DiametroControlMM = input.DiametroControlMM
 		}
 
+		// Neutro con calibre propio
+		if numNeutros > 0 && calibreNeutro != "" && calibreNeutro != calibreFase {
+			diametroNeutro, err := uc.tablaRepo.ObtenerDiametroConductor(
+				ctx,
+				calibreNeutro,
+				material.String(),
+				aislamiento,
+			)
+			if err != nil {
+				return dto.ResultadoCanalizacion{}, nil, nil, 0, fmt.Errorf("obtener diámetro neutro: %w", err)
+			}
+			charolaInput.DiametroNeutroMM = &diametroNeutro
+		}
+
 		var resultadoCanalizacion dto.CharolaEspaciadoOutput
 
 		switch tipoCanalizacion {
@@ -638,6 +1367,9 @@ func (uc *OrquestadorMemoriaCalculoUseCase) calcularCanalizacion(
 				HilosPorFase:     input.HilosPorFase,
 				DiametroFaseMM:   diametroFase,
 				DiametroTierraMM: diametroTierra,
+				SeccionFaseMM2:   seccionFase,
+				TipoCharola:      input.TipoCharola,
+				PeralteMM:        input.PeralteCharolaMM,
 			}
 			if input.DiametroControlMM != nil && *input.DiametroControlMM > 0 {
 				triangularInput.DiametroControlMM = input.DiametroControlMM
@@ -664,6 +1396,9 @@ func (uc *OrquestadorMemoriaCalculoUseCase) calcularCanalizacion(
 				EspacioControlMM:  resultadoTriangular.EspacioControlMM,
 				AnchoControlMM:    resultadoTriangular.AnchoControlMM,
 				AnchoTierraMM:     resultadoTriangular.AnchoTierraMM,
+				TipoCharola:       resultadoTriangular.TipoCharola,
+				PeralteMM:         resultadoTriangular.PeralteMM,
+				Llenado:           resultadoTriangular.Llenado,
 				FactorTriangular:  resultadoTriangular.FactorTriangular,
 				FactorControl:     resultadoTriangular.FactorControl,
 			}
@@ -679,12 +1414,16 @@ func (uc *OrquestadorMemoriaCalculoUseCase) calcularCanalizacion(
 				DiametroFaseMM:    resultadoCharola.DiametroFaseMM,
 				DiametroTierraMM:  resultadoCharola.DiametroTierraMM,
 				DiametroControlMM: resultadoCharola.DiametroControlMM,
+				DiametroNeutroMM:  resultadoCharola.DiametroNeutroMM,
 				NumHilosTotal:     resultadoCharola.NumHilosTotal,
 				EspacioFuerzaMM:   resultadoCharola.EspacioFuerzaMM,
 				AnchoFuerzaMM:     resultadoCharola.AnchoFuerzaMM,
 				EspacioControlMM:  resultadoCharola.EspacioControlMM,
 				AnchoControlMM:    resultadoCharola.AnchoControlMM,
 				AnchoTierraMM:     resultadoCharola.AnchoTierraMM,
+				TipoCharola:       resultadoCharola.TipoCharola,
+				PeralteMM:         resultadoCharola.PeralteMM,
+				Llenado:           resultadoCharola.Llenado,
 				FactorControl:     resultadoCharola.FactorControl,
 			}
 
@@ -702,22 +1441,52 @@ func (uc *OrquestadorMemoriaCalculoUseCase) calcularCanalizacion(
 
 		return canalizacion, detalleCharola, nil, 0, nil
 
+	} else if !tipoCanalizacion.RequiereTuberia() {
+		// DIRECTAMENTE ENTERRADO: no hay canalización que dimensionar, solo la
+		// profundidad de la zanja (ya validada contra la Tabla 300-5)
+		condiciones, err := input.ToDomainCondicionesSubterraneas()
+		if err != nil {
+			return dto.ResultadoCanalizacion{}, nil, nil, 0, fmt.Errorf("condiciones subterráneas: %w", err)
+		}
+		canalizacion = dto.ResultadoCanalizacion{
+			Tamano:        fmt.Sprintf("Directamente enterrado a %.0f mm", condiciones.ProfundidadMM),
+			NumeroDeTubos: 0,
+		}
+		return canalizacion, nil, nil, 0, nil
+
 	} else {
-		// For TUBERIA types: use tuberia use case
+		// For TUBERIA types (incluye ducto subterráneo PVC): use tuberia use case
 
 		// Calcular número de hilos de tierra según normativa NOM
 		numTierras := calcularNumHilosTierra(tipoCanalizacion, input.NumTuberias)
 
+		if calibreNeutro == "" {
+			calibreNeutro = calibreFase
+		}
+
 		tuberiaInput := dto.TuberiaInput{
 			NumFases:         sistemaElectrico.CantidadFases(),
 			CalibreFase:      calibreFase,
 			NumNeutros:       numNeutros,
-			CalibreNeutro:    calibreFase, // Same as fase for now
+			CalibreNeutro:    calibreNeutro,
 			CalibreTierra:    calibreTierra,
 			TipoCanalizacion: input.TipoCanalizacion,
 			NumTuberias:      input.NumTuberias,
 			NumTierras:       numTierras,
 			HilosPorFase:     input.HilosPorFase, // Necesario para calcular conductores por tubo
+			Material:         material.String(),
+			TipoAislamiento:  aislamiento.String(),
+		}
+
+		// Cable multiconductor: un cable por juego en paralelo, del mayor calibre de fase y neutro
+		if tipoCable != nil {
+			calibreCable, err := uc.calibreCableMulticonductor(ctx, calibreFase, calibreNeutro, numNeutros)
+			if err != nil {
+				return dto.ResultadoCanalizacion{}, nil, nil, 0, err
+			}
+			tuberiaInput.CalibreFase = calibreCable
+			tuberiaInput.TipoCable = input.TipoCable
+			tuberiaInput.NumCables = input.HilosPorFase
 		}
 
 		resultadoTuberia, err := uc.calcularTamanioTuberiaUC.Execute(ctx, tuberiaInput)
@@ -737,6 +1506,10 @@ func (uc *OrquestadorMemoriaCalculoUseCase) calcularCanalizacion(
 			AreaOcupacionTuboMM2: resultadoTuberia.AreaOcupacionTuboMM2,
 			DesignacionMetrica:   resultadoTuberia.DesignacionMetrica,
 			FillFactor:           resultadoTuberia.FillFactor,
+			RelacionAtascamiento: resultadoTuberia.RelacionAtascamiento,
+			Nota:                 resultadoTuberia.NotaAtascamiento,
+			NumCablesPorTubo:     resultadoTuberia.NumCablesPorTubo,
+			CableMulticonductor:  resultadoTuberia.CableMulticonductor,
 		}
 
 		// After tubería selection, get physical dimensions for SVG rendering
@@ -758,6 +1531,134 @@ func (uc *OrquestadorMemoriaCalculoUseCase) calcularCanalizacion(
 	}
 }
 
+// calcularCharolaMulticonductor dimensiona la charola para cables multiconductores (TC/MC):
+// un cable por juego en paralelo con la tierra de fábrica dentro, sin conductor de tierra
+// aparte. El ancho y el llenado NOM 392-22 se calculan con el diámetro exterior del cable.
+func (uc *OrquestadorMemoriaCalculoUseCase) calcularCharolaMulticonductor(
+	ctx context.Context,
+	tipoCable valueobject.TipoCableMulticonductor,
+	calibreFase string,
+	calibreNeutro string,
+	tipoCanalizacion entity.TipoCanalizacion,
+	sistemaElectrico entity.SistemaElectrico,
+	input dto.EquipoInput,
+	numNeutros int,
+) (dto.ResultadoCanalizacion, *dto.DetalleCharola, *dto.DetalleTuberia, float64, error) {
+	cable, err := uc.obtenerCableMulticonductor(ctx, tipoCable, calibreFase, calibreNeutro, sistemaElectrico, numNeutros)
+	if err != nil {
+		return dto.ResultadoCanalizacion{}, nil, nil, 0, err
+	}
+
+	var cablesControl []valueobject.CableControl
+	var diametroControl float64
+	if input.DiametroControlMM != nil && *input.DiametroControlMM > 0 {
+		diametroControl = *input.DiametroControlMM
+		cableControl, err := valueobject.NewCableControl(valueobject.CableControlParams{
+			Cantidad:   1,
+			DiametroMM: diametroControl,
+		})
+		if err != nil {
+			return dto.ResultadoCanalizacion{}, nil, nil, 0, fmt.Errorf("crear cable control: %w", err)
+		}
+		cablesControl = append(cablesControl, cableControl)
+	}
+
+	especificacion, err := input.ToDomainEspecificacionCharola()
+	if err != nil {
+		return dto.ResultadoCanalizacion{}, nil, nil, 0, fmt.Errorf("especificación de charola: %w", err)
+	}
+	tablaLlenado, err := uc.tablaRepo.ObtenerTablaLlenadoCharola(ctx, especificacion.Tipo)
+	if err != nil {
+		return dto.ResultadoCanalizacion{}, nil, nil, 0, fmt.Errorf("obtener tabla charola: %w", err)
+	}
+
+	resultado, llenado, err := service.CalcularCharolaMulticonductorConLlenado(
+		tipoCanalizacion,
+		input.HilosPorFase,
+		cable,
+		cablesControl,
+		service.CriterioLlenadoCharola{Especificacion: especificacion, Tabla: tablaLlenado},
+	)
+	if err != nil {
+		return dto.ResultadoCanalizacion{}, nil, nil, 0, fmt.Errorf("charola multiconductor: %w", err)
+	}
+
+	// Espaciados cada cable deja un diámetro libre; juntos ocupan n·d
+	anchoFuerza := float64(input.HilosPorFase) * cable.DiametroMM()
+	if tipoCanalizacion == entity.TipoCanalizacionCharolaCableEspaciado {
+		anchoFuerza *= 2
+	}
+
+	detalle := &dto.DetalleCharola{
+		NumCables:        input.HilosPorFase,
+		DiametroCableMM:  cable.DiametroMM(),
+		AnchoFuerzaMM:    anchoFuerza,
+		EspacioControlMM: diametroControl,
+		AnchoControlMM:   diametroControl,
+		TipoCharola:      string(especificacion.Tipo),
+		PeralteMM:        especificacion.PeralteMM,
+		Llenado:          llenadoCharolaToDTO(llenado),
+	}
+	if diametroControl > 0 {
+		detalle.DiametroControlMM = &diametroControl
+		detalle.FactorControl = 1.0
+	}
+
+	canalizacion := dto.ResultadoCanalizacion{
+		Tamano:           resultado.Tamano,
+		AnchoComercialMM: resultado.AnchoComercialMM,
+		AreaRequeridaMM2: resultado.AnchoRequerido,
+		NumeroDeTubos:    1,
+	}
+	return canalizacion, detalle, nil, 0, nil
+}
+
+// obtenerCableMulticonductor busca en la tabla de fabricante el cable con un conductor
+// por fase más el neutro, del mayor calibre entre fase y neutro.
+func (uc *OrquestadorMemoriaCalculoUseCase) obtenerCableMulticonductor(
+	ctx context.Context,
+	tipoCable valueobject.TipoCableMulticonductor,
+	calibreFase string,
+	calibreNeutro string,
+	sistemaElectrico entity.SistemaElectrico,
+	numNeutros int,
+) (valueobject.CableMulticonductor, error) {
+	calibreCable, err := uc.calibreCableMulticonductor(ctx, calibreFase, calibreNeutro, numNeutros)
+	if err != nil {
+		return valueobject.CableMulticonductor{}, err
+	}
+	cable, err := uc.tablaRepo.ObtenerCableMulticonductor(ctx, tipoCable, calibreCable, sistemaElectrico.CantidadFases()+numNeutros)
+	if err != nil {
+		return valueobject.CableMulticonductor{}, fmt.Errorf("obtener cable multiconductor: %w", err)
+	}
+	return cable, nil
+}
+
+// calibreCableMulticonductor retorna el calibre de los conductores del cable: todos son
+// iguales, así que un neutro mayor que la fase (armónicas) fija el calibre del cable.
+func (uc *OrquestadorMemoriaCalculoUseCase) calibreCableMulticonductor(
+	ctx context.Context,
+	calibreFase string,
+	calibreNeutro string,
+	numNeutros int,
+) (string, error) {
+	if numNeutros == 0 || calibreNeutro == "" || calibreNeutro == calibreFase {
+		return calibreFase, nil
+	}
+	seccionFase, err := uc.tablaRepo.ObtenerSeccionConductor(ctx, calibreFase)
+	if err != nil {
+		return "", fmt.Errorf("obtener sección fase: %w", err)
+	}
+	seccionNeutro, err := uc.tablaRepo.ObtenerSeccionConductor(ctx, calibreNeutro)
+	if err != nil {
+		return "", fmt.Errorf("obtener sección neutro: %w", err)
+	}
+	if seccionNeutro > seccionFase {
+		return calibreNeutro, nil
+	}
+	return calibreFase, nil
+}
+
 // generarDiagramaCharola genera el diagrama SVG para la charola usando el puerto de geometry.
 // Retorna nil si el puerto no está configurado o si hay un error.
 func (uc *OrquestadorMemoriaCalculoUseCase) generarDiagramaCharola(
@@ -772,17 +1673,34 @@ func (uc *OrquestadorMemoriaCalculoUseCase) generarDiagramaCharola(
 		return nil
 	}
 
-	resultado, err := uc.geometryGeneratorPort.GenerarDiagramaCharola(
-		detalle.DiametroFaseMM,
-		detalle.DiametroTierraMM,
-		detalle.DiametroControlMM,
-		0, // numHilosControl - not stored in detalle, assume 0
-		sistemaElectrico,
-		hilosPorFase,
-		anchoComercialMM,
-		areaRequeridaMM2,
-		tipoCanalizacion,
-	)
+	var resultado *port.GeometryDiagramaCharola
+	var err error
+	if detalle.NumCables > 0 {
+		// Cables multiconductores: un cable por juego en paralelo
+		resultado, err = uc.geometryGeneratorPort.GenerarDiagramaCharolaMulticonductor(
+			detalle.DiametroCableMM,
+			detalle.NumCables,
+			detalle.DiametroControlMM,
+			sistemaElectrico,
+			anchoComercialMM,
+			areaRequeridaMM2,
+			tipoCanalizacion,
+			detalle.PeralteMM,
+		)
+	} else {
+		resultado, err = uc.geometryGeneratorPort.GenerarDiagramaCharola(
+			detalle.DiametroFaseMM,
+			detalle.DiametroTierraMM,
+			detalle.DiametroControlMM,
+			0, // numHilosControl - not stored in detalle, assume 0
+			sistemaElectrico,
+			hilosPorFase,
+			anchoComercialMM,
+			areaRequeridaMM2,
+			tipoCanalizacion,
+			detalle.PeralteMM,
+		)
+	}
 	if err != nil || resultado == nil {
 		// Silently fail - diagram is optional
 		return nil
@@ -820,6 +1738,7 @@ func (uc *OrquestadorMemoriaCalculoUseCase) generarDiagramaCharola(
 		ViewBox:      resultado.ViewBox,
 		Cotas:        cotas,
 		SVG:          resultado.SVG,
+		DXF:          resultado.DXF,
 	}
 }
 
@@ -839,18 +1758,31 @@ func (uc *OrquestadorMemoriaCalculoUseCase) generarDiagramaTuberia(
 		areaNeutroMM2 = detalle.AreaNeutroMM2
 	}
 
-	resultado, err := uc.geometryGeneratorPort.GenerarDiagramaTuberia(
-		detalle.AreaFaseMM2,
-		areaNeutroMM2,
-		detalle.AreaTierraMM2,
-		detalle.NumFasesPorTubo,
-		detalle.NumNeutrosPorTubo,
-		detalle.NumTierras,
-		sistemaElectrico,
-		detalle.DiametroInteriorMM,
-		detalle.DiametroExteriorMM,
-		detalle.NumTuberias,
-	)
+	var resultado *port.GeometryDiagramaTuberia
+	var err error
+	if cable := detalle.CableMulticonductor; cable != nil {
+		resultado, err = uc.geometryGeneratorPort.GenerarDiagramaTuberiaMulticonductor(
+			cable.DiametroExteriorMM,
+			detalle.NumCablesPorTubo,
+			sistemaElectrico,
+			detalle.DiametroInteriorMM,
+			detalle.DiametroExteriorMM,
+			detalle.NumTuberias,
+		)
+	} else {
+		resultado, err = uc.geometryGeneratorPort.GenerarDiagramaTuberia(
+			detalle.AreaFaseMM2,
+			areaNeutroMM2,
+			detalle.AreaTierraMM2,
+			detalle.NumFasesPorTubo,
+			detalle.NumNeutrosPorTubo,
+			detalle.NumTierras,
+			sistemaElectrico,
+			detalle.DiametroInteriorMM,
+			detalle.DiametroExteriorMM,
+			detalle.NumTuberias,
+		)
+	}
 	if err != nil || resultado == nil {
 		// Silently fail - diagram is optional
 		return nil
@@ -875,5 +1807,6 @@ func (uc *OrquestadorMemoriaCalculoUseCase) generarDiagramaTuberia(
 		DiametroExterior: resultado.DiametroExterior,
 		ViewBox:          resultado.ViewBox,
 		SVG:              resultado.SVG,
+		DXF:              resultado.DXF,
 	}
 }
@@ -0,0 +1,198 @@
+// internal/calculos/application/usecase/orquestador_memoria_calculo_test.go
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/calculos/infrastructure/adapter/driven/csv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// nuevoOrquestadorCSV arma el pipeline completo sobre las tablas NOM del repositorio.
+func nuevoOrquestadorCSV(t *testing.T) *OrquestadorMemoriaCalculoUseCase {
+	t.Helper()
+	tablaRepo, err := csv.NewCSVTablaNOMRepository("../../../../data/tablas_nom")
+	require.NoError(t, err)
+
+	calcularCaidaTensionUC := NewCalcularCaidaTensionUseCase(tablaRepo)
+	return NewOrquestadorMemoriaCalculoUseCase(
+		NewCalcularCorrienteUseCase(nil, tablaRepo),
+		NewAjustarCorrienteUseCase(tablaRepo),
+		NewSeleccionarConductorUseCase(tablaRepo),
+		NewCalcularTamanioTuberiaUseCase(tablaRepo),
+		NewCalcularCharolaEspaciadoUseCase(tablaRepo),
+		NewCalcularCharolaTriangularUseCase(tablaRepo),
+		calcularCaidaTensionUC,
+		NewSeleccionarConductorPorCaidaTensionUseCase(calcularCaidaTensionUC, tablaRepo),
+		NewCalcularCortocircuitoUseCase(tablaRepo),
+		NewVerificarSoporteTermicoUseCase(tablaRepo),
+		NewSeleccionarITMUseCase(tablaRepo),
+		NewVerificarCoordinacionProteccionUseCase(tablaRepo),
+		NewDimensionarNeutroUseCase(tablaRepo),
+		tablaRepo,
+		nil,
+	)
+}
+
+// inputMemoriaEstrella es un circuito de 100 A en estrella 480 V, tubería PVC de 30 m.
+func inputMemoriaEstrella() dto.EquipoInput {
+	return dto.EquipoInput{
+		Modo:             dto.ModoManualAmperaje,
+		TipoEquipo:       "CARGA",
+		AmperajeNominal:  100,
+		Tension:          480,
+		TipoVoltaje:      "FASE_FASE",
+		SistemaElectrico: dto.SistemaElectricoEstrella,
+		TipoCanalizacion: "TUBERIA_PVC",
+		LongitudCircuito: 30,
+		Estado:           "Nuevo Leon",
+	}
+}
+
+func TestOrquestadorMemoriaCalculo_AnexoE(t *testing.T) {
+	uc := nuevoOrquestadorCSV(t)
+
+	// 100 A CARGA (F_uso 1.25), Nuevo León (F_temp 0.88), 4 portadores en el tubo (F_agr 0.80):
+	// I_adj = 100 × 1.25 × factor / (0.88 × 0.80)
+	tests := []struct {
+		name               string
+		tercera            float64 // % de la fundamental
+		wantReduccion      float64
+		wantPorNeutro      bool
+		wantFactor         float64
+		wantCorrienteAjust float64
+	}{
+		// 3ª = 25 / 1.0308 = 24.3 % de la fase RMS → 0.86 por corriente de fase
+		{"15–33 %: reducción 0.86 por corriente de fase", 25, 0.86, false, 1.0308 / 0.86, 212.82},
+		// 3ª = 40 / 1.0770 = 37.1 % → por corriente de neutro 3 × I_3 = 120 A, reducción 0.86
+		{"33–45 %: por corriente de neutro con reducción 0.86", 40, 0.86, true, 3 * 0.40 / 0.86, 247.75},
+		// 3ª = 60 / 1.1662 = 51.4 % → por corriente de neutro 3 × I_3 = 180 A, sin reducción
+		{"> 45 %: por corriente de neutro sin reducción", 60, 1.0, true, 3 * 0.60, 319.60},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := inputMemoriaEstrella()
+			input.EspectroArmonico = map[int]float64{3: tt.tercera}
+
+			out, err := uc.Execute(context.Background(), input)
+			require.NoError(t, err)
+
+			ar := out.Corrientes.Armonicos
+			require.NotNil(t, ar)
+			assert.InDelta(t, tt.wantReduccion, ar.FactorReduccion, 0.001)
+			assert.Equal(t, tt.wantPorNeutro, ar.DimensionPorNeutro)
+			assert.InDelta(t, tt.wantFactor, out.Corrientes.FactorArmonico, 0.001)
+			assert.Equal(t, 0.80, out.Corrientes.FactorAgrupamiento, "el neutro sigue contando como portador")
+			assert.InDelta(t, tt.wantCorrienteAjust, out.Corrientes.CorrienteAjustada, 0.01)
+
+			// El conductor de fase cubre la corriente con la que se dimensionó
+			assert.GreaterOrEqual(t, out.CableFase.Capacidad, out.Corrientes.CorrienteAjustada)
+			if tt.wantPorNeutro {
+				require.NotNil(t, out.Neutro)
+				assert.InDelta(t, 3*tt.tercera, out.Neutro.CorrienteArmonica, 0.01)
+			}
+		})
+	}
+}
+
+func TestOrquestadorMemoriaCalculo_CoordinacionIncluyeReduccionArmonica(t *testing.T) {
+	uc := nuevoOrquestadorCSV(t)
+
+	input := inputMemoriaEstrella()
+	input.EspectroArmonico = map[int]float64{3: 25}
+
+	out, err := uc.Execute(context.Background(), input)
+	require.NoError(t, err)
+
+	co := out.Proteccion.Coordinacion
+	require.NotNil(t, co)
+	// F_temp 0.88 × F_agr 0.80 × reducción Anexo E 0.86
+	assert.InDelta(t, 0.88*0.80*0.86, co.FactorCorreccion, 0.0001)
+	assert.Equal(t, out.CableFase.Calibre, co.CalibreSeleccionado)
+}
+
+func TestOrquestadorMemoriaCalculo_CoordinacionConCalibreFinal(t *testing.T) {
+	uc := nuevoOrquestadorCSV(t)
+
+	// Circuito largo: la caída de tensión aumenta el calibre después del paso 3c
+	input := inputMemoriaEstrella()
+	input.LongitudCircuito = 400
+
+	out, err := uc.Execute(context.Background(), input)
+	require.NoError(t, err)
+	require.True(t, out.CableFase.SeleccionPorCaidaTension)
+
+	co := out.Proteccion.Coordinacion
+	require.NotNil(t, co)
+	assert.Equal(t, out.CableFase.Calibre, co.CalibreSeleccionado)
+	assert.Equal(t, out.CableFase.Capacidad, co.Capacidad)
+	assert.True(t, co.Cumple)
+	assert.True(t, out.CumpleNormativa)
+
+	for _, p := range out.Pasos {
+		if p.Nombre == "Coordinación con la Protección" {
+			assert.Equal(t, *co, p.Resultado)
+		}
+	}
+}
+
+func TestOrquestadorMemoriaCalculo_SoporteCortocircuitoLimiteTerminal(t *testing.T) {
+	uc := nuevoOrquestadorCSV(t)
+
+	// THHN (90 °C) en terminales de 75 °C; la falla de ~31 kA durante 3 ciclos
+	// obliga a aumentar la fase de 1/0 a 3/0 AWG en el paso 3b
+	input := inputMemoriaEstrella()
+	input.TipoAislamiento = "THHN"
+	input.TransformadorKVA = 1500
+	input.TransformadorZPorcentaje = 5.75
+
+	out, err := uc.Execute(context.Background(), input)
+	require.NoError(t, err)
+	require.True(t, out.CableFase.SeleccionPorCortocircuito)
+	assert.Equal(t, "3/0", out.CableFase.Calibre)
+
+	lt := out.Corrientes.LimiteTerminal
+	require.NotNil(t, lt)
+	// Columna de 90 °C corregida (F_temp 0.91) y limitada a la columna de 75 °C
+	assert.Equal(t, out.CableFase.Capacidad, lt.CapacidadConductor)
+	assert.InDelta(t, 225.0, lt.CapacidadConductor, 0.001)
+	assert.InDelta(t, 225*0.91, lt.AmpacidadCorregida, 0.01)
+	assert.InDelta(t, 200.0, lt.CapacidadTerminal, 0.001)
+	assert.InDelta(t, 200.0, lt.AmpacidadFinal, 0.001)
+	assert.True(t, lt.LimitadaPorTerminal)
+}
+
+func TestOrquestadorMemoriaCalculo_PasosEnOrdenDeEjecucion(t *testing.T) {
+	uc := nuevoOrquestadorCSV(t)
+
+	input := inputMemoriaEstrella()
+	input.LongitudCircuito = 400
+	input.TransformadorKVA = 1500
+	input.TransformadorZPorcentaje = 5.75
+
+	out, err := uc.Execute(context.Background(), input)
+	require.NoError(t, err)
+
+	nombres := make([]string, 0, len(out.Pasos))
+	for i, p := range out.Pasos {
+		assert.Equal(t, i+1, p.Numero, p.Nombre)
+		nombres = append(nombres, p.Nombre)
+	}
+	assert.Equal(t, []string{
+		"Corriente Nominal",
+		"Ajuste de Corriente",
+		"Selección de Protección (ITM)",
+		"Selección de Conductores",
+		"Soporte Térmico al Cortocircuito (I²t)",
+		"Coordinación con la Protección",
+		"Conductor Neutro",
+		"Dimensionamiento de Tubería",
+		"Caída de Tensión",
+		"Recálculo por Caída de Tensión",
+		"Cortocircuito",
+	}, nombres)
+}
@@ -4,6 +4,7 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"math"
 
 	"github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
 	"github.com/garfex/calculadora-filtros/internal/calculos/application/port"
@@ -28,7 +29,9 @@ func NewSeleccionarConductorUseCase(
 }
 
 // Execute selecciona conductor de alimentación y tierra.
-// Retorna un DTO plano para evitar domain bleeding.
+// Si temperaturaTerminal es menor que temperatura (aislamiento de 90 °C), el
+// calibre debe cumplir además la columna de la terminal con corrienteDiseno
+// (NOM 110-14(c)). Retorna un DTO plano para evitar domain bleeding.
 func (uc *SeleccionarConductorUseCase) Execute(
 	ctx context.Context,
 	corrienteAjustada valueobject.Corriente,
@@ -37,6 +40,8 @@ func (uc *SeleccionarConductorUseCase) Execute(
 	material valueobject.MaterialConductor,
 	temperatura valueobject.Temperatura,
 	tipoCanalizacion entity.TipoCanalizacion,
+	temperaturaTerminal valueobject.Temperatura,
+	corrienteDiseno valueobject.Corriente,
 ) (dto.ResultadoConductores, error) {
 	// Obtener tabla de ampacidad
 	tablaAmpacidad, err := uc.tablaRepo.ObtenerTablaAmpacidad(ctx, tipoCanalizacion, material, temperatura)
@@ -45,7 +50,16 @@ func (uc *SeleccionarConductorUseCase) Execute(
 	}
 
 	// Seleccionar conductor de alimentación
-	conductor, err := service.SeleccionarConductorAlimentacion(corrienteAjustada, hilosPorFase, tablaAmpacidad)
+	var conductor valueobject.Conductor
+	var limiteTerminal *dto.ResultadoLimiteTerminal
+	if temperaturaTerminal > 0 && temperaturaTerminal < temperatura {
+		conductor, limiteTerminal, err = uc.seleccionarConLimiteTerminal(
+			ctx, corrienteAjustada, corrienteDiseno, hilosPorFase, material,
+			temperatura, temperaturaTerminal, tipoCanalizacion, tablaAmpacidad,
+		)
+	} else {
+		conductor, err = service.SeleccionarConductorAlimentacion(corrienteAjustada, hilosPorFase, tablaAmpacidad)
+	}
 	if err != nil {
 		return dto.ResultadoConductores{}, fmt.Errorf("seleccionar conductor alimentación: %w", err)
 	}
@@ -83,6 +97,110 @@ func (uc *SeleccionarConductorUseCase) Execute(
 			Material:   conductorTierra.Material().String(),
 			SeccionMM2: conductorTierra.SeccionMM2(),
 		},
-		TablaUsada: tablaUsada,
+		TablaUsada:     tablaUsada,
+		LimiteTerminal: limiteTerminal,
 	}, nil
 }
+
+// seleccionarConLimiteTerminal selecciona el calibre con la columna del aislamiento
+// derrateada y la columna de la terminal sin derratear, y documenta ambos valores.
+func (uc *SeleccionarConductorUseCase) seleccionarConLimiteTerminal(
+	ctx context.Context,
+	corrienteAjustada valueobject.Corriente,
+	corrienteDiseno valueobject.Corriente,
+	hilosPorFase int,
+	material valueobject.MaterialConductor,
+	temperatura valueobject.Temperatura,
+	temperaturaTerminal valueobject.Temperatura,
+	tipoCanalizacion entity.TipoCanalizacion,
+	tablaAmpacidad []valueobject.EntradaTablaConductor,
+) (valueobject.Conductor, *dto.ResultadoLimiteTerminal, error) {
+	tablaTerminal, err := uc.tablaRepo.ObtenerTablaAmpacidad(ctx, tipoCanalizacion, material, temperaturaTerminal)
+	if err != nil {
+		return valueobject.Conductor{}, nil, fmt.Errorf("obtener tabla ampacidad terminal: %w", err)
+	}
+
+	seleccion, err := service.SeleccionarConductorLimiteTerminal(
+		corrienteAjustada, corrienteDiseno, hilosPorFase, tablaAmpacidad, tablaTerminal,
+	)
+	if err != nil {
+		return valueobject.Conductor{}, nil, err
+	}
+
+	limite := nuevoResultadoLimiteTerminal(
+		seleccion.CapacidadConductor, seleccion.CapacidadTerminal, corrienteAjustada, corrienteDiseno,
+		material, temperatura, temperaturaTerminal, tipoCanalizacion,
+	)
+	limite.CalibreAumentado = seleccion.PorTerminal
+	if seleccion.PorTerminal {
+		limite.Nota = fmt.Sprintf(
+			"Calibre definido por la temperatura de las terminales (%d °C, NOM 110-14(c)): %.2f A ≥ %.2f A por hilo",
+			temperaturaTerminal.Valor(), seleccion.CapacidadTerminal, corrienteDiseno.Valor()/float64(max(hilosPorFase, 1)),
+		)
+	}
+
+	return seleccion.Conductor, limite, nil
+}
+
+// LimiteTerminal documenta el límite de 110-14(c) para un calibre ya fijado por otro
+// criterio (p. ej. el soporte térmico al cortocircuito): la ampacidad de la columna
+// del aislamiento corregida y limitada a la columna de la terminal, igual que en la
+// selección por ampacidad. Retorna nil si la terminal no limita al aislamiento.
+func (uc *SeleccionarConductorUseCase) LimiteTerminal(
+	ctx context.Context,
+	calibre string,
+	corrienteAjustada valueobject.Corriente,
+	corrienteDiseno valueobject.Corriente,
+	material valueobject.MaterialConductor,
+	temperatura valueobject.Temperatura,
+	tipoCanalizacion entity.TipoCanalizacion,
+	temperaturaTerminal valueobject.Temperatura,
+) (*dto.ResultadoLimiteTerminal, error) {
+	if temperaturaTerminal <= 0 || temperaturaTerminal >= temperatura {
+		return nil, nil
+	}
+
+	capacidadConductor, err := uc.tablaRepo.ObtenerCapacidadConductor(ctx, tipoCanalizacion, material, temperatura, calibre)
+	if err != nil {
+		return nil, fmt.Errorf("obtener capacidad para calibre %s: %w", calibre, err)
+	}
+	capacidadTerminal, err := uc.tablaRepo.ObtenerCapacidadConductor(ctx, tipoCanalizacion, material, temperaturaTerminal, calibre)
+	if err != nil {
+		return nil, fmt.Errorf("obtener capacidad terminal para calibre %s: %w", calibre, err)
+	}
+
+	return nuevoResultadoLimiteTerminal(
+		capacidadConductor, capacidadTerminal, corrienteAjustada, corrienteDiseno,
+		material, temperatura, temperaturaTerminal, tipoCanalizacion,
+	), nil
+}
+
+// nuevoResultadoLimiteTerminal deriva la ampacidad corregida y la final, limitada
+// por la terminal, a partir de las capacidades de tabla de ambas columnas.
+func nuevoResultadoLimiteTerminal(
+	capacidadConductor float64,
+	capacidadTerminal float64,
+	corrienteAjustada valueobject.Corriente,
+	corrienteDiseno valueobject.Corriente,
+	material valueobject.MaterialConductor,
+	temperatura valueobject.Temperatura,
+	temperaturaTerminal valueobject.Temperatura,
+	tipoCanalizacion entity.TipoCanalizacion,
+) *dto.ResultadoLimiteTerminal {
+	// I_ajustada = I_diseño / (F_temperatura × F_agrupamiento)
+	factorCorreccion := corrienteDiseno.Valor() / corrienteAjustada.Valor()
+	ampacidadCorregida := capacidadConductor * factorCorreccion
+	ampacidadFinal := math.Min(ampacidadCorregida, capacidadTerminal)
+
+	return &dto.ResultadoLimiteTerminal{
+		TemperaturaConductor: temperatura.Valor(),
+		TemperaturaTerminal:  temperaturaTerminal.Valor(),
+		TablaTerminal:        helpers.NombreTablaAmpacidad(string(tipoCanalizacion), material, temperaturaTerminal),
+		CapacidadConductor:   capacidadConductor,
+		FactorCorreccion:     factorCorreccion,
+		AmpacidadCorregida:   ampacidadCorregida,
+		CapacidadTerminal:    capacidadTerminal,
+		AmpacidadFinal:       ampacidadFinal,
+		LimitadaPorTerminal:  capacidadTerminal < ampacidadCorregida,
+	}
+}
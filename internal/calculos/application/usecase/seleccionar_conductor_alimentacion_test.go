@@ -51,6 +51,14 @@ func (m *mockConductorAlimentacionRepo) ObtenerTablaTierra(ctx context.Context)
 	return nil, nil
 }
 
+func (m *mockConductorAlimentacionRepo) ObtenerTablaITM(ctx context.Context) ([]valueobject.EntradaTablaITM, error) {
+	return nil, nil
+}
+
+func (m *mockConductorAlimentacionRepo) ObtenerTablaCorrienteMotor(ctx context.Context, fases int) ([]valueobject.EntradaTablaMotor, error) {
+	return nil, nil
+}
+
 func (m *mockConductorAlimentacionRepo) ObtenerImpedancia(
 	ctx context.Context,
 	calibre string,
@@ -83,11 +91,23 @@ func (m *mockConductorAlimentacionRepo) ObtenerFactorAgrupamiento(ctx context.Co
 	return 1.0, nil
 }
 
+func (m *mockConductorAlimentacionRepo) ObtenerFactorResistividadTermica(ctx context.Context, canalizacion entity.TipoCanalizacion, resistividad float64) (float64, error) {
+	return 1.0, nil
+}
+
+func (m *mockConductorAlimentacionRepo) ObtenerFactorProfundidad(ctx context.Context, canalizacion entity.TipoCanalizacion, profundidadMM float64) (float64, error) {
+	return 1.0, nil
+}
+
+func (m *mockConductorAlimentacionRepo) ObtenerFactorAgrupamientoSubterraneo(ctx context.Context, canalizacion entity.TipoCanalizacion, numCircuitos int) (float64, error) {
+	return 1.0, nil
+}
+
 func (m *mockConductorAlimentacionRepo) ObtenerDiametroConductor(
 	ctx context.Context,
 	calibre string,
 	material string,
-	conAislamiento bool,
+	aislamiento valueobject.TipoAislamiento,
 ) (float64, error) {
 	return 0, nil
 }
@@ -106,7 +126,23 @@ func (m *mockConductorAlimentacionRepo) ObtenerTablaCharola(
 	return nil, nil
 }
 
-func (m *mockConductorAlimentacionRepo) ObtenerAreaConductor(ctx context.Context, calibre string) (float64, error) {
+func (m *mockConductorAlimentacionRepo) ObtenerTablaLlenadoCharola(
+	ctx context.Context,
+	tipo entity.TipoCharola,
+) ([]valueobject.EntradaTablaLlenadoCharola, error) {
+	return nil, nil
+}
+
+func (m *mockConductorAlimentacionRepo) ObtenerCableMulticonductor(
+	ctx context.Context,
+	tipo valueobject.TipoCableMulticonductor,
+	calibre string,
+	conductores int,
+) (valueobject.CableMulticonductor, error) {
+	return valueobject.CableMulticonductor{}, nil
+}
+
+func (m *mockConductorAlimentacionRepo) ObtenerAreaConductor(ctx context.Context, calibre string, aislamiento valueobject.TipoAislamiento) (float64, error) {
 	return 0, nil
 }
 
@@ -48,6 +48,7 @@ func (uc *SeleccionarConductorPorCaidaTensionUseCase) Execute(
 	hilosPorFase     int,
 	factorPotencia   float64,
 	temperatura      valueobject.Temperatura, // para ObtenerCapacidadConductor
+	aislamiento      valueobject.TipoAislamiento,
 ) (dto.ResultadoConductorCaidaTension, error) {
 	// La tabla NOM tiene 19 calibres (14 AWG → 1000 MCM).
 	// En el peor caso se necesitan hasta 17 saltos desde el calibre más pequeño.
@@ -109,7 +110,7 @@ func (uc *SeleccionarConductorPorCaidaTensionUseCase) Execute(
 				CalibreOriginal:     calibreAmpacidad,
 				CalibreSeleccionado: calibreSiguiente,
 				SeccionMM2:          seccion,
-				TipoAislamiento:     "THW", // TODO: obtener de tabla cuando esté disponible
+				TipoAislamiento:     aislamiento.String(),
 				Capacidad:           capacidad,
 				CaidaTension:        resultadoCaida,
 				Nota:                nota,
@@ -0,0 +1,65 @@
+// internal/calculos/application/usecase/seleccionar_itm.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/port"
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/service"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+)
+
+// SeleccionarITMUseCase propone el ITM cuando el usuario no lo proporciona.
+type SeleccionarITMUseCase struct {
+	tablaRepo port.TablaNOMRepository
+}
+
+// NewSeleccionarITMUseCase crea una nueva instancia.
+func NewSeleccionarITMUseCase(tablaRepo port.TablaNOMRepository) *SeleccionarITMUseCase {
+	return &SeleccionarITMUseCase{tablaRepo: tablaRepo}
+}
+
+// Execute selecciona la capacidad estándar inmediata superior a I_n × F_uso,
+// con el factor de uso correspondiente al tipo de equipo. Para motores se usa
+// el 250 % de la Tabla 430-52 (interruptor de tiempo inverso) sobre la
+// corriente a plena carga.
+func (uc *SeleccionarITMUseCase) Execute(
+	ctx context.Context,
+	corrienteNominal valueobject.Corriente,
+	tipoEquipo entity.TipoEquipo,
+) (dto.ResultadoSeleccionITM, error) {
+	factorUso, err := service.CalcularFactorUso(tipoEquipo)
+	if err != nil {
+		return dto.ResultadoSeleccionITM{}, fmt.Errorf("calcular factor de uso: %w", err)
+	}
+	norma := "NOM 240-6(a)"
+	if tipoEquipo == entity.TipoEquipoMotor {
+		factorUso = service.FactorProteccionMotor
+		norma = "NOM 430-52 y 240-6(a)"
+	}
+
+	tabla, err := uc.tablaRepo.ObtenerTablaITM(ctx)
+	if err != nil {
+		return dto.ResultadoSeleccionITM{}, fmt.Errorf("obtener tabla de ITM: %w", err)
+	}
+
+	seleccion, err := service.SeleccionarITM(corrienteNominal, factorUso, tabla)
+	if err != nil {
+		return dto.ResultadoSeleccionITM{}, fmt.Errorf("seleccionar ITM: %w", err)
+	}
+
+	return dto.ResultadoSeleccionITM{
+		CorrienteNominal: seleccion.CorrienteNominal,
+		FactorUso:        seleccion.FactorUso,
+		CorrienteMinima:  seleccion.CorrienteMinima,
+		ITM:              seleccion.Amperaje,
+		Marco:            seleccion.Marco,
+		Justificacion: fmt.Sprintf(
+			"I_n × F_uso = %.2f A × %.2f = %.2f A → ITM estándar inmediato superior %d A (marco %d AF), %s",
+			seleccion.CorrienteNominal, seleccion.FactorUso, seleccion.CorrienteMinima, seleccion.Amperaje, seleccion.Marco, norma,
+		),
+	}, nil
+}
@@ -0,0 +1,124 @@
+// internal/calculos/application/usecase/verificar_coordinacion_proteccion.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/port"
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/service"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+)
+
+// VerificarCoordinacionProteccionUseCase verifica que el ITM proteja al conductor
+// de fase (NOM 240-4) y, si no lo hace, busca el calibre superior mínimo cuya
+// ampacidad corregida quede coordinada con el ITM.
+type VerificarCoordinacionProteccionUseCase struct {
+	tablaRepo port.TablaNOMRepository
+}
+
+// NewVerificarCoordinacionProteccionUseCase crea una nueva instancia.
+func NewVerificarCoordinacionProteccionUseCase(tablaRepo port.TablaNOMRepository) *VerificarCoordinacionProteccionUseCase {
+	return &VerificarCoordinacionProteccionUseCase{tablaRepo: tablaRepo}
+}
+
+// Execute verifica el calibre dado y prueba calibres superiores hasta que el ITM
+// quede coordinado. Si se agota la tabla NOM, retorna el último resultado con
+// Cumple=false (no es error fatal).
+// Si temperaturaTerminal es menor que temperatura (aislamiento de 90 °C), la
+// ampacidad corregida se limita a la de la columna de la terminal (110-14(c)).
+func (uc *VerificarCoordinacionProteccionUseCase) Execute(
+	ctx context.Context,
+	calibre string,
+	capacidad float64, // ampacidad de tabla del calibre dado
+	material valueobject.MaterialConductor,
+	temperatura valueobject.Temperatura,
+	tipoCanalizacion entity.TipoCanalizacion,
+	factorCorreccion float64, // F_temperatura × F_agrupamiento
+	hilosPorFase int,
+	itm int,
+	temperaturaTerminal valueobject.Temperatura,
+) (dto.ResultadoCoordinacionProteccion, error) {
+	// Mismo límite que la selección por caída de tensión: 19 calibres en la tabla NOM.
+	const maxIntentos = 18
+
+	tablaITM, err := uc.tablaRepo.ObtenerTablaITM(ctx)
+	if err != nil {
+		return dto.ResultadoCoordinacionProteccion{}, fmt.Errorf("obtener tabla de ITM: %w", err)
+	}
+
+	calibreActual := calibre
+	capacidadActual := capacidad
+	limitaTerminal := temperaturaTerminal > 0 && temperaturaTerminal < temperatura
+	for intento := 0; ; intento++ {
+		// Con límite por terminal: I_z = mín(Capacidad × F, Capacidad_terminal) × N_hilos
+		factorEfectivo := factorCorreccion
+		capacidadTerminal := 0.0
+		if limitaTerminal {
+			capacidadTerminal, err = uc.tablaRepo.ObtenerCapacidadConductor(ctx, tipoCanalizacion, material, temperaturaTerminal, calibreActual)
+			if err != nil {
+				return dto.ResultadoCoordinacionProteccion{}, fmt.Errorf("obtener capacidad terminal para calibre %s: %w", calibreActual, err)
+			}
+			if capacidadActual > 0 && capacidadTerminal < capacidadActual*factorCorreccion {
+				factorEfectivo = capacidadTerminal / capacidadActual
+			}
+		}
+
+		verificacion, err := service.VerificarCoordinacionProteccion(capacidadActual, factorEfectivo, hilosPorFase, itm, tablaITM)
+		if err != nil {
+			return dto.ResultadoCoordinacionProteccion{}, fmt.Errorf("verificar coordinación calibre %s: %w", calibreActual, err)
+		}
+
+		seccion, err := uc.tablaRepo.ObtenerSeccionConductor(ctx, calibreActual)
+		if err != nil {
+			return dto.ResultadoCoordinacionProteccion{}, fmt.Errorf("obtener sección para calibre %s: %w", calibreActual, err)
+		}
+
+		resultado := dto.ResultadoCoordinacionProteccion{
+			CalibreOriginal:         calibre,
+			CalibreSeleccionado:     calibreActual,
+			SeccionMM2:              seccion,
+			Capacidad:               capacidadActual,
+			FactorCorreccion:        factorCorreccion,
+			HilosPorFase:            hilosPorFase,
+			AmpacidadCorregida:      verificacion.AmpacidadCorregida,
+			ITM:                     verificacion.ITM,
+			ITMMaximoPermitido:      verificacion.ITMMaximoPermitido,
+			AplicaSiguienteSuperior: verificacion.AplicaSiguienteSuperior,
+			Cumple:                  verificacion.Cumple,
+			CapacidadTerminal:       capacidadTerminal,
+		}
+
+		if verificacion.Cumple {
+			if calibreActual != calibre {
+				resultado.Nota = fmt.Sprintf(
+					"Calibre aumentado de %s a %s por coordinación con el ITM de %d A (NOM 240-4)",
+					calibre,
+					calibreActual,
+					itm,
+				)
+			}
+			return resultado, nil
+		}
+
+		calibreSiguiente, err := service.ObtenerCalibreSuperior(calibreActual)
+		if err != nil || intento+1 >= maxIntentos {
+			// Llegamos al máximo de la tabla NOM
+			resultado.Nota = fmt.Sprintf(
+				"Ningún calibre hasta %s queda protegido por el ITM de %d A (NOM 240-4)",
+				calibreActual,
+				itm,
+			)
+			return resultado, nil
+		}
+
+		capacidadSiguiente, err := uc.tablaRepo.ObtenerCapacidadConductor(ctx, tipoCanalizacion, material, temperatura, calibreSiguiente)
+		if err != nil {
+			return dto.ResultadoCoordinacionProteccion{}, fmt.Errorf("obtener capacidad para calibre %s: %w", calibreSiguiente, err)
+		}
+		calibreActual = calibreSiguiente
+		capacidadActual = capacidadSiguiente
+	}
+}
@@ -0,0 +1,87 @@
+// internal/calculos/application/usecase/verificar_coordinacion_proteccion_test.go
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockCoordinacionRepo resolves sections, ampacities and the ITM table.
+type mockCoordinacionRepo struct {
+	mockSeccionRepo
+	capacidades   map[string]float64
+	capacidades90 map[string]float64 // columna de 90°C; nil = usar capacidades
+	tablaITM      []valueobject.EntradaTablaITM
+}
+
+func (m *mockCoordinacionRepo) ObtenerCapacidadConductor(ctx context.Context, canalizacion entity.TipoCanalizacion, material valueobject.MaterialConductor, temperatura valueobject.Temperatura, calibre string) (float64, error) {
+	if temperatura == valueobject.Temp90 && m.capacidades90 != nil {
+		return m.capacidades90[calibre], nil
+	}
+	return m.capacidades[calibre], nil
+}
+
+func (m *mockCoordinacionRepo) ObtenerTablaITM(ctx context.Context) ([]valueobject.EntradaTablaITM, error) {
+	return m.tablaITM, nil
+}
+
+func TestVerificarCoordinacionProteccionUseCase(t *testing.T) {
+	repo := &mockCoordinacionRepo{
+		mockSeccionRepo: mockSeccionRepo{secciones: map[string]float64{"2": 33.6, "1": 42.4, "1/0": 53.5}},
+		capacidades:     map[string]float64{"2": 115, "1": 130, "1/0": 150},
+		tablaITM: []valueobject.EntradaTablaITM{
+			{Amperaje: 100, Marco: 100}, {Amperaje: 110, Marco: 150}, {Amperaje: 125, Marco: 150}, {Amperaje: 150, Marco: 150},
+		},
+	}
+	uc := NewVerificarCoordinacionProteccionUseCase(repo)
+	ctx := context.Background()
+
+	t.Run("ITM coordinado por siguiente superior", func(t *testing.T) {
+		r, err := uc.Execute(ctx, "2", 115, valueobject.MaterialCobre, valueobject.Temp75, entity.TipoCanalizacionTuberiaPVC, 1.0, 1, 125, valueobject.Temp75)
+		require.NoError(t, err)
+
+		assert.True(t, r.Cumple)
+		assert.True(t, r.AplicaSiguienteSuperior)
+		assert.Equal(t, "2", r.CalibreSeleccionado)
+		assert.Empty(t, r.Nota)
+	})
+
+	t.Run("aumenta calibre cuando el ITM no protege al conductor", func(t *testing.T) {
+		// 2 AWG: 115 × 0.82 = 94.3 A → máx 100 A; 1 AWG: 106.6 A → 110; 1/0: 123 A → 125
+		r, err := uc.Execute(ctx, "2", 115, valueobject.MaterialCobre, valueobject.Temp75, entity.TipoCanalizacionTuberiaPVC, 0.82, 1, 125, valueobject.Temp75)
+		require.NoError(t, err)
+
+		assert.True(t, r.Cumple)
+		assert.Equal(t, "1/0", r.CalibreSeleccionado)
+		assert.InDelta(t, 53.5, r.SeccionMM2, 1e-9)
+		assert.Contains(t, r.Nota, "Calibre aumentado de 2 a 1/0")
+	})
+}
+
+func TestVerificarCoordinacionProteccionUseCase_LimiteTerminal(t *testing.T) {
+	repo := &mockCoordinacionRepo{
+		mockSeccionRepo: mockSeccionRepo{secciones: map[string]float64{"2": 33.6, "1/0": 53.5}},
+		capacidades:     map[string]float64{"2": 115, "1/0": 150},
+		capacidades90:   map[string]float64{"2": 130, "1/0": 170},
+		tablaITM: []valueobject.EntradaTablaITM{
+			{Amperaje: 100, Marco: 100}, {Amperaje: 110, Marco: 150}, {Amperaje: 125, Marco: 150}, {Amperaje: 150, Marco: 150},
+		},
+	}
+	uc := NewVerificarCoordinacionProteccionUseCase(repo)
+	ctx := context.Background()
+
+	// 2 AWG a 90°C: 130 × 1.0 = 130 A, pero la terminal de 75°C limita a 115 A → máx 125 A
+	r, err := uc.Execute(ctx, "2", 130, valueobject.MaterialCobre, valueobject.Temp90, entity.TipoCanalizacionTuberiaPVC, 1.0, 1, 150, valueobject.Temp75)
+	require.NoError(t, err)
+
+	assert.True(t, r.Cumple)
+	assert.Equal(t, "1/0", r.CalibreSeleccionado)
+	assert.InDelta(t, 150, r.CapacidadTerminal, 1e-9)
+	assert.InDelta(t, 150, r.AmpacidadCorregida, 1e-9)
+	assert.Equal(t, 1.0, r.FactorCorreccion)
+}
@@ -0,0 +1,99 @@
+// internal/calculos/application/usecase/verificar_soporte_termico.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/port"
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/service"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+)
+
+// VerificarSoporteTermicoUseCase verifica que un conductor soporte la corriente de
+// cortocircuito durante el tiempo de liberación (ecuación adiabática I²t) y, si no
+// la soporta, busca el calibre superior mínimo que sí lo haga.
+type VerificarSoporteTermicoUseCase struct {
+	tablaRepo port.TablaNOMRepository
+}
+
+// NewVerificarSoporteTermicoUseCase crea una nueva instancia.
+func NewVerificarSoporteTermicoUseCase(tablaRepo port.TablaNOMRepository) *VerificarSoporteTermicoUseCase {
+	return &VerificarSoporteTermicoUseCase{tablaRepo: tablaRepo}
+}
+
+// Execute verifica el calibre dado y prueba calibres superiores hasta encontrar uno
+// cuya sección supere la sección mínima I²t. Si se agota la tabla NOM, retorna el
+// último resultado con Cumple=false (no es error fatal).
+func (uc *VerificarSoporteTermicoUseCase) Execute(
+	ctx context.Context,
+	calibre string,
+	material valueobject.MaterialConductor,
+	corrienteFallaKA float64, // por conductor
+	tiempoLiberacion float64, // s
+	temperaturaInicial float64, // °C
+	temperaturaMaxima float64, // °C
+) (dto.ResultadoSoporteTermico, error) {
+	// Mismo límite que la selección por caída de tensión: 19 calibres en la tabla NOM.
+	const maxIntentos = 18
+
+	calibreActual := calibre
+	for intento := 0; ; intento++ {
+		seccion, err := uc.tablaRepo.ObtenerSeccionConductor(ctx, calibreActual)
+		if err != nil {
+			return dto.ResultadoSoporteTermico{}, fmt.Errorf("obtener sección para calibre %s: %w", calibreActual, err)
+		}
+
+		verificacion, err := service.VerificarSoporteTermico(
+			service.EntradaSoporteTermico{
+				Material:           material,
+				SeccionMM2:         seccion,
+				TemperaturaInicial: temperaturaInicial,
+				TemperaturaMaxima:  temperaturaMaxima,
+			},
+			corrienteFallaKA,
+			tiempoLiberacion,
+		)
+		if err != nil {
+			return dto.ResultadoSoporteTermico{}, fmt.Errorf("verificar soporte térmico calibre %s: %w", calibreActual, err)
+		}
+
+		resultado := dto.ResultadoSoporteTermico{
+			CalibreOriginal:      calibre,
+			CalibreSeleccionado:  calibreActual,
+			SeccionMM2:           verificacion.SeccionMM2,
+			SeccionMinimaMM2:     verificacion.SeccionMinimaMM2,
+			CorrienteFallaKA:     verificacion.CorrienteFallaKA,
+			CorrienteSoportadaKA: verificacion.CorrienteSoportadaKA,
+			TiempoLiberacion:     verificacion.TiempoLiberacion,
+			TemperaturaInicial:   verificacion.TemperaturaInicial,
+			TemperaturaMaxima:    verificacion.TemperaturaMaxima,
+			Cumple:               verificacion.Cumple,
+		}
+
+		if verificacion.Cumple {
+			if calibreActual != calibre {
+				resultado.Nota = fmt.Sprintf(
+					"Calibre aumentado de %s a %s por soporte térmico al cortocircuito (I²t)",
+					calibre,
+					calibreActual,
+				)
+			}
+			return resultado, nil
+		}
+
+		calibreSiguiente, err := service.ObtenerCalibreSuperior(calibreActual)
+		if err != nil || intento+1 >= maxIntentos {
+			// Llegamos al máximo de la tabla NOM
+			resultado.Nota = fmt.Sprintf(
+				"Ningún calibre hasta %s soporta %.2f kA durante %.3f s (I²t)",
+				calibreActual,
+				corrienteFallaKA,
+				tiempoLiberacion,
+			)
+			return resultado, nil
+		}
+		calibreActual = calibreSiguiente
+	}
+}
@@ -0,0 +1,65 @@
+// internal/calculos/application/usecase/verificar_soporte_termico_test.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockSeccionRepo reuses mockTablaRepo and only resolves conductor sections.
+type mockSeccionRepo struct {
+	mockTablaRepo
+	secciones map[string]float64
+}
+
+func (m *mockSeccionRepo) ObtenerSeccionConductor(ctx context.Context, calibre string) (float64, error) {
+	s, ok := m.secciones[calibre]
+	if !ok {
+		return 0, fmt.Errorf("calibre %s no encontrado", calibre)
+	}
+	return s, nil
+}
+
+func TestVerificarSoporteTermicoUseCase(t *testing.T) {
+	repo := &mockSeccionRepo{secciones: map[string]float64{
+		"8": 8.37, "6": 13.3, "4": 21.2, "2": 33.6,
+		"750": 380, "1000": 507,
+	}}
+	uc := NewVerificarSoporteTermicoUseCase(repo)
+	ctx := context.Background()
+
+	t.Run("calibre por ampacidad ya soporta la falla", func(t *testing.T) {
+		r, err := uc.Execute(ctx, "2", valueobject.MaterialCobre, 10, 0.05, 75, 150)
+		require.NoError(t, err)
+
+		assert.True(t, r.Cumple)
+		assert.Equal(t, "2", r.CalibreSeleccionado)
+		assert.Empty(t, r.Nota)
+	})
+
+	t.Run("aumenta calibre hasta soportar la falla", func(t *testing.T) {
+		// 10 kA, 3 ciclos, 75→150 °C requiere 21.4 mm²: 4 AWG (21.2) no alcanza → 2 AWG
+		r, err := uc.Execute(ctx, "8", valueobject.MaterialCobre, 10, 0.05, 75, 150)
+		require.NoError(t, err)
+
+		assert.True(t, r.Cumple)
+		assert.Equal(t, "8", r.CalibreOriginal)
+		assert.Equal(t, "2", r.CalibreSeleccionado)
+		assert.InDelta(t, 21.40, r.SeccionMinimaMM2, 0.01)
+		assert.Contains(t, r.Nota, "Calibre aumentado de 8 a 2")
+	})
+
+	t.Run("se agota la tabla sin cumplir", func(t *testing.T) {
+		r, err := uc.Execute(ctx, "750", valueobject.MaterialCobre, 500, 0.5, 75, 150)
+		require.NoError(t, err)
+
+		assert.False(t, r.Cumple)
+		assert.Equal(t, "1000", r.CalibreSeleccionado)
+		assert.Contains(t, r.Nota, "Ningún calibre")
+	})
+}
@@ -0,0 +1,60 @@
+// internal/calculos/domain/entity/armonicos.go
+package entity
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrEspectroArmonicoInvalido is returned when the harmonic spectrum has invalid orders or percentages.
+var ErrEspectroArmonicoInvalido = errors.New("espectro armónico inválido")
+
+// EspectroArmonico describes the harmonic content of the load current.
+// Either the total distortion (THDi) or the individual orders can be given;
+// when orders are present they take precedence over THDi.
+type EspectroArmonico struct {
+	THDi    float64         // distorsión armónica total de corriente [% de la fundamental]
+	Ordenes map[int]float64 // orden armónico (h ≥ 2) → [% de la fundamental]
+}
+
+// NewEspectroArmonico validates and builds a harmonic spectrum.
+func NewEspectroArmonico(thdi float64, ordenes map[int]float64) (EspectroArmonico, error) {
+	if thdi < 0 {
+		return EspectroArmonico{}, fmt.Errorf("%w: THDi no puede ser negativo: %.2f", ErrEspectroArmonicoInvalido, thdi)
+	}
+	for orden, porcentaje := range ordenes {
+		if orden < 2 {
+			return EspectroArmonico{}, fmt.Errorf("%w: orden %d (debe ser ≥ 2)", ErrEspectroArmonicoInvalido, orden)
+		}
+		if porcentaje < 0 {
+			return EspectroArmonico{}, fmt.Errorf("%w: orden %d con porcentaje negativo %.2f", ErrEspectroArmonicoInvalido, orden, porcentaje)
+		}
+	}
+	return EspectroArmonico{THDi: thdi, Ordenes: ordenes}, nil
+}
+
+// TieneContenido indica si el espectro aporta distorsión.
+func (e EspectroArmonico) TieneContenido() bool {
+	if e.THDi > 0 {
+		return true
+	}
+	for _, porcentaje := range e.Ordenes {
+		if porcentaje > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// FactorArmonico is the result of the harmonic derating.
+//
+//	Factor = FactorRMS × FactorAnexoE
+type FactorArmonico struct {
+	THDi               float64 // [%] usado (calculado desde los órdenes si se dieron)
+	TerceraArmonica    float64 // contenido de armónicas triples (3ª, 9ª, …) [% de la corriente de fase RMS]
+	FactorRMS          float64 // I_rms / I_1 = √(1 + THDi²)
+	FactorReduccion    float64 // factor de reducción del Anexo E (1.0 o 0.86)
+	DimensionPorNeutro bool    // el conductor se dimensiona por la corriente del neutro
+	FactorAnexoE       float64 // multiplicador de corriente derivado del Anexo E
+	Factor             float64 // multiplicador total sobre la corriente nominal
+}
@@ -0,0 +1,31 @@
+// internal/calculos/domain/entity/armonicos_test.go
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEspectroArmonico(t *testing.T) {
+	e, err := entity.NewEspectroArmonico(0, map[int]float64{3: 20, 5: 10})
+	require.NoError(t, err)
+	assert.True(t, e.TieneContenido())
+
+	vacio, err := entity.NewEspectroArmonico(0, nil)
+	require.NoError(t, err)
+	assert.False(t, vacio.TieneContenido())
+}
+
+func TestNewEspectroArmonico_Invalido(t *testing.T) {
+	_, err := entity.NewEspectroArmonico(-1, nil)
+	assert.ErrorIs(t, err, entity.ErrEspectroArmonicoInvalido)
+
+	_, err = entity.NewEspectroArmonico(0, map[int]float64{1: 10})
+	assert.ErrorIs(t, err, entity.ErrEspectroArmonicoInvalido)
+
+	_, err = entity.NewEspectroArmonico(0, map[int]float64{5: -3})
+	assert.ErrorIs(t, err, entity.ErrEspectroArmonicoInvalido)
+}
@@ -0,0 +1,76 @@
+// internal/calculos/domain/entity/banco_capacitores.go
+package entity
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+)
+
+// BancoCapacitores represents a power-factor correction capacitor bank,
+// optionally switched in steps by an automatic controller.
+// Nominal current: I = KVAR / (KV × √3), where KV = Voltaje / 1000 (same as FiltroRechazo).
+type BancoCapacitores struct {
+	Equipo
+	KVAR  float64
+	Pasos int // number of switched steps (1 = fixed bank)
+}
+
+func NewBancoCapacitores(clave string, voltaje int, kvar float64, pasos int, itm ITM) (*BancoCapacitores, error) {
+	if kvar <= 0 {
+		return nil, fmt.Errorf("KVAR debe ser mayor que cero: %.2f", kvar)
+	}
+	if pasos < 1 {
+		return nil, fmt.Errorf("pasos debe ser al menos 1: %d", pasos)
+	}
+	if voltaje <= 0 {
+		return nil, fmt.Errorf("%w: voltaje es %d", ErrDivisionPorCero, voltaje)
+	}
+	return &BancoCapacitores{
+		Equipo: Equipo{
+			Clave:   clave,
+			Tipo:    TipoEquipoBancoCapacitores,
+			Voltaje: voltaje,
+			ITM:     itm,
+		},
+		KVAR:  kvar,
+		Pasos: pasos,
+	}, nil
+}
+
+func (bc *BancoCapacitores) CalcularCorrienteNominal() (valueobject.Corriente, error) {
+	kv := float64(bc.Voltaje) / 1000.0
+	denominador := kv * math.Sqrt(3)
+	corriente := bc.KVAR / denominador
+	return valueobject.NewCorriente(corriente)
+}
+
+// KVARPorPaso returns the reactive power of each step, assuming equal steps.
+func (bc *BancoCapacitores) KVARPorPaso() float64 {
+	return bc.KVAR / float64(bc.Pasos)
+}
+
+// PotenciaKVAR returns reactive power directly from KVAR rating [kVAR].
+func (bc *BancoCapacitores) PotenciaKVAR() float64 {
+	return bc.KVAR
+}
+
+// PotenciaKVA returns apparent power. A capacitor bank is purely reactive, so kVA = kVAR.
+func (bc *BancoCapacitores) PotenciaKVA() float64 {
+	return bc.KVAR
+}
+
+// PotenciaKW returns active power. A capacitor bank is purely reactive, so kW = 0.
+func (bc *BancoCapacitores) PotenciaKW() float64 {
+	return 0
+}
+
+// RequisitosBancoCapacitores groups the NOM 460 requirements derived from the
+// bank's nominal current and voltage.
+type RequisitosBancoCapacitores struct {
+	CorrienteMinimaConductor     float64 // [A], 135 % de I_n (460-8(a))
+	CorrienteMinimaDesconectador float64 // [A], 135 % de I_n (460-8(c))
+	TensionResidualMaxima        float64 // [V] tras la desconexión (460-6(a) / 460-28(a))
+	TiempoDescarga               float64 // [s] para alcanzar la tensión residual
+}
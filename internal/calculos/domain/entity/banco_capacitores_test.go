@@ -0,0 +1,52 @@
+// internal/calculos/domain/entity/banco_capacitores_test.go
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBancoCapacitores(t *testing.T) {
+	bc, err := entity.NewBancoCapacitores("BC-001", 480, 150, 6, entity.ITM{Amperaje: 250})
+	require.NoError(t, err)
+
+	assert.Equal(t, "BC-001", bc.Clave)
+	assert.Equal(t, entity.TipoEquipoBancoCapacitores, bc.Tipo)
+	assert.Equal(t, 480, bc.Voltaje)
+	assert.InDelta(t, 150.0, bc.KVAR, 0.001)
+	assert.Equal(t, 6, bc.Pasos)
+	assert.InDelta(t, 25.0, bc.KVARPorPaso(), 0.001)
+}
+
+func TestNewBancoCapacitores_Invalido(t *testing.T) {
+	_, err := entity.NewBancoCapacitores("BC-BAD", 480, 0, 1, entity.ITM{})
+	assert.Error(t, err)
+
+	_, err = entity.NewBancoCapacitores("BC-BAD", 480, 100, 0, entity.ITM{})
+	assert.Error(t, err)
+
+	_, err = entity.NewBancoCapacitores("BC-BAD", 0, 100, 1, entity.ITM{})
+	assert.Error(t, err)
+}
+
+func TestBancoCapacitores_CalcularCorrienteNominal(t *testing.T) {
+	// I = 150 / (0.48 × √3) = 180.42 A
+	bc, err := entity.NewBancoCapacitores("BC-001", 480, 150, 6, entity.ITM{})
+	require.NoError(t, err)
+
+	corriente, err := bc.CalcularCorrienteNominal()
+	require.NoError(t, err)
+	assert.InDelta(t, 180.42, corriente.Valor(), 0.01)
+}
+
+func TestBancoCapacitores_Potencias(t *testing.T) {
+	bc, err := entity.NewBancoCapacitores("BC-001", 480, 150, 1, entity.ITM{})
+	require.NoError(t, err)
+
+	assert.InDelta(t, 150.0, bc.PotenciaKVAR(), 0.001)
+	assert.InDelta(t, 150.0, bc.PotenciaKVA(), 0.001)
+	assert.InDelta(t, 0.0, bc.PotenciaKW(), 0.001)
+}
@@ -0,0 +1,116 @@
+// internal/calculos/domain/entity/catalogo_precios.go
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+)
+
+// ErrPrecioNoEncontrado is returned when the catalog has no price for a material.
+var ErrPrecioNoEncontrado = errors.New("precio no encontrado en el catálogo")
+
+// CatalogoPrecios holds unit prices per meter used to cost a circuit:
+// conductors by calibre, material and insulation, conduit by type and trade
+// size, and cable tray by commercial width.
+type CatalogoPrecios struct {
+	Moneda      string
+	conductores map[string]float64
+	tuberias    map[string]float64
+	charolas    map[int]float64
+}
+
+// NewCatalogoPrecios creates an empty catalog in the given currency.
+func NewCatalogoPrecios(moneda string) *CatalogoPrecios {
+	return &CatalogoPrecios{
+		Moneda:      moneda,
+		conductores: make(map[string]float64),
+		tuberias:    make(map[string]float64),
+		charolas:    make(map[int]float64),
+	}
+}
+
+// AgregarConductor registers the price per meter of a conductor.
+// An empty aislamiento registers a generic price used for any insulation.
+func (c *CatalogoPrecios) AgregarConductor(calibre string, material valueobject.MaterialConductor, aislamiento string, precioMetro float64) error {
+	if precioMetro < 0 {
+		return fmt.Errorf("precio negativo para conductor %s %s: %.2f", calibre, material, precioMetro)
+	}
+	c.conductores[claveConductor(calibre, material, aislamiento)] = precioMetro
+	return nil
+}
+
+// AgregarTuberia registers the price per meter of a conduit.
+func (c *CatalogoPrecios) AgregarTuberia(tipo TipoCanalizacion, tamano string, precioMetro float64) error {
+	if !tipo.RequiereTuberia() {
+		return fmt.Errorf("%w: %s no es tubería", ErrTipoCanalizacionInvalido, tipo)
+	}
+	if precioMetro < 0 {
+		return fmt.Errorf("precio negativo para tubería %s %s: %.2f", tipo, tamano, precioMetro)
+	}
+	c.tuberias[claveTuberia(tipo, tamano)] = precioMetro
+	return nil
+}
+
+// AgregarCharola registers the price per meter of a cable tray of the given width.
+func (c *CatalogoPrecios) AgregarCharola(anchoMM float64, precioMetro float64) error {
+	if anchoMM <= 0 {
+		return fmt.Errorf("ancho de charola debe ser mayor que cero: %.1f", anchoMM)
+	}
+	if precioMetro < 0 {
+		return fmt.Errorf("precio negativo para charola de %.0f mm: %.2f", anchoMM, precioMetro)
+	}
+	c.charolas[int(math.Round(anchoMM))] = precioMetro
+	return nil
+}
+
+// PrecioConductor returns the price per meter of a conductor with the given
+// insulation, falling back to the generic price registered without aislamiento.
+func (c *CatalogoPrecios) PrecioConductor(calibre string, material valueobject.MaterialConductor, aislamiento string) (float64, error) {
+	if precio, ok := c.conductores[claveConductor(calibre, material, aislamiento)]; ok {
+		return precio, nil
+	}
+	precio, ok := c.conductores[claveConductor(calibre, material, "")]
+	if !ok {
+		return 0, fmt.Errorf("%w: conductor %s %s %s", ErrPrecioNoEncontrado, calibre, material, aislamiento)
+	}
+	return precio, nil
+}
+
+// PrecioTuberia returns the price per meter of a conduit.
+func (c *CatalogoPrecios) PrecioTuberia(tipo TipoCanalizacion, tamano string) (float64, error) {
+	precio, ok := c.tuberias[claveTuberia(tipo, tamano)]
+	if !ok {
+		return 0, fmt.Errorf("%w: tubería %s de %s", ErrPrecioNoEncontrado, tipo, tamano)
+	}
+	return precio, nil
+}
+
+// PrecioCharola returns the price per meter of a cable tray of the given width.
+func (c *CatalogoPrecios) PrecioCharola(anchoMM float64) (float64, error) {
+	precio, ok := c.charolas[int(math.Round(anchoMM))]
+	if !ok {
+		return 0, fmt.Errorf("%w: charola de %.0f mm", ErrPrecioNoEncontrado, anchoMM)
+	}
+	return precio, nil
+}
+
+// claveConductor ignores the " AWG" / " MCM" suffix so "2" and "2 AWG" match,
+// and the case of the insulation.
+func claveConductor(calibre string, material valueobject.MaterialConductor, aislamiento string) string {
+	calibre = strings.TrimSpace(calibre)
+	calibre = strings.TrimSuffix(calibre, " AWG")
+	calibre = strings.TrimSuffix(calibre, " MCM")
+	return calibre + "|" + material.String() + "|" + strings.ToUpper(strings.TrimSpace(aislamiento))
+}
+
+// claveTuberia prices the underground duct bank as PVC conduit of the same size.
+func claveTuberia(tipo TipoCanalizacion, tamano string) string {
+	if tipo == TipoCanalizacionDuctoSubterraneo {
+		tipo = TipoCanalizacionTuberiaPVC
+	}
+	return string(tipo) + "|" + strings.TrimSpace(tamano)
+}
@@ -0,0 +1,60 @@
+// internal/calculos/domain/entity/catalogo_precios_test.go
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCatalogoPrecios(t *testing.T) {
+	c := entity.NewCatalogoPrecios("MXN")
+	require.NoError(t, c.AgregarConductor("250", valueobject.MaterialCobre, "", 520))
+	require.NoError(t, c.AgregarTuberia(entity.TipoCanalizacionTuberiaPVC, "2", 60))
+	require.NoError(t, c.AgregarCharola(304.8, 400))
+
+	require.NoError(t, c.AgregarConductor("250", valueobject.MaterialCobre, "XHHW", 560))
+
+	t.Run("conductor ignora sufijo AWG/MCM", func(t *testing.T) {
+		p, err := c.PrecioConductor("250 MCM", valueobject.MaterialCobre, "")
+		require.NoError(t, err)
+		assert.Equal(t, 520.0, p)
+
+		_, err = c.PrecioConductor("250 MCM", valueobject.MaterialAluminio, "")
+		assert.ErrorIs(t, err, entity.ErrPrecioNoEncontrado)
+	})
+
+	t.Run("conductor por aislamiento con precio genérico de respaldo", func(t *testing.T) {
+		p, err := c.PrecioConductor("250 MCM", valueobject.MaterialCobre, "xhhw")
+		require.NoError(t, err)
+		assert.Equal(t, 560.0, p)
+
+		p, err = c.PrecioConductor("250 MCM", valueobject.MaterialCobre, "THW")
+		require.NoError(t, err)
+		assert.Equal(t, 520.0, p)
+	})
+
+	t.Run("tubería por tipo y tamaño", func(t *testing.T) {
+		p, err := c.PrecioTuberia(entity.TipoCanalizacionTuberiaPVC, "2")
+		require.NoError(t, err)
+		assert.Equal(t, 60.0, p)
+
+		_, err = c.PrecioTuberia(entity.TipoCanalizacionTuberiaAceroPG, "2")
+		assert.ErrorIs(t, err, entity.ErrPrecioNoEncontrado)
+	})
+
+	t.Run("charola por ancho en mm", func(t *testing.T) {
+		p, err := c.PrecioCharola(305)
+		require.NoError(t, err)
+		assert.Equal(t, 400.0, p)
+	})
+
+	t.Run("validaciones", func(t *testing.T) {
+		assert.Error(t, c.AgregarConductor("2", valueobject.MaterialCobre, "", -1))
+		assert.ErrorIs(t, c.AgregarTuberia(entity.TipoCanalizacionCharolaCableEspaciado, "2", 10), entity.ErrTipoCanalizacionInvalido)
+		assert.Error(t, c.AgregarCharola(0, 10))
+	})
+}
@@ -0,0 +1,12 @@
+// internal/calculos/domain/entity/coordinacion_proteccion.go
+package entity
+
+// ResultadoCoordinacionProteccion is the NOM 240-4 check between the corrected
+// ampacity of the phase conductor and the ITM rating that protects it.
+type ResultadoCoordinacionProteccion struct {
+	AmpacidadCorregida      float64 // Capacidad × factor de corrección × hilos por fase
+	ITM                     int     // capacidad del ITM instalado
+	ITMMaximoPermitido      int     // máxima capacidad de ITM que protege al conductor
+	AplicaSiguienteSuperior bool    // true si el ITM excede la ampacidad amparado en 240-4(b)
+	Cumple                  bool    // ITM ≤ ITMMaximoPermitido
+}
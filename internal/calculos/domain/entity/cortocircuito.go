@@ -0,0 +1,41 @@
+// internal/calculos/domain/entity/cortocircuito.go
+package entity
+
+// FuenteCortocircuito describes the upstream source used for the fault study:
+// the utility short-circuit capacity, a service transformer, or both in series.
+type FuenteCortocircuito struct {
+	PotenciaCortocircuitoMVA float64 // MVAcc disponible de la red (compañía suministradora)
+	TransformadorKVA         float64 // capacidad del transformador que alimenta el tablero
+	TransformadorZPorcentaje float64 // impedancia del transformador en %Z
+}
+
+// Definida reports whether at least one source was provided.
+func (f FuenteCortocircuito) Definida() bool {
+	return f.PotenciaCortocircuitoMVA > 0 || f.TransformadorKVA > 0
+}
+
+// ResultadoCortocircuito holds the symmetrical fault currents at the load end of a circuit.
+type ResultadoCortocircuito struct {
+	TensionFaseFase          float64 // V_ff usada en el cálculo
+	ImpedanciaFuenteOhm      float64 // X de red + transformador referida a V_ff (Ω)
+	ResistenciaConductorOhm  float64 // R·L/N del conductor de fase (Ω)
+	ReactanciaConductorOhm   float64 // X·L/N del conductor de fase (Ω)
+	ImpedanciaTrifasicaOhm   float64 // |Z| por fase hasta el extremo de carga (Ω)
+	ImpedanciaLineaTierraOhm float64 // |Z| del lazo fase → conductor de tierra (Ω)
+	IccBornesKA              float64 // falla trifásica en el origen del circuito (L = 0)
+	IccTrifasicaKA           float64 // falla trifásica en el extremo de carga; 0 si el sistema no es trifásico
+	IccLineaTierraKA         float64 // falla fase-tierra en el extremo de carga
+}
+
+// ResultadoSoporteTermico holds the adiabatic (I²t) short-circuit withstand check
+// of one conductor for the available fault current and the protection clearing time.
+type ResultadoSoporteTermico struct {
+	SeccionMM2           float64 // sección del conductor evaluado
+	SeccionMinimaMM2     float64 // sección mínima que soporta la falla
+	CorrienteFallaKA     float64 // corriente de falla por conductor
+	CorrienteSoportadaKA float64 // corriente máxima que soporta el conductor en el tiempo dado
+	TiempoLiberacion     float64 // s
+	TemperaturaInicial   float64 // °C — temperatura de operación antes de la falla
+	TemperaturaMaxima    float64 // °C — temperatura máxima de cortocircuito del aislamiento
+	Cumple               bool    // SeccionMM2 ≥ SeccionMinimaMM2
+}
@@ -0,0 +1,69 @@
+// internal/calculos/domain/entity/instalacion_subterranea.go
+package entity
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Condiciones de referencia de las tablas del Anexo B (B-310-7 y B-310-10).
+const (
+	ResistividadTermicaReferencia = 90.0 // resistividad térmica del suelo [°C·cm/W] (RHO 90)
+	ProfundidadReferenciaMM       = 750.0
+	TemperaturaTerrenoReferencia  = 20 // temperatura del terreno [°C]
+)
+
+// ErrCondicionesSubterraneasInvalidas is returned when soil or burial parameters are out of range.
+var ErrCondicionesSubterraneasInvalidas = errors.New("condiciones de instalación subterránea inválidas")
+
+// CondicionesSubterraneas describe el terreno donde se instala un circuito subterráneo.
+// Las tablas del Anexo B están calculadas para RHO 90, 750 mm de profundidad y
+// 20 °C de temperatura del terreno; cualquier otro valor se corrige con factores.
+type CondicionesSubterraneas struct {
+	ResistividadTermica float64 // resistividad térmica del suelo [°C·cm/W]
+	ProfundidadMM       float64 // profundidad de enterramiento hasta la parte superior del ducto o cable [mm]
+	TemperaturaTerreno  int     // temperatura del terreno a la profundidad de instalación [°C]
+}
+
+// NewCondicionesSubterraneas validates and builds the underground installation conditions.
+// Zero values take the Anexo B reference conditions; temperaturaTerreno nil = 20 °C.
+// The depth must reach the minimum cover of NOM Tabla 300-5 for the installation type.
+func NewCondicionesSubterraneas(
+	tipo TipoCanalizacion,
+	resistividad float64,
+	profundidadMM float64,
+	temperaturaTerreno *int,
+) (CondicionesSubterraneas, error) {
+	if !tipo.EsSubterranea() {
+		return CondicionesSubterraneas{}, fmt.Errorf("%w: %s no es una instalación subterránea", ErrCondicionesSubterraneasInvalidas, tipo)
+	}
+
+	c := CondicionesSubterraneas{
+		ResistividadTermica: resistividad,
+		ProfundidadMM:       profundidadMM,
+		TemperaturaTerreno:  TemperaturaTerrenoReferencia,
+	}
+	if c.ResistividadTermica == 0 {
+		c.ResistividadTermica = ResistividadTermicaReferencia
+	}
+	if c.ProfundidadMM == 0 {
+		c.ProfundidadMM = ProfundidadReferenciaMM
+	}
+	if temperaturaTerreno != nil {
+		c.TemperaturaTerreno = *temperaturaTerreno
+	}
+
+	if c.ResistividadTermica < 0 {
+		return CondicionesSubterraneas{}, fmt.Errorf("%w: resistividad térmica negativa: %.1f", ErrCondicionesSubterraneasInvalidas, c.ResistividadTermica)
+	}
+	if c.ProfundidadMM < 0 {
+		return CondicionesSubterraneas{}, fmt.Errorf("%w: profundidad negativa: %.0f mm", ErrCondicionesSubterraneasInvalidas, c.ProfundidadMM)
+	}
+	if minima := tipo.ProfundidadMinimaMM(); c.ProfundidadMM < minima {
+		return CondicionesSubterraneas{}, fmt.Errorf(
+			"%w: profundidad %.0f mm menor a la mínima de %.0f mm (NOM Tabla 300-5)",
+			ErrCondicionesSubterraneasInvalidas, c.ProfundidadMM, minima,
+		)
+	}
+	return c, nil
+}
@@ -0,0 +1,48 @@
+// internal/calculos/domain/entity/instalacion_subterranea_test.go
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCondicionesSubterraneas_Referencia(t *testing.T) {
+	c, err := entity.NewCondicionesSubterraneas(entity.TipoCanalizacionDuctoSubterraneo, 0, 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 90.0, c.ResistividadTermica)
+	assert.Equal(t, 750.0, c.ProfundidadMM)
+	assert.Equal(t, 20, c.TemperaturaTerreno)
+}
+
+func TestNewCondicionesSubterraneas_Valores(t *testing.T) {
+	temp := 25
+	c, err := entity.NewCondicionesSubterraneas(entity.TipoCanalizacionDirectamenteEnterrado, 120, 900, &temp)
+	require.NoError(t, err)
+	assert.Equal(t, 120.0, c.ResistividadTermica)
+	assert.Equal(t, 900.0, c.ProfundidadMM)
+	assert.Equal(t, 25, c.TemperaturaTerreno)
+}
+
+func TestNewCondicionesSubterraneas_Invalidas(t *testing.T) {
+	casos := []struct {
+		nombre       string
+		tipo         entity.TipoCanalizacion
+		resistividad float64
+		profundidad  float64
+	}{
+		{"no subterranea", entity.TipoCanalizacionTuberiaPVC, 90, 750},
+		{"resistividad negativa", entity.TipoCanalizacionDuctoSubterraneo, -10, 750},
+		{"ducto menor a 450 mm", entity.TipoCanalizacionDuctoSubterraneo, 90, 300},
+		{"enterrado menor a 600 mm", entity.TipoCanalizacionDirectamenteEnterrado, 90, 450},
+	}
+	for _, c := range casos {
+		t.Run(c.nombre, func(t *testing.T) {
+			_, err := entity.NewCondicionesSubterraneas(c.tipo, c.resistividad, c.profundidad, nil)
+			require.Error(t, err)
+			assert.ErrorIs(t, err, entity.ErrCondicionesSubterraneasInvalidas)
+		})
+	}
+}
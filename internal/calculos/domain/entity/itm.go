@@ -34,3 +34,13 @@ func NewITM(amperaje, polos, bornes, voltaje int) (ITM, error) {
 		Voltaje:  voltaje,
 	}, nil
 }
+
+// SeleccionITM is the result of sizing the breaker from the nominal current:
+// the smallest standard rating ≥ CorrienteNominal × FactorUso.
+type SeleccionITM struct {
+	CorrienteNominal float64 // A
+	FactorUso        float64 // 1.25 o 1.35 según el tipo de equipo
+	CorrienteMinima  float64 // CorrienteNominal × FactorUso
+	Amperaje         int     // capacidad estándar seleccionada
+	Marco            int     // tamaño de marco (AF)
+}
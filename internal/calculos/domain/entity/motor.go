@@ -0,0 +1,61 @@
+// internal/calculos/domain/entity/motor.go
+package entity
+
+import (
+	"fmt"
+
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+)
+
+// Motor represents an induction motor fed from the same board as the filters.
+// Per NOM 430-6(a)(1) the nominal current is NOT the nameplate value but the
+// full-load current from Tabla 430-248 (single-phase) or 430-250 (three-phase).
+type Motor struct {
+	Equipo
+	PotenciaHP          float64
+	Fases               int     // 1 or 3
+	CorrientePlenaCarga float64 // [A], from Tabla 430-248 / 430-250
+}
+
+func NewMotor(clave string, voltaje int, potenciaHP float64, fases int, corrientePlenaCarga float64, itm ITM) (*Motor, error) {
+	if potenciaHP <= 0 {
+		return nil, fmt.Errorf("HP debe ser mayor que cero: %.2f", potenciaHP)
+	}
+	if fases != 1 && fases != 3 {
+		return nil, fmt.Errorf("fases debe ser 1 o 3: %d", fases)
+	}
+	if corrientePlenaCarga <= 0 {
+		return nil, fmt.Errorf("corriente de plena carga debe ser mayor que cero: %.2f", corrientePlenaCarga)
+	}
+	if voltaje <= 0 {
+		return nil, fmt.Errorf("%w: voltaje es %d", ErrDivisionPorCero, voltaje)
+	}
+	return &Motor{
+		Equipo: Equipo{
+			Clave:   clave,
+			Tipo:    TipoEquipoMotor,
+			Voltaje: voltaje,
+			ITM:     itm,
+		},
+		PotenciaHP:          potenciaHP,
+		Fases:               fases,
+		CorrientePlenaCarga: corrientePlenaCarga,
+	}, nil
+}
+
+// CalcularCorrienteNominal returns the table full-load current (NOM 430-6(a)(1)).
+func (m *Motor) CalcularCorrienteNominal() (valueobject.Corriente, error) {
+	return valueobject.NewCorriente(m.CorrientePlenaCarga)
+}
+
+// CorrientePlenaCargaMotor is the result of looking up a motor in Tabla 430-248/430-250.
+// The table row and voltage column may differ from the requested values: the next
+// larger HP rating and the nearest listed voltage are used.
+type CorrientePlenaCargaMotor struct {
+	PotenciaHP      float64 // HP solicitado
+	PotenciaHPTabla float64 // HP del renglón usado
+	Tension         int     // tensión de operación [V]
+	TensionTabla    int     // columna de tensión usada [V]
+	Fases           int
+	Corriente       float64 // [A]
+}
@@ -0,0 +1,55 @@
+// internal/calculos/domain/entity/motor_test.go
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMotor(t *testing.T) {
+	itm, err := entity.NewITM(70, 3, 3, 480)
+	require.NoError(t, err)
+
+	m, err := entity.NewMotor("M-001", 480, 20, 3, 27, itm)
+	require.NoError(t, err)
+
+	assert.Equal(t, "M-001", m.Clave)
+	assert.Equal(t, entity.TipoEquipoMotor, m.Tipo)
+	assert.Equal(t, 480, m.Voltaje)
+	assert.InDelta(t, 20.0, m.PotenciaHP, 0.001)
+	assert.Equal(t, 3, m.Fases)
+}
+
+func TestNewMotor_Invalido(t *testing.T) {
+	tests := []struct {
+		name    string
+		voltaje int
+		hp      float64
+		fases   int
+		flc     float64
+	}{
+		{"hp cero", 480, 0, 3, 27},
+		{"fases 2", 480, 20, 2, 27},
+		{"corriente cero", 480, 20, 3, 0},
+		{"voltaje cero", 0, 20, 3, 27},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := entity.NewMotor("M-BAD", tt.voltaje, tt.hp, tt.fases, tt.flc, entity.ITM{})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestMotor_CalcularCorrienteNominal(t *testing.T) {
+	m, err := entity.NewMotor("M-001", 480, 20, 3, 27, entity.ITM{})
+	require.NoError(t, err)
+
+	corriente, err := m.CalcularCorrienteNominal()
+	require.NoError(t, err)
+	assert.InDelta(t, 27.0, corriente.Valor(), 0.001)
+}
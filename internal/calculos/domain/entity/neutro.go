@@ -0,0 +1,25 @@
+// internal/calculos/domain/entity/neutro.go
+package entity
+
+// CorrienteNeutro es el resultado del análisis de corriente en el conductor neutro.
+//
+// El neutro transporta la componente fundamental no compensada por el desbalance
+// de las fases más las armónicas triples (3ª, 9ª, 15ª…), que se suman en fase:
+//
+//	I_N = √(I_N,desbalance² + Σ I_N,h²)
+type CorrienteNeutro struct {
+	CorrienteFase       float64 // corriente fundamental de fase usada como referencia [A]
+	Desbalance          float64 // desbalance de carga aplicado [%]
+	CorrienteDesbalance float64 // componente fundamental por desbalance [A]
+	CorrienteArmonica   float64 // componente por armónicas triples [A]
+	Corriente           float64 // corriente RMS total del neutro [A]
+	Portador            bool    // el neutro se cuenta como conductor portador de corriente (310-15(b)(5))
+}
+
+// Relacion retorna I_N / I_fase; 0 si no hay corriente de fase.
+func (c CorrienteNeutro) Relacion() float64 {
+	if c.CorrienteFase <= 0 {
+		return 0
+	}
+	return c.Corriente / c.CorrienteFase
+}
@@ -0,0 +1,83 @@
+// internal/calculos/domain/entity/proyecto.go
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrProyectoInvalido is returned when a project has no name or no valid circuits.
+var ErrProyectoInvalido = errors.New("proyecto inválido")
+
+// CircuitoProyecto is the project-level view of one calculated circuit:
+// only what the aggregate needs to build the consolidated summary.
+type CircuitoProyecto struct {
+	Nombre          string
+	Alimentador     string  // circuito del proyecto que lo alimenta; vacío = tablero general
+	PotenciaKVA     float64 // potencia aparente conectada del circuito
+	CumpleNormativa bool
+}
+
+// Proyecto groups the N circuits fed from the same switchboard.
+// Each circuit is calculated independently; the aggregate only consolidates.
+type Proyecto struct {
+	Nombre    string
+	Circuitos []CircuitoProyecto
+}
+
+// NewProyecto validates and creates a Proyecto.
+// Requires a name, at least one circuit and unique, non-empty circuit names.
+func NewProyecto(nombre string, circuitos []CircuitoProyecto) (*Proyecto, error) {
+	nombre = strings.TrimSpace(nombre)
+	if nombre == "" {
+		return nil, fmt.Errorf("%w: nombre vacío", ErrProyectoInvalido)
+	}
+	if len(circuitos) == 0 {
+		return nil, fmt.Errorf("%w: se requiere al menos un circuito", ErrProyectoInvalido)
+	}
+
+	vistos := make(map[string]bool, len(circuitos))
+	for i, c := range circuitos {
+		clave := strings.ToUpper(strings.TrimSpace(c.Nombre))
+		if clave == "" {
+			return nil, fmt.Errorf("%w: circuito %d sin nombre", ErrProyectoInvalido, i+1)
+		}
+		if vistos[clave] {
+			return nil, fmt.Errorf("%w: circuito duplicado '%s'", ErrProyectoInvalido, c.Nombre)
+		}
+		vistos[clave] = true
+	}
+
+	return &Proyecto{Nombre: nombre, Circuitos: circuitos}, nil
+}
+
+// TotalKVA returns the total connected apparent power of the project.
+// Only circuits fed from the main switchboard are added: a derived circuit's
+// load is already included in its feeder.
+func (p *Proyecto) TotalKVA() float64 {
+	total := 0.0
+	for _, c := range p.Circuitos {
+		if c.Alimentador != "" {
+			continue
+		}
+		total += c.PotenciaKVA
+	}
+	return total
+}
+
+// CircuitosNoConformes returns the names of the circuits that do not comply with NOM.
+func (p *Proyecto) CircuitosNoConformes() []string {
+	noConformes := []string{}
+	for _, c := range p.Circuitos {
+		if !c.CumpleNormativa {
+			noConformes = append(noConformes, c.Nombre)
+		}
+	}
+	return noConformes
+}
+
+// CumpleNormativa is true only when every circuit complies.
+func (p *Proyecto) CumpleNormativa() bool {
+	return len(p.CircuitosNoConformes()) == 0
+}
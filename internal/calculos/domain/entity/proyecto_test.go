@@ -0,0 +1,66 @@
+// internal/calculos/domain/entity/proyecto_test.go
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProyecto(t *testing.T) {
+	circuitos := []entity.CircuitoProyecto{
+		{Nombre: "FA-01", PotenciaKVA: 83.14, CumpleNormativa: true},
+		{Nombre: "TR-01", PotenciaKVA: 75, CumpleNormativa: false},
+		{Nombre: "FR-01", PotenciaKVA: 50, CumpleNormativa: true},
+	}
+
+	t.Run("consolida kVA y circuitos no conformes", func(t *testing.T) {
+		p, err := entity.NewProyecto("Tablero TG-1", circuitos)
+		require.NoError(t, err)
+		assert.InDelta(t, 208.14, p.TotalKVA(), 0.001)
+		assert.Equal(t, []string{"TR-01"}, p.CircuitosNoConformes())
+		assert.False(t, p.CumpleNormativa())
+	})
+
+	t.Run("kVA total no duplica la carga de los derivados", func(t *testing.T) {
+		p, err := entity.NewProyecto("Tablero TG-1", []entity.CircuitoProyecto{
+			{Nombre: "AL-1", PotenciaKVA: 100, CumpleNormativa: true},
+			{Nombre: "D-1", Alimentador: "AL-1", PotenciaKVA: 60, CumpleNormativa: true},
+			{Nombre: "D-2", Alimentador: "AL-1", PotenciaKVA: 40, CumpleNormativa: true},
+			{Nombre: "FA-01", PotenciaKVA: 25, CumpleNormativa: true},
+		})
+		require.NoError(t, err)
+		assert.InDelta(t, 125.0, p.TotalKVA(), 0.001)
+	})
+
+	t.Run("todos conformes", func(t *testing.T) {
+		p, err := entity.NewProyecto("Tablero TG-1", circuitos[:1])
+		require.NoError(t, err)
+		assert.Empty(t, p.CircuitosNoConformes())
+		assert.True(t, p.CumpleNormativa())
+	})
+
+	t.Run("rechaza nombre vacío", func(t *testing.T) {
+		_, err := entity.NewProyecto(" ", circuitos)
+		assert.ErrorIs(t, err, entity.ErrProyectoInvalido)
+	})
+
+	t.Run("rechaza proyecto sin circuitos", func(t *testing.T) {
+		_, err := entity.NewProyecto("Tablero TG-1", nil)
+		assert.ErrorIs(t, err, entity.ErrProyectoInvalido)
+	})
+
+	t.Run("rechaza circuitos duplicados", func(t *testing.T) {
+		_, err := entity.NewProyecto("Tablero TG-1", []entity.CircuitoProyecto{
+			{Nombre: "FA-01"}, {Nombre: "fa-01"},
+		})
+		assert.ErrorIs(t, err, entity.ErrProyectoInvalido)
+	})
+
+	t.Run("rechaza circuito sin nombre", func(t *testing.T) {
+		_, err := entity.NewProyecto("Tablero TG-1", []entity.CircuitoProyecto{{Nombre: ""}})
+		assert.ErrorIs(t, err, entity.ErrProyectoInvalido)
+	})
+}
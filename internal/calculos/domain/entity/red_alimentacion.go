@@ -0,0 +1,31 @@
+// internal/calculos/domain/entity/red_alimentacion.go
+package entity
+
+import "errors"
+
+// ErrRedAlimentacionInvalida is returned when the feeder tree has unnamed or duplicate
+// runs, references an unknown feeder or contains a cycle.
+var ErrRedAlimentacionInvalida = errors.New("red de alimentación inválida")
+
+// TramoAlimentacion is one run of a distribution tree (tablero → alimentador → derivados).
+// Alimentador is the name of the upstream run that feeds it; empty means the run
+// leaves directly from the service entrance (acometida / tablero general).
+type TramoAlimentacion struct {
+	Nombre      string
+	Alimentador string
+}
+
+// EsRaiz reports whether the run is fed directly from the service entrance.
+func (t TramoAlimentacion) EsRaiz() bool {
+	return t.Alimentador == ""
+}
+
+// ResultadoCaidaAcumulada holds the voltage drop from the service entrance to the
+// load end of a run: the upstream (feeder) drop plus the run's own drop.
+type ResultadoCaidaAcumulada struct {
+	CaidaAlimentador float64 // % acumulado aguas arriba (acometida → tablero del tramo)
+	CaidaPropia      float64 // % del tramo según CalcularCaidaTension
+	CaidaAcumulada   float64 // CaidaAlimentador + CaidaPropia
+	LimiteTotal      float64 // límite combinado alimentador + derivado (NOM: 5%)
+	Cumple           bool    // CaidaAcumulada ≤ LimiteTotal
+}
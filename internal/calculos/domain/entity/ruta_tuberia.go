@@ -0,0 +1,131 @@
+// internal/calculos/domain/entity/ruta_tuberia.go
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrRutaTuberiaInvalida is returned when a conduit route has no sections or a section is out of range.
+var ErrRutaTuberiaInvalida = errors.New("ruta de tubería inválida")
+
+// MaxCurvasEntreRegistrosGrados es el total de curvas permitido entre registros
+// o puntos de jalado (NOM-001-SEDE-2012, 344-26, 352-26, 358-26: no más de 360°).
+const MaxCurvasEntreRegistrosGrados = 360.0
+
+// TipoTramo identifica un tramo de la ruta de tubería.
+type TipoTramo string
+
+const (
+	TipoTramoRecto TipoTramo = "RECTO"
+	TipoTramoCurva TipoTramo = "CURVA"
+)
+
+// TramoRuta es un tramo de la ruta en el orden en que se jala el cable.
+// Los tramos rectos usan LongitudM; las curvas usan AnguloGrados y RadioM.
+type TramoRuta struct {
+	Tipo         TipoTramo
+	LongitudM    float64 // tramo recto [m]
+	AnguloGrados float64 // curva [°]
+	RadioM       float64 // radio de curvatura al eje de la tubería [m]
+}
+
+// RutaTuberia es la secuencia de tramos entre dos puntos de jalado.
+type RutaTuberia struct {
+	Tramos []TramoRuta
+}
+
+// NewRutaTuberia valida y construye una ruta de tubería.
+func NewRutaTuberia(tramos []TramoRuta) (RutaTuberia, error) {
+	if len(tramos) == 0 {
+		return RutaTuberia{}, fmt.Errorf("%w: se requiere al menos un tramo", ErrRutaTuberiaInvalida)
+	}
+
+	normalizados := make([]TramoRuta, len(tramos))
+	for i, t := range tramos {
+		t.Tipo = TipoTramo(strings.ToUpper(strings.TrimSpace(string(t.Tipo))))
+		switch t.Tipo {
+		case TipoTramoRecto:
+			if t.LongitudM <= 0 {
+				return RutaTuberia{}, fmt.Errorf("%w: tramo %d recto con longitud %.2f m", ErrRutaTuberiaInvalida, i+1, t.LongitudM)
+			}
+		case TipoTramoCurva:
+			if t.AnguloGrados <= 0 || t.AnguloGrados > 180 {
+				return RutaTuberia{}, fmt.Errorf("%w: tramo %d curva con ángulo %.1f° (debe estar en (0, 180])", ErrRutaTuberiaInvalida, i+1, t.AnguloGrados)
+			}
+			if t.RadioM <= 0 {
+				return RutaTuberia{}, fmt.Errorf("%w: tramo %d curva con radio %.3f m", ErrRutaTuberiaInvalida, i+1, t.RadioM)
+			}
+		default:
+			return RutaTuberia{}, fmt.Errorf("%w: tramo %d con tipo %q (esperado RECTO o CURVA)", ErrRutaTuberiaInvalida, i+1, t.Tipo)
+		}
+		normalizados[i] = t
+	}
+
+	return RutaTuberia{Tramos: normalizados}, nil
+}
+
+// LongitudTotalM suma la longitud de los tramos rectos.
+func (r RutaTuberia) LongitudTotalM() float64 {
+	total := 0.0
+	for _, t := range r.Tramos {
+		if t.Tipo == TipoTramoRecto {
+			total += t.LongitudM
+		}
+	}
+	return total
+}
+
+// TotalCurvasGrados suma los ángulos de todas las curvas de la ruta.
+func (r RutaTuberia) TotalCurvasGrados() float64 {
+	total := 0.0
+	for _, t := range r.Tramos {
+		if t.Tipo == TipoTramoCurva {
+			total += t.AnguloGrados
+		}
+	}
+	return total
+}
+
+// ConfiguracionJalado es el acomodo de los conductores dentro del tubo durante el jalado.
+type ConfiguracionJalado string
+
+const (
+	ConfiguracionJaladoUnico      ConfiguracionJalado = "UNICO"      // un solo conductor
+	ConfiguracionJaladoTriangular ConfiguracionJalado = "TRIANGULAR" // 3 conductores en triángulo
+	ConfiguracionJaladoAcunado    ConfiguracionJalado = "ACUNADO"    // 3 conductores en cuna (cradle)
+	ConfiguracionJaladoMultiple   ConfiguracionJalado = "MULTIPLE"   // 2 o más de 3 conductores
+)
+
+// ResultadoTramoJalado holds the pulling tension at the end of one section and,
+// for bends, the sidewall bearing pressure.
+type ResultadoTramoJalado struct {
+	Numero              int
+	Tipo                TipoTramo
+	TensionEntradaN     float64
+	TensionSalidaN      float64
+	PresionLateralNPorM float64 // solo curvas: presión lateral sobre el conductor más cargado
+	CumplePresion       bool
+}
+
+// ResultadoTensionJalado is the pulling analysis of a route: tension per section,
+// sidewall pressure at bends and jam ratio for 3-conductor pulls.
+type ResultadoTensionJalado struct {
+	Configuracion                ConfiguracionJalado
+	FactorCorreccionPeso         float64 // w_c
+	CoeficienteFriccion          float64
+	PesoTotalNPorM               float64 // peso de todos los conductores jalados
+	Tramos                       []ResultadoTramoJalado
+	TensionFinalN                float64
+	TensionMaximaPermitidaN      float64
+	PresionLateralMaximaNPorM    float64 // mayor presión lateral calculada en la ruta
+	PresionLateralPermitidaNPorM float64
+	RelacionAtascamiento         float64 // 1.05 × D / d; 0 si no son 3 conductores
+	RiesgoAtascamiento           bool    // 2.8 ≤ relación ≤ 3.2
+	TotalCurvasGrados            float64
+	ExcedeCurvasNOM              bool // más de 360° entre puntos de jalado
+	CumpleTension                bool
+	CumplePresionLateral         bool
+	Cumple                       bool
+}
@@ -0,0 +1,39 @@
+// internal/calculos/domain/entity/ruta_tuberia_test.go
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRutaTuberia_Invalida(t *testing.T) {
+	_, err := entity.NewRutaTuberia(nil)
+	assert.ErrorIs(t, err, entity.ErrRutaTuberiaInvalida)
+
+	_, err = entity.NewRutaTuberia([]entity.TramoRuta{{Tipo: entity.TipoTramoCurva, AnguloGrados: 200, RadioM: 1}})
+	assert.ErrorIs(t, err, entity.ErrRutaTuberiaInvalida)
+
+	_, err = entity.NewRutaTuberia([]entity.TramoRuta{{Tipo: "diagonal", LongitudM: 1}})
+	assert.ErrorIs(t, err, entity.ErrRutaTuberiaInvalida)
+
+	_, err = entity.NewRutaTuberia([]entity.TramoRuta{{Tipo: entity.TipoTramoRecto, LongitudM: 0}})
+	assert.ErrorIs(t, err, entity.ErrRutaTuberiaInvalida)
+}
+
+func TestRutaTuberia_Totales(t *testing.T) {
+	ruta, err := entity.NewRutaTuberia([]entity.TramoRuta{
+		{Tipo: "recto", LongitudM: 12},
+		{Tipo: " curva ", AnguloGrados: 90, RadioM: 0.6},
+		{Tipo: entity.TipoTramoRecto, LongitudM: 8},
+		{Tipo: entity.TipoTramoCurva, AnguloGrados: 45, RadioM: 0.6},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, entity.TipoTramoRecto, ruta.Tramos[0].Tipo)
+	assert.Equal(t, entity.TipoTramoCurva, ruta.Tramos[1].Tipo)
+	assert.Equal(t, 20.0, ruta.LongitudTotalM())
+	assert.Equal(t, 135.0, ruta.TotalCurvasGrados())
+}
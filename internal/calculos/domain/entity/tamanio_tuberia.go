@@ -66,3 +66,14 @@ func (r ResultadoTamanioTuberia) TipoCanalizacion() TipoCanalizacion {
 func (r ResultadoTamanioTuberia) NumTuberias() int {
 	return r.numTuberias
 }
+
+// VerificacionAtascamiento is the jam-ratio check of a conduit with three equal
+// conductors. When the selected size falls in the jamming range the result is
+// stepped up to the next size that clears it.
+type VerificacionAtascamiento struct {
+	Resultado        ResultadoTamanioTuberia // tubo final (el original si no hubo ajuste)
+	TamanoOriginal   string                  // tubo seleccionado por área
+	RelacionOriginal float64                 // 1.05 × D / d del tubo original
+	Relacion         float64                 // 1.05 × D / d del tubo final
+	Ajustada         bool
+}
@@ -53,6 +53,20 @@ const (
 	// Tabla 9 resistance column: res_{material}_pvc (no metallic conduit effect)
 	// DMG factor: 1.0
 	TipoCanalizacionCharolaCableTriangular TipoCanalizacion = "CHAROLA_CABLE_TRIANGULAR"
+
+	// TipoCanalizacionDuctoSubterraneo represents a circuit in an underground duct bank
+	// (one PVC duct per circuit). No 60°C column — minimum column is 75°C.
+	// Ampacity table: Anexo B-310-7 → data/tablas_nom/b-310-7-ducto-subterraneo.csv
+	// Tabla 9 resistance column: res_{material}_pvc
+	// DMG factor: 1.0
+	TipoCanalizacionDuctoSubterraneo TipoCanalizacion = "DUCTO_SUBTERRANEO"
+
+	// TipoCanalizacionDirectamenteEnterrado represents cables buried directly in the
+	// soil, without conduit. No 60°C column — minimum column is 75°C.
+	// Ampacity table: Anexo B-310-10 → data/tablas_nom/b-310-10-directamente-enterrado.csv
+	// Tabla 9 resistance column: res_{material}_pvc (no metallic conduit effect)
+	// DMG factor: 1.0
+	TipoCanalizacionDirectamenteEnterrado TipoCanalizacion = "DIRECTAMENTE_ENTERRADO"
 )
 
 // ErrTipoCanalizacionInvalido is returned when an unknown TipoCanalizacion is provided.
@@ -66,7 +80,9 @@ func ValidarTipoCanalizacion(tc TipoCanalizacion) error {
 		TipoCanalizacionTuberiaAceroPG,
 		TipoCanalizacionTuberiaAceroPD,
 		TipoCanalizacionCharolaCableEspaciado,
-		TipoCanalizacionCharolaCableTriangular:
+		TipoCanalizacionCharolaCableTriangular,
+		TipoCanalizacionDuctoSubterraneo,
+		TipoCanalizacionDirectamenteEnterrado:
 		return nil
 	default:
 		return fmt.Errorf("%w: %q", ErrTipoCanalizacionInvalido, tc)
@@ -88,6 +104,10 @@ func ParseTipoCanalizacion(s string) (TipoCanalizacion, error) {
 		return TipoCanalizacionCharolaCableEspaciado, nil
 	case string(TipoCanalizacionCharolaCableTriangular):
 		return TipoCanalizacionCharolaCableTriangular, nil
+	case string(TipoCanalizacionDuctoSubterraneo):
+		return TipoCanalizacionDuctoSubterraneo, nil
+	case string(TipoCanalizacionDirectamenteEnterrado):
+		return TipoCanalizacionDirectamenteEnterrado, nil
 	default:
 		return "", fmt.Errorf("%w: %q", ErrTipoCanalizacionInvalido, s)
 	}
@@ -104,3 +124,48 @@ func (tc TipoCanalizacion) EsCharola() bool {
 		return false
 	}
 }
+
+// EsSubterranea returns true for underground installations (duct bank or direct burial).
+// Subterranean ampacity uses earth temperature, soil thermal resistivity and burial
+// depth instead of the ambient temperature of the state.
+func (tc TipoCanalizacion) EsSubterranea() bool {
+	switch tc {
+	case TipoCanalizacionDuctoSubterraneo,
+		TipoCanalizacionDirectamenteEnterrado:
+		return true
+	default:
+		return false
+	}
+}
+
+// RequiereTuberia returns true if the conductors are installed inside a conduit or
+// duct, so the conduit must be sized by fill (NOM Capítulo 10, Tabla 1).
+func (tc TipoCanalizacion) RequiereTuberia() bool {
+	return !tc.EsCharola() && tc != TipoCanalizacionDirectamenteEnterrado
+}
+
+// CanalizacionAmpacidadMulticonductor returns the canalization whose ampacity table,
+// Tabla 9 column and DMG apply to multiconductor cables (TC/MC) installed in tc.
+// In charola, multiconductor cables use Tabla 310-15(b)(16) (NOM 392-80(a)(1)) and
+// their conductors are bundled as in conduit, so the PVC conduit type is returned;
+// conduit and underground types are unchanged.
+func (tc TipoCanalizacion) CanalizacionAmpacidadMulticonductor() TipoCanalizacion {
+	if tc.EsCharola() {
+		return TipoCanalizacionTuberiaPVC
+	}
+	return tc
+}
+
+// ProfundidadMinimaMM returns the minimum cover in mm for underground installations
+// per NOM Tabla 300-5 (600 V or less, circuits without vehicular traffic):
+// 450 mm for PVC duct, 600 mm for direct burial. Returns 0 for above-ground types.
+func (tc TipoCanalizacion) ProfundidadMinimaMM() float64 {
+	switch tc {
+	case TipoCanalizacionDuctoSubterraneo:
+		return 450
+	case TipoCanalizacionDirectamenteEnterrado:
+		return 600
+	default:
+		return 0
+	}
+}
@@ -20,6 +20,8 @@ func TestParseTipoCanalizacion_validos(t *testing.T) {
 		{"TUBERIA_ACERO_PD", entity.TipoCanalizacionTuberiaAceroPD},
 		{"CHAROLA_CABLE_ESPACIADO", entity.TipoCanalizacionCharolaCableEspaciado},
 		{"CHAROLA_CABLE_TRIANGULAR", entity.TipoCanalizacionCharolaCableTriangular},
+		{"DUCTO_SUBTERRANEO", entity.TipoCanalizacionDuctoSubterraneo},
+		{"DIRECTAMENTE_ENTERRADO", entity.TipoCanalizacionDirectamenteEnterrado},
 	}
 	for _, c := range casos {
 		t.Run(c.input, func(t *testing.T) {
@@ -95,3 +97,30 @@ func TestTipoCanalizacion_valoresString(t *testing.T) {
 	assert.Equal(t, entity.TipoCanalizacion("CHAROLA_CABLE_ESPACIADO"), entity.TipoCanalizacionCharolaCableEspaciado)
 	assert.Equal(t, entity.TipoCanalizacion("CHAROLA_CABLE_TRIANGULAR"), entity.TipoCanalizacionCharolaCableTriangular)
 }
+
+func TestTipoCanalizacion_EsSubterranea(t *testing.T) {
+	assert.True(t, entity.TipoCanalizacionDuctoSubterraneo.EsSubterranea())
+	assert.True(t, entity.TipoCanalizacionDirectamenteEnterrado.EsSubterranea())
+	assert.False(t, entity.TipoCanalizacionTuberiaPVC.EsSubterranea())
+	assert.False(t, entity.TipoCanalizacionCharolaCableEspaciado.EsSubterranea())
+}
+
+func TestTipoCanalizacion_RequiereTuberia(t *testing.T) {
+	assert.True(t, entity.TipoCanalizacionTuberiaAceroPG.RequiereTuberia())
+	assert.True(t, entity.TipoCanalizacionDuctoSubterraneo.RequiereTuberia())
+	assert.False(t, entity.TipoCanalizacionDirectamenteEnterrado.RequiereTuberia())
+	assert.False(t, entity.TipoCanalizacionCharolaCableTriangular.RequiereTuberia())
+}
+
+func TestTipoCanalizacion_ProfundidadMinimaMM(t *testing.T) {
+	assert.Equal(t, 450.0, entity.TipoCanalizacionDuctoSubterraneo.ProfundidadMinimaMM())
+	assert.Equal(t, 600.0, entity.TipoCanalizacionDirectamenteEnterrado.ProfundidadMinimaMM())
+	assert.Equal(t, 0.0, entity.TipoCanalizacionTuberiaPVC.ProfundidadMinimaMM())
+}
+
+func TestTipoCanalizacion_CanalizacionAmpacidadMulticonductor(t *testing.T) {
+	assert.Equal(t, entity.TipoCanalizacionTuberiaPVC, entity.TipoCanalizacionCharolaCableEspaciado.CanalizacionAmpacidadMulticonductor())
+	assert.Equal(t, entity.TipoCanalizacionTuberiaPVC, entity.TipoCanalizacionCharolaCableTriangular.CanalizacionAmpacidadMulticonductor())
+	assert.Equal(t, entity.TipoCanalizacionTuberiaAceroPG, entity.TipoCanalizacionTuberiaAceroPG.CanalizacionAmpacidadMulticonductor())
+	assert.Equal(t, entity.TipoCanalizacionDuctoSubterraneo, entity.TipoCanalizacionDuctoSubterraneo.CanalizacionAmpacidadMulticonductor())
+}
@@ -0,0 +1,106 @@
+// internal/calculos/domain/entity/tipo_charola.go
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// TipoCharola identifies the cable tray construction. It determines which
+// NOM 392-22 fill rules apply and which commercial widths are available.
+type TipoCharola string
+
+const (
+	// TipoCharolaEscalera represents a ladder cable tray (also covers ventilated trough).
+	// Fill: Tabla 392-22(a) columnas 1/2 (multiconductores) y 392-22(b)(1) (monoconductores).
+	TipoCharolaEscalera TipoCharola = "ESCALERA"
+
+	// TipoCharolaFondoSolido represents a solid-bottom cable tray.
+	// Fill: Tabla 392-22(a) columnas 3/4; sum of diameters limited to 90% of the width.
+	TipoCharolaFondoSolido TipoCharola = "FONDO_SOLIDO"
+
+	// TipoCharolaCanal represents a ventilated channel cable tray (75, 100 or 150 mm).
+	// Fill: Tabla 392-22(a)(5) (multiconductores) y 392-22(b)(2) (monoconductores).
+	TipoCharolaCanal TipoCharola = "CANAL"
+)
+
+// Peraltes comerciales de charola tipo escalera y fondo sólido [mm] (3", 4" y 6").
+var PeraltesCharolaMM = []float64{76.2, 101.6, 152.4}
+
+// PeralteCharolaDefaultMM es el peralte usado cuando no se especifica (4").
+const PeralteCharolaDefaultMM = 101.6
+
+// ErrTipoCharolaInvalido is returned when an unknown TipoCharola is provided.
+var ErrTipoCharolaInvalido = errors.New("tipo de charola inválido")
+
+// ErrPeralteCharolaInvalido is returned when the tray depth is not a commercial option.
+var ErrPeralteCharolaInvalido = errors.New("peralte de charola inválido")
+
+// ValidarTipoCharola returns an error if t is not a recognized tray type.
+func ValidarTipoCharola(t TipoCharola) error {
+	switch t {
+	case TipoCharolaEscalera, TipoCharolaFondoSolido, TipoCharolaCanal:
+		return nil
+	default:
+		return fmt.Errorf("%w: '%s'", ErrTipoCharolaInvalido, t)
+	}
+}
+
+// ParseTipoCharola converts a string to TipoCharola. Empty string defaults to ESCALERA.
+func ParseTipoCharola(s string) (TipoCharola, error) {
+	if strings.TrimSpace(s) == "" {
+		return TipoCharolaEscalera, nil
+	}
+	t := TipoCharola(strings.ToUpper(strings.TrimSpace(s)))
+	if err := ValidarTipoCharola(t); err != nil {
+		return "", err
+	}
+	return t, nil
+}
+
+// FactorAnchoUtil returns the fraction of the tray width usable by the sum of cable
+// diameters: 0.90 for solid bottom (392-22(a)(3)), 1.0 otherwise.
+func (t TipoCharola) FactorAnchoUtil() float64 {
+	if t == TipoCharolaFondoSolido {
+		return 0.90
+	}
+	return 1.0
+}
+
+// ConstanteReduccionArea returns the mm² subtracted per mm of the sum of diameters of
+// the cables laid in a single layer when smaller multiconductor cables share the tray:
+// 30 (columna 2) for ladder and 25 (columna 4) for solid bottom, Tabla 392-22(a).
+func (t TipoCharola) ConstanteReduccionArea() float64 {
+	if t == TipoCharolaFondoSolido {
+		return 25
+	}
+	return 30
+}
+
+// EspecificacionCharola agrupa el tipo y el peralte de la charola seleccionada.
+type EspecificacionCharola struct {
+	Tipo      TipoCharola
+	PeralteMM float64 // profundidad útil; 0 en canal ventilado (no aplica)
+}
+
+// NewEspecificacionCharola validates the tray type and depth. Empty tipo = ESCALERA and
+// peralte 0 = 101.6 mm (4"). The depth must be a commercial option; it is ignored for CANAL.
+func NewEspecificacionCharola(tipo string, peralteMM float64) (EspecificacionCharola, error) {
+	t, err := ParseTipoCharola(tipo)
+	if err != nil {
+		return EspecificacionCharola{}, err
+	}
+	if t == TipoCharolaCanal {
+		return EspecificacionCharola{Tipo: t}, nil
+	}
+	if peralteMM == 0 {
+		return EspecificacionCharola{Tipo: t, PeralteMM: PeralteCharolaDefaultMM}, nil
+	}
+	for _, p := range PeraltesCharolaMM {
+		if peralteMM == p {
+			return EspecificacionCharola{Tipo: t, PeralteMM: peralteMM}, nil
+		}
+	}
+	return EspecificacionCharola{}, fmt.Errorf("%w: %.1f mm (opciones: %v)", ErrPeralteCharolaInvalido, peralteMM, PeraltesCharolaMM)
+}
@@ -0,0 +1,53 @@
+// internal/calculos/domain/entity/tipo_charola_test.go
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTipoCharola(t *testing.T) {
+	casos := []struct {
+		input    string
+		expected entity.TipoCharola
+	}{
+		{"", entity.TipoCharolaEscalera},
+		{"ESCALERA", entity.TipoCharolaEscalera},
+		{"fondo_solido", entity.TipoCharolaFondoSolido},
+		{"CANAL", entity.TipoCharolaCanal},
+	}
+	for _, c := range casos {
+		t.Run(c.input, func(t *testing.T) {
+			got, err := entity.ParseTipoCharola(c.input)
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, got)
+		})
+	}
+
+	_, err := entity.ParseTipoCharola("MALLA")
+	assert.ErrorIs(t, err, entity.ErrTipoCharolaInvalido)
+}
+
+func TestNewEspecificacionCharola(t *testing.T) {
+	esp, err := entity.NewEspecificacionCharola("", 0)
+	require.NoError(t, err)
+	assert.Equal(t, entity.TipoCharolaEscalera, esp.Tipo)
+	assert.Equal(t, entity.PeralteCharolaDefaultMM, esp.PeralteMM)
+
+	esp, err = entity.NewEspecificacionCharola("FONDO_SOLIDO", 152.4)
+	require.NoError(t, err)
+	assert.Equal(t, 152.4, esp.PeralteMM)
+	assert.Equal(t, 0.90, esp.Tipo.FactorAnchoUtil())
+	assert.Equal(t, 25.0, esp.Tipo.ConstanteReduccionArea())
+
+	// Canal ventilado: el peralte no aplica
+	esp, err = entity.NewEspecificacionCharola("CANAL", 101.6)
+	require.NoError(t, err)
+	assert.Zero(t, esp.PeralteMM)
+
+	_, err = entity.NewEspecificacionCharola("ESCALERA", 90)
+	assert.ErrorIs(t, err, entity.ErrPeralteCharolaInvalido)
+}
@@ -5,14 +5,16 @@ import "fmt"
 
 // TipoEquipo identifies the category of electrical equipment.
 // Using a named string type allows the domain to remain descriptive and
-// extensible: filters (active, rejection), boards, transformers, loads, etc.
+// extensible: filters (active, rejection), capacitor banks, transformers, loads, motors, etc.
 type TipoEquipo string
 
 const (
-	TipoEquipoFiltroActivo  TipoEquipo = "FILTRO_ACTIVO"
-	TipoEquipoFiltroRechazo TipoEquipo = "FILTRO_RECHAZO"
-	TipoEquipoTransformador TipoEquipo = "TRANSFORMADOR"
-	TipoEquipoCarga         TipoEquipo = "CARGA"
+	TipoEquipoFiltroActivo     TipoEquipo = "FILTRO_ACTIVO"
+	TipoEquipoFiltroRechazo    TipoEquipo = "FILTRO_RECHAZO"
+	TipoEquipoTransformador    TipoEquipo = "TRANSFORMADOR"
+	TipoEquipoCarga            TipoEquipo = "CARGA"
+	TipoEquipoMotor            TipoEquipo = "MOTOR"
+	TipoEquipoBancoCapacitores TipoEquipo = "BANCO_CAPACITORES"
 )
 
 // ParseTipoEquipo converts a string (e.g., from the database) to a TipoEquipo.
@@ -26,6 +28,10 @@ func ParseTipoEquipo(s string) (TipoEquipo, error) {
 		return TipoEquipoTransformador, nil
 	case string(TipoEquipoCarga):
 		return TipoEquipoCarga, nil
+	case string(TipoEquipoMotor):
+		return TipoEquipoMotor, nil
+	case string(TipoEquipoBancoCapacitores):
+		return TipoEquipoBancoCapacitores, nil
 	default:
 		return "", fmt.Errorf("%w: '%s'", ErrTipoEquipoInvalido, s)
 	}
@@ -21,6 +21,8 @@ func TestParseTipoEquipo(t *testing.T) {
 		{"FILTRO_RECHAZO valid", "FILTRO_RECHAZO", entity.TipoEquipoFiltroRechazo, false},
 		{"TRANSFORMADOR valid", "TRANSFORMADOR", entity.TipoEquipoTransformador, false},
 		{"CARGA valid", "CARGA", entity.TipoEquipoCarga, false},
+		{"MOTOR valid", "MOTOR", entity.TipoEquipoMotor, false},
+		{"BANCO_CAPACITORES valid", "BANCO_CAPACITORES", entity.TipoEquipoBancoCapacitores, false},
 		{"lowercase invalid", "filtro_activo", entity.TipoEquipo(""), true},
 		{"empty invalid", "", entity.TipoEquipo(""), true},
 		{"old ACTIVO invalid", "ACTIVO", entity.TipoEquipo(""), true},
@@ -46,4 +48,6 @@ func TestTipoEquipo_String(t *testing.T) {
 	assert.Equal(t, "FILTRO_RECHAZO", entity.TipoEquipoFiltroRechazo.String())
 	assert.Equal(t, "TRANSFORMADOR", entity.TipoEquipoTransformador.String())
 	assert.Equal(t, "CARGA", entity.TipoEquipoCarga.String())
+	assert.Equal(t, "MOTOR", entity.TipoEquipoMotor.String())
+	assert.Equal(t, "BANCO_CAPACITORES", entity.TipoEquipoBancoCapacitores.String())
 }
@@ -54,3 +54,17 @@ func (tr *Transformador) PotenciaKW() float64 {
 func (tr *Transformador) PotenciaKVAR() float64 {
 	return 0
 }
+
+// FactorK is the harmonic loading of a transformer (UL 1561 / IEEE C57.110).
+//
+//	K = Σ (I_h / I_R)² × h²
+//	I_max(pu) = √((1 + P_EC-R) / (1 + F_HL × P_EC-R))
+type FactorK struct {
+	K                 float64 // factor K de la carga
+	KNominal          int     // K-rating estándar inmediato superior; 0 si K excede el mayor estándar
+	FHL               float64 // factor de pérdidas armónicas por corrientes parásitas (IEEE C57.110)
+	PerdidasEC        float64 // P_EC-R: pérdidas por corrientes parásitas en devanados [pu de I²R]
+	CorrienteMaximaPU float64 // corriente de carga máxima admisible [pu de la nominal]
+	KVA               float64 // capacidad nominal del transformador
+	KVADerrateado     float64 // capacidad con la carga no lineal = KVA × I_max(pu)
+}
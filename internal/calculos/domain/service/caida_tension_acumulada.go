@@ -0,0 +1,97 @@
+// internal/calculos/domain/service/caida_tension_acumulada.go
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+)
+
+// LimiteCaidaTensionTotal es el límite combinado alimentador + circuito derivado
+// (acometida → carga más lejana) recomendado por NOM-001-SEDE-2012 Art. 215-2(A).
+const LimiteCaidaTensionTotal = 5.0
+
+// CalcularCaidaTensionAcumulada suma la caída aguas arriba (alimentador) con la caída
+// propia del tramo —calculada con CalcularCaidaTension— y la compara con el límite total.
+//
+//	%e_acumulada = %e_alimentador + %e_tramo ≤ límite total
+//
+// Si limiteTotal ≤ 0 se usa LimiteCaidaTensionTotal.
+func CalcularCaidaTensionAcumulada(caidaAlimentador, caidaPropia, limiteTotal float64) entity.ResultadoCaidaAcumulada {
+	if limiteTotal <= 0 {
+		limiteTotal = LimiteCaidaTensionTotal
+	}
+	acumulada := caidaAlimentador + caidaPropia
+
+	return entity.ResultadoCaidaAcumulada{
+		CaidaAlimentador: caidaAlimentador,
+		CaidaPropia:      caidaPropia,
+		CaidaAcumulada:   acumulada,
+		LimiteTotal:      limiteTotal,
+		Cumple:           acumulada <= limiteTotal,
+	}
+}
+
+// OrdenarTramosAlimentacion valida el árbol tablero → alimentador → derivados y devuelve:
+//   - orden: índices de los tramos en orden de cálculo, cada alimentador antes que sus
+//     derivados, respetando el orden original entre hermanos.
+//   - alimentadores: para cada tramo, el índice de su alimentador (-1 si es raíz).
+//
+// Errores: nombre vacío o duplicado, alimentador inexistente, tramo que se alimenta a sí
+// mismo o ciclo en la red.
+func OrdenarTramosAlimentacion(tramos []entity.TramoAlimentacion) (orden []int, alimentadores []int, err error) {
+	indice := make(map[string]int, len(tramos))
+	for i, t := range tramos {
+		clave := claveTramo(t.Nombre)
+		if clave == "" {
+			return nil, nil, fmt.Errorf("%w: tramo %d sin nombre", entity.ErrRedAlimentacionInvalida, i+1)
+		}
+		if _, existe := indice[clave]; existe {
+			return nil, nil, fmt.Errorf("%w: tramo duplicado '%s'", entity.ErrRedAlimentacionInvalida, t.Nombre)
+		}
+		indice[clave] = i
+	}
+
+	alimentadores = make([]int, len(tramos))
+	hijos := make(map[int][]int, len(tramos))
+	raices := make([]int, 0, len(tramos))
+	for i, t := range tramos {
+		alimentadores[i] = -1
+		if t.EsRaiz() {
+			raices = append(raices, i)
+			continue
+		}
+		padre, existe := indice[claveTramo(t.Alimentador)]
+		if !existe {
+			return nil, nil, fmt.Errorf("%w: el alimentador '%s' del tramo '%s' no existe",
+				entity.ErrRedAlimentacionInvalida, t.Alimentador, t.Nombre)
+		}
+		if padre == i {
+			return nil, nil, fmt.Errorf("%w: el tramo '%s' no puede alimentarse a sí mismo",
+				entity.ErrRedAlimentacionInvalida, t.Nombre)
+		}
+		alimentadores[i] = padre
+		hijos[padre] = append(hijos[padre], i)
+	}
+
+	// Recorrido en anchura desde la acometida: todo tramo no alcanzado está en un ciclo.
+	orden = make([]int, 0, len(tramos))
+	cola := raices
+	for len(cola) > 0 {
+		actual := cola[0]
+		cola = cola[1:]
+		orden = append(orden, actual)
+		cola = append(cola, hijos[actual]...)
+	}
+	if len(orden) != len(tramos) {
+		return nil, nil, fmt.Errorf("%w: ciclo en la red de alimentación", entity.ErrRedAlimentacionInvalida)
+	}
+
+	return orden, alimentadores, nil
+}
+
+// claveTramo normaliza el nombre para comparar sin distinguir mayúsculas ni espacios.
+func claveTramo(nombre string) string {
+	return strings.ToUpper(strings.TrimSpace(nombre))
+}
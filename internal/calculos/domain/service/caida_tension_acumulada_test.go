@@ -0,0 +1,83 @@
+// internal/calculos/domain/service/caida_tension_acumulada_test.go
+package service_test
+
+import (
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalcularCaidaTensionAcumulada(t *testing.T) {
+	t.Run("alimentador + derivado dentro del límite", func(t *testing.T) {
+		r := service.CalcularCaidaTensionAcumulada(2.0, 2.5, 5.0)
+		assert.InDelta(t, 4.5, r.CaidaAcumulada, 1e-9)
+		assert.Equal(t, 2.0, r.CaidaAlimentador)
+		assert.Equal(t, 2.5, r.CaidaPropia)
+		assert.True(t, r.Cumple)
+	})
+
+	t.Run("cada tramo cumple 3% pero el total excede 5%", func(t *testing.T) {
+		r := service.CalcularCaidaTensionAcumulada(2.8, 2.9, 5.0)
+		assert.InDelta(t, 5.7, r.CaidaAcumulada, 1e-9)
+		assert.False(t, r.Cumple)
+	})
+
+	t.Run("límite no definido usa 5%", func(t *testing.T) {
+		r := service.CalcularCaidaTensionAcumulada(0, 4.9, 0)
+		assert.Equal(t, service.LimiteCaidaTensionTotal, r.LimiteTotal)
+		assert.True(t, r.Cumple)
+	})
+}
+
+func TestOrdenarTramosAlimentacion(t *testing.T) {
+	t.Run("alimentadores antes que sus derivados", func(t *testing.T) {
+		tramos := []entity.TramoAlimentacion{
+			{Nombre: "D-1", Alimentador: "AL-1"},
+			{Nombre: "AL-1"},
+			{Nombre: "D-2", Alimentador: "al-1"},
+			{Nombre: "D-1.1", Alimentador: "D-1"},
+			{Nombre: "FA-01"},
+		}
+
+		orden, alimentadores, err := service.OrdenarTramosAlimentacion(tramos)
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 4, 0, 2, 3}, orden)
+		assert.Equal(t, []int{1, -1, 1, 0, -1}, alimentadores)
+	})
+
+	t.Run("alimentador inexistente", func(t *testing.T) {
+		_, _, err := service.OrdenarTramosAlimentacion([]entity.TramoAlimentacion{
+			{Nombre: "D-1", Alimentador: "AL-9"},
+		})
+		assert.ErrorIs(t, err, entity.ErrRedAlimentacionInvalida)
+		assert.Contains(t, err.Error(), "AL-9")
+	})
+
+	t.Run("tramo que se alimenta a sí mismo", func(t *testing.T) {
+		_, _, err := service.OrdenarTramosAlimentacion([]entity.TramoAlimentacion{
+			{Nombre: "AL-1", Alimentador: "AL-1"},
+		})
+		assert.ErrorIs(t, err, entity.ErrRedAlimentacionInvalida)
+	})
+
+	t.Run("ciclo en la red", func(t *testing.T) {
+		_, _, err := service.OrdenarTramosAlimentacion([]entity.TramoAlimentacion{
+			{Nombre: "TG"},
+			{Nombre: "A", Alimentador: "B"},
+			{Nombre: "B", Alimentador: "A"},
+		})
+		assert.ErrorIs(t, err, entity.ErrRedAlimentacionInvalida)
+		assert.Contains(t, err.Error(), "ciclo")
+	})
+
+	t.Run("nombres duplicados", func(t *testing.T) {
+		_, _, err := service.OrdenarTramosAlimentacion([]entity.TramoAlimentacion{
+			{Nombre: "AL-1"},
+			{Nombre: "al-1 "},
+		})
+		assert.ErrorIs(t, err, entity.ErrRedAlimentacionInvalida)
+	})
+}
@@ -13,6 +13,7 @@ var ErrCharolaNoEncontrada = errors.New("no se encontró charola suficiente")
 
 // CalcularCharolaEspaciado calcula el ancho requerido de charola para cables espaciados.
 // Recibe value objects del dominio para representar conductores y cables de control.
+// El neutro se considera del mismo diámetro que la fase.
 
 func CalcularCharolaEspaciado(
 	hilosPorFase int,
@@ -22,28 +23,115 @@ func CalcularCharolaEspaciado(
 	tablaCharola []valueobject.EntradaTablaCanalizacion,
 	cablesControl []valueobject.CableControl,
 ) (entity.Canalizacion, error) {
-	if hilosPorFase < 1 {
-		return entity.Canalizacion{}, fmt.Errorf("CalcularCharolaEspaciado: %w", ErrHilosPorFaseInvalido)
+	return CalcularCharolaEspaciadoConNeutro(hilosPorFase, sistema, conductorFase, conductorFase, conductorTierra, tablaCharola, cablesControl)
+}
+
+// CalcularCharolaEspaciadoConNeutro calcula el ancho requerido de charola para cables
+// espaciados cuando el neutro tiene un calibre distinto al de la fase.
+func CalcularCharolaEspaciadoConNeutro(
+	hilosPorFase int,
+	sistema entity.SistemaElectrico,
+	conductorFase valueobject.ConductorCharola,
+	conductorNeutro valueobject.ConductorCharola,
+	conductorTierra valueobject.ConductorCharola,
+	tablaCharola []valueobject.EntradaTablaCanalizacion,
+	cablesControl []valueobject.CableControl,
+) (entity.Canalizacion, error) {
+	anchoRequerido, err := anchoRequeridoEspaciado(hilosPorFase, sistema, conductorFase, conductorNeutro, conductorTierra, cablesControl)
+	if err != nil {
+		return entity.Canalizacion{}, err
 	}
 
-	// Determinar numero de fases segun el tipo de sistema
-	var numFases int
-	var tieneNeutro bool
+	// El valor de la tabla es el ancho directo en mm
+	for _, entrada := range tablaCharola {
+		anchoCharolaMM := entrada.AreaInteriorMM2
+		if anchoCharolaMM >= anchoRequerido {
+			return entity.Canalizacion{
+				Tipo:             entity.TipoCanalizacionCharolaCableEspaciado,
+				Tamano:           entrada.Tamano,
+				AnchoRequerido:   anchoRequerido,
+				AnchoComercialMM: anchoCharolaMM,
+			}, nil
+		}
+	}
+
+	return entity.Canalizacion{}, fmt.Errorf("CalcularCharolaEspaciado: %w", ErrCharolaNoEncontrada)
+}
+
+// CalcularCharolaEspaciadoConLlenado selecciona el primer ancho del tipo de charola
+// indicado que cubre el ancho requerido por el espaciado y cumple el llenado NOM 392-22.
+func CalcularCharolaEspaciadoConLlenado(
+	hilosPorFase int,
+	sistema entity.SistemaElectrico,
+	conductorFase valueobject.ConductorCharola,
+	conductorNeutro valueobject.ConductorCharola,
+	conductorTierra valueobject.ConductorCharola,
+	cablesControl []valueobject.CableControl,
+	criterio CriterioLlenadoCharola,
+) (entity.Canalizacion, ResultadoLlenadoCharola, error) {
+	anchoRequerido, err := anchoRequeridoEspaciado(hilosPorFase, sistema, conductorFase, conductorNeutro, conductorTierra, cablesControl)
+	if err != nil {
+		return entity.Canalizacion{}, ResultadoLlenadoCharola{}, err
+	}
+
+	numFases, tieneNeutro, _ := fasesYNeutro(sistema)
+	monoconductores := []GrupoMonoconductores{
+		{Conductor: conductorFase, Cantidad: numFases * hilosPorFase},
+		{Conductor: conductorTierra, Cantidad: 1},
+	}
+	if tieneNeutro {
+		monoconductores = append(monoconductores, GrupoMonoconductores{Conductor: conductorNeutro, Cantidad: hilosPorFase})
+	}
+
+	canalizacion, llenado, err := seleccionarCharolaConLlenado(
+		entity.TipoCanalizacionCharolaCableEspaciado,
+		anchoRequerido,
+		criterio,
+		monoconductores,
+		nil,
+		cablesControl,
+		ErrCharolaNoEncontrada,
+	)
+	if err != nil {
+		return entity.Canalizacion{}, ResultadoLlenadoCharola{}, fmt.Errorf("CalcularCharolaEspaciado: %w", err)
+	}
+	return canalizacion, llenado, nil
+}
+
+// fasesYNeutro retorna el número de fases y si el sistema lleva neutro.
+func fasesYNeutro(sistema entity.SistemaElectrico) (int, bool, error) {
 	switch sistema {
 	case entity.SistemaElectricoMonofasico:
-		numFases = 1
-		tieneNeutro = true
+		return 1, true, nil
 	case entity.SistemaElectricoBifasico:
-		numFases = 2
-		tieneNeutro = true
+		return 2, true, nil
 	case entity.SistemaElectricoDelta:
-		numFases = 3
-		tieneNeutro = false
+		return 3, false, nil
 	case entity.SistemaElectricoEstrella:
-		numFases = 3
-		tieneNeutro = true
+		return 3, true, nil
 	default:
-		return entity.Canalizacion{}, fmt.Errorf("CalcularCharolaEspaciado: %w", fmt.Errorf("sistema eléctrico no válido: %v", sistema))
+		return 0, false, fmt.Errorf("sistema eléctrico no válido: %v", sistema)
+	}
+}
+
+// anchoRequeridoEspaciado calcula el ancho de charola para cables espaciados:
+// EF + ancho_fuerza + EC + ancho_control + tierra.
+func anchoRequeridoEspaciado(
+	hilosPorFase int,
+	sistema entity.SistemaElectrico,
+	conductorFase valueobject.ConductorCharola,
+	conductorNeutro valueobject.ConductorCharola,
+	conductorTierra valueobject.ConductorCharola,
+	cablesControl []valueobject.CableControl,
+) (float64, error) {
+	if hilosPorFase < 1 {
+		return 0, fmt.Errorf("CalcularCharolaEspaciado: %w", ErrHilosPorFaseInvalido)
+	}
+
+	// Determinar numero de fases segun el tipo de sistema
+	numFases, tieneNeutro, err := fasesYNeutro(sistema)
+	if err != nil {
+		return 0, fmt.Errorf("CalcularCharolaEspaciado: %w", err)
 	}
 
 	// Calcular hilos de fase y neutro multiplicando por hilosPorFase (conductores en paralelo)
@@ -54,7 +142,8 @@ func CalcularCharolaEspaciado(
 		hilosNeutro = hilosPorFase // El neutro se multiplica igual que las fases
 	}
 
-	totalHilos := hilosFaseTotal + hilosNeutro
+	// Suma de diámetros de los conductores de fuerza (fases + neutro)
+	diametrosFuerza := float64(hilosFaseTotal)*conductorFase.DiametroMM() + float64(hilosNeutro)*conductorNeutro.DiametroMM()
 
 	// Calcular ancho para cables en charola con espaciado
 	// Formula: EF + ancho_fuerza + EC + ancho_control + tierra
@@ -63,8 +152,8 @@ func CalcularCharolaEspaciado(
 	// ancho_fuerza = total_hilos * diametro_fase
 	// ancho_control = diametro_control
 
-	// Espacio fuerza = cantidad de fases * diametro fase
-	espacioFuerza := float64(totalHilos) * conductorFase.DiametroMM()
+	// Espacio fuerza = suma de diámetros de fases y neutro
+	espacioFuerza := diametrosFuerza
 
 	// Espacio control = 2 * diametro control (uno a cada lado)
 	var espacioControl float64
@@ -76,24 +165,11 @@ func CalcularCharolaEspaciado(
 		}
 	}
 
-	// Ancho fuerza = total_hilos * diametro fase
-	anchoFuerza := float64(totalHilos) * conductorFase.DiametroMM()
+	// Ancho fuerza = suma de diámetros de fases y neutro
+	anchoFuerza := diametrosFuerza
 
 	// Ancho total = EF + ancho_fuerza + EC + ancho_control + tierra
 	anchoRequerido := espacioFuerza + anchoFuerza + espacioControl + anchoControl + conductorTierra.DiametroMM()
 
-	// El valor de la tabla es el ancho directo en mm
-	for _, entrada := range tablaCharola {
-		anchoCharolaMM := entrada.AreaInteriorMM2
-		if anchoCharolaMM >= anchoRequerido {
-			return entity.Canalizacion{
-				Tipo:             entity.TipoCanalizacionCharolaCableEspaciado,
-				Tamano:           entrada.Tamano,
-				AnchoRequerido:   anchoRequerido,
-				AnchoComercialMM: anchoCharolaMM,
-			}, nil
-		}
-	}
-
-	return entity.Canalizacion{}, fmt.Errorf("CalcularCharolaEspaciado: %w", ErrCharolaNoEncontrada)
+	return anchoRequerido, nil
 }
@@ -190,3 +190,29 @@ func TestCalcularCharolaEspaciado(t *testing.T) {
 		assert.Equal(t, anchoRequerido, result.AnchoRequerido)
 	})
 }
+
+func TestCalcularCharolaEspaciadoConNeutro(t *testing.T) {
+	tablaCharola := []valueobject.EntradaTablaCanalizacion{
+		{Tamano: "6", AreaInteriorMM2: 152.4},
+		{Tamano: "9", AreaInteriorMM2: 228.6},
+		{Tamano: "12", AreaInteriorMM2: 304.8},
+	}
+	conductorFase, _ := valueobject.NewConductorCharola(valueobject.ConductorCharolaParams{DiametroMM: 20.0})
+	conductorNeutro, _ := valueobject.NewConductorCharola(valueobject.ConductorCharolaParams{DiametroMM: 30.0})
+	conductorTierra, _ := valueobject.NewConductorCharola(valueobject.ConductorCharolaParams{DiametroMM: 8.0})
+
+	result, err := service.CalcularCharolaEspaciadoConNeutro(
+		1,
+		entity.SistemaElectricoEstrella,
+		conductorFase,
+		conductorNeutro,
+		conductorTierra,
+		tablaCharola,
+		nil,
+	)
+
+	require.NoError(t, err)
+	// Fuerza: 3 × 20 + 1 × 30 = 90 mm → 2 × 90 + 8 = 188 mm → 9"
+	assert.InDelta(t, 188.0, result.AnchoRequerido, 0.001)
+	assert.Equal(t, "9", result.Tamano)
+}
@@ -0,0 +1,79 @@
+// internal/calculos/domain/service/calcular_charola_multiconductor.go
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+)
+
+// ErrNumeroCablesInvalido is returned when the number of multiconductor cables is less than 1.
+var ErrNumeroCablesInvalido = errors.New("el número de cables multiconductores debe ser mayor a cero")
+
+// CalcularCharolaMulticonductorConLlenado selecciona el primer ancho del tipo de charola
+// indicado que cubre el ancho requerido por numCables cables multiconductores de fuerza
+// iguales y cumple el llenado NOM 392-22. La tierra va dentro del cable, por lo que no
+// se suma un conductor de tierra aparte.
+func CalcularCharolaMulticonductorConLlenado(
+	tipoCanalizacion entity.TipoCanalizacion,
+	numCables int,
+	cable valueobject.CableMulticonductor,
+	cablesControl []valueobject.CableControl,
+	criterio CriterioLlenadoCharola,
+) (entity.Canalizacion, ResultadoLlenadoCharola, error) {
+	anchoRequerido, err := anchoRequeridoMulticonductor(tipoCanalizacion, numCables, cable, cablesControl)
+	if err != nil {
+		return entity.Canalizacion{}, ResultadoLlenadoCharola{}, err
+	}
+
+	canalizacion, llenado, err := seleccionarCharolaConLlenado(
+		tipoCanalizacion,
+		anchoRequerido,
+		criterio,
+		nil,
+		[]GrupoCablesMulticonductor{{Cable: cable, Cantidad: numCables}},
+		cablesControl,
+		ErrCharolaNoEncontrada,
+	)
+	if err != nil {
+		return entity.Canalizacion{}, ResultadoLlenadoCharola{}, fmt.Errorf("CalcularCharolaMulticonductor: %w", err)
+	}
+	return canalizacion, llenado, nil
+}
+
+// anchoRequeridoMulticonductor calcula el ancho de charola para cables multiconductores:
+// espaciados, cada cable deja un diámetro libre (2·n·d); juntos, n·d. Los cables de
+// control se suman igual que en las disposiciones de monoconductores.
+func anchoRequeridoMulticonductor(
+	tipoCanalizacion entity.TipoCanalizacion,
+	numCables int,
+	cable valueobject.CableMulticonductor,
+	cablesControl []valueobject.CableControl,
+) (float64, error) {
+	if numCables < 1 {
+		return 0, fmt.Errorf("CalcularCharolaMulticonductor: %w", ErrNumeroCablesInvalido)
+	}
+
+	anchoFuerza := float64(numCables) * cable.DiametroMM()
+	switch tipoCanalizacion {
+	case entity.TipoCanalizacionCharolaCableEspaciado:
+		anchoFuerza *= 2
+	case entity.TipoCanalizacionCharolaCableTriangular:
+	default:
+		return 0, fmt.Errorf("CalcularCharolaMulticonductor: tipo de canalización no válido para charola: %s", tipoCanalizacion)
+	}
+
+	// Espacio y ancho de control (a ambos lados)
+	var espacioControl float64
+	var anchoControl float64
+	for _, cableControl := range cablesControl {
+		if cableControl.Cantidad() > 0 && cableControl.DiametroMM() > 0 {
+			espacioControl += cableControl.DiametroMM()
+			anchoControl += cableControl.DiametroMM()
+		}
+	}
+
+	return anchoFuerza + espacioControl + anchoControl, nil
+}
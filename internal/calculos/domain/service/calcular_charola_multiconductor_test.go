@@ -0,0 +1,76 @@
+// internal/calculos/domain/service/calcular_charola_multiconductor_test.go
+package service_test
+
+import (
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/service"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func cableMulticonductor(t *testing.T, calibre string, seccion, diametro float64) valueobject.CableMulticonductor {
+	t.Helper()
+	cable, err := valueobject.NewCableMulticonductor(valueobject.CableMulticonductorParams{
+		Tipo: valueobject.CableTC, Calibre: calibre, SeccionMM2: seccion, NumConductores: 3, DiametroExteriorMM: diametro,
+	})
+	require.NoError(t, err)
+	return cable
+}
+
+func TestCalcularCharolaMulticonductorConLlenado_4_0EnUnaCapa(t *testing.T) {
+	// 3 cables TC 3C 4/0 AWG (Ø42.2 mm): una sola capa, Σd = 126.6 mm
+	cable := cableMulticonductor(t, "4/0 AWG", 107.2, 42.2)
+
+	t.Run("espaciados: 2·3·42.2 = 253.2 mm → 12\"", func(t *testing.T) {
+		result, llenado, err := service.CalcularCharolaMulticonductorConLlenado(
+			entity.TipoCanalizacionCharolaCableEspaciado, 3, cable, nil,
+			service.CriterioLlenadoCharola{Especificacion: especificacion(t, "ESCALERA"), Tabla: llenadoEscalera})
+		require.NoError(t, err)
+		assert.Equal(t, "12", result.Tamano)
+		assert.InDelta(t, 253.2, result.AnchoRequerido, 0.01)
+		assert.InDelta(t, 126.6, llenado.SumaDiametrosMM, 0.01)
+		assert.Zero(t, llenado.AreaMulticonductoresMM2)
+	})
+
+	t.Run("juntos en fondo sólido: 126.6 mm ≤ 0.9·152.4 → 6\"", func(t *testing.T) {
+		result, llenado, err := service.CalcularCharolaMulticonductorConLlenado(
+			entity.TipoCanalizacionCharolaCableTriangular, 3, cable, nil,
+			service.CriterioLlenadoCharola{Especificacion: especificacion(t, "FONDO_SOLIDO"), Tabla: llenadoFondoSolido})
+		require.NoError(t, err)
+		assert.Equal(t, "6", result.Tamano)
+		assert.True(t, llenado.Cumple)
+	})
+}
+
+func TestCalcularCharolaMulticonductorConLlenado_MenoresA4_0PorArea(t *testing.T) {
+	// 6 cables Ø25 mm juntos: 150 mm de ancho, 6·π·25²/4 = 2945.2 mm²
+	cable := cableMulticonductor(t, "2 AWG", 33.6, 25)
+
+	result, llenado, err := service.CalcularCharolaMulticonductorConLlenado(
+		entity.TipoCanalizacionCharolaCableTriangular, 6, cable, nil,
+		service.CriterioLlenadoCharola{Especificacion: especificacion(t, "ESCALERA"), Tabla: llenadoEscalera})
+	require.NoError(t, err)
+	assert.Equal(t, "6", result.Tamano)
+	assert.InDelta(t, 2945.2, llenado.AreaMulticonductoresMM2, 0.1)
+	assert.InDelta(t, 4500.0, llenado.AreaMulticonductoresPermitidaMM2, 0.01)
+
+	// Canal 6": 2945.2 mm² > 2450 mm²
+	_, _, err = service.CalcularCharolaMulticonductorConLlenado(
+		entity.TipoCanalizacionCharolaCableTriangular, 6, cable, nil,
+		service.CriterioLlenadoCharola{Especificacion: especificacion(t, "CANAL"), Tabla: llenadoCanal})
+	assert.ErrorIs(t, err, service.ErrCharolaNoEncontrada)
+}
+
+func TestCalcularCharolaMulticonductorConLlenado_Invalido(t *testing.T) {
+	cable := cableMulticonductor(t, "4/0 AWG", 107.2, 42.2)
+	criterio := service.CriterioLlenadoCharola{Especificacion: especificacion(t, "ESCALERA"), Tabla: llenadoEscalera}
+
+	_, _, err := service.CalcularCharolaMulticonductorConLlenado(entity.TipoCanalizacionCharolaCableEspaciado, 0, cable, nil, criterio)
+	assert.ErrorIs(t, err, service.ErrNumeroCablesInvalido)
+
+	_, _, err = service.CalcularCharolaMulticonductorConLlenado(entity.TipoCanalizacionTuberiaPVC, 1, cable, nil, criterio)
+	assert.Error(t, err)
+}
@@ -27,13 +27,77 @@ func CalcularCharolaTriangular(
 	tablaCharola []valueobject.EntradaTablaCanalizacion,
 	cablesControl []valueobject.CableControl,
 ) (entity.Canalizacion, error) {
-	if hilosPorFase < 1 {
-		return entity.Canalizacion{}, fmt.Errorf("CalcularCharolaTriangular: %w", ErrHilosPorFaseInvalido)
+	anchoRequerido, err := anchoRequeridoTriangular(hilosPorFase, conductorFase, conductorTierra, cablesControl)
+	if err != nil {
+		return entity.Canalizacion{}, err
 	}
 	if len(tablaCharola) == 0 {
 		return entity.Canalizacion{}, fmt.Errorf("CalcularCharolaTriangular: %w", ErrTablaCharolaVacia)
 	}
 
+	// Seleccionar charola por ancho
+	for _, entrada := range tablaCharola {
+		anchoCharolaMM := obtenerAnchoCharola(entrada)
+		if anchoCharolaMM >= anchoRequerido {
+			return entity.Canalizacion{
+				Tipo:             entity.TipoCanalizacionCharolaCableTriangular,
+				Tamano:           entrada.Tamano,
+				AnchoRequerido:   anchoRequerido,
+				AnchoComercialMM: anchoCharolaMM,
+			}, nil
+		}
+	}
+
+	return entity.Canalizacion{}, fmt.Errorf("CalcularCharolaTriangular: %w", ErrCharolaTriangularNoEncontrada)
+}
+
+// CalcularCharolaTriangularConLlenado selecciona el primer ancho del tipo de charola
+// indicado que cubre el ancho requerido por la disposición triangular y cumple el
+// llenado NOM 392-22. Cada grupo triangular lleva tres monoconductores de fase.
+func CalcularCharolaTriangularConLlenado(
+	hilosPorFase int,
+	conductorFase valueobject.ConductorCharola,
+	conductorTierra valueobject.ConductorCharola,
+	cablesControl []valueobject.CableControl,
+	criterio CriterioLlenadoCharola,
+) (entity.Canalizacion, ResultadoLlenadoCharola, error) {
+	anchoRequerido, err := anchoRequeridoTriangular(hilosPorFase, conductorFase, conductorTierra, cablesControl)
+	if err != nil {
+		return entity.Canalizacion{}, ResultadoLlenadoCharola{}, err
+	}
+
+	monoconductores := []GrupoMonoconductores{
+		{Conductor: conductorFase, Cantidad: 3 * hilosPorFase},
+		{Conductor: conductorTierra, Cantidad: 1},
+	}
+
+	canalizacion, llenado, err := seleccionarCharolaConLlenado(
+		entity.TipoCanalizacionCharolaCableTriangular,
+		anchoRequerido,
+		criterio,
+		monoconductores,
+		nil,
+		cablesControl,
+		ErrCharolaTriangularNoEncontrada,
+	)
+	if err != nil {
+		return entity.Canalizacion{}, ResultadoLlenadoCharola{}, fmt.Errorf("CalcularCharolaTriangular: %w", err)
+	}
+	return canalizacion, llenado, nil
+}
+
+// anchoRequeridoTriangular calcula el ancho de charola para la disposición triangular:
+// potencia + espacio fuerza + control + tierra.
+func anchoRequeridoTriangular(
+	hilosPorFase int,
+	conductorFase valueobject.ConductorCharola,
+	conductorTierra valueobject.ConductorCharola,
+	cablesControl []valueobject.CableControl,
+) (float64, error) {
+	if hilosPorFase < 1 {
+		return 0, fmt.Errorf("CalcularCharolaTriangular: %w", ErrHilosPorFaseInvalido)
+	}
+
 	// factorTriangular: factor de espaciado NOM-001-SEDE para disposición triangular de cables en charola.
 	const factorTriangular = 2.15
 	// Calcular ancho requerido para charola triangular
@@ -54,18 +118,5 @@ func CalcularCharolaTriangular(
 	// Ancho total = potencia + espacio fuerza + control + tierra
 	anchoRequerido := anchoPotencia + espacioFuerza + espacioControl + anchoControl + conductorTierra.DiametroMM()
 
-	// Seleccionar charola por ancho
-	for _, entrada := range tablaCharola {
-		anchoCharolaMM := obtenerAnchoCharola(entrada)
-		if anchoCharolaMM >= anchoRequerido {
-			return entity.Canalizacion{
-				Tipo:             entity.TipoCanalizacionCharolaCableTriangular,
-				Tamano:           entrada.Tamano,
-				AnchoRequerido:   anchoRequerido,
-				AnchoComercialMM: anchoCharolaMM,
-			}, nil
-		}
-	}
-
-	return entity.Canalizacion{}, fmt.Errorf("CalcularCharolaTriangular: %w", ErrCharolaTriangularNoEncontrada)
+	return anchoRequerido, nil
 }
@@ -3,7 +3,9 @@ package service
 
 import (
 	"fmt"
+	"math"
 
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
 	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
 )
 
@@ -57,3 +59,20 @@ func rangoContiene(rango string, temp int) bool {
 	}
 	return false
 }
+
+// CalcularFactorTemperaturaTerreno retorna el factor de corrección de las tablas
+// subterráneas del Anexo B (referidas a 20 °C de terreno) para otra temperatura
+// del terreno (NOM 310-15(b)(2), fórmula de corrección):
+//
+//	F = √((Tc − Ta) / (Tc − 20))
+func CalcularFactorTemperaturaTerreno(
+	tempTerreno int,
+	tempConductor valueobject.Temperatura,
+) (float64, error) {
+	tc := float64(tempConductor.Valor())
+	ta := float64(tempTerreno)
+	if ta >= tc {
+		return 0, fmt.Errorf("CalcularFactorTemperaturaTerreno: temperatura del terreno %d°C no es menor a la del conductor %d°C", tempTerreno, tempConductor.Valor())
+	}
+	return math.Sqrt((tc - ta) / (tc - float64(entity.TemperaturaTerrenoReferencia))), nil
+}
@@ -55,3 +55,30 @@ func TestCalcularFactorTemperatura(t *testing.T) {
 		})
 	}
 }
+
+func TestCalcularFactorTemperaturaTerreno(t *testing.T) {
+	tests := []struct {
+		name           string
+		tempTerreno    int
+		tempConductor  valueobject.Temperatura
+		expectedFactor float64
+		wantErr        bool
+	}{
+		{"20°C referencia", 20, valueobject.Temp75, 1.00, false},
+		{"30°C + 75C conductor", 30, valueobject.Temp75, 0.9045, false},
+		{"10°C + 90C conductor", 10, valueobject.Temp90, 1.0690, false},
+		{"terreno sobre conductor", 80, valueobject.Temp75, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			factor, err := service.CalcularFactorTemperaturaTerreno(tt.tempTerreno, tt.tempConductor)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.InDelta(t, tt.expectedFactor, factor, 0.001)
+		})
+	}
+}
@@ -10,15 +10,16 @@ import (
 // CalcularFactorUso retorna el factor de uso según el tipo de equipo.
 //
 // Factores según normativa NOM:
-//   - FILTRO_ACTIVO, FILTRO_RECHAZO → 1.35
+//   - FILTRO_ACTIVO, FILTRO_RECHAZO, BANCO_CAPACITORES → 1.35 (NOM 460-8)
 //   - TRANSFORMADOR, CARGA → 1.25
+//   - MOTOR → 1.25 (NOM 430-22, conductor al 125 % de la corriente a plena carga)
 //
 // Retorna error si el tipo de equipo no es válido.
 func CalcularFactorUso(tipoEquipo entity.TipoEquipo) (float64, error) {
 	switch tipoEquipo {
-	case entity.TipoEquipoFiltroActivo, entity.TipoEquipoFiltroRechazo:
+	case entity.TipoEquipoFiltroActivo, entity.TipoEquipoFiltroRechazo, entity.TipoEquipoBancoCapacitores:
 		return 1.35, nil
-	case entity.TipoEquipoTransformador, entity.TipoEquipoCarga:
+	case entity.TipoEquipoTransformador, entity.TipoEquipoCarga, entity.TipoEquipoMotor:
 		return 1.25, nil
 	default:
 		return 0, fmt.Errorf("CalcularFactorUso: %w: '%s'", entity.ErrTipoEquipoInvalido, tipoEquipo)
@@ -28,6 +28,12 @@ func TestCalcularFactorUso(t *testing.T) {
 			expectedValue: 1.35,
 			wantErr:       false,
 		},
+		{
+			name:          "BANCO_CAPACITORES retorna 1.35",
+			tipoEquipo:    entity.TipoEquipoBancoCapacitores,
+			expectedValue: 1.35,
+			wantErr:       false,
+		},
 		{
 			name:          "TRANSFORMADOR retorna 1.25",
 			tipoEquipo:    entity.TipoEquipoTransformador,
@@ -40,6 +46,12 @@ func TestCalcularFactorUso(t *testing.T) {
 			expectedValue: 1.25,
 			wantErr:       false,
 		},
+		{
+			name:          "MOTOR retorna 1.25",
+			tipoEquipo:    entity.TipoEquipoMotor,
+			expectedValue: 1.25,
+			wantErr:       false,
+		},
 		{
 			name:          "Tipo de equipo inválido retorna error",
 			tipoEquipo:    "TIPO_INVALIDO",
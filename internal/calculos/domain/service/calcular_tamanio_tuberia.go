@@ -146,6 +146,61 @@ func CalcularTamanioTuberiaWithMultiplePipes(
 	)
 }
 
+// CalcularTamanioTuberiaMulticonductor sizes the conduit for multiconductor cables (TC/MC).
+// Each cable counts as a single conductor of its overall diameter (NOM Capítulo 10,
+// Tabla 1, Nota 9), so the fill factor depends on the number of cables per tube:
+// 53 % for one, 31 % for two and 40 % for three or more. The factory ground is inside
+// the cable and is not added to the fill.
+func CalcularTamanioTuberiaMulticonductor(
+	cable valueobject.CableMulticonductor,
+	cablesPorTubo int,
+	numTuberias int,
+	tipoCanalizacion entity.TipoCanalizacion,
+	tablaOcupacion []valueobject.EntradaTablaOcupacion,
+) (entity.ResultadoTamanioTuberia, error) {
+	if numTuberias < 1 {
+		return entity.ResultadoTamanioTuberia{}, fmt.Errorf("CalcularTamanioTuberiaMulticonductor: %w", ErrNumeroDeTubosInvalido)
+	}
+	if cablesPorTubo < 1 {
+		return entity.ResultadoTamanioTuberia{}, fmt.Errorf("CalcularTamanioTuberiaMulticonductor: %w", ErrAreaRequeridaInvalida)
+	}
+	if len(tablaOcupacion) == 0 {
+		return entity.ResultadoTamanioTuberia{}, fmt.Errorf("CalcularTamanioTuberiaMulticonductor: %w", ErrTablaOcupacionVacia)
+	}
+
+	areaCables := float64(cablesPorTubo) * cable.AreaExteriorMM2()
+	fillFactor := determinarFillFactor(cablesPorTubo)
+
+	var areaPermitida float64
+	for _, entrada := range tablaOcupacion {
+		areaInterior := entrada.AreaInteriorMM2
+		if areaInterior <= 0 {
+			areaInterior = entrada.AreaOcupacionMM2 / 0.40
+		}
+		areaPermitida = areaInterior * fillFactor
+		if areaPermitida >= areaCables {
+			return entity.NewResultadoTamanioTuberia(
+				areaCables,
+				entrada.Tamano,
+				entrada.DesignacionMetrica,
+				tipoCanalizacion,
+				numTuberias,
+			)
+		}
+	}
+
+	return entity.ResultadoTamanioTuberia{}, fmt.Errorf(
+		"%w: área de cables %.2f mm² excede máxima disponible %.2f mm² al %.0f %% (numTuberias=%d)",
+		ErrTuberiaNoEncontrada, areaCables, areaPermitida, fillFactor*100, numTuberias,
+	)
+}
+
+// FactorRellenoCables returns the Capítulo 10 Tabla 1 fill factor for the given number
+// of cables per tube.
+func FactorRellenoCables(cablesPorTubo int) float64 {
+	return determinarFillFactor(cablesPorTubo)
+}
+
 // DiseñoMetricoConduit returns the metric designation for a given trade size.
 // This is a helper for converting between trade sizes and metric designations.
 func DiseñoMetricoConduit(tamano string) (string, error) {
@@ -206,3 +206,31 @@ func TestDiseñoMetricoConduit(t *testing.T) {
 		}
 	}
 }
+
+func TestCalcularTamanioTuberiaMulticonductor(t *testing.T) {
+	cable, err := valueobject.NewCableMulticonductor(valueobject.CableMulticonductorParams{
+		Tipo: valueobject.CableTC, Calibre: "6 AWG", NumConductores: 3, DiametroExteriorMM: 20,
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		cablesPorTubo int
+		tamano        string
+	}{
+		{1, "1 1/4"}, // 314.2 mm² ≤ 53 % de 935 mm²
+		{2, "2"},     // 628.3 mm² ≤ 31 % de 2122.5 mm²
+		{3, "2 1/2"}, // 942.5 mm² ≤ 40 % de 3030 mm²
+	}
+	for _, tt := range tests {
+		r, err := service.CalcularTamanioTuberiaMulticonductor(cable, tt.cablesPorTubo, 1, entity.TipoCanalizacionTuberiaPVC, tablaOcupacionTest)
+		require.NoError(t, err, tt.cablesPorTubo)
+		assert.Equal(t, tt.tamano, r.TuberiaRecomendada(), tt.cablesPorTubo)
+		assert.InDelta(t, float64(tt.cablesPorTubo)*cable.AreaExteriorMM2(), r.AreaPorTuboMM2(), 0.01)
+	}
+
+	_, err = service.CalcularTamanioTuberiaMulticonductor(cable, 12, 1, entity.TipoCanalizacionTuberiaPVC, tablaOcupacionTest)
+	assert.ErrorIs(t, err, service.ErrTuberiaNoEncontrada)
+
+	_, err = service.CalcularTamanioTuberiaMulticonductor(cable, 1, 0, entity.TipoCanalizacionTuberiaPVC, tablaOcupacionTest)
+	assert.ErrorIs(t, err, service.ErrNumeroDeTubosInvalido)
+}
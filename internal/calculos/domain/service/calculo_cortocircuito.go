@@ -0,0 +1,157 @@
+// internal/calculos/domain/service/calculo_cortocircuito.go
+package service
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+)
+
+// ErrFuenteCortocircuitoInvalida is returned when no usable source (MVAcc or kVA + %Z) is given.
+var ErrFuenteCortocircuitoInvalida = errors.New("fuente de cortocircuito inválida")
+
+// EntradaCalculoCortocircuito contains the pre-resolved Tabla 9 data needed for the
+// fault calculation. The application layer resolves R, X for the phase conductor and
+// for the return path (equipment grounding conductor).
+type EntradaCalculoCortocircuito struct {
+	Fuente                     entity.FuenteCortocircuito
+	ResistenciaFaseOhmPorKm    float64                 // Tabla 9 del conductor de fase
+	ReactanciaFaseOhmPorKm     float64                 // Tabla 9 del conductor de fase
+	ResistenciaRetornoOhmPorKm float64                 // Tabla 9 del conductor de tierra; 0 = igual que fase
+	ReactanciaRetornoOhmPorKm  float64                 // Tabla 9 del conductor de tierra; 0 = igual que fase
+	HilosPorFase               int                     // conductores en paralelo por fase
+	ConductoresRetorno         int                     // conductores de tierra en paralelo (1 por tubo)
+	SistemaElectrico           entity.SistemaElectrico // trifásico → se reporta Icc 3φ
+	TipoVoltaje                entity.TipoVoltaje      // para obtener V_ff a partir de la tensión ingresada
+}
+
+// CalcularCortocircuito calcula las corrientes simétricas de falla en el extremo de carga
+// por el método óhmico (IEEE-141 / IEEE-242):
+//
+//	X_red  = V_ff² / (MVAcc × 10⁶)
+//	X_tr   = (%Z / 100) × V_ff² / (kVA × 10³)
+//	R_c    = R × L / N        X_c = X × L / N
+//
+//	Icc_3φ = V_ff / (√3 × √(R_c² + (X_red + X_tr + X_c)²))
+//	Icc_FT = V_fn / √((R_c + R_t)² + (X_red + X_tr + X_c + X_t)²)
+//
+// Donde R_t, X_t son del conductor de tierra (retorno de la falla). La red y el
+// transformador se consideran puramente reactivos, lo que da el valor máximo de falla.
+// Icc_3φ solo se reporta en sistemas trifásicos (DELTA, ESTRELLA).
+func CalcularCortocircuito(
+	entrada EntradaCalculoCortocircuito,
+	distancia float64,
+	tension valueobject.Tension,
+) (entity.ResultadoCortocircuito, error) {
+	if err := validarFuenteCortocircuito(entrada.Fuente); err != nil {
+		return entity.ResultadoCortocircuito{}, err
+	}
+	if distancia <= 0 {
+		return entity.ResultadoCortocircuito{}, fmt.Errorf("CalcularCortocircuito: %w: %.2f", ErrDistanciaInvalida, distancia)
+	}
+	if entrada.HilosPorFase <= 0 {
+		return entity.ResultadoCortocircuito{}, fmt.Errorf("CalcularCortocircuito: %w: %d", ErrHilosPorFaseInvalido, entrada.HilosPorFase)
+	}
+
+	// Step 1: Tensiones de referencia
+	vff := tensionFaseFase(tension, entrada.TipoVoltaje)
+	vfn := vff / math.Sqrt(3)
+
+	// Step 2: Impedancia de la fuente referida a V_ff
+	xFuente := reactanciaFuente(entrada.Fuente, vff)
+
+	// Step 3: Impedancia del conductor de fase
+	lKm := distancia / 1000.0
+	n := float64(entrada.HilosPorFase)
+	rc := entrada.ResistenciaFaseOhmPorKm * lKm / n
+	xc := entrada.ReactanciaFaseOhmPorKm * lKm / n
+
+	// Step 4: Impedancia del retorno (conductor de tierra)
+	rRet, xRet := entrada.ResistenciaRetornoOhmPorKm, entrada.ReactanciaRetornoOhmPorKm
+	if rRet <= 0 && xRet <= 0 {
+		rRet, xRet = entrada.ResistenciaFaseOhmPorKm, entrada.ReactanciaFaseOhmPorKm
+	}
+	m := float64(entrada.ConductoresRetorno)
+	if m <= 0 {
+		m = 1
+	}
+	rt := rRet * lKm / m
+	xt := xRet * lKm / m
+
+	// Step 5: Corrientes de falla
+	z3 := math.Hypot(rc, xFuente+xc)
+	zLT := math.Hypot(rc+rt, xFuente+xc+xt)
+
+	resultado := entity.ResultadoCortocircuito{
+		TensionFaseFase:          vff,
+		ImpedanciaFuenteOhm:      xFuente,
+		ResistenciaConductorOhm:  rc,
+		ReactanciaConductorOhm:   xc,
+		ImpedanciaTrifasicaOhm:   z3,
+		ImpedanciaLineaTierraOhm: zLT,
+		IccBornesKA:              vff / (math.Sqrt(3) * xFuente) / 1000,
+		IccLineaTierraKA:         vfn / zLT / 1000,
+	}
+	if entrada.SistemaElectrico.CantidadFases() == 3 {
+		resultado.IccTrifasicaKA = vff / (math.Sqrt(3) * z3) / 1000
+	}
+
+	return resultado, nil
+}
+
+// CalcularCorrienteFallaFuente calcula la corriente de falla trifásica disponible en el
+// origen del circuito (L = 0), que solo depende de la fuente:
+//
+//	Icc = V_ff / (√3 × (X_red + X_tr))
+//
+// Es la corriente que deben soportar los conductores ante una falla franca justo
+// aguas abajo de la protección.
+func CalcularCorrienteFallaFuente(
+	fuente entity.FuenteCortocircuito,
+	tension valueobject.Tension,
+	tipoVoltaje entity.TipoVoltaje,
+) (float64, error) {
+	if err := validarFuenteCortocircuito(fuente); err != nil {
+		return 0, err
+	}
+	vff := tensionFaseFase(tension, tipoVoltaje)
+	return vff / (math.Sqrt(3) * reactanciaFuente(fuente, vff)) / 1000, nil
+}
+
+// validarFuenteCortocircuito verifica que haya al menos una fuente válida.
+func validarFuenteCortocircuito(f entity.FuenteCortocircuito) error {
+	if !f.Definida() {
+		return fmt.Errorf("%w: se requiere MVAcc de la red o kVA y %%Z del transformador", ErrFuenteCortocircuitoInvalida)
+	}
+	if f.PotenciaCortocircuitoMVA < 0 || f.TransformadorKVA < 0 {
+		return fmt.Errorf("%w: potencias negativas", ErrFuenteCortocircuitoInvalida)
+	}
+	if f.TransformadorKVA > 0 && f.TransformadorZPorcentaje <= 0 {
+		return fmt.Errorf("%w: %%Z del transformador debe ser mayor que cero", ErrFuenteCortocircuitoInvalida)
+	}
+	return nil
+}
+
+// tensionFaseFase obtiene V_ff a partir de la tensión ingresada.
+func tensionFaseFase(tension valueobject.Tension, tipoVoltaje entity.TipoVoltaje) float64 {
+	vff := float64(tension.Valor())
+	if tipoVoltaje.EsFaseNeutro() {
+		vff *= math.Sqrt(3)
+	}
+	return vff
+}
+
+// reactanciaFuente suma la reactancia de la red y del transformador referidas a V_ff.
+func reactanciaFuente(f entity.FuenteCortocircuito, vff float64) float64 {
+	x := 0.0
+	if f.PotenciaCortocircuitoMVA > 0 {
+		x += vff * vff / (f.PotenciaCortocircuitoMVA * 1e6)
+	}
+	if f.TransformadorKVA > 0 {
+		x += (f.TransformadorZPorcentaje / 100) * vff * vff / (f.TransformadorKVA * 1e3)
+	}
+	return x
+}
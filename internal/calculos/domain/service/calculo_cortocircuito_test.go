@@ -0,0 +1,101 @@
+// internal/calculos/domain/service/calculo_cortocircuito_test.go
+package service_test
+
+import (
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/service"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalcularCortocircuito(t *testing.T) {
+	tension480, err := valueobject.NewTension(480, "V")
+	require.NoError(t, err)
+
+	t.Run("transformador 500 kVA 5.75% — 480V delta, 30 m", func(t *testing.T) {
+		entrada := service.EntradaCalculoCortocircuito{
+			Fuente:                     entity.FuenteCortocircuito{TransformadorKVA: 500, TransformadorZPorcentaje: 5.75},
+			ResistenciaFaseOhmPorKm:    0.171,
+			ReactanciaFaseOhmPorKm:     0.041,
+			ResistenciaRetornoOhmPorKm: 3.9,
+			ReactanciaRetornoOhmPorKm:  0.05,
+			HilosPorFase:               1,
+			ConductoresRetorno:         1,
+			SistemaElectrico:           entity.SistemaElectricoDelta,
+			TipoVoltaje:                entity.TipoVoltajeFaseFase,
+		}
+
+		r, err := service.CalcularCortocircuito(entrada, 30, tension480)
+		require.NoError(t, err)
+
+		// X_tr = 0.0575 × 480² / 500 000 = 0.0265 Ω → I_bornes = I_pc / %Z = 10.46 kA
+		assert.InDelta(t, 0.0265, r.ImpedanciaFuenteOhm, 0.0001)
+		assert.InDelta(t, 10.46, r.IccBornesKA, 0.01)
+		assert.InDelta(t, 9.83, r.IccTrifasicaKA, 0.01)
+		// El retorno por el conductor de tierra limita la falla a tierra
+		assert.InDelta(t, 2.21, r.IccLineaTierraKA, 0.01)
+	})
+
+	t.Run("red y transformador en serie reducen la falla", func(t *testing.T) {
+		soloTr := service.EntradaCalculoCortocircuito{
+			Fuente:                  entity.FuenteCortocircuito{TransformadorKVA: 500, TransformadorZPorcentaje: 5.75},
+			ResistenciaFaseOhmPorKm: 0.171,
+			ReactanciaFaseOhmPorKm:  0.041,
+			HilosPorFase:            1,
+			SistemaElectrico:        entity.SistemaElectricoEstrella,
+			TipoVoltaje:             entity.TipoVoltajeFaseFase,
+		}
+		conRed := soloTr
+		conRed.Fuente.PotenciaCortocircuitoMVA = 250
+
+		a, err := service.CalcularCortocircuito(soloTr, 30, tension480)
+		require.NoError(t, err)
+		b, err := service.CalcularCortocircuito(conRed, 30, tension480)
+		require.NoError(t, err)
+		assert.Less(t, b.IccTrifasicaKA, a.IccTrifasicaKA)
+	})
+
+	t.Run("monofásico no reporta falla trifásica", func(t *testing.T) {
+		tension127, err := valueobject.NewTension(127, "V")
+		require.NoError(t, err)
+		entrada := service.EntradaCalculoCortocircuito{
+			Fuente:                  entity.FuenteCortocircuito{PotenciaCortocircuitoMVA: 100},
+			ResistenciaFaseOhmPorKm: 8.9,
+			ReactanciaFaseOhmPorKm:  0.057,
+			HilosPorFase:            1,
+			SistemaElectrico:        entity.SistemaElectricoMonofasico,
+			TipoVoltaje:             entity.TipoVoltajeFaseNeutro,
+		}
+
+		r, err := service.CalcularCortocircuito(entrada, 20, tension127)
+		require.NoError(t, err)
+		assert.Zero(t, r.IccTrifasicaKA)
+		assert.InDelta(t, 0.357, r.IccLineaTierraKA, 0.001)
+	})
+
+	t.Run("sin fuente es error", func(t *testing.T) {
+		_, err := service.CalcularCortocircuito(service.EntradaCalculoCortocircuito{HilosPorFase: 1}, 30, tension480)
+		assert.ErrorIs(t, err, service.ErrFuenteCortocircuitoInvalida)
+	})
+
+	t.Run("transformador sin %Z es error", func(t *testing.T) {
+		entrada := service.EntradaCalculoCortocircuito{
+			Fuente:       entity.FuenteCortocircuito{TransformadorKVA: 500},
+			HilosPorFase: 1,
+		}
+		_, err := service.CalcularCortocircuito(entrada, 30, tension480)
+		assert.ErrorIs(t, err, service.ErrFuenteCortocircuitoInvalida)
+	})
+
+	t.Run("distancia cero es error", func(t *testing.T) {
+		entrada := service.EntradaCalculoCortocircuito{
+			Fuente:       entity.FuenteCortocircuito{PotenciaCortocircuitoMVA: 100},
+			HilosPorFase: 1,
+		}
+		_, err := service.CalcularCortocircuito(entrada, 0, tension480)
+		assert.ErrorIs(t, err, service.ErrDistanciaInvalida)
+	})
+}
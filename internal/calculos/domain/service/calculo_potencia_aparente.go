@@ -0,0 +1,29 @@
+// internal/calculos/domain/service/calculo_potencia_aparente.go
+package service
+
+import (
+	"math"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+)
+
+// CalcularPotenciaAparenteKVA calcula la potencia aparente conectada de un circuito
+// a partir de su corriente nominal. Es la inversa de CalcularAmperajeNominalCircuito
+// con FP = 1, por lo que usa los mismos factores por sistema:
+//   - Monofásico/Bifásico: S = V × I / 1000
+//   - Delta/Estrella (trifásico): S = √3 × V × I / 1000
+func CalcularPotenciaAparenteKVA(
+	corriente valueobject.Corriente,
+	tension valueobject.Tension,
+	sistema entity.SistemaElectrico,
+) float64 {
+	va := float64(tension.Valor()) * corriente.Valor()
+
+	switch sistema {
+	case entity.SistemaElectricoMonofasico, entity.SistemaElectricoBifasico:
+		return va / 1000
+	default:
+		return math.Sqrt(3) * va / 1000
+	}
+}
@@ -0,0 +1,41 @@
+// internal/calculos/domain/service/calculo_potencia_aparente_test.go
+package service_test
+
+import (
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/service"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalcularPotenciaAparenteKVA(t *testing.T) {
+	tests := []struct {
+		name      string
+		corriente float64
+		tension   float64
+		sistema   entity.SistemaElectrico
+		esperado  float64
+	}{
+		// 75 kVA @ 480V → I = 90.21 A → S = √3 × 480 × 90.21 / 1000 = 75 kVA
+		{"trifásico delta", 90.21, 480, entity.SistemaElectricoDelta, 75.0},
+		{"trifásico estrella", 100, 220, entity.SistemaElectricoEstrella, 38.105},
+		// 127V × 20A = 2.54 kVA
+		{"monofásico", 20, 127, entity.SistemaElectricoMonofasico, 2.54},
+		{"bifásico", 20, 220, entity.SistemaElectricoBifasico, 4.4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			corriente, err := valueobject.NewCorriente(tt.corriente)
+			require.NoError(t, err)
+			tension, err := valueobject.NewTension(tt.tension, "V")
+			require.NoError(t, err)
+
+			kva := service.CalcularPotenciaAparenteKVA(corriente, tension, tt.sistema)
+			assert.InDelta(t, tt.esperado, kva, 0.01)
+		})
+	}
+}
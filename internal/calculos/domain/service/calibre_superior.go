@@ -73,3 +73,21 @@ func ObtenerCalibreSuperior(calibreActual string) (string, error) {
 	// Retornar el siguiente calibre
 	return calibresNOM[indiceActual+1], nil
 }
+
+// indiceCalibre retorna la posición del calibre en la secuencia NOM, o -1 si no existe.
+// Acepta sufijos " AWG" y " MCM" (ej: "2 AWG", "500 MCM").
+func indiceCalibre(calibre string) int {
+	calibreNormalizado := strings.TrimSuffix(normalizarCalibre(calibre), " MCM")
+	for i, c := range calibresNOM {
+		if c == calibreNormalizado {
+			return i
+		}
+	}
+	return -1
+}
+
+// MismoCalibre compara dos calibres ignorando los sufijos " AWG" / " MCM".
+func MismoCalibre(a, b string) bool {
+	ia := indiceCalibre(a)
+	return ia >= 0 && ia == indiceCalibre(b)
+}
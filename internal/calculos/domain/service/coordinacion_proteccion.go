@@ -0,0 +1,80 @@
+// internal/calculos/domain/service/coordinacion_proteccion.go
+package service
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+)
+
+// LimiteSiguienteSuperiorITM es la capacidad máxima del dispositivo para la que
+// NOM 240-4(b) permite usar el ITM estándar inmediato superior a la ampacidad.
+const LimiteSiguienteSuperiorITM = 800
+
+// VerificarCoordinacionProteccion verifica que el ITM proteja al conductor (NOM 240-4):
+//
+//	I_z = Capacidad × F_corrección × N_hilos
+//
+// Reglas:
+//   - ITM ≤ I_z → cumple.
+//   - 240-4(b): si I_z no coincide con una capacidad estándar y el ITM es ≤ 800 A,
+//     se permite el ITM estándar inmediato superior a I_z.
+//   - 240-4(c): por encima de 800 A el ITM no debe exceder I_z.
+//
+// factorCorreccion es el producto de los factores que reducen la ampacidad del
+// conductor (temperatura × agrupamiento). La tabla debe estar ordenada ascendente.
+func VerificarCoordinacionProteccion(
+	capacidad float64,
+	factorCorreccion float64,
+	hilosPorFase int,
+	itm int,
+	tablaITM []valueobject.EntradaTablaITM,
+) (entity.ResultadoCoordinacionProteccion, error) {
+	if capacidad <= 0 {
+		return entity.ResultadoCoordinacionProteccion{}, fmt.Errorf("capacidad del conductor debe ser mayor que cero: %.2f", capacidad)
+	}
+	if factorCorreccion <= 0 {
+		return entity.ResultadoCoordinacionProteccion{}, fmt.Errorf("factor de corrección debe ser mayor que cero: %.4f", factorCorreccion)
+	}
+	if hilosPorFase <= 0 {
+		return entity.ResultadoCoordinacionProteccion{}, fmt.Errorf("%w: %d", ErrHilosPorFaseInvalido, hilosPorFase)
+	}
+	if itm <= 0 {
+		return entity.ResultadoCoordinacionProteccion{}, fmt.Errorf("ITM debe ser mayor que cero: %d", itm)
+	}
+
+	ampacidad := capacidad * factorCorreccion * float64(hilosPorFase)
+	maximo := itmMaximoPermitido(ampacidad, tablaITM)
+
+	return entity.ResultadoCoordinacionProteccion{
+		AmpacidadCorregida:      ampacidad,
+		ITM:                     itm,
+		ITMMaximoPermitido:      maximo,
+		AplicaSiguienteSuperior: float64(itm) > ampacidad && itm <= maximo,
+		Cumple:                  itm <= maximo,
+	}, nil
+}
+
+// itmMaximoPermitido returns the largest standard ITM rating that still protects
+// a conductor of the given corrected ampacity. Without a table, the ampacity itself.
+func itmMaximoPermitido(ampacidad float64, tablaITM []valueobject.EntradaTablaITM) int {
+	if len(tablaITM) == 0 {
+		return int(math.Floor(ampacidad))
+	}
+
+	maximo := 0
+	for _, entrada := range tablaITM {
+		if float64(entrada.Amperaje) <= ampacidad {
+			maximo = entrada.Amperaje
+			continue
+		}
+		// 240-4(b): estándar inmediato superior, solo hasta 800 A
+		if entrada.Amperaje <= LimiteSiguienteSuperiorITM {
+			return entrada.Amperaje
+		}
+		break
+	}
+	return maximo
+}
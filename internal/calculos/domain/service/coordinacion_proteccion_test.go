@@ -0,0 +1,66 @@
+// internal/calculos/domain/service/coordinacion_proteccion_test.go
+package service_test
+
+import (
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/service"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var tablaITMCoordinacion = []valueobject.EntradaTablaITM{
+	{Amperaje: 100, Marco: 100},
+	{Amperaje: 110, Marco: 150},
+	{Amperaje: 125, Marco: 150},
+	{Amperaje: 150, Marco: 150},
+	{Amperaje: 700, Marco: 800},
+	{Amperaje: 800, Marco: 800},
+	{Amperaje: 1000, Marco: 1200},
+	{Amperaje: 1200, Marco: 1200},
+}
+
+func TestVerificarCoordinacionProteccion(t *testing.T) {
+	tests := []struct {
+		name           string
+		capacidad      float64
+		factor         float64
+		hilos          int
+		itm            int
+		ampacidad      float64
+		maximo         int
+		cumple         bool
+		siguienteSuper bool
+	}{
+		{"ITM menor que la ampacidad", 130, 1.0, 1, 125, 130, 150, true, false},
+		{"240-4(b) siguiente superior", 115, 1.0, 1, 125, 115, 125, true, true},
+		{"ITM excede siguiente superior", 115, 1.0, 1, 150, 115, 125, false, false},
+		{"factores reducen la ampacidad", 130, 0.82, 1, 125, 106.6, 110, false, false},
+		{"hilos en paralelo suman ampacidad", 380, 1.0, 2, 800, 760, 800, true, true},
+		{"240-4(c) sobre 800 A no aplica siguiente superior", 475, 1.0, 2, 1000, 950, 800, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := service.VerificarCoordinacionProteccion(tt.capacidad, tt.factor, tt.hilos, tt.itm, tablaITMCoordinacion)
+			require.NoError(t, err)
+
+			assert.InDelta(t, tt.ampacidad, r.AmpacidadCorregida, 0.01)
+			assert.Equal(t, tt.maximo, r.ITMMaximoPermitido)
+			assert.Equal(t, tt.cumple, r.Cumple)
+			assert.Equal(t, tt.siguienteSuper, r.AplicaSiguienteSuperior)
+		})
+	}
+}
+
+func TestVerificarCoordinacionProteccion_Errores(t *testing.T) {
+	_, err := service.VerificarCoordinacionProteccion(0, 1, 1, 100, tablaITMCoordinacion)
+	assert.Error(t, err)
+
+	_, err = service.VerificarCoordinacionProteccion(100, 1, 0, 100, tablaITMCoordinacion)
+	assert.ErrorIs(t, err, service.ErrHilosPorFaseInvalido)
+
+	_, err = service.VerificarCoordinacionProteccion(100, 1, 1, 0, tablaITMCoordinacion)
+	assert.Error(t, err)
+}
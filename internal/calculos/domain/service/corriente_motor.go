@@ -0,0 +1,102 @@
+// internal/calculos/domain/service/corriente_motor.go
+package service
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+)
+
+// ErrMotorNoEncontrado is returned when the motor rating is not listed in Tabla 430-248/430-250.
+var ErrMotorNoEncontrado = errors.New("motor no encontrado en tabla de corriente a plena carga")
+
+// FactorProteccionMotor es el porcentaje máximo de la Tabla 430-52 para un
+// interruptor automático de tiempo inverso (250 % de la corriente a plena carga).
+const FactorProteccionMotor = 2.50
+
+// toleranciaHP absorbe el redondeo de potencias fraccionarias (1/6, 1/3 HP).
+const toleranciaHP = 0.01
+
+// ToleranciaTensionMotor es la desviación máxima (fracción de la tensión de
+// operación) entre la tensión del sistema y la columna de la tabla: cubre las
+// tensiones nominales de sistema (127 → 115 V, 220 → 230 V, 480 → 460 V) sin
+// tomar la columna de otra clase de tensión cuando la celda está en blanco.
+const ToleranciaTensionMotor = 0.10
+
+// BuscarCorrientePlenaCargaMotor obtiene la corriente a plena carga de la
+// Tabla 430-248 (monofásicos) o 430-250 (trifásicos), según NOM 430-6(a)(1).
+//
+// Se usa el renglón de HP igual o inmediato superior al solicitado y la columna
+// de tensión más cercana a la de operación (p. ej. 220 V → 230 V, 480 V → 460 V)
+// dentro de ToleranciaTensionMotor; si esa columna está en blanco para el renglón
+// (p. ej. 250 HP a 230 V) se retorna ErrMotorNoEncontrado en lugar de usar otra
+// clase de tensión. La tensión es fase-fase en motores trifásicos.
+// La tabla debe estar ordenada por PotenciaHP ascendente.
+func BuscarCorrientePlenaCargaMotor(
+	potenciaHP float64,
+	tension int,
+	fases int,
+	tabla []valueobject.EntradaTablaMotor,
+) (entity.CorrientePlenaCargaMotor, error) {
+	if potenciaHP <= 0 {
+		return entity.CorrientePlenaCargaMotor{}, fmt.Errorf("HP debe ser mayor que cero: %.2f", potenciaHP)
+	}
+	if tension <= 0 {
+		return entity.CorrientePlenaCargaMotor{}, fmt.Errorf("tensión debe ser mayor que cero: %d", tension)
+	}
+
+	for _, entrada := range tabla {
+		if entrada.PotenciaHP+toleranciaHP < potenciaHP {
+			continue
+		}
+
+		tensionTabla, corriente, ok := columnaTensionMasCercana(tension, entrada.Corrientes)
+		if !ok {
+			return entity.CorrientePlenaCargaMotor{}, fmt.Errorf(
+				"%w: %.2f HP sin valor de corriente para %d V", ErrMotorNoEncontrado, entrada.PotenciaHP, tension,
+			)
+		}
+
+		return entity.CorrientePlenaCargaMotor{
+			PotenciaHP:      potenciaHP,
+			PotenciaHPTabla: entrada.PotenciaHP,
+			Tension:         tension,
+			TensionTabla:    tensionTabla,
+			Fases:           fases,
+			Corriente:       corriente,
+		}, nil
+	}
+
+	return entity.CorrientePlenaCargaMotor{}, fmt.Errorf(
+		"%w: %.2f HP, %d fase(s)", ErrMotorNoEncontrado, potenciaHP, fases,
+	)
+}
+
+// columnaTensionMasCercana elige la columna listada más cercana a la tensión de
+// operación, siempre que esté dentro de ToleranciaTensionMotor; ante empate se
+// prefiere la tensión menor, cuya corriente tabulada es mayor (lado conservador).
+func columnaTensionMasCercana(tension int, corrientes map[int]float64) (int, float64, bool) {
+	mejorTension := 0
+	mejorDiferencia := math.MaxInt
+	maxDiferencia := ToleranciaTensionMotor * float64(tension)
+	for t := range corrientes {
+		diferencia := t - tension
+		if diferencia < 0 {
+			diferencia = -diferencia
+		}
+		if float64(diferencia) > maxDiferencia {
+			continue
+		}
+		if diferencia < mejorDiferencia || (diferencia == mejorDiferencia && t < mejorTension) {
+			mejorTension = t
+			mejorDiferencia = diferencia
+		}
+	}
+	if mejorTension == 0 {
+		return 0, 0, false
+	}
+	return mejorTension, corrientes[mejorTension], true
+}
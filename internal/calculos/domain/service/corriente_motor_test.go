@@ -0,0 +1,106 @@
+// internal/calculos/domain/service/corriente_motor_test.go
+package service_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/service"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Extracto de la Tabla 430-250 (motores trifásicos).
+var tablaMotorTrifasico = []valueobject.EntradaTablaMotor{
+	{PotenciaHP: 5, Corrientes: map[int]float64{200: 17.5, 208: 16.7, 230: 15.2, 460: 7.6, 575: 6.1}},
+	{PotenciaHP: 7.5, Corrientes: map[int]float64{200: 25.3, 208: 24.2, 230: 22, 460: 11, 575: 9}},
+	{PotenciaHP: 10, Corrientes: map[int]float64{200: 32.2, 208: 30.8, 230: 28, 460: 14, 575: 11}},
+}
+
+func TestBuscarCorrientePlenaCargaMotor(t *testing.T) {
+	tests := []struct {
+		name             string
+		hp               float64
+		tension          int
+		wantHPTabla      float64
+		wantTensionTabla int
+		wantCorriente    float64
+	}{
+		{"10 HP a 480 V usa columna 460 V", 10, 480, 10, 460, 14},
+		{"10 HP a 220 V usa columna 230 V", 10, 220, 10, 230, 28},
+		{"6 HP usa renglón inmediato superior", 6, 460, 7.5, 460, 11},
+		{"5 HP exacto a 208 V", 5, 208, 5, 208, 16.7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := service.BuscarCorrientePlenaCargaMotor(tt.hp, tt.tension, 3, tablaMotorTrifasico)
+			require.NoError(t, err)
+			assert.InDelta(t, tt.wantHPTabla, res.PotenciaHPTabla, 0.001)
+			assert.Equal(t, tt.wantTensionTabla, res.TensionTabla)
+			assert.InDelta(t, tt.wantCorriente, res.Corriente, 0.001)
+			assert.InDelta(t, tt.hp, res.PotenciaHP, 0.001)
+		})
+	}
+}
+
+func TestBuscarCorrientePlenaCargaMotor_FueraDeTabla(t *testing.T) {
+	_, err := service.BuscarCorrientePlenaCargaMotor(15, 460, 3, tablaMotorTrifasico)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, service.ErrMotorNoEncontrado))
+}
+
+// Renglones de la Tabla 430-250 con celdas en blanco y de la 430-248 (monofásicos).
+var (
+	tablaMotorTrifasicoBlancos = []valueobject.EntradaTablaMotor{
+		{PotenciaHP: 3, Corrientes: map[int]float64{200: 11.0, 208: 10.6, 230: 9.6, 460: 4.8, 575: 3.9}},
+		{PotenciaHP: 250, Corrientes: map[int]float64{460: 302, 575: 242, 2300: 60}},
+		{PotenciaHP: 300, Corrientes: map[int]float64{460: 361, 575: 289, 2300: 72}},
+	}
+	tablaMotorMonofasico = []valueobject.EntradaTablaMotor{
+		{PotenciaHP: 3, Corrientes: map[int]float64{115: 34, 200: 19.6, 208: 18.7, 230: 17}},
+	}
+)
+
+func TestBuscarCorrientePlenaCargaMotor_ToleranciaTension(t *testing.T) {
+	tests := []struct {
+		name             string
+		hp               float64
+		tension          int
+		fases            int
+		tabla            []valueobject.EntradaTablaMotor
+		wantErr          bool
+		wantTensionTabla int
+		wantCorriente    float64
+	}{
+		{"250 HP a 220 V: celda de 230 V en blanco", 250, 220, 3, tablaMotorTrifasicoBlancos, true, 0, 0},
+		{"300 HP a 220 V: celda de 230 V en blanco", 300, 220, 3, tablaMotorTrifasicoBlancos, true, 0, 0},
+		{"3 HP trifásico a 127 V: celda de 115 V en blanco", 3, 127, 3, tablaMotorTrifasicoBlancos, true, 0, 0},
+		{"250 HP a 480 V usa columna 460 V", 250, 480, 3, tablaMotorTrifasicoBlancos, false, 460, 302},
+		{"3 HP trifásico a 220 V usa columna 230 V", 3, 220, 3, tablaMotorTrifasicoBlancos, false, 230, 9.6},
+		{"3 HP monofásico a 127 V usa columna 115 V", 3, 127, 1, tablaMotorMonofasico, false, 115, 34},
+		{"3 HP monofásico a 220 V usa columna 230 V", 3, 220, 1, tablaMotorMonofasico, false, 230, 17},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := service.BuscarCorrientePlenaCargaMotor(tt.hp, tt.tension, tt.fases, tt.tabla)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, service.ErrMotorNoEncontrado)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTensionTabla, res.TensionTabla)
+			assert.InDelta(t, tt.wantCorriente, res.Corriente, 0.001)
+		})
+	}
+}
+
+func TestBuscarCorrientePlenaCargaMotor_Invalido(t *testing.T) {
+	_, err := service.BuscarCorrientePlenaCargaMotor(0, 460, 3, tablaMotorTrifasico)
+	assert.Error(t, err)
+
+	_, err = service.BuscarCorrientePlenaCargaMotor(10, 0, 3, tablaMotorTrifasico)
+	assert.Error(t, err)
+}
@@ -0,0 +1,147 @@
+// internal/calculos/domain/service/corriente_neutro.go
+package service
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+)
+
+// ErrDesbalanceInvalido se retorna cuando el desbalance de carga está fuera de 0–100 %.
+var ErrDesbalanceInvalido = errors.New("desbalance de carga inválido")
+
+// UmbralTriplenesNeutroPortador es el contenido de armónicas triples (fracción de la
+// corriente de fase RMS, ver contenidoTriplenesFase) por encima del cual el neutro de un
+// sistema estrella se considera portador de corriente. Es el mismo límite a partir del
+// cual el Anexo E de IEC 60364-5-52 aplica su reducción por neutro con carga.
+const UmbralTriplenesNeutroPortador = 0.15
+
+// contenidoTriplenes retorna la suma cuadrática de las armónicas múltiplos de 3
+// (fracción de la fundamental). Con solo THDi no se conoce el reparto por orden y
+// se considera 0.
+func contenidoTriplenes(espectro *entity.EspectroArmonico) float64 {
+	if espectro == nil {
+		return 0
+	}
+	suma := 0.0
+	for orden, porcentaje := range espectro.Ordenes {
+		if orden%3 == 0 {
+			suma += (porcentaje / 100.0) * (porcentaje / 100.0)
+		}
+	}
+	return math.Sqrt(suma)
+}
+
+// contenidoTriplenesFase retorna el contenido de armónicas triples referido a la
+// corriente de fase RMS (fracción): I_3k / I_rms. Es la base común de NeutroEsPortador
+// y del criterio del Anexo E en CalcularFactorArmonico.
+func contenidoTriplenesFase(espectro *entity.EspectroArmonico) float64 {
+	if espectro == nil {
+		return 0
+	}
+	thd := thdEspectro(*espectro)
+	return contenidoTriplenes(espectro) / math.Sqrt(1+thd*thd)
+}
+
+// NeutroEsPortador decide si el neutro cuenta para el factor de agrupamiento
+// (NOM-001-SEDE 310-15(b)(5)):
+//   - MONOFASICO: el neutro lleva la corriente de la fase → cuenta.
+//   - BIFASICO (2F-3H de un sistema estrella): lleva aproximadamente la corriente de fase → cuenta.
+//   - ESTRELLA: solo lleva el desbalance → no cuenta, salvo carga no lineal con
+//     armónicas triples > UmbralTriplenesNeutroPortador de la corriente de fase RMS.
+//   - DELTA: sin neutro.
+func NeutroEsPortador(sistema entity.SistemaElectrico, espectro *entity.EspectroArmonico) bool {
+	switch sistema {
+	case entity.SistemaElectricoMonofasico, entity.SistemaElectricoBifasico:
+		return true
+	case entity.SistemaElectricoEstrella:
+		return contenidoTriplenesFase(espectro) > UmbralTriplenesNeutroPortador
+	default:
+		return false
+	}
+}
+
+// CalcularCorrienteNeutro obtiene la corriente del neutro por desbalance y armónicas triples.
+//
+// desbalance es la diferencia de la fase menos cargada respecto a las demás [%]:
+//
+//	ESTRELLA:   I_N,1 = d × I                  I_N,h = 3 × I_h
+//	BIFASICO:   I_N,1 = I × √(1 − k + k²), k = 1 − d    I_N,h = 2 × I_h
+//	MONOFASICO: I_N   = I_rms (corriente de retorno)
+//	DELTA:      sin neutro (I_N = 0)
+func CalcularCorrienteNeutro(
+	sistema entity.SistemaElectrico,
+	corrienteFase valueobject.Corriente,
+	desbalance float64,
+	espectro *entity.EspectroArmonico,
+) (entity.CorrienteNeutro, error) {
+	if desbalance < 0 || desbalance > 100 {
+		return entity.CorrienteNeutro{}, fmt.Errorf("%w: %.2f%% (debe estar entre 0 y 100)", ErrDesbalanceInvalido, desbalance)
+	}
+
+	i := corrienteFase.Valor()
+	d := desbalance / 100.0
+	triplenes := contenidoTriplenes(espectro)
+
+	resultado := entity.CorrienteNeutro{
+		CorrienteFase: i,
+		Desbalance:    desbalance,
+		Portador:      NeutroEsPortador(sistema, espectro),
+	}
+
+	switch sistema {
+	case entity.SistemaElectricoEstrella:
+		resultado.CorrienteDesbalance = d * i
+		resultado.CorrienteArmonica = 3 * triplenes * i
+	case entity.SistemaElectricoBifasico:
+		k := 1 - d
+		resultado.CorrienteDesbalance = i * math.Sqrt(1-k+k*k)
+		resultado.CorrienteArmonica = 2 * triplenes * i
+	case entity.SistemaElectricoMonofasico:
+		thd := 0.0
+		if espectro != nil {
+			thd = CalcularFactorArmonico(*espectro, false).THDi / 100.0
+		}
+		resultado.CorrienteDesbalance = i
+		resultado.CorrienteArmonica = thd * i
+	default:
+		return resultado, nil
+	}
+
+	resultado.Corriente = math.Hypot(resultado.CorrienteDesbalance, resultado.CorrienteArmonica)
+	return resultado, nil
+}
+
+// SeleccionarConductorNeutro elige el menor calibre cuya ampacidad cubre la corriente de
+// neutro ya corregida (por hilo), sin quedar por debajo de calibreMinimo.
+func SeleccionarConductorNeutro(
+	corrienteNeutro float64,
+	hilosPorFase int,
+	calibreMinimo string,
+	tabla []valueobject.EntradaTablaConductor,
+) (valueobject.Conductor, error) {
+	if len(tabla) == 0 {
+		return valueobject.Conductor{}, fmt.Errorf("%w: tabla vacía", ErrConductorNoEncontrado)
+	}
+
+	if hilosPorFase < 1 {
+		hilosPorFase = 1
+	}
+
+	corrientePorHilo := corrienteNeutro / float64(hilosPorFase)
+	minimo := indiceCalibre(calibreMinimo)
+
+	for _, entrada := range tabla {
+		if entrada.Capacidad >= corrientePorHilo && indiceCalibre(entrada.Conductor.Calibre) >= minimo {
+			return valueobject.NewConductor(entrada.Conductor)
+		}
+	}
+
+	return valueobject.Conductor{}, fmt.Errorf(
+		"%w: corriente de neutro por hilo %.2f A excede máxima capacidad de tabla %.2f A",
+		ErrConductorNoEncontrado, corrientePorHilo, tabla[len(tabla)-1].Capacidad,
+	)
+}
@@ -0,0 +1,117 @@
+// internal/calculos/domain/service/corriente_neutro_test.go
+package service_test
+
+import (
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/service"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalcularCorrienteNeutro(t *testing.T) {
+	corriente, _ := valueobject.NewCorriente(100)
+
+	tests := []struct {
+		name         string
+		sistema      entity.SistemaElectrico
+		desbalance   float64
+		espectro     *entity.EspectroArmonico
+		wantNeutro   float64
+		wantPortador bool
+	}{
+		{
+			name:       "estrella solo desbalance",
+			sistema:    entity.SistemaElectricoEstrella,
+			desbalance: 20,
+			wantNeutro: 20,
+		},
+		{
+			// 3 × 30 % = 90 A de 3ª armónica en el neutro
+			name:         "estrella con 3ª armónica",
+			sistema:      entity.SistemaElectricoEstrella,
+			espectro:     &entity.EspectroArmonico{Ordenes: map[int]float64{3: 30, 5: 20}},
+			wantNeutro:   90,
+			wantPortador: true,
+		},
+		{
+			// triplenes √(0.30² + 0.05²) = 0.3041 → 91.24 A; I_N = √(10² + 91.24²)
+			name:         "estrella desbalance y triplenes",
+			sistema:      entity.SistemaElectricoEstrella,
+			desbalance:   10,
+			espectro:     &entity.EspectroArmonico{Ordenes: map[int]float64{3: 30, 9: 5}},
+			wantNeutro:   91.79,
+			wantPortador: true,
+		},
+		{
+			// 3ª de 10 % < 15 %: el neutro no cuenta para agrupamiento
+			name:       "estrella triplenes bajo umbral",
+			sistema:    entity.SistemaElectricoEstrella,
+			espectro:   &entity.EspectroArmonico{Ordenes: map[int]float64{3: 10}},
+			wantNeutro: 30,
+		},
+		{
+			name:         "bifasico balanceado",
+			sistema:      entity.SistemaElectricoBifasico,
+			wantNeutro:   100,
+			wantPortador: true,
+		},
+		{
+			// k = 0.5 → √(1 − 0.5 + 0.25) = 0.866
+			name:         "bifasico 50 % desbalance",
+			sistema:      entity.SistemaElectricoBifasico,
+			desbalance:   50,
+			wantNeutro:   86.60,
+			wantPortador: true,
+		},
+		{
+			name:       "delta sin neutro",
+			sistema:    entity.SistemaElectricoDelta,
+			desbalance: 50,
+			wantNeutro: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := service.CalcularCorrienteNeutro(tt.sistema, corriente, tt.desbalance, tt.espectro)
+			require.NoError(t, err)
+			assert.InDelta(t, tt.wantNeutro, res.Corriente, 0.01)
+			assert.Equal(t, tt.wantPortador, res.Portador)
+		})
+	}
+}
+
+func TestCalcularCorrienteNeutro_DesbalanceInvalido(t *testing.T) {
+	corriente, _ := valueobject.NewCorriente(100)
+	_, err := service.CalcularCorrienteNeutro(entity.SistemaElectricoEstrella, corriente, 120, nil)
+	assert.ErrorIs(t, err, service.ErrDesbalanceInvalido)
+}
+
+func TestSeleccionarConductorNeutro(t *testing.T) {
+	tests := []struct {
+		name          string
+		corriente     float64
+		hilos         int
+		calibreMinimo string
+		wantCalibre   string
+	}{
+		{"por ampacidad", 80, 1, "8 AWG", "4 AWG"},
+		{"limitado por mínimo", 80, 1, "2", "2 AWG"},
+		{"sin corriente usa mínimo", 0, 1, "6 AWG", "6 AWG"},
+		{"hilos en paralelo", 250, 2, "", "2 AWG"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conductor, err := service.SeleccionarConductorNeutro(tt.corriente, tt.hilos, tt.calibreMinimo, tablaConductorTest)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantCalibre, conductor.Calibre())
+		})
+	}
+
+	_, err := service.SeleccionarConductorNeutro(1000, 1, "", tablaConductorTest)
+	assert.ErrorIs(t, err, service.ErrConductorNoEncontrado)
+}
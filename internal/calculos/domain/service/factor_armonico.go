@@ -0,0 +1,72 @@
+// internal/calculos/domain/service/factor_armonico.go
+package service
+
+import (
+	"math"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+)
+
+// CalcularFactorArmonico obtiene el multiplicador de corriente por contenido armónico.
+//
+// 1. Corriente eficaz: I_rms = I_1 × √(1 + THDi²), con THDi = √(Σ I_h²) / I_1 si
+// se proporcionan los órdenes individuales.
+//
+// 2. En circuitos trifásicos de 4 hilos (neutroConCarga) se aplica el criterio de
+// IEC 60364-5-52 Anexo E según el contenido de armónicas triples en la corriente de
+// fase RMS (la misma base y el mismo umbral que NeutroEsPortador):
+//
+//	 0 – 15 %  → por corriente de fase, factor 1.00
+//	15 – 33 %  → por corriente de fase, factor 0.86
+//	33 – 45 %  → por corriente de neutro (3 × I_3), factor 0.86
+//	  > 45 %   → por corriente de neutro (3 × I_3), factor 1.00
+//
+// El resultado es un multiplicador (≥ 1) a incluir en el mapa de factores de AjustarCorriente.
+// El conteo del neutro como conductor portador para el factor de agrupamiento
+// (310-15(b)(5)(c)) es independiente y lo decide NeutroEsPortador.
+func CalcularFactorArmonico(espectro entity.EspectroArmonico, neutroConCarga bool) entity.FactorArmonico {
+	thd := thdEspectro(espectro)
+	factorRMS := math.Sqrt(1 + thd*thd)
+	tercera := contenidoTriplenesFase(&espectro)
+
+	factorReduccion := 1.0
+	dimensionPorNeutro := false
+	factorAnexoE := 1.0
+	if neutroConCarga {
+		switch {
+		case tercera > 0.45:
+			dimensionPorNeutro = true
+			factorAnexoE = 3 * tercera
+		case tercera > 0.33:
+			dimensionPorNeutro = true
+			factorReduccion = 0.86
+			factorAnexoE = 3 * tercera / factorReduccion
+		case tercera > UmbralTriplenesNeutroPortador:
+			factorReduccion = 0.86
+			factorAnexoE = 1 / factorReduccion
+		}
+	}
+
+	return entity.FactorArmonico{
+		THDi:               thd * 100,
+		TerceraArmonica:    tercera * 100,
+		FactorRMS:          factorRMS,
+		FactorReduccion:    factorReduccion,
+		DimensionPorNeutro: dimensionPorNeutro,
+		FactorAnexoE:       factorAnexoE,
+		Factor:             factorRMS * factorAnexoE,
+	}
+}
+
+// thdEspectro retorna la distorsión total (fracción de la fundamental); si se dieron
+// los órdenes individuales, THDi = √(Σ I_h²) / I_1.
+func thdEspectro(espectro entity.EspectroArmonico) float64 {
+	if len(espectro.Ordenes) == 0 {
+		return espectro.THDi / 100.0
+	}
+	suma := 0.0
+	for _, porcentaje := range espectro.Ordenes {
+		suma += (porcentaje / 100.0) * (porcentaje / 100.0)
+	}
+	return math.Sqrt(suma)
+}
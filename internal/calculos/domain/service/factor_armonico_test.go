@@ -0,0 +1,63 @@
+// internal/calculos/domain/service/factor_armonico_test.go
+package service_test
+
+import (
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/service"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalcularFactorArmonico_SoloTHDi(t *testing.T) {
+	// THDi 40 % → I_rms / I_1 = √1.16 = 1.077
+	res := service.CalcularFactorArmonico(entity.EspectroArmonico{THDi: 40}, true)
+	assert.InDelta(t, 1.077, res.FactorRMS, 0.001)
+	assert.InDelta(t, 1.0, res.FactorAnexoE, 0.001)
+	assert.InDelta(t, 1.077, res.Factor, 0.001)
+}
+
+func TestCalcularFactorArmonico_AnexoE(t *testing.T) {
+	tests := []struct {
+		name           string
+		ordenes        map[int]float64
+		neutroConCarga bool
+		wantPorNeutro  bool
+		wantReduccion  float64
+		wantFactor     float64
+	}{
+		{
+			// THD 12 %, 3ª = 10/1.0072 = 9.9 % → sin reducción
+			name: "3a menor a 15%", ordenes: map[int]float64{3: 10, 5: 7}, neutroConCarga: true,
+			wantPorNeutro: false, wantReduccion: 1.0, wantFactor: 1.0072,
+		},
+		{
+			// THD 30.4 %, 3ª = 25/1.0452 = 23.9 % → 1.0452 / 0.86
+			name: "3a entre 15 y 33%", ordenes: map[int]float64{3: 25, 5: 15, 7: 8}, neutroConCarga: true,
+			wantPorNeutro: false, wantReduccion: 0.86, wantFactor: 1.2153,
+		},
+		{
+			// THD 41.2 %, 3ª = 40/1.0816 = 37.0 % → 3 × 0.40 / 0.86
+			name: "3a entre 33 y 45%", ordenes: map[int]float64{3: 40, 5: 10}, neutroConCarga: true,
+			wantPorNeutro: true, wantReduccion: 0.86, wantFactor: 1.3953,
+		},
+		{
+			// 3ª = 60/1.1662 = 51.4 % → 3 × 0.60
+			name: "3a mayor a 45%", ordenes: map[int]float64{3: 60}, neutroConCarga: true,
+			wantPorNeutro: true, wantReduccion: 1.0, wantFactor: 1.8,
+		},
+		{
+			name: "sin neutro solo corriente RMS", ordenes: map[int]float64{3: 60}, neutroConCarga: false,
+			wantPorNeutro: false, wantReduccion: 1.0, wantFactor: 1.1662,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := service.CalcularFactorArmonico(entity.EspectroArmonico{Ordenes: tt.ordenes}, tt.neutroConCarga)
+			assert.Equal(t, tt.wantPorNeutro, res.DimensionPorNeutro)
+			assert.InDelta(t, tt.wantReduccion, res.FactorReduccion, 0.001)
+			assert.InDelta(t, tt.wantFactor, res.Factor, 0.001)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// internal/calculos/domain/service/factor_k.go
+package service
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+)
+
+// ErrFactorKInvalido is returned when the K-factor cannot be computed from the input.
+var ErrFactorKInvalido = errors.New("datos inválidos para factor K")
+
+// FactoresKNominales son los K-rating estándar de transformadores (UL 1561).
+var FactoresKNominales = []int{1, 4, 9, 13, 20, 30, 40, 50}
+
+// PerdidasCorrientesParasitasDefault es el P_EC-R típico de un transformador
+// tipo seco de baja tensión (pu de las pérdidas I²R) según IEEE C57.110.
+const PerdidasCorrientesParasitasDefault = 0.15
+
+// CalcularFactorK obtiene el factor K de una carga no lineal y la capacidad
+// derrateada del transformador que la alimenta.
+//
+// 1. Con la fundamental como referencia (p_1 = 1):
+//
+//	K = F_HL = Σ (p_h² × h²) / Σ p_h²
+//
+// 2. Corriente máxima admisible (IEEE C57.110):
+//
+//	I_max(pu) = √((1 + P_EC-R) / (1 + F_HL × P_EC-R))
+//
+// 3. K-rating: estándar inmediato superior a K (UL 1561).
+//
+// Requiere los órdenes individuales del espectro; el THDi solo no define K.
+func CalcularFactorK(espectro entity.EspectroArmonico, kva, perdidasEC float64) (entity.FactorK, error) {
+	if kva <= 0 {
+		return entity.FactorK{}, fmt.Errorf("%w: kVA debe ser mayor que cero: %.2f", ErrFactorKInvalido, kva)
+	}
+	if perdidasEC <= 0 {
+		return entity.FactorK{}, fmt.Errorf("%w: P_EC-R debe ser mayor que cero: %.4f", ErrFactorKInvalido, perdidasEC)
+	}
+	if len(espectro.Ordenes) == 0 {
+		return entity.FactorK{}, fmt.Errorf("%w: se requieren los órdenes armónicos individuales", ErrFactorKInvalido)
+	}
+
+	sumaCuadrados := 1.0 // fundamental
+	sumaPonderada := 1.0
+	for orden, porcentaje := range espectro.Ordenes {
+		p := porcentaje / 100.0
+		sumaCuadrados += p * p
+		sumaPonderada += p * p * float64(orden*orden)
+	}
+	k := sumaPonderada / sumaCuadrados
+
+	corrienteMaxima := math.Sqrt((1 + perdidasEC) / (1 + k*perdidasEC))
+
+	return entity.FactorK{
+		K:                 k,
+		KNominal:          kNominalSuperior(k),
+		FHL:               k,
+		PerdidasEC:        perdidasEC,
+		CorrienteMaximaPU: corrienteMaxima,
+		KVA:               kva,
+		KVADerrateado:     kva * corrienteMaxima,
+	}, nil
+}
+
+// kNominalSuperior retorna el K-rating estándar ≥ k; 0 si excede el mayor estándar.
+func kNominalSuperior(k float64) int {
+	for _, nominal := range FactoresKNominales {
+		if k <= float64(nominal)+1e-9 {
+			return nominal
+		}
+	}
+	return 0
+}
@@ -0,0 +1,56 @@
+// internal/calculos/domain/service/factor_k_test.go
+package service_test
+
+import (
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalcularFactorK_RectificadorSeisPulsos(t *testing.T) {
+	// Σp² = 1.0726, Σp²h² = 4.7686 → K = 4.446
+	// I_max = √(1.15 / (1 + 4.446 × 0.15)) = 0.8306
+	espectro := entity.EspectroArmonico{Ordenes: map[int]float64{5: 20, 7: 14, 11: 9, 13: 7}}
+
+	res, err := service.CalcularFactorK(espectro, 500, service.PerdidasCorrientesParasitasDefault)
+	require.NoError(t, err)
+
+	assert.InDelta(t, 4.446, res.K, 0.001)
+	assert.Equal(t, res.K, res.FHL)
+	assert.Equal(t, 9, res.KNominal)
+	assert.InDelta(t, 0.8306, res.CorrienteMaximaPU, 0.0001)
+	assert.InDelta(t, 415.31, res.KVADerrateado, 0.01)
+}
+
+func TestCalcularFactorK_KNominal(t *testing.T) {
+	tests := []struct {
+		name    string
+		ordenes map[int]float64
+		want    int
+	}{
+		{name: "sin distorsion", ordenes: map[int]float64{5: 0}, want: 1},
+		{name: "K 2.5", ordenes: map[int]float64{2: 100}, want: 4},        // (1 + 4) / (1 + 1) = 2.5 → 4
+		{name: "excede K-50", ordenes: map[int]float64{11: 100}, want: 0}, // (1 + 121) / 2 = 61
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := service.CalcularFactorK(entity.EspectroArmonico{Ordenes: tt.ordenes}, 100, 0.1)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, res.KNominal)
+		})
+	}
+}
+
+func TestCalcularFactorK_Invalido(t *testing.T) {
+	_, err := service.CalcularFactorK(entity.EspectroArmonico{THDi: 30}, 500, 0.15)
+	assert.ErrorIs(t, err, service.ErrFactorKInvalido)
+
+	_, err = service.CalcularFactorK(entity.EspectroArmonico{Ordenes: map[int]float64{5: 20}}, 0, 0.15)
+	assert.ErrorIs(t, err, service.ErrFactorKInvalido)
+
+	_, err = service.CalcularFactorK(entity.EspectroArmonico{Ordenes: map[int]float64{5: 20}}, 500, 0)
+	assert.ErrorIs(t, err, service.ErrFactorKInvalido)
+}
@@ -0,0 +1,90 @@
+// internal/calculos/domain/service/limite_terminal.go
+package service
+
+import (
+	"fmt"
+
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+)
+
+// SeleccionLimiteTerminal es el resultado de seleccionar un conductor de 90 °C
+// limitado por la temperatura de sus terminales (NOM 110-14(c)).
+type SeleccionLimiteTerminal struct {
+	Conductor          valueobject.Conductor
+	CapacidadConductor float64 // ampacidad de tabla en la columna del aislamiento (90 °C)
+	CapacidadTerminal  float64 // ampacidad de tabla en la columna de la terminal (60/75 °C)
+	// PorTerminal indica que la columna de la terminal obligó a un calibre mayor
+	// que el que resultaba del derrateo en la columna del aislamiento.
+	PorTerminal bool
+}
+
+// AplicaLimiteTerminal indica si el derrateo parte de la columna de 90 °C con la
+// ampacidad limitada a la de las terminales (NOM 110-14(c)): conductores de 90 °C
+// conectados a terminales de 60 o 75 °C. Los aislamientos de 75 °C conservan la
+// columna de la terminal.
+func AplicaLimiteTerminal(
+	aislamiento valueobject.TipoAislamiento,
+	temperaturaTerminal valueobject.Temperatura,
+) bool {
+	return aislamiento.TemperaturaMaxima() == valueobject.Temp90 && temperaturaTerminal < valueobject.Temp90
+}
+
+// SeleccionarConductorLimiteTerminal selecciona el calibre mínimo que cumple a la vez:
+//
+//   - Columna del aislamiento: Capacidad_90 ≥ I_ajustada / N_hilos
+//     (I_ajustada ya incluye los factores de temperatura y agrupamiento de 90 °C).
+//   - Columna de la terminal: Capacidad_terminal ≥ I_diseño / N_hilos
+//     (I_diseño = I_nominal × F_uso, sin factores de corrección; 110-14(c)).
+//
+// Ambas tablas deben estar ordenadas por capacidad ascendente; los calibres sin
+// entrada en la tabla de la terminal se omiten.
+func SeleccionarConductorLimiteTerminal(
+	corrienteAjustada valueobject.Corriente,
+	corrienteDiseno valueobject.Corriente,
+	hilosPorFase int,
+	tablaConductor []valueobject.EntradaTablaConductor,
+	tablaTerminal []valueobject.EntradaTablaConductor,
+) (SeleccionLimiteTerminal, error) {
+	if len(tablaConductor) == 0 || len(tablaTerminal) == 0 {
+		return SeleccionLimiteTerminal{}, fmt.Errorf("%w: tabla vacía", ErrConductorNoEncontrado)
+	}
+
+	if hilosPorFase < 1 {
+		hilosPorFase = 1
+	}
+
+	ajustadaPorHilo := corrienteAjustada.Valor() / float64(hilosPorFase)
+	disenoPorHilo := corrienteDiseno.Valor() / float64(hilosPorFase)
+
+	capacidadTerminal := make(map[string]float64, len(tablaTerminal))
+	for _, entrada := range tablaTerminal {
+		capacidadTerminal[normalizarCalibre(entrada.Conductor.Calibre)] = entrada.Capacidad
+	}
+
+	cumpleAislamiento := false
+	for _, entrada := range tablaConductor {
+		if entrada.Capacidad < ajustadaPorHilo {
+			continue
+		}
+		terminal, ok := capacidadTerminal[normalizarCalibre(entrada.Conductor.Calibre)]
+		if ok && terminal >= disenoPorHilo {
+			conductor, err := valueobject.NewConductor(entrada.Conductor)
+			if err != nil {
+				return SeleccionLimiteTerminal{}, err
+			}
+			return SeleccionLimiteTerminal{
+				Conductor:          conductor,
+				CapacidadConductor: entrada.Capacidad,
+				CapacidadTerminal:  terminal,
+				PorTerminal:        cumpleAislamiento,
+			}, nil
+		}
+		// Este calibre cumple por derrateo pero no por terminal
+		cumpleAislamiento = true
+	}
+
+	return SeleccionLimiteTerminal{}, fmt.Errorf(
+		"%w: corriente por hilo %.2f A (ajustada) / %.2f A (terminal) excede máxima capacidad de tabla",
+		ErrConductorNoEncontrado, ajustadaPorHilo, disenoPorHilo,
+	)
+}
@@ -0,0 +1,81 @@
+// internal/calculos/domain/service/limite_terminal_test.go
+package service_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/service"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Extracto de la Tabla 310-15(b)(16) Cu: columnas de 90°C y 75°C
+var (
+	tablaCu90 = []valueobject.EntradaTablaConductor{
+		entradaConductor("4 AWG", 95, 21.15),
+		entradaConductor("2 AWG", 130, 33.62),
+		entradaConductor("1/0 AWG", 170, 53.49),
+		entradaConductor("2/0 AWG", 195, 67.43),
+	}
+	tablaCu75 = []valueobject.EntradaTablaConductor{
+		entradaConductor("4 AWG", 85, 21.15),
+		entradaConductor("2 AWG", 115, 33.62),
+		entradaConductor("1/0 AWG", 150, 53.49),
+		entradaConductor("2/0 AWG", 175, 67.43),
+	}
+)
+
+func TestAplicaLimiteTerminal(t *testing.T) {
+	assert.True(t, service.AplicaLimiteTerminal(valueobject.AislamientoTHHN, valueobject.Temp75))
+	assert.True(t, service.AplicaLimiteTerminal(valueobject.AislamientoXHHW, valueobject.Temp60))
+	assert.False(t, service.AplicaLimiteTerminal(valueobject.AislamientoTHW, valueobject.Temp60))
+	assert.False(t, service.AplicaLimiteTerminal(valueobject.AislamientoTHHN, valueobject.Temp90))
+}
+
+func TestSeleccionarConductorLimiteTerminal(t *testing.T) {
+	tests := []struct {
+		name        string
+		ajustada    float64
+		diseno      float64
+		hilos       int
+		calibre     string
+		capacidad   float64
+		terminal    float64
+		porTerminal bool
+	}{
+		// 90°C: 2 AWG (130 ≥ 120); 75°C: 115 ≥ 110
+		{"derrateo define el calibre", 120, 110, 1, "2 AWG", 130, 115, false},
+		// 90°C: 2 AWG cumple, pero 75°C: 115 < 120 → 1/0 AWG (150 ≥ 120)
+		{"terminal aumenta el calibre", 120, 120, 1, "1/0 AWG", 170, 150, true},
+		// 240/2 = 120 A por hilo (ajustada), 220/2 = 110 A por hilo (terminal)
+		{"hilos en paralelo", 240, 220, 2, "2 AWG", 130, 115, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ajustada, err := valueobject.NewCorriente(tt.ajustada)
+			require.NoError(t, err)
+			diseno, err := valueobject.NewCorriente(tt.diseno)
+			require.NoError(t, err)
+
+			sel, err := service.SeleccionarConductorLimiteTerminal(ajustada, diseno, tt.hilos, tablaCu90, tablaCu75)
+			require.NoError(t, err)
+			assert.Equal(t, tt.calibre, sel.Conductor.Calibre())
+			assert.Equal(t, tt.capacidad, sel.CapacidadConductor)
+			assert.Equal(t, tt.terminal, sel.CapacidadTerminal)
+			assert.Equal(t, tt.porTerminal, sel.PorTerminal)
+		})
+	}
+}
+
+func TestSeleccionarConductorLimiteTerminal_ExcedeTabla(t *testing.T) {
+	ajustada, err := valueobject.NewCorriente(180)
+	require.NoError(t, err)
+	diseno, err := valueobject.NewCorriente(180)
+	require.NoError(t, err)
+
+	// 2/0 AWG cumple a 90°C (195) pero no a 75°C (175)
+	_, err = service.SeleccionarConductorLimiteTerminal(ajustada, diseno, 1, tablaCu90, tablaCu75)
+	assert.True(t, errors.Is(err, service.ErrConductorNoEncontrado))
+}
@@ -0,0 +1,222 @@
+// internal/calculos/domain/service/llenado_charola.go
+package service
+
+import (
+	"fmt"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+)
+
+// SeccionMonoconductorPorAreaMM2 es la sección a partir de la cual los monoconductores
+// se limitan por área en lugar de suma de diámetros: 507 mm² (1000 kcmil), 392-22(b)(1).
+const SeccionMonoconductorPorAreaMM2 = 506.7
+
+// constanteReduccionMonoconductor is the mm² subtracted per mm of the sum of diameters
+// of single conductors smaller than 1000 kcmil sharing the tray, Tabla 392-22(b)(1) columna 2.
+const constanteReduccionMonoconductor = 28
+
+// SeccionMulticonductorUnaCapaMM2 es la sección (4/0 AWG) a partir de la cual los cables
+// multiconductores de fuerza se tienden en una sola capa y se limitan por suma de
+// diámetros en lugar de área, 392-22(a)(1)(a).
+const SeccionMulticonductorUnaCapaMM2 = 107.2
+
+// CriterioLlenadoCharola agrupa el tipo y peralte de la charola con la tabla de
+// anchos comerciales y límites de llenado NOM 392-22 para ese tipo.
+type CriterioLlenadoCharola struct {
+	Especificacion entity.EspecificacionCharola
+	Tabla          []valueobject.EntradaTablaLlenadoCharola
+}
+
+// GrupoMonoconductores representa varios cables monoconductores iguales en la charola.
+type GrupoMonoconductores struct {
+	Conductor valueobject.ConductorCharola
+	Cantidad  int
+}
+
+// GrupoCablesMulticonductor representa varios cables multiconductores de fuerza (TC/MC)
+// iguales en la charola.
+type GrupoCablesMulticonductor struct {
+	Cable    valueobject.CableMulticonductor
+	Cantidad int
+}
+
+// ResultadoLlenadoCharola es la verificación de llenado NOM 392-22 de un ancho de charola.
+type ResultadoLlenadoCharola struct {
+	Cumple bool
+	Motivo string // regla incumplida; vacío si cumple
+
+	AnchoUtilMM     float64 // ancho × 0.90 en fondo sólido
+	SumaDiametrosMM float64 // monoconductores menores a 1000 kcmil y multiconductores de 4/0 y mayores, en una sola capa
+
+	AreaMonoconductoresMM2           float64 // monoconductores de 1000 kcmil y mayores
+	AreaMonoconductoresPermitidaMM2  float64
+	AreaMulticonductoresMM2          float64
+	AreaMulticonductoresPermitidaMM2 float64
+}
+
+// VerificarLlenadoCharola aplica las reglas de llenado NOM 392-22 a un ancho de charola:
+//
+//   - Monoconductores menores a 1000 kcmil: Σd ≤ ancho útil, una sola capa (392-22(b)(1)(b)/(d));
+//     en canal ventilado aplica a todos los calibres (392-22(b)(2)).
+//   - Monoconductores de 1000 kcmil y mayores: ΣA ≤ Col. 1 − 28·Σd de los menores (392-22(b)(1)(a)/(c)).
+//   - Multiconductores menores a 4/0: ΣA ≤ Col. 1 − 30·Σd (escalera) o Col. 3 − 25·Σd (fondo sólido),
+//     con Σd de los monoconductores tendidos en una capa; en canal, Tabla 392-22(a)(5).
+//
+// En fondo sólido el ancho útil y el área de monoconductores se reducen al 90 %
+// (criterio de 392-22(a)(3)). Ningún cable puede exceder el peralte de la charola.
+func VerificarLlenadoCharola(
+	especificacion entity.EspecificacionCharola,
+	entrada valueobject.EntradaTablaLlenadoCharola,
+	monoconductores []GrupoMonoconductores,
+	multiconductores []valueobject.CableControl,
+) ResultadoLlenadoCharola {
+	return VerificarLlenadoCharolaConCables(especificacion, entrada, monoconductores, nil, multiconductores)
+}
+
+// VerificarLlenadoCharolaConCables es VerificarLlenadoCharola con cables multiconductores
+// de fuerza (TC/MC). Los de 4/0 y mayores se tienden en una sola capa y suman su diámetro
+// al ancho útil (392-22(a)(1)(a)); los menores, o cualquiera en canal, se limitan por área
+// junto con los cables de control.
+func VerificarLlenadoCharolaConCables(
+	especificacion entity.EspecificacionCharola,
+	entrada valueobject.EntradaTablaLlenadoCharola,
+	monoconductores []GrupoMonoconductores,
+	cablesFuerza []GrupoCablesMulticonductor,
+	multiconductores []valueobject.CableControl,
+) ResultadoLlenadoCharola {
+	tipo := especificacion.Tipo
+	factorUtil := tipo.FactorAnchoUtil()
+	resultado := ResultadoLlenadoCharola{AnchoUtilMM: entrada.AnchoMM * factorUtil}
+
+	noCumple := func(format string, args ...any) ResultadoLlenadoCharola {
+		resultado.Motivo = fmt.Sprintf(format, args...)
+		return resultado
+	}
+
+	// Monoconductores: separar los que se limitan por área (≥ 1000 kcmil)
+	var sumaDiametrosTotal float64
+	hayPorArea := false
+	for _, grupo := range monoconductores {
+		if grupo.Cantidad <= 0 {
+			continue
+		}
+		diametro := grupo.Conductor.DiametroMM()
+		if especificacion.PeralteMM > 0 && diametro > especificacion.PeralteMM {
+			return noCumple("diámetro %.1f mm excede el peralte de %.1f mm", diametro, especificacion.PeralteMM)
+		}
+		sumaDiametrosTotal += diametro * float64(grupo.Cantidad)
+		if tipo != entity.TipoCharolaCanal && grupo.Conductor.SeccionMM2() >= SeccionMonoconductorPorAreaMM2 {
+			hayPorArea = true
+			resultado.AreaMonoconductoresMM2 += grupo.Conductor.AreaExteriorMM2() * float64(grupo.Cantidad)
+			continue
+		}
+		resultado.SumaDiametrosMM += diametro * float64(grupo.Cantidad)
+	}
+
+	// Multiconductores de fuerza: los de 4/0 y mayores van en una capa
+	cantidadMulti := 0
+	for _, grupo := range cablesFuerza {
+		if grupo.Cantidad <= 0 {
+			continue
+		}
+		diametro := grupo.Cable.DiametroMM()
+		if especificacion.PeralteMM > 0 && diametro > especificacion.PeralteMM {
+			return noCumple("diámetro %.1f mm excede el peralte de %.1f mm", diametro, especificacion.PeralteMM)
+		}
+		if tipo != entity.TipoCharolaCanal && grupo.Cable.SeccionMM2() >= SeccionMulticonductorUnaCapaMM2 {
+			sumaDiametrosTotal += diametro * float64(grupo.Cantidad)
+			resultado.SumaDiametrosMM += diametro * float64(grupo.Cantidad)
+			continue
+		}
+		cantidadMulti += grupo.Cantidad
+		resultado.AreaMulticonductoresMM2 += grupo.Cable.AreaExteriorMM2() * float64(grupo.Cantidad)
+	}
+
+	if tipo == entity.TipoCharolaCanal {
+		if resultado.SumaDiametrosMM > resultado.AnchoUtilMM {
+			return noCumple("suma de diámetros %.1f mm excede el ancho del canal de %.1f mm (392-22(b)(2))",
+				resultado.SumaDiametrosMM, resultado.AnchoUtilMM)
+		}
+	} else {
+		if hayPorArea {
+			resultado.AreaMonoconductoresPermitidaMM2 = entrada.AreaMonoconductorMM2*factorUtil -
+				constanteReduccionMonoconductor*resultado.SumaDiametrosMM
+			if resultado.AreaMonoconductoresMM2 > resultado.AreaMonoconductoresPermitidaMM2 {
+				return noCumple("área de monoconductores de 1000 kcmil y mayores %.0f mm² excede %.0f mm² (392-22(b)(1))",
+					resultado.AreaMonoconductoresMM2, resultado.AreaMonoconductoresPermitidaMM2)
+			}
+		}
+		if resultado.SumaDiametrosMM > resultado.AnchoUtilMM {
+			return noCumple("suma de diámetros %.1f mm excede el ancho útil de %.1f mm (392-22(b)(1))",
+				resultado.SumaDiametrosMM, resultado.AnchoUtilMM)
+		}
+	}
+
+	// Multiconductores por área
+	for _, cable := range multiconductores {
+		if cable.Cantidad() <= 0 {
+			continue
+		}
+		if especificacion.PeralteMM > 0 && cable.DiametroMM() > especificacion.PeralteMM {
+			return noCumple("diámetro %.1f mm excede el peralte de %.1f mm", cable.DiametroMM(), especificacion.PeralteMM)
+		}
+		cantidadMulti += cable.Cantidad()
+		resultado.AreaMulticonductoresMM2 += cable.AreaExteriorMM2() * float64(cable.Cantidad())
+	}
+	if cantidadMulti > 0 {
+		switch {
+		case tipo == entity.TipoCharolaCanal && cantidadMulti == 1 && sumaDiametrosTotal == 0:
+			resultado.AreaMulticonductoresPermitidaMM2 = entrada.AreaMulticonductorUnicoMM2
+		case tipo == entity.TipoCharolaCanal:
+			resultado.AreaMulticonductoresPermitidaMM2 = entrada.AreaMulticonductorMM2
+		default:
+			resultado.AreaMulticonductoresPermitidaMM2 = entrada.AreaMulticonductorMM2 -
+				tipo.ConstanteReduccionArea()*sumaDiametrosTotal
+		}
+		if resultado.AreaMulticonductoresMM2 > resultado.AreaMulticonductoresPermitidaMM2 {
+			return noCumple("área de multiconductores %.0f mm² excede %.0f mm² (Tabla 392-22(a))",
+				resultado.AreaMulticonductoresMM2, resultado.AreaMulticonductoresPermitidaMM2)
+		}
+	}
+
+	resultado.Cumple = true
+	return resultado
+}
+
+// seleccionarCharolaConLlenado retorna el primer ancho comercial que cubre el ancho
+// requerido y cumple el llenado NOM 392-22. errNoEncontrada se envuelve con el motivo
+// del último ancho rechazado.
+func seleccionarCharolaConLlenado(
+	tipoCanalizacion entity.TipoCanalizacion,
+	anchoRequerido float64,
+	criterio CriterioLlenadoCharola,
+	monoconductores []GrupoMonoconductores,
+	cablesFuerza []GrupoCablesMulticonductor,
+	multiconductores []valueobject.CableControl,
+	errNoEncontrada error,
+) (entity.Canalizacion, ResultadoLlenadoCharola, error) {
+	if len(criterio.Tabla) == 0 {
+		return entity.Canalizacion{}, ResultadoLlenadoCharola{}, ErrTablaCharolaVacia
+	}
+
+	motivo := fmt.Sprintf("ancho requerido %.1f mm", anchoRequerido)
+	for _, entrada := range criterio.Tabla {
+		if entrada.AnchoMM < anchoRequerido {
+			continue
+		}
+		llenado := VerificarLlenadoCharolaConCables(criterio.Especificacion, entrada, monoconductores, cablesFuerza, multiconductores)
+		if !llenado.Cumple {
+			motivo = fmt.Sprintf("charola %s\": %s", entrada.Tamano, llenado.Motivo)
+			continue
+		}
+		return entity.Canalizacion{
+			Tipo:             tipoCanalizacion,
+			Tamano:           entrada.Tamano,
+			AnchoRequerido:   anchoRequerido,
+			AnchoComercialMM: entrada.AnchoMM,
+		}, llenado, nil
+	}
+
+	return entity.Canalizacion{}, ResultadoLlenadoCharola{}, fmt.Errorf("%w: %s (%s)", errNoEncontrada, motivo, criterio.Especificacion.Tipo)
+}
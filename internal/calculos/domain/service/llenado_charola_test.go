@@ -0,0 +1,141 @@
+// internal/calculos/domain/service/llenado_charola_test.go
+package service_test
+
+import (
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/service"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Anchos y áreas de las tablas charola-llenado-392-22.csv / charola-canal-392-22.csv
+var (
+	llenadoEscalera = []valueobject.EntradaTablaLlenadoCharola{
+		{Tamano: "6", AnchoMM: 152.4, AreaMulticonductorMM2: 4500, AreaMonoconductorMM2: 4200},
+		{Tamano: "9", AnchoMM: 228.6, AreaMulticonductorMM2: 6800, AreaMonoconductorMM2: 6100},
+		{Tamano: "12", AnchoMM: 304.8, AreaMulticonductorMM2: 9000, AreaMonoconductorMM2: 8400},
+	}
+	llenadoFondoSolido = []valueobject.EntradaTablaLlenadoCharola{
+		{Tamano: "6", AnchoMM: 152.4, AreaMulticonductorMM2: 3500, AreaMonoconductorMM2: 4200},
+		{Tamano: "9", AnchoMM: 228.6, AreaMulticonductorMM2: 5100, AreaMonoconductorMM2: 6100},
+		{Tamano: "12", AnchoMM: 304.8, AreaMulticonductorMM2: 7100, AreaMonoconductorMM2: 8400},
+	}
+	llenadoCanal = []valueobject.EntradaTablaLlenadoCharola{
+		{Tamano: "3", AnchoMM: 76.2, AreaMulticonductorUnicoMM2: 1500, AreaMulticonductorMM2: 850},
+		{Tamano: "4", AnchoMM: 101.6, AreaMulticonductorUnicoMM2: 2900, AreaMulticonductorMM2: 1600},
+		{Tamano: "6", AnchoMM: 152.4, AreaMulticonductorUnicoMM2: 4500, AreaMulticonductorMM2: 2450},
+	}
+)
+
+func especificacion(t *testing.T, tipo string) entity.EspecificacionCharola {
+	t.Helper()
+	esp, err := entity.NewEspecificacionCharola(tipo, 0)
+	require.NoError(t, err)
+	return esp
+}
+
+func TestCalcularCharolaTriangularConLlenado_1000kcmilPorTipo(t *testing.T) {
+	// 2 hilos por fase de 1000 kcmil (Ø35 mm): ancho requerido
+	// 2·35·2 + 2.15·35 + 9 = 224.25 mm → 9" por ancho.
+	// Área 6 × π·35²/4 = 5772.7 mm²
+	conductorFase, err := valueobject.NewConductorCharola(valueobject.ConductorCharolaParams{DiametroMM: 35, SeccionMM2: 506.7})
+	require.NoError(t, err)
+	conductorTierra, err := valueobject.NewConductorCharola(valueobject.ConductorCharolaParams{DiametroMM: 9, SeccionMM2: 33.6})
+	require.NoError(t, err)
+
+	t.Run("escalera: 6100 − 28·9 = 5848 mm² cumple en 9\"", func(t *testing.T) {
+		result, llenado, err := service.CalcularCharolaTriangularConLlenado(2, conductorFase, conductorTierra, nil,
+			service.CriterioLlenadoCharola{Especificacion: especificacion(t, "ESCALERA"), Tabla: llenadoEscalera})
+		require.NoError(t, err)
+		assert.Equal(t, "9", result.Tamano)
+		assert.True(t, llenado.Cumple)
+		assert.InDelta(t, 5772.7, llenado.AreaMonoconductoresMM2, 0.1)
+		assert.InDelta(t, 5848.0, llenado.AreaMonoconductoresPermitidaMM2, 0.1)
+	})
+
+	t.Run("fondo sólido: 0.9·6100 − 252 = 5238 mm² no cumple, sube a 12\"", func(t *testing.T) {
+		result, llenado, err := service.CalcularCharolaTriangularConLlenado(2, conductorFase, conductorTierra, nil,
+			service.CriterioLlenadoCharola{Especificacion: especificacion(t, "FONDO_SOLIDO"), Tabla: llenadoFondoSolido})
+		require.NoError(t, err)
+		assert.Equal(t, "12", result.Tamano)
+		assert.InDelta(t, 274.32, llenado.AnchoUtilMM, 0.01)
+	})
+
+	t.Run("canal: no hay ancho suficiente", func(t *testing.T) {
+		_, _, err := service.CalcularCharolaTriangularConLlenado(2, conductorFase, conductorTierra, nil,
+			service.CriterioLlenadoCharola{Especificacion: especificacion(t, "CANAL"), Tabla: llenadoCanal})
+		assert.ErrorIs(t, err, service.ErrCharolaTriangularNoEncontrada)
+	})
+}
+
+func TestCalcularCharolaEspaciadoConLlenado_Canal(t *testing.T) {
+	// Monofásico, fase y neutro Ø10 mm, tierra Ø5 mm, control Ø12 mm:
+	// 20 + 20 + 12 + 12 + 5 = 69 mm → canal 3" (76.2 mm)
+	conductor, _ := valueobject.NewConductorCharola(valueobject.ConductorCharolaParams{DiametroMM: 10})
+	tierra, _ := valueobject.NewConductorCharola(valueobject.ConductorCharolaParams{DiametroMM: 5})
+	control, _ := valueobject.NewCableControl(valueobject.CableControlParams{Cantidad: 1, DiametroMM: 12})
+
+	result, llenado, err := service.CalcularCharolaEspaciadoConLlenado(
+		1, entity.SistemaElectricoMonofasico, conductor, conductor, tierra,
+		[]valueobject.CableControl{control},
+		service.CriterioLlenadoCharola{Especificacion: especificacion(t, "CANAL"), Tabla: llenadoCanal},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "3", result.Tamano)
+	assert.InDelta(t, 69.0, result.AnchoRequerido, 0.01)
+	assert.InDelta(t, 25.0, llenado.SumaDiametrosMM, 0.01)
+	assert.InDelta(t, 850.0, llenado.AreaMulticonductoresPermitidaMM2, 0.01)
+}
+
+func TestVerificarLlenadoCharola(t *testing.T) {
+	mono := func(diametro float64, cantidad int) []service.GrupoMonoconductores {
+		c, err := valueobject.NewConductorCharola(valueobject.ConductorCharolaParams{DiametroMM: diametro})
+		require.NoError(t, err)
+		return []service.GrupoMonoconductores{{Conductor: c, Cantidad: cantidad}}
+	}
+	control := func(diametro float64, cantidad int) []valueobject.CableControl {
+		c, err := valueobject.NewCableControl(valueobject.CableControlParams{Cantidad: cantidad, DiametroMM: diametro})
+		require.NoError(t, err)
+		return []valueobject.CableControl{c}
+	}
+
+	t.Run("escalera: suma de diámetros dentro del ancho", func(t *testing.T) {
+		r := service.VerificarLlenadoCharola(especificacion(t, "ESCALERA"), llenadoEscalera[0], mono(25, 6), nil)
+		assert.True(t, r.Cumple)
+		assert.InDelta(t, 150.0, r.SumaDiametrosMM, 0.01)
+	})
+
+	t.Run("fondo sólido: suma de diámetros excede el 90% del ancho", func(t *testing.T) {
+		r := service.VerificarLlenadoCharola(especificacion(t, "FONDO_SOLIDO"), llenadoFondoSolido[0], mono(25, 6), nil)
+		assert.False(t, r.Cumple)
+		assert.Contains(t, r.Motivo, "392-22(b)(1)")
+	})
+
+	t.Run("multiconductores: área menos 30·Σd de monoconductores", func(t *testing.T) {
+		// 4500 − 30·100 = 1500 mm²; control Ø50 mm = 1963.5 mm²
+		r := service.VerificarLlenadoCharola(especificacion(t, "ESCALERA"), llenadoEscalera[0], mono(25, 4), control(50, 1))
+		assert.False(t, r.Cumple)
+		assert.InDelta(t, 1500.0, r.AreaMulticonductoresPermitidaMM2, 0.01)
+		assert.Contains(t, r.Motivo, "392-22(a)")
+	})
+
+	t.Run("canal: un multiconductor usa su propia columna", func(t *testing.T) {
+		r := service.VerificarLlenadoCharola(especificacion(t, "CANAL"), llenadoCanal[0], nil, control(40, 1))
+		assert.True(t, r.Cumple)
+		assert.InDelta(t, 1500.0, r.AreaMulticonductoresPermitidaMM2, 0.01)
+
+		r = service.VerificarLlenadoCharola(especificacion(t, "CANAL"), llenadoCanal[0], nil, control(25, 2))
+		assert.False(t, r.Cumple)
+	})
+
+	t.Run("cable más grueso que el peralte", func(t *testing.T) {
+		esp, err := entity.NewEspecificacionCharola("ESCALERA", 76.2)
+		require.NoError(t, err)
+		r := service.VerificarLlenadoCharola(esp, llenadoEscalera[2], mono(80, 1), nil)
+		assert.False(t, r.Cumple)
+		assert.Contains(t, r.Motivo, "peralte")
+	})
+}
@@ -0,0 +1,119 @@
+// internal/calculos/domain/service/relacion_atascamiento.go
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+)
+
+// ErrDiametroTuboNoDisponible is returned when the conduit dimension table has no
+// inside diameter for a trade size.
+var ErrDiametroTuboNoDisponible = errors.New("diámetro interior de tubería no disponible")
+
+const (
+	// Rango de la relación de atascamiento (jam ratio) con riesgo de que tres
+	// conductores se acuñen lado a lado en una curva.
+	RelacionAtascamientoMin = 2.8
+	RelacionAtascamientoMax = 3.2
+
+	// factorOvalamiento considera que el tubo se ovala en las curvas (D efectivo = 1.05 D).
+	factorOvalamiento = 1.05
+)
+
+// CalcularRelacionAtascamiento calcula la relación de atascamiento de tres conductores
+// iguales de diámetro d en un tubo de diámetro interior D:
+//
+//	Relación = 1.05 × D / d
+func CalcularRelacionAtascamiento(diametroInteriorMM, diametroConductorMM float64) float64 {
+	if diametroConductorMM <= 0 {
+		return 0
+	}
+	return factorOvalamiento * diametroInteriorMM / diametroConductorMM
+}
+
+// EnRangoAtascamiento indica si la relación cae en el rango 2.8 – 3.2.
+func EnRangoAtascamiento(relacion float64) bool {
+	return relacion >= RelacionAtascamientoMin && relacion <= RelacionAtascamientoMax
+}
+
+// VerificarAtascamientoTuberia revisa la relación de atascamiento del tubo seleccionado por
+// área. Si cae en el rango de riesgo avanza por la tabla de ocupación (ordenada de menor a
+// mayor) hasta el primer tamaño que queda fuera del rango. Los diámetros interiores se
+// toman de la tabla de dimensiones de tubo.
+func VerificarAtascamientoTuberia(
+	seleccion entity.ResultadoTamanioTuberia,
+	tablaOcupacion []valueobject.EntradaTablaOcupacion,
+	tablaDimensiones []valueobject.EntradaTablaCanalizacion,
+	diametroConductorMM float64,
+) (entity.VerificacionAtascamiento, error) {
+	if diametroConductorMM <= 0 {
+		return entity.VerificacionAtascamiento{}, fmt.Errorf("VerificarAtascamientoTuberia: diámetro de conductor %.2f mm", diametroConductorMM)
+	}
+
+	diametros := make(map[string]float64, len(tablaDimensiones))
+	for _, e := range tablaDimensiones {
+		if e.DiametroInteriorMM > 0 {
+			diametros[e.Tamano] = e.DiametroInteriorMM
+		}
+	}
+
+	inicio := -1
+	for i, e := range tablaOcupacion {
+		if e.Tamano == seleccion.TuberiaRecomendada() {
+			inicio = i
+			break
+		}
+	}
+	if inicio < 0 {
+		return entity.VerificacionAtascamiento{}, fmt.Errorf("%w: %s no está en la tabla de ocupación",
+			ErrTuberiaNoEncontrada, seleccion.TuberiaRecomendada())
+	}
+
+	verificacion := entity.VerificacionAtascamiento{
+		Resultado:      seleccion,
+		TamanoOriginal: seleccion.TuberiaRecomendada(),
+	}
+
+	for i := inicio; i < len(tablaOcupacion); i++ {
+		entrada := tablaOcupacion[i]
+		diametroTubo, ok := diametros[entrada.Tamano]
+		if !ok {
+			return entity.VerificacionAtascamiento{}, fmt.Errorf("%w: %s", ErrDiametroTuboNoDisponible, entrada.Tamano)
+		}
+
+		relacion := CalcularRelacionAtascamiento(diametroTubo, diametroConductorMM)
+		if i == inicio {
+			verificacion.RelacionOriginal = relacion
+		}
+		if EnRangoAtascamiento(relacion) {
+			continue
+		}
+
+		verificacion.Relacion = relacion
+		if i == inicio {
+			return verificacion, nil
+		}
+
+		resultado, err := entity.NewResultadoTamanioTuberia(
+			seleccion.AreaPorTuboMM2(),
+			entrada.Tamano,
+			entrada.DesignacionMetrica,
+			seleccion.TipoCanalizacion(),
+			seleccion.NumTuberias(),
+		)
+		if err != nil {
+			return entity.VerificacionAtascamiento{}, fmt.Errorf("VerificarAtascamientoTuberia: %w", err)
+		}
+		verificacion.Resultado = resultado
+		verificacion.Ajustada = true
+		return verificacion, nil
+	}
+
+	return entity.VerificacionAtascamiento{}, fmt.Errorf(
+		"%w: todos los tamaños desde %s quedan en el rango de atascamiento (%.1f – %.1f)",
+		ErrTuberiaNoEncontrada, seleccion.TuberiaRecomendada(), RelacionAtascamientoMin, RelacionAtascamientoMax,
+	)
+}
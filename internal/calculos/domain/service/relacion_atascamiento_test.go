@@ -0,0 +1,79 @@
+// internal/calculos/domain/service/relacion_atascamiento_test.go
+package service_test
+
+import (
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/service"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	ocupacionAtascamiento = []valueobject.EntradaTablaOcupacion{
+		{Tamano: "1 1/4", AreaOcupacionMM2: 374, DesignacionMetrica: "35"},
+		{Tamano: "1 1/2", AreaOcupacionMM2: 510, DesignacionMetrica: "41"},
+		{Tamano: "2", AreaOcupacionMM2: 843, DesignacionMetrica: "53"},
+	}
+	dimensionesAtascamiento = []valueobject.EntradaTablaCanalizacion{
+		{Tamano: "1 1/4", DiametroInteriorMM: 47.63},
+		{Tamano: "1 1/2", DiametroInteriorMM: 54.61},
+		{Tamano: "2", DiametroInteriorMM: 68.07},
+	}
+)
+
+func seleccionTuberia(t *testing.T, tamano, designacion string) entity.ResultadoTamanioTuberia {
+	t.Helper()
+	r, err := entity.NewResultadoTamanioTuberia(300, tamano, designacion, entity.TipoCanalizacionTuberiaPVC, 1)
+	require.NoError(t, err)
+	return r
+}
+
+func TestCalcularRelacionAtascamiento(t *testing.T) {
+	assert.InDelta(t, 3.15, service.CalcularRelacionAtascamiento(60, 20), 0.0001)
+	assert.Zero(t, service.CalcularRelacionAtascamiento(60, 0))
+
+	assert.True(t, service.EnRangoAtascamiento(2.8))
+	assert.True(t, service.EnRangoAtascamiento(3.2))
+	assert.False(t, service.EnRangoAtascamiento(2.79))
+	assert.False(t, service.EnRangoAtascamiento(3.21))
+}
+
+func TestVerificarAtascamientoTuberia(t *testing.T) {
+	t.Run("fuera del rango: conserva el tubo", func(t *testing.T) {
+		v, err := service.VerificarAtascamientoTuberia(
+			seleccionTuberia(t, "1 1/4", "35"), ocupacionAtascamiento, dimensionesAtascamiento, 20)
+		require.NoError(t, err)
+		assert.False(t, v.Ajustada)
+		assert.Equal(t, "1 1/4", v.Resultado.TuberiaRecomendada())
+		assert.InDelta(t, 2.5006, v.Relacion, 0.001) // 1.05 × 47.63 / 20
+		assert.Equal(t, v.RelacionOriginal, v.Relacion)
+	})
+
+	t.Run("en el rango: pasa al siguiente tamaño", func(t *testing.T) {
+		v, err := service.VerificarAtascamientoTuberia(
+			seleccionTuberia(t, "1 1/2", "41"), ocupacionAtascamiento, dimensionesAtascamiento, 20)
+		require.NoError(t, err)
+		assert.True(t, v.Ajustada)
+		assert.Equal(t, "1 1/2", v.TamanoOriginal)
+		assert.InDelta(t, 2.867, v.RelacionOriginal, 0.001) // 1.05 × 54.61 / 20
+		assert.Equal(t, "2", v.Resultado.TuberiaRecomendada())
+		assert.Equal(t, "53", v.Resultado.DesignacionMetrica())
+		assert.InDelta(t, 3.574, v.Relacion, 0.001) // 1.05 × 68.07 / 20
+		assert.Equal(t, 300.0, v.Resultado.AreaPorTuboMM2())
+	})
+
+	t.Run("sin tamaño mayor fuera del rango", func(t *testing.T) {
+		_, err := service.VerificarAtascamientoTuberia(
+			seleccionTuberia(t, "2", "53"), ocupacionAtascamiento, dimensionesAtascamiento, 23)
+		assert.ErrorIs(t, err, service.ErrTuberiaNoEncontrada)
+	})
+
+	t.Run("sin diámetro interior en la tabla", func(t *testing.T) {
+		_, err := service.VerificarAtascamientoTuberia(
+			seleccionTuberia(t, "1 1/2", "41"), ocupacionAtascamiento, dimensionesAtascamiento[:2], 20)
+		assert.ErrorIs(t, err, service.ErrDiametroTuboNoDisponible)
+	})
+}
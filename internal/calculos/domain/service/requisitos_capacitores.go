@@ -0,0 +1,42 @@
+// internal/calculos/domain/service/requisitos_capacitores.go
+package service
+
+import (
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+)
+
+// Requisitos NOM 460 para capacitores.
+const (
+	// FactorCapacitores es el 135 % de la corriente nominal exigido para
+	// conductores (460-8(a)) y medio de desconexión (460-8(c)).
+	FactorCapacitores = 1.35
+
+	// TensionResidualMaximaCapacitores es la tensión residual máxima tras desconectar [V].
+	TensionResidualMaximaCapacitores = 50.0
+
+	// TiempoDescargaBajaTension aplica a capacitores de 600 V o menos (460-6(a)) [s].
+	TiempoDescargaBajaTension = 60.0
+
+	// TiempoDescargaMediaTension aplica a capacitores de más de 600 V (460-28(a)) [s].
+	TiempoDescargaMediaTension = 300.0
+)
+
+// CalcularRequisitosBancoCapacitores obtiene los mínimos de conductor y medio de
+// desconexión (135 % de I_n) y el requisito de descarga según la tensión del banco.
+func CalcularRequisitosBancoCapacitores(
+	corrienteNominal valueobject.Corriente,
+	tension int,
+) entity.RequisitosBancoCapacitores {
+	tiempoDescarga := TiempoDescargaBajaTension
+	if tension > 600 {
+		tiempoDescarga = TiempoDescargaMediaTension
+	}
+
+	return entity.RequisitosBancoCapacitores{
+		CorrienteMinimaConductor:     corrienteNominal.Valor() * FactorCapacitores,
+		CorrienteMinimaDesconectador: corrienteNominal.Valor() * FactorCapacitores,
+		TensionResidualMaxima:        TensionResidualMaximaCapacitores,
+		TiempoDescarga:               tiempoDescarga,
+	}
+}
@@ -0,0 +1,29 @@
+// internal/calculos/domain/service/requisitos_capacitores_test.go
+package service_test
+
+import (
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/service"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalcularRequisitosBancoCapacitores(t *testing.T) {
+	corriente, err := valueobject.NewCorriente(100)
+	require.NoError(t, err)
+
+	t.Run("baja tensión: descarga a 50 V en 1 min", func(t *testing.T) {
+		req := service.CalcularRequisitosBancoCapacitores(corriente, 480)
+		assert.InDelta(t, 135.0, req.CorrienteMinimaConductor, 0.001)
+		assert.InDelta(t, 135.0, req.CorrienteMinimaDesconectador, 0.001)
+		assert.InDelta(t, 50.0, req.TensionResidualMaxima, 0.001)
+		assert.InDelta(t, 60.0, req.TiempoDescarga, 0.001)
+	})
+
+	t.Run("más de 600 V: descarga en 5 min", func(t *testing.T) {
+		req := service.CalcularRequisitosBancoCapacitores(corriente, 4160)
+		assert.InDelta(t, 300.0, req.TiempoDescarga, 0.001)
+	})
+}
@@ -0,0 +1,51 @@
+// internal/calculos/domain/service/seleccionar_itm.go
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+)
+
+// ErrITMNoEncontrado is returned when no standard breaker rating covers the required current.
+var ErrITMNoEncontrado = errors.New("no se encontró ITM estándar con capacidad suficiente")
+
+// SeleccionarITM propone el interruptor termomagnético a partir de la corriente nominal.
+//
+//	I_mín = I_n × F_uso
+//
+// Se selecciona la capacidad estándar (NOM 240-6(a)) inmediata superior o igual a I_mín.
+// La tabla debe estar ordenada por Amperaje ascendente.
+func SeleccionarITM(
+	corrienteNominal valueobject.Corriente,
+	factorUso float64,
+	tabla []valueobject.EntradaTablaITM,
+) (entity.SeleccionITM, error) {
+	if factorUso <= 0 {
+		return entity.SeleccionITM{}, fmt.Errorf("factor de uso debe ser mayor que cero: %.2f", factorUso)
+	}
+	if len(tabla) == 0 {
+		return entity.SeleccionITM{}, fmt.Errorf("%w: tabla de ITM vacía", ErrITMNoEncontrado)
+	}
+
+	corrienteMinima := corrienteNominal.Valor() * factorUso
+
+	for _, entrada := range tabla {
+		if float64(entrada.Amperaje) >= corrienteMinima {
+			return entity.SeleccionITM{
+				CorrienteNominal: corrienteNominal.Valor(),
+				FactorUso:        factorUso,
+				CorrienteMinima:  corrienteMinima,
+				Amperaje:         entrada.Amperaje,
+				Marco:            entrada.Marco,
+			}, nil
+		}
+	}
+
+	return entity.SeleccionITM{}, fmt.Errorf(
+		"%w: %.2f A excede máximo de tabla %d A",
+		ErrITMNoEncontrado, corrienteMinima, tabla[len(tabla)-1].Amperaje,
+	)
+}
@@ -0,0 +1,63 @@
+// internal/calculos/domain/service/seleccionar_itm_test.go
+package service_test
+
+import (
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/service"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var tablaITMTest = []valueobject.EntradaTablaITM{
+	{Amperaje: 15, Marco: 100},
+	{Amperaje: 20, Marco: 100},
+	{Amperaje: 100, Marco: 100},
+	{Amperaje: 125, Marco: 150},
+	{Amperaje: 150, Marco: 150},
+	{Amperaje: 175, Marco: 250},
+}
+
+func TestSeleccionarITM(t *testing.T) {
+	tests := []struct {
+		name      string
+		corriente float64
+		factorUso float64
+		amperaje  int
+		marco     int
+	}{
+		{"carga 80 A × 1.25 = 100 A exacto", 80, 1.25, 100, 100},
+		{"filtro activo 100 A × 1.35 = 135 A → 150", 100, 1.35, 150, 150},
+		{"transformador 120 A × 1.25 = 150 A exacto", 120, 1.25, 150, 150},
+		{"corriente baja → mínimo estándar", 5, 1.25, 15, 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			corriente, err := valueobject.NewCorriente(tt.corriente)
+			require.NoError(t, err)
+
+			r, err := service.SeleccionarITM(corriente, tt.factorUso, tablaITMTest)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.amperaje, r.Amperaje)
+			assert.Equal(t, tt.marco, r.Marco)
+			assert.InDelta(t, tt.corriente*tt.factorUso, r.CorrienteMinima, 1e-9)
+		})
+	}
+}
+
+func TestSeleccionarITM_Errores(t *testing.T) {
+	corriente, err := valueobject.NewCorriente(200)
+	require.NoError(t, err)
+
+	_, err = service.SeleccionarITM(corriente, 1.25, tablaITMTest)
+	assert.ErrorIs(t, err, service.ErrITMNoEncontrado)
+
+	_, err = service.SeleccionarITM(corriente, 1.25, nil)
+	assert.ErrorIs(t, err, service.ErrITMNoEncontrado)
+
+	_, err = service.SeleccionarITM(corriente, 0, tablaITMTest)
+	assert.Error(t, err)
+}
@@ -9,7 +9,7 @@ import (
 // SeleccionarTemperatura determines the temperature column according to NOM rules.
 //
 // Rules per NOM-001-SEDE-2012:
-// - Corriente < 100A -> 60°C (or 75°C if charola triangular without 60°C column)
+// - Corriente < 100A -> 60°C (or 75°C if charola triangular or underground, without 60°C column)
 // - Corriente >= 100A -> 75°C
 // - If temperaturaOverride is provided, it takes precedence
 func SeleccionarTemperatura(
@@ -24,8 +24,8 @@ func SeleccionarTemperatura(
 
 	// NOM rules based on current
 	if corriente.Valor() < 100 {
-		// < 100A -> 60°C (or 75°C if charola triangular)
-		if tipoCanalizacion == entity.TipoCanalizacionCharolaCableTriangular {
+		// < 100A -> 60°C (or 75°C if the table has no 60°C column)
+		if tipoCanalizacion == entity.TipoCanalizacionCharolaCableTriangular || tipoCanalizacion.EsSubterranea() {
 			return valueobject.Temp75
 		}
 		return valueobject.Temp60
@@ -34,3 +34,16 @@ func SeleccionarTemperatura(
 	// >= 100A -> 75°C
 	return valueobject.Temp75
 }
+
+// LimitarTemperaturaAislamiento limita la columna de temperatura al rating del aislamiento
+// (NOM 310-15(a)(3)): el conductor no puede operar por encima de la temperatura de su aislamiento.
+func LimitarTemperaturaAislamiento(
+	temperatura valueobject.Temperatura,
+	aislamiento valueobject.TipoAislamiento,
+) valueobject.Temperatura {
+	maxima := aislamiento.TemperaturaMaxima()
+	if maxima > 0 && temperatura > maxima {
+		return maxima
+	}
+	return temperatura
+}
@@ -41,6 +41,19 @@ func TestSeleccionarTemperatura_CorrienteBajaEnCharolaTriangular(t *testing.T) {
 	assert.Equal(t, valueobject.Temp75, temp)
 }
 
+func TestSeleccionarTemperatura_CorrienteBajaSubterranea(t *testing.T) {
+	// Tablas del Anexo B (ducto subterráneo, directamente enterrado) no tienen columna 60°C
+	corriente, err := valueobject.NewCorriente(50.0)
+	require.NoError(t, err)
+
+	for _, tipo := range []entity.TipoCanalizacion{
+		entity.TipoCanalizacionDuctoSubterraneo,
+		entity.TipoCanalizacionDirectamenteEnterrado,
+	} {
+		assert.Equal(t, valueobject.Temp75, service.SeleccionarTemperatura(corriente, tipo, nil), tipo)
+	}
+}
+
 func TestSeleccionarTemperatura_CorrienteBajaEnCharolaEspaciado(t *testing.T) {
 	// Corriente <= 100A en charola espaciado → 60°C (tiene columna 60°C)
 	corriente, err := valueobject.NewCorriente(90.0)
@@ -81,3 +94,12 @@ func TestSeleccionarTemperatura_CorrienteExacta100AEnCharolaTriangular(t *testin
 
 	assert.Equal(t, valueobject.Temp75, temp)
 }
+
+func TestLimitarTemperaturaAislamiento(t *testing.T) {
+	// THW (75°C) limita un override de 90°C; THHN (90°C) lo permite
+	assert.Equal(t, valueobject.Temp75, service.LimitarTemperaturaAislamiento(valueobject.Temp90, valueobject.AislamientoTHW))
+	assert.Equal(t, valueobject.Temp90, service.LimitarTemperaturaAislamiento(valueobject.Temp90, valueobject.AislamientoTHHN))
+	assert.Equal(t, valueobject.Temp60, service.LimitarTemperaturaAislamiento(valueobject.Temp60, valueobject.AislamientoTHW))
+	// Conductor desnudo: sin límite
+	assert.Equal(t, valueobject.Temp90, service.LimitarTemperaturaAislamiento(valueobject.Temp90, valueobject.SinAislamiento))
+}
@@ -0,0 +1,99 @@
+// internal/calculos/domain/service/soporte_termico_conductor.go
+package service
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+)
+
+// TiempoLiberacionFallaDefault es el tiempo de liberación usado cuando no se indica:
+// 3 ciclos a 60 Hz, disparo instantáneo típico de un interruptor termomagnético.
+const TiempoLiberacionFallaDefault = 0.05
+
+// cmilPorMM2 convierte mm² a circular mils (1 mm² = 1973.525 cmil).
+const cmilPorMM2 = 1973.525
+
+// ErrSoporteTermicoInvalido is returned when the withstand check inputs are out of range.
+var ErrSoporteTermicoInvalido = errors.New("datos de soporte térmico inválidos")
+
+// TemperaturaMaximaCortocircuito returns the maximum short-circuit temperature (°C)
+// allowed by the insulation according to ICEA P-32-382:
+//   - Termoplástico (TW, THW, THHN, THWN): 150 °C
+//   - Termofijo (XHHW, RHH, RHW, USE-2) y conductor desnudo: 250 °C
+func TemperaturaMaximaCortocircuito(tipoAislamiento string) float64 {
+	switch strings.ToUpper(strings.TrimSpace(tipoAislamiento)) {
+	case "TW", "THW", "THW-2", "THHN", "THWN", "THWN-2":
+		return 150
+	default:
+		return 250
+	}
+}
+
+// EntradaSoporteTermico contains the conductor data needed for the I²t check.
+// La sección viene de las tablas NOM; las temperaturas las resuelve la aplicación.
+type EntradaSoporteTermico struct {
+	Material           valueobject.MaterialConductor
+	SeccionMM2         float64
+	TemperaturaInicial float64 // °C
+	TemperaturaMaxima  float64 // °C
+}
+
+// VerificarSoporteTermico aplica la ecuación adiabática de cortocircuito (ICEA P-32-382 / IEEE 242):
+//
+//	(I / A)² × t = K × log₁₀((T₂ + β) / (T₁ + β))
+//
+// Donde:
+//
+//	I  = corriente de falla por conductor en A
+//	A  = sección del conductor en circular mils
+//	t  = tiempo de liberación de la falla en s
+//	T₁ = temperatura de operación del conductor (°C)
+//	T₂ = temperatura máxima de cortocircuito del aislamiento (°C)
+//	Cobre:    K = 0.0297, β = 234
+//	Aluminio: K = 0.0125, β = 228
+func VerificarSoporteTermico(
+	entrada EntradaSoporteTermico,
+	corrienteFallaKA float64,
+	tiempoLiberacion float64,
+) (entity.ResultadoSoporteTermico, error) {
+	if entrada.SeccionMM2 <= 0 {
+		return entity.ResultadoSoporteTermico{}, fmt.Errorf("%w: sección %.2f mm²", ErrSoporteTermicoInvalido, entrada.SeccionMM2)
+	}
+	if corrienteFallaKA <= 0 {
+		return entity.ResultadoSoporteTermico{}, fmt.Errorf("%w: corriente de falla %.2f kA", ErrSoporteTermicoInvalido, corrienteFallaKA)
+	}
+	if tiempoLiberacion <= 0 {
+		return entity.ResultadoSoporteTermico{}, fmt.Errorf("%w: tiempo de liberación %.4f s", ErrSoporteTermicoInvalido, tiempoLiberacion)
+	}
+	if entrada.TemperaturaMaxima <= entrada.TemperaturaInicial {
+		return entity.ResultadoSoporteTermico{}, fmt.Errorf("%w: temperatura máxima (%.0f °C) debe ser mayor que la inicial (%.0f °C)",
+			ErrSoporteTermicoInvalido, entrada.TemperaturaMaxima, entrada.TemperaturaInicial)
+	}
+
+	k, beta := 0.0297, 234.0
+	if entrada.Material == valueobject.MaterialAluminio {
+		k, beta = 0.0125, 228.0
+	}
+	termino := k * math.Log10((entrada.TemperaturaMaxima+beta)/(entrada.TemperaturaInicial+beta))
+
+	corrienteA := corrienteFallaKA * 1000
+	seccionMinimaCmil := corrienteA * math.Sqrt(tiempoLiberacion/termino)
+	seccionMinima := seccionMinimaCmil / cmilPorMM2
+	corrienteSoportada := entrada.SeccionMM2 * cmilPorMM2 * math.Sqrt(termino/tiempoLiberacion) / 1000
+
+	return entity.ResultadoSoporteTermico{
+		SeccionMM2:           entrada.SeccionMM2,
+		SeccionMinimaMM2:     seccionMinima,
+		CorrienteFallaKA:     corrienteFallaKA,
+		CorrienteSoportadaKA: corrienteSoportada,
+		TiempoLiberacion:     tiempoLiberacion,
+		TemperaturaInicial:   entrada.TemperaturaInicial,
+		TemperaturaMaxima:    entrada.TemperaturaMaxima,
+		Cumple:               entrada.SeccionMM2 >= seccionMinima,
+	}, nil
+}
@@ -0,0 +1,90 @@
+// internal/calculos/domain/service/soporte_termico_conductor_test.go
+package service_test
+
+import (
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/service"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerificarSoporteTermico(t *testing.T) {
+	t.Run("2 AWG Cu THW 75→150 °C, 3 ciclos soporta 15.7 kA", func(t *testing.T) {
+		entrada := service.EntradaSoporteTermico{
+			Material:           valueobject.MaterialCobre,
+			SeccionMM2:         33.6,
+			TemperaturaInicial: 75,
+			TemperaturaMaxima:  150,
+		}
+
+		r, err := service.VerificarSoporteTermico(entrada, 10, service.TiempoLiberacionFallaDefault)
+		require.NoError(t, err)
+
+		assert.InDelta(t, 15.70, r.CorrienteSoportadaKA, 0.01)
+		assert.InDelta(t, 21.40, r.SeccionMinimaMM2, 0.01)
+		assert.True(t, r.Cumple)
+	})
+
+	t.Run("aluminio soporta menos que cobre con la misma sección", func(t *testing.T) {
+		entrada := service.EntradaSoporteTermico{
+			Material:           valueobject.MaterialAluminio,
+			SeccionMM2:         33.6,
+			TemperaturaInicial: 75,
+			TemperaturaMaxima:  150,
+		}
+
+		r, err := service.VerificarSoporteTermico(entrada, 12, service.TiempoLiberacionFallaDefault)
+		require.NoError(t, err)
+
+		assert.InDelta(t, 10.28, r.CorrienteSoportadaKA, 0.01)
+		assert.False(t, r.Cumple)
+	})
+
+	t.Run("tiempo de liberación mayor exige más sección", func(t *testing.T) {
+		entrada := service.EntradaSoporteTermico{
+			Material:           valueobject.MaterialCobre,
+			SeccionMM2:         13.3,
+			TemperaturaInicial: 30,
+			TemperaturaMaxima:  250,
+		}
+
+		rapido, err := service.VerificarSoporteTermico(entrada, 10, 0.05)
+		require.NoError(t, err)
+		lento, err := service.VerificarSoporteTermico(entrada, 10, 0.5)
+		require.NoError(t, err)
+
+		assert.InDelta(t, 12.81, rapido.SeccionMinimaMM2, 0.01)
+		assert.True(t, rapido.Cumple)
+		assert.Greater(t, lento.SeccionMinimaMM2, rapido.SeccionMinimaMM2)
+		assert.False(t, lento.Cumple)
+	})
+
+	t.Run("datos inválidos", func(t *testing.T) {
+		base := service.EntradaSoporteTermico{SeccionMM2: 33.6, TemperaturaInicial: 75, TemperaturaMaxima: 150}
+
+		_, err := service.VerificarSoporteTermico(base, 0, 0.05)
+		assert.ErrorIs(t, err, service.ErrSoporteTermicoInvalido)
+
+		_, err = service.VerificarSoporteTermico(base, 10, 0)
+		assert.ErrorIs(t, err, service.ErrSoporteTermicoInvalido)
+
+		sinSeccion := base
+		sinSeccion.SeccionMM2 = 0
+		_, err = service.VerificarSoporteTermico(sinSeccion, 10, 0.05)
+		assert.ErrorIs(t, err, service.ErrSoporteTermicoInvalido)
+
+		invertida := base
+		invertida.TemperaturaMaxima = 60
+		_, err = service.VerificarSoporteTermico(invertida, 10, 0.05)
+		assert.ErrorIs(t, err, service.ErrSoporteTermicoInvalido)
+	})
+}
+
+func TestTemperaturaMaximaCortocircuito(t *testing.T) {
+	assert.Equal(t, 150.0, service.TemperaturaMaximaCortocircuito("THW"))
+	assert.Equal(t, 150.0, service.TemperaturaMaximaCortocircuito("thhn"))
+	assert.Equal(t, 250.0, service.TemperaturaMaximaCortocircuito("XHHW"))
+	assert.Equal(t, 250.0, service.TemperaturaMaximaCortocircuito(""))
+}
@@ -0,0 +1,236 @@
+// internal/calculos/domain/service/tension_jalado.go
+package service
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+)
+
+// ErrTensionJaladoInvalida is returned when the pulling calculation inputs are out of range.
+var ErrTensionJaladoInvalida = errors.New("datos de tensión de jalado inválidos")
+
+const (
+	// CoeficienteFriccionDefault es el coeficiente de fricción dinámico típico de
+	// cable con cubierta termoplástica en tubo con lubricante.
+	CoeficienteFriccionDefault = 0.5
+
+	// PresionLateralPermitidaDefault es la presión lateral máxima típica de cables de
+	// potencia de baja tensión: 500 lb/ft ≈ 7 300 N/m.
+	PresionLateralPermitidaDefault = 7300.0
+
+	// EsfuerzoMaximoCobre es la tensión máxima por sección del conductor de cobre
+	// recocido (jalado desde el conductor): 0.008 lb/cmil ≈ 70 N/mm².
+	EsfuerzoMaximoCobre = 70.0
+
+	// EsfuerzoMaximoAluminio es el equivalente para aluminio: 0.006 lb/cmil ≈ 52.5 N/mm².
+	EsfuerzoMaximoAluminio = 52.5
+
+	// factorCorreccionPesoMultiple es w_c para más de 3 conductores (aproximación usual).
+	factorCorreccionPesoMultiple = 1.4
+
+	gravedad = 9.80665 // m/s²
+
+	densidadCobre       = 8.89 // g/cm³
+	densidadAluminio    = 2.70 // g/cm³
+	densidadAislamiento = 1.40 // g/cm³ (PVC/nylon)
+)
+
+// EntradaTensionJalado contiene los datos físicos del jalado.
+// Los diámetros vienen de las tablas NOM (capítulo 10); la ruta la proporciona el usuario.
+type EntradaTensionJalado struct {
+	Ruta                    entity.RutaTuberia
+	Material                valueobject.MaterialConductor
+	NumConductores          int
+	SeccionMM2              float64 // sección de cobre/aluminio de un conductor
+	DiametroConductorMM     float64 // diámetro exterior con aislamiento (d)
+	DiametroInteriorTuboMM  float64 // D
+	PesoConductorKgM        float64 // peso de un conductor; 0 = estimar con EstimarPesoConductorKgM
+	CoeficienteFriccion     float64 // 0 = CoeficienteFriccionDefault
+	PresionLateralPermitida float64 // N/m; 0 = PresionLateralPermitidaDefault
+	TensionInicialN         float64 // tensión con la que el cable entra al primer tramo
+}
+
+// EstimarPesoConductorKgM estima el peso por metro de un conductor aislado a partir de
+// su sección metálica y su diámetro exterior:
+//
+//	w = A_metal × ρ_metal + (π/4 × d² − A_metal) × ρ_aislamiento   [kg/m con mm² y g/cm³ × 10⁻³]
+func EstimarPesoConductorKgM(material valueobject.MaterialConductor, seccionMM2, diametroExteriorMM float64) float64 {
+	densidad := densidadCobre
+	if material == valueobject.MaterialAluminio {
+		densidad = densidadAluminio
+	}
+	areaAislamiento := math.Max(math.Pi/4*diametroExteriorMM*diametroExteriorMM-seccionMM2, 0)
+	return (seccionMM2*densidad + areaAislamiento*densidadAislamiento) / 1000
+}
+
+// CalcularTensionJalado calcula la tensión de jalado tramo por tramo (en el orden de la ruta),
+// la presión lateral en las curvas y la relación de atascamiento (IEEE 1185 / ICEA):
+//
+//	Tramo recto (horizontal):  T_s = T_e + W × w_c × f × L
+//	Curva:                     T_s = T_e × e^(w_c × f × θ)
+//
+// Factor de corrección de peso w_c (d = diámetro del conductor, D = diámetro interior):
+//
+//	1 conductor:   w_c = 1
+//	Triangular:    w_c = 1 / √(1 − (d / (D − d))²)        (D/d < 2.5)
+//	Acunado:       w_c = 1 + 4/3 × (d / (D − d))²
+//	Más de 3:      w_c = 1.4
+//
+// Presión lateral en la curva de radio R:
+//
+//	1 conductor:   P = T / R
+//	Triangular:    P = w_c × T / (2R)
+//	Acunado:       P = (3w_c − 2) × T / (3R)
+//
+// Tensión máxima: esfuerzo × sección × n (n ≤ 3) o × 0.8 n (n > 3).
+func CalcularTensionJalado(entrada EntradaTensionJalado) (entity.ResultadoTensionJalado, error) {
+	if err := validarEntradaTensionJalado(entrada); err != nil {
+		return entity.ResultadoTensionJalado{}, err
+	}
+
+	n := entrada.NumConductores
+	d := entrada.DiametroConductorMM
+	diametroTubo := entrada.DiametroInteriorTuboMM
+
+	friccion := entrada.CoeficienteFriccion
+	if friccion <= 0 {
+		friccion = CoeficienteFriccionDefault
+	}
+	presionPermitida := entrada.PresionLateralPermitida
+	if presionPermitida <= 0 {
+		presionPermitida = PresionLateralPermitidaDefault
+	}
+	pesoConductor := entrada.PesoConductorKgM
+	if pesoConductor <= 0 {
+		pesoConductor = EstimarPesoConductorKgM(entrada.Material, entrada.SeccionMM2, d)
+	}
+	pesoTotal := float64(n) * pesoConductor * gravedad // N/m
+
+	configuracion, wc := configuracionJalado(n, d, diametroTubo)
+
+	resultado := entity.ResultadoTensionJalado{
+		Configuracion:                configuracion,
+		FactorCorreccionPeso:         wc,
+		CoeficienteFriccion:          friccion,
+		PesoTotalNPorM:               pesoTotal,
+		Tramos:                       make([]entity.ResultadoTramoJalado, 0, len(entrada.Ruta.Tramos)),
+		TensionMaximaPermitidaN:      tensionMaximaPermitida(entrada.Material, entrada.SeccionMM2, n),
+		PresionLateralPermitidaNPorM: presionPermitida,
+		TotalCurvasGrados:            entrada.Ruta.TotalCurvasGrados(),
+		CumplePresionLateral:         true,
+	}
+	resultado.ExcedeCurvasNOM = resultado.TotalCurvasGrados > entity.MaxCurvasEntreRegistrosGrados
+
+	if n == 3 {
+		resultado.RelacionAtascamiento = CalcularRelacionAtascamiento(diametroTubo, d)
+		resultado.RiesgoAtascamiento = EnRangoAtascamiento(resultado.RelacionAtascamiento)
+	}
+
+	tension := entrada.TensionInicialN
+	for i, tramo := range entrada.Ruta.Tramos {
+		r := entity.ResultadoTramoJalado{
+			Numero:          i + 1,
+			Tipo:            tramo.Tipo,
+			TensionEntradaN: tension,
+			CumplePresion:   true,
+		}
+
+		switch tramo.Tipo {
+		case entity.TipoTramoRecto:
+			tension += pesoTotal * wc * friccion * tramo.LongitudM
+		case entity.TipoTramoCurva:
+			theta := tramo.AnguloGrados * math.Pi / 180
+			tension *= math.Exp(wc * friccion * theta)
+			r.PresionLateralNPorM = presionLateral(configuracion, wc, tension, tramo.RadioM)
+			r.CumplePresion = r.PresionLateralNPorM <= presionPermitida
+			resultado.PresionLateralMaximaNPorM = math.Max(resultado.PresionLateralMaximaNPorM, r.PresionLateralNPorM)
+			if !r.CumplePresion {
+				resultado.CumplePresionLateral = false
+			}
+		}
+
+		r.TensionSalidaN = tension
+		resultado.Tramos = append(resultado.Tramos, r)
+	}
+
+	resultado.TensionFinalN = tension
+	resultado.CumpleTension = tension <= resultado.TensionMaximaPermitidaN
+	resultado.Cumple = resultado.CumpleTension &&
+		resultado.CumplePresionLateral &&
+		!resultado.RiesgoAtascamiento &&
+		!resultado.ExcedeCurvasNOM
+
+	return resultado, nil
+}
+
+func validarEntradaTensionJalado(entrada EntradaTensionJalado) error {
+	if len(entrada.Ruta.Tramos) == 0 {
+		return fmt.Errorf("%w: la ruta no tiene tramos", ErrTensionJaladoInvalida)
+	}
+	if entrada.NumConductores <= 0 {
+		return fmt.Errorf("%w: número de conductores %d", ErrTensionJaladoInvalida, entrada.NumConductores)
+	}
+	if entrada.SeccionMM2 <= 0 {
+		return fmt.Errorf("%w: sección %.2f mm²", ErrTensionJaladoInvalida, entrada.SeccionMM2)
+	}
+	if entrada.DiametroConductorMM <= 0 {
+		return fmt.Errorf("%w: diámetro de conductor %.2f mm", ErrTensionJaladoInvalida, entrada.DiametroConductorMM)
+	}
+	if entrada.DiametroInteriorTuboMM <= entrada.DiametroConductorMM {
+		return fmt.Errorf("%w: diámetro interior del tubo (%.2f mm) debe ser mayor que el del conductor (%.2f mm)",
+			ErrTensionJaladoInvalida, entrada.DiametroInteriorTuboMM, entrada.DiametroConductorMM)
+	}
+	if entrada.TensionInicialN < 0 {
+		return fmt.Errorf("%w: tensión inicial %.2f N", ErrTensionJaladoInvalida, entrada.TensionInicialN)
+	}
+	return nil
+}
+
+// configuracionJalado determina el acomodo probable y su factor de corrección de peso.
+// Con 3 conductores y D/d < 2.5 solo cabe el triángulo; con más holgura se acomodan en cuna,
+// que es el caso más desfavorable para la tensión.
+func configuracionJalado(n int, d, diametroTubo float64) (entity.ConfiguracionJalado, float64) {
+	x := d / (diametroTubo - d)
+	switch {
+	case n == 1:
+		return entity.ConfiguracionJaladoUnico, 1
+	case n == 3 && diametroTubo/d < 2.5 && x < 1:
+		return entity.ConfiguracionJaladoTriangular, 1 / math.Sqrt(1-x*x)
+	case n == 3:
+		return entity.ConfiguracionJaladoAcunado, 1 + 4.0/3.0*x*x
+	case n == 2:
+		return entity.ConfiguracionJaladoMultiple, 1 + 4.0/3.0*x*x
+	default:
+		return entity.ConfiguracionJaladoMultiple, factorCorreccionPesoMultiple
+	}
+}
+
+// presionLateral calcula la presión sobre el conductor más cargado a la salida de una curva.
+func presionLateral(configuracion entity.ConfiguracionJalado, wc, tension, radioM float64) float64 {
+	switch configuracion {
+	case entity.ConfiguracionJaladoUnico:
+		return tension / radioM
+	case entity.ConfiguracionJaladoTriangular:
+		return wc * tension / (2 * radioM)
+	default:
+		return (3*wc - 2) * tension / (3 * radioM)
+	}
+}
+
+// tensionMaximaPermitida: con más de 3 conductores la tensión no se reparte por igual
+// y solo se considera el 80% de ellos.
+func tensionMaximaPermitida(material valueobject.MaterialConductor, seccionMM2 float64, n int) float64 {
+	esfuerzo := EsfuerzoMaximoCobre
+	if material == valueobject.MaterialAluminio {
+		esfuerzo = EsfuerzoMaximoAluminio
+	}
+	conductores := float64(n)
+	if n > 3 {
+		conductores *= 0.8
+	}
+	return esfuerzo * seccionMM2 * conductores
+}
@@ -0,0 +1,173 @@
+// internal/calculos/domain/service/tension_jalado_test.go
+package service_test
+
+import (
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/service"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rutaPrueba: 30 m recto, curva de 90° con R = 0.5 m, 20 m recto.
+func rutaPrueba(t *testing.T) entity.RutaTuberia {
+	t.Helper()
+	ruta, err := entity.NewRutaTuberia([]entity.TramoRuta{
+		{Tipo: entity.TipoTramoRecto, LongitudM: 30},
+		{Tipo: entity.TipoTramoCurva, AnguloGrados: 90, RadioM: 0.5},
+		{Tipo: entity.TipoTramoRecto, LongitudM: 20},
+	})
+	require.NoError(t, err)
+	return ruta
+}
+
+func TestCalcularTensionJalado(t *testing.T) {
+	t.Run("un conductor: tensión por tramo y presión lateral en la curva", func(t *testing.T) {
+		r, err := service.CalcularTensionJalado(service.EntradaTensionJalado{
+			Ruta:                   rutaPrueba(t),
+			Material:               valueobject.MaterialCobre,
+			NumConductores:         1,
+			SeccionMM2:             100,
+			DiametroConductorMM:    15,
+			DiametroInteriorTuboMM: 40,
+			PesoConductorKgM:       1,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, entity.ConfiguracionJaladoUnico, r.Configuracion)
+		assert.Equal(t, 1.0, r.FactorCorreccionPeso)
+		require.Len(t, r.Tramos, 3)
+		// T₁ = 9.807 N/m × 0.5 × 30 m
+		assert.InDelta(t, 147.10, r.Tramos[0].TensionSalidaN, 0.01)
+		// T₂ = T₁ × e^(0.5 × π/2)
+		assert.InDelta(t, 322.63, r.Tramos[1].TensionSalidaN, 0.01)
+		assert.InDelta(t, 645.26, r.Tramos[1].PresionLateralNPorM, 0.01)
+		// T₃ = T₂ + 9.807 × 0.5 × 20
+		assert.InDelta(t, 420.70, r.TensionFinalN, 0.01)
+		assert.Equal(t, 7000.0, r.TensionMaximaPermitidaN)
+		assert.Zero(t, r.RelacionAtascamiento)
+		assert.True(t, r.Cumple)
+	})
+
+	t.Run("tres conductores en cuna con relación de atascamiento crítica", func(t *testing.T) {
+		r, err := service.CalcularTensionJalado(service.EntradaTensionJalado{
+			Ruta:                   rutaPrueba(t),
+			Material:               valueobject.MaterialCobre,
+			NumConductores:         3,
+			SeccionMM2:             100,
+			DiametroConductorMM:    20,
+			DiametroInteriorTuboMM: 60,
+			PesoConductorKgM:       1,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, entity.ConfiguracionJaladoAcunado, r.Configuracion)
+		assert.InDelta(t, 1.3333, r.FactorCorreccionPeso, 0.0001) // 1 + 4/3 × (20/40)²
+		assert.InDelta(t, 3.15, r.RelacionAtascamiento, 0.0001)   // 1.05 × 60 / 20
+		assert.True(t, r.RiesgoAtascamiento)
+		assert.True(t, r.CumpleTension)
+		assert.False(t, r.Cumple)
+	})
+
+	t.Run("tres conductores en triángulo", func(t *testing.T) {
+		r, err := service.CalcularTensionJalado(service.EntradaTensionJalado{
+			Ruta:                   rutaPrueba(t),
+			Material:               valueobject.MaterialCobre,
+			NumConductores:         3,
+			SeccionMM2:             100,
+			DiametroConductorMM:    20,
+			DiametroInteriorTuboMM: 45,
+			PesoConductorKgM:       1,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, entity.ConfiguracionJaladoTriangular, r.Configuracion)
+		assert.InDelta(t, 1.6667, r.FactorCorreccionPeso, 0.0001) // 1 / √(1 − 0.8²)
+		assert.False(t, r.RiesgoAtascamiento)
+	})
+
+	t.Run("excede tensión y presión lateral", func(t *testing.T) {
+		ruta, err := entity.NewRutaTuberia([]entity.TramoRuta{
+			{Tipo: entity.TipoTramoRecto, LongitudM: 300},
+			{Tipo: entity.TipoTramoCurva, AnguloGrados: 90, RadioM: 0.3},
+		})
+		require.NoError(t, err)
+
+		r, err := service.CalcularTensionJalado(service.EntradaTensionJalado{
+			Ruta:                   ruta,
+			Material:               valueobject.MaterialAluminio,
+			NumConductores:         1,
+			SeccionMM2:             33.6,
+			DiametroConductorMM:    10,
+			DiametroInteriorTuboMM: 40,
+			PesoConductorKgM:       2,
+		})
+		require.NoError(t, err)
+
+		assert.InDelta(t, 1764, r.TensionMaximaPermitidaN, 0.01) // 52.5 N/mm² × 33.6 mm²
+		assert.False(t, r.CumpleTension)
+		assert.False(t, r.CumplePresionLateral)
+		assert.False(t, r.Tramos[1].CumplePresion)
+		assert.False(t, r.Cumple)
+	})
+
+	t.Run("más de 3 conductores: w_c = 1.4 y 80% de la tensión", func(t *testing.T) {
+		r, err := service.CalcularTensionJalado(service.EntradaTensionJalado{
+			Ruta:                   rutaPrueba(t),
+			Material:               valueobject.MaterialCobre,
+			NumConductores:         4,
+			SeccionMM2:             10,
+			DiametroConductorMM:    6,
+			DiametroInteriorTuboMM: 27,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, entity.ConfiguracionJaladoMultiple, r.Configuracion)
+		assert.Equal(t, 1.4, r.FactorCorreccionPeso)
+		assert.InDelta(t, 2240, r.TensionMaximaPermitidaN, 0.01) // 70 × 10 × 0.8 × 4
+		assert.Greater(t, r.PesoTotalNPorM, 0.0)                  // peso estimado
+	})
+
+	t.Run("más de 360° de curvas entre registros", func(t *testing.T) {
+		tramos := []entity.TramoRuta{{Tipo: entity.TipoTramoRecto, LongitudM: 5}}
+		for i := 0; i < 5; i++ {
+			tramos = append(tramos, entity.TramoRuta{Tipo: entity.TipoTramoCurva, AnguloGrados: 90, RadioM: 0.5})
+		}
+		ruta, err := entity.NewRutaTuberia(tramos)
+		require.NoError(t, err)
+
+		r, err := service.CalcularTensionJalado(service.EntradaTensionJalado{
+			Ruta:                   ruta,
+			NumConductores:         1,
+			SeccionMM2:             10,
+			DiametroConductorMM:    6,
+			DiametroInteriorTuboMM: 20,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, 450.0, r.TotalCurvasGrados)
+		assert.True(t, r.ExcedeCurvasNOM)
+		assert.False(t, r.Cumple)
+	})
+
+	t.Run("tubo menor que el conductor", func(t *testing.T) {
+		_, err := service.CalcularTensionJalado(service.EntradaTensionJalado{
+			Ruta:                   rutaPrueba(t),
+			NumConductores:         1,
+			SeccionMM2:             10,
+			DiametroConductorMM:    20,
+			DiametroInteriorTuboMM: 15,
+		})
+		assert.ErrorIs(t, err, service.ErrTensionJaladoInvalida)
+	})
+}
+
+func TestEstimarPesoConductorKgM(t *testing.T) {
+	// 4/0 AWG Cu THW: 107.2 mm² de cobre, Ø 15.34 mm
+	peso := service.EstimarPesoConductorKgM(valueobject.MaterialCobre, 107.2, 15.34)
+	assert.InDelta(t, 1.062, peso, 0.001)
+
+	assert.Less(t, service.EstimarPesoConductorKgM(valueobject.MaterialAluminio, 107.2, 15.34), peso)
+}
@@ -30,6 +30,23 @@ type factorAgrupamientoEntry struct {
 	factor      float64
 }
 
+// factorSubterraneoEntry holds a row of the underground correction tables
+// (resistividad térmica, profundidad, agrupamiento): the row key and one factor
+// per underground installation type.
+type factorSubterraneoEntry struct {
+	limite          float64
+	factorDucto     float64
+	factorEnterrado float64
+}
+
+// factor returns the column for the given underground installation type.
+func (e factorSubterraneoEntry) factor(canalizacion entity.TipoCanalizacion) float64 {
+	if canalizacion == entity.TipoCanalizacionDirectamenteEnterrado {
+		return e.factorEnterrado
+	}
+	return e.factorDucto
+}
+
 // impedanciaEntry holds all impedance values for a given calibre from Tabla 9.
 type impedanciaEntry struct {
 	SeccionMM2      float64
@@ -43,12 +60,31 @@ type impedanciaEntry struct {
 	ResAlAcero      float64
 }
 
-// diametroConductorEntry holds diameter values for conductors from Tabla 5.
+// diametroConductorEntry holds diameter and area values for conductors from Tabla 5.
 type diametroConductorEntry struct {
 	DiamTWTHW   float64
 	DiamRHH_RHW float64
 	DiamXHHW    float64
+	DiamTHHN    float64
 	AreaTWTHW   float64
+	AreaRHH_RHW float64
+	AreaXHHW    float64
+	AreaTHHN    float64
+}
+
+// dimensiones returns the Tabla 5 diameter and area columns for the insulation type:
+// THW → TW/THW, THHN → THHN/THWN, XHHW → XHHW, RHH y USE-2 → RHH/RHW.
+func (e diametroConductorEntry) dimensiones(aislamiento valueobject.TipoAislamiento) (diametro, area float64) {
+	switch aislamiento {
+	case valueobject.AislamientoTHHN:
+		return e.DiamTHHN, e.AreaTHHN
+	case valueobject.AislamientoXHHW:
+		return e.DiamXHHW, e.AreaXHHW
+	case valueobject.AislamientoRHH, valueobject.AislamientoUSE2:
+		return e.DiamRHH_RHW, e.AreaRHH_RHW
+	default:
+		return e.DiamTWTHW, e.AreaTWTHW
+	}
 }
 
 // conductorDesnudoEntry holds values for bare conductors from Tabla 8.
@@ -75,13 +111,21 @@ type tuboOcupacionEntry struct {
 type CSVTablaNOMRepository struct {
 	basePath               string
 	tablaTierra            []valueobject.EntradaTablaTierra
+	tablaITM               []valueobject.EntradaTablaITM
+	tablaMotorMonofasico   []valueobject.EntradaTablaMotor // Tabla 430-248
+	tablaMotorTrifasico    []valueobject.EntradaTablaMotor // Tabla 430-250
 	tablasAmpacidad        map[entity.TipoCanalizacion]map[valueobject.MaterialConductor]map[valueobject.Temperatura][]valueobject.EntradaTablaConductor
 	tablaImpedancia        map[string]impedanciaEntry // key: calibre
 	tablaConduit           []valueobject.EntradaTablaCanalizacion
 	tablasCharola          map[entity.TipoCanalizacion][]valueobject.EntradaTablaCanalizacion
+	tablasLlenadoCharola   map[entity.TipoCharola][]valueobject.EntradaTablaLlenadoCharola
+	tablaCablesMulti       []valueobject.EntradaTablaCableMulticonductor
 	estadosTemperatura     map[string]int
 	factoresTemperatura    []factorTemperaturaEntry
 	factoresAgrupamiento   []factorAgrupamientoEntry
+	factoresResistividad   []factorSubterraneoEntry
+	factoresProfundidad    []factorSubterraneoEntry
+	factoresAgrupSubterr   []factorSubterraneoEntry
 	tablaDiametros         map[string]diametroConductorEntry
 	tablaConductorDesnudo  map[string]conductorDesnudoEntry // Tabla 8 - conductores desnudos
 	tablasOcupacionTuberia map[entity.TipoCanalizacion][]valueobject.EntradaTablaOcupacion
@@ -111,6 +155,26 @@ func NewCSVTablaNOMRepository(basePath string) (*CSVTablaNOMRepository, error) {
 	}
 	repo.tablaTierra = tablaTierra
 
+	// Load standard breaker ratings table (NOM 240-6(a))
+	tablaITM, err := repo.loadTablaITM()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load ITM table: %w", err)
+	}
+	repo.tablaITM = tablaITM
+
+	// Load motor full-load current tables (430-248 single-phase, 430-250 three-phase)
+	tablaMotorMonofasico, err := repo.loadTablaMotor("430-248.csv")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load single-phase motor table: %w", err)
+	}
+	repo.tablaMotorMonofasico = tablaMotorMonofasico
+
+	tablaMotorTrifasico, err := repo.loadTablaMotor("430-250.csv")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load three-phase motor table: %w", err)
+	}
+	repo.tablaMotorTrifasico = tablaMotorTrifasico
+
 	// Load impedance table (Tabla 9)
 	tablaImpedancia, err := repo.loadTablaImpedancia()
 	if err != nil {
@@ -138,6 +202,20 @@ func NewCSVTablaNOMRepository(basePath string) (*CSVTablaNOMRepository, error) {
 	repo.tablasCharola[entity.TipoCanalizacionCharolaCableEspaciado] = tablaEspaciado
 	repo.tablasCharola[entity.TipoCanalizacionCharolaCableTriangular] = tablaTriangular
 
+	// Load cable tray fill tables by tray type (NOM 392-22)
+	tablasLlenado, err := repo.loadTablasLlenadoCharola()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load charola fill tables: %w", err)
+	}
+	repo.tablasLlenadoCharola = tablasLlenado
+
+	// Load manufacturer dimensions of multiconductor cables (TC/MC)
+	tablaCablesMulti, err := repo.loadTablaCablesMulticonductor()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load multiconductor cable table: %w", err)
+	}
+	repo.tablaCablesMulti = tablaCablesMulti
+
 	// Load ampacity tables for conduit types
 	for _, canalizacion := range []entity.TipoCanalizacion{
 		entity.TipoCanalizacionTuberiaPVC,
@@ -204,6 +282,30 @@ func NewCSVTablaNOMRepository(basePath string) (*CSVTablaNOMRepository, error) {
 		}
 	}
 
+	// Underground installations (Anexo B) -> b-310-7 (ducto) y b-310-10 (directamente enterrado)
+	// Solo columnas de 75C y 90C, igual que la charola triangular
+	for canalizacion, filename := range map[entity.TipoCanalizacion]string{
+		entity.TipoCanalizacionDuctoSubterraneo:      "b-310-7-ducto-subterraneo.csv",
+		entity.TipoCanalizacionDirectamenteEnterrado: "b-310-10-directamente-enterrado.csv",
+	} {
+		repo.tablasAmpacidad[canalizacion] = make(map[valueobject.MaterialConductor]map[valueobject.Temperatura][]valueobject.EntradaTablaConductor)
+		for _, material := range []valueobject.MaterialConductor{
+			valueobject.MaterialCobre,
+			valueobject.MaterialAluminio,
+		} {
+			repo.tablasAmpacidad[canalizacion][material] = make(map[valueobject.Temperatura][]valueobject.EntradaTablaConductor)
+
+			tabla, err := repo.loadTablaAmpacidad(filename, material)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load ampacity table for %s %s: %w", canalizacion, material, err)
+			}
+
+			for _, temp := range []valueobject.Temperatura{valueobject.Temp75, valueobject.Temp90} {
+				repo.tablasAmpacidad[canalizacion][material][temp] = extractByTemperature(tabla, material, temp)
+			}
+		}
+	}
+
 	// Load estados_temperatura.csv
 	estadosTemp, err := repo.loadEstadosTemperatura()
 	if err != nil {
@@ -225,6 +327,17 @@ func NewCSVTablaNOMRepository(basePath string) (*CSVTablaNOMRepository, error) {
 	}
 	repo.factoresAgrupamiento = factoresAgr
 
+	// Load underground correction factors (soil resistivity, burial depth, grouping)
+	if repo.factoresResistividad, err = repo.loadFactoresSubterraneos("factor-resistividad-termica.csv"); err != nil {
+		return nil, fmt.Errorf("failed to load factores resistividad termica: %w", err)
+	}
+	if repo.factoresProfundidad, err = repo.loadFactoresSubterraneos("factor-profundidad-enterramiento.csv"); err != nil {
+		return nil, fmt.Errorf("failed to load factores profundidad: %w", err)
+	}
+	if repo.factoresAgrupSubterr, err = repo.loadFactoresSubterraneos("factor-agrupamiento-subterraneo.csv"); err != nil {
+		return nil, fmt.Errorf("failed to load factores agrupamiento subterraneo: %w", err)
+	}
+
 	// Load tabla diametros (tabla-5-dimensiones-aislamiento.csv)
 	tablaDiam, err := repo.loadTablaDiametros()
 	if err != nil {
@@ -261,6 +374,19 @@ func (r *CSVTablaNOMRepository) ObtenerTablaTierra(ctx context.Context) ([]value
 	return r.tablaTierra, nil
 }
 
+// ObtenerTablaITM returns the standard breaker ratings table (NOM 240-6(a)).
+func (r *CSVTablaNOMRepository) ObtenerTablaITM(ctx context.Context) ([]valueobject.EntradaTablaITM, error) {
+	return r.tablaITM, nil
+}
+
+// ObtenerTablaCorrienteMotor returns Tabla 430-250 for three-phase motors and 430-248 otherwise.
+func (r *CSVTablaNOMRepository) ObtenerTablaCorrienteMotor(ctx context.Context, fases int) ([]valueobject.EntradaTablaMotor, error) {
+	if fases == 3 {
+		return r.tablaMotorTrifasico, nil
+	}
+	return r.tablaMotorMonofasico, nil
+}
+
 // ObtenerTablaAmpacidad returns ampacity table entries for the given conduit type, material, and temperature.
 func (r *CSVTablaNOMRepository) ObtenerTablaAmpacidad(
 	ctx context.Context,
@@ -364,7 +490,8 @@ func (r *CSVTablaNOMRepository) ObtenerTablaCanalizacion(
 	case entity.TipoCanalizacionTuberiaPVC,
 		entity.TipoCanalizacionTuberiaAluminio,
 		entity.TipoCanalizacionTuberiaAceroPG,
-		entity.TipoCanalizacionTuberiaAceroPD:
+		entity.TipoCanalizacionTuberiaAceroPD,
+		entity.TipoCanalizacionDuctoSubterraneo:
 		return r.tablaConduit, nil
 	case entity.TipoCanalizacionCharolaCableEspaciado,
 		entity.TipoCanalizacionCharolaCableTriangular:
@@ -433,6 +560,52 @@ func (r *CSVTablaNOMRepository) ObtenerFactorAgrupamiento(ctx context.Context, c
 	return 0.30, nil
 }
 
+// ObtenerFactorResistividadTermica returns the soil thermal resistivity correction factor
+// for an underground installation. Takes the first row with resistivity ≥ the given
+// value (the next higher resistivity, which is conservative).
+func (r *CSVTablaNOMRepository) ObtenerFactorResistividadTermica(ctx context.Context, canalizacion entity.TipoCanalizacion, resistividad float64) (float64, error) {
+	if !canalizacion.EsSubterranea() {
+		return 0, fmt.Errorf("factor de resistividad térmica no aplica a: %s", canalizacion)
+	}
+	for _, entrada := range r.factoresResistividad {
+		if resistividad <= entrada.limite {
+			return entrada.factor(canalizacion), nil
+		}
+	}
+	return 0, fmt.Errorf("resistividad térmica %.1f °C·cm/W fuera de tabla", resistividad)
+}
+
+// ObtenerFactorProfundidad returns the burial depth correction factor for an
+// underground installation. Takes the first row with depth ≥ the given value.
+func (r *CSVTablaNOMRepository) ObtenerFactorProfundidad(ctx context.Context, canalizacion entity.TipoCanalizacion, profundidadMM float64) (float64, error) {
+	if !canalizacion.EsSubterranea() {
+		return 0, fmt.Errorf("factor de profundidad no aplica a: %s", canalizacion)
+	}
+	for _, entrada := range r.factoresProfundidad {
+		if profundidadMM <= entrada.limite {
+			return entrada.factor(canalizacion), nil
+		}
+	}
+	return 0, fmt.Errorf("profundidad %.0f mm fuera de tabla", profundidadMM)
+}
+
+// ObtenerFactorAgrupamientoSubterraneo returns the grouping factor for adjacent
+// underground circuits (one circuit per duct, or one cable set per trench position).
+func (r *CSVTablaNOMRepository) ObtenerFactorAgrupamientoSubterraneo(ctx context.Context, canalizacion entity.TipoCanalizacion, numCircuitos int) (float64, error) {
+	if !canalizacion.EsSubterranea() {
+		return 0, fmt.Errorf("factor de agrupamiento subterráneo no aplica a: %s", canalizacion)
+	}
+	if numCircuitos <= 0 {
+		return 0, fmt.Errorf("número de circuitos debe ser mayor que cero: %d", numCircuitos)
+	}
+	for _, entrada := range r.factoresAgrupSubterr {
+		if float64(numCircuitos) <= entrada.limite {
+			return entrada.factor(canalizacion), nil
+		}
+	}
+	return 0, fmt.Errorf("%d circuitos subterráneos fuera de tabla; se requiere cálculo según 310-15(c)", numCircuitos)
+}
+
 // rangoContiene checks if a temperature range contains the given temperature.
 func rangoContiene(rango string, temp int) bool {
 	var min, max int
@@ -446,22 +619,34 @@ func rangoContiene(rango string, temp int) bool {
 }
 
 // ObtenerDiametroConductor returns the diameter in mm for a given calibre, material, and insulation type.
-func (r *CSVTablaNOMRepository) ObtenerDiametroConductor(ctx context.Context, calibre string, material string, conAislamiento bool) (float64, error) {
+// Con aislamiento se usa la columna de la Tabla 5 que le corresponde; sin aislamiento
+// (conductor desnudo) el diámetro de la Tabla 8.
+func (r *CSVTablaNOMRepository) ObtenerDiametroConductor(ctx context.Context, calibre string, material string, aislamiento valueobject.TipoAislamiento) (float64, error) {
 	// tablaDiametros tiene keys con sufijo " AWG" (tal como vienen del CSV)
 	// Los calibres MCM (250 MCM, 300 MCM, 500 MCM) ya tienen sufijo " MCM", no " AWG"
 	calibreKey := calibre
 	if !strings.HasSuffix(calibre, " AWG") && !strings.HasSuffix(calibre, " MCM") {
 		calibreKey = calibre + " AWG"
 	}
+
+	if aislamiento == valueobject.SinAislamiento {
+		entry, ok := r.tablaConductorDesnudo[calibreKey]
+		if !ok || entry.DiametroMM <= 0 {
+			return 0, fmt.Errorf("calibre no encontrado en tabla de conductor desnudo: %s", calibre)
+		}
+		return entry.DiametroMM, nil
+	}
+
 	entry, ok := r.tablaDiametros[calibreKey]
 	if !ok {
 		return 0, fmt.Errorf("calibre no encontrado en tabla de diametros: %s", calibre)
 	}
 
-	if conAislamiento {
-		return entry.DiamTWTHW, nil
+	diametro, _ := entry.dimensiones(aislamiento)
+	if diametro <= 0 {
+		return 0, fmt.Errorf("diámetro %s no disponible para calibre: %s", aislamiento, calibre)
 	}
-	return entry.DiamRHH_RHW, nil
+	return diametro, nil
 }
 
 // ObtenerCharolaPorAncho returns the smallest tray size that fits the required width.
@@ -481,8 +666,8 @@ func (r *CSVTablaNOMRepository) ObtenerCharolaPorAncho(ctx context.Context, anch
 	return valueobject.EntradaTablaCanalizacion{}, fmt.Errorf("no se encontró charola para ancho requerido: %.2f mm", anchoRequeridoMM)
 }
 
-// ObtenerAreaConductor returns the area with insulation (area_tw_thw) for a given calibre.
-func (r *CSVTablaNOMRepository) ObtenerAreaConductor(ctx context.Context, calibre string) (float64, error) {
+// ObtenerAreaConductor returns the area with insulation (Tabla 5 column of the insulation type) for a given calibre.
+func (r *CSVTablaNOMRepository) ObtenerAreaConductor(ctx context.Context, calibre string, aislamiento valueobject.TipoAislamiento) (float64, error) {
 	// tablaDiametros tiene keys con sufijo " AWG"
 	// Los calibres MCM (250 MCM, 300 MCM, 500 MCM) ya tienen sufijo " MCM", no " AWG"
 	calibreKey := calibre
@@ -494,11 +679,12 @@ func (r *CSVTablaNOMRepository) ObtenerAreaConductor(ctx context.Context, calibr
 		return 0, fmt.Errorf("calibre no encontrado en tabla de áreas: %s", calibre)
 	}
 
-	if entry.AreaTWTHW <= 0 {
-		return 0, fmt.Errorf("área no disponible para calibre: %s", calibre)
+	_, area := entry.dimensiones(aislamiento)
+	if area <= 0 {
+		return 0, fmt.Errorf("área %s no disponible para calibre: %s", aislamiento, calibre)
 	}
 
-	return entry.AreaTWTHW, nil
+	return area, nil
 }
 
 // ObtenerAreaConductorDesnudo returns the area for bare conductor (Tabla 8) - used for ground conductors.
@@ -551,6 +737,33 @@ func (r *CSVTablaNOMRepository) ObtenerTablaCharola(ctx context.Context, tipo en
 	}
 }
 
+// ObtenerTablaLlenadoCharola returns the commercial widths and NOM 392-22 fill limits
+// for the given tray type, sorted by width ascending.
+func (r *CSVTablaNOMRepository) ObtenerTablaLlenadoCharola(ctx context.Context, tipo entity.TipoCharola) ([]valueobject.EntradaTablaLlenadoCharola, error) {
+	tabla, ok := r.tablasLlenadoCharola[tipo]
+	if !ok {
+		return nil, fmt.Errorf("tabla de llenado no cargada para charola: %s", tipo)
+	}
+	return tabla, nil
+}
+
+// ObtenerCableMulticonductor returns the manufacturer dimensions of a TC/MC cable with the
+// given calibre and number of insulated conductors.
+func (r *CSVTablaNOMRepository) ObtenerCableMulticonductor(ctx context.Context, tipo valueobject.TipoCableMulticonductor, calibre string, conductores int) (valueobject.CableMulticonductor, error) {
+	for _, entrada := range r.tablaCablesMulti {
+		if entrada.Tipo == tipo && entrada.Calibre == calibre && entrada.NumConductores == conductores {
+			return valueobject.NewCableMulticonductor(valueobject.CableMulticonductorParams{
+				Tipo:               entrada.Tipo,
+				Calibre:            entrada.Calibre,
+				SeccionMM2:         entrada.SeccionMM2,
+				NumConductores:     entrada.NumConductores,
+				DiametroExteriorMM: entrada.DiametroExteriorMM,
+			})
+		}
+	}
+	return valueobject.CableMulticonductor{}, fmt.Errorf("cable %s %dC %s no encontrado en tabla de fabricante", tipo, conductores, calibre)
+}
+
 func parseAnchoCharola(tamano string) float64 {
 	var ancho float64
 	if _, err := fmt.Sscanf(tamano, "%fmm", &ancho); err == nil {
@@ -632,6 +845,118 @@ func (r *CSVTablaNOMRepository) loadTablaTierra() ([]valueobject.EntradaTablaTie
 	return result, nil
 }
 
+// loadTablaITM loads itm-capacidades-estandar.csv (standard breaker ratings and frame size).
+func (r *CSVTablaNOMRepository) loadTablaITM() ([]valueobject.EntradaTablaITM, error) {
+	filePath := filepath.Join(r.basePath, "itm-capacidades-estandar.csv")
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open itm-capacidades-estandar.csv: %w", err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("cannot read itm-capacidades-estandar.csv: %w", err)
+	}
+
+	if len(records) < 2 {
+		return nil, fmt.Errorf("itm-capacidades-estandar.csv is empty or missing header")
+	}
+
+	// Validate header
+	header := records[0]
+	expectedHeader := []string{"amperaje", "marco"}
+	for i, col := range expectedHeader {
+		if i >= len(header) || header[i] != col {
+			return nil, fmt.Errorf("itm-capacidades-estandar.csv: invalid header at position %d, expected %q", i, col)
+		}
+	}
+
+	var result []valueobject.EntradaTablaITM
+	for i, record := range records[1:] {
+		if len(record) < 2 {
+			continue
+		}
+
+		amperaje, err := strconv.Atoi(record[0])
+		if err != nil {
+			return nil, fmt.Errorf("itm-capacidades-estandar.csv line %d: invalid amperaje: %w", i+2, err)
+		}
+		marco, err := strconv.Atoi(record[1])
+		if err != nil {
+			return nil, fmt.Errorf("itm-capacidades-estandar.csv line %d: invalid marco: %w", i+2, err)
+		}
+
+		result = append(result, valueobject.EntradaTablaITM{Amperaje: amperaje, Marco: marco})
+	}
+
+	return result, nil
+}
+
+// loadTablaMotor loads a motor full-load current table (430-248.csv or 430-250.csv).
+// Header: hp followed by one column per voltage in volts; empty cells mean "not listed".
+func (r *CSVTablaNOMRepository) loadTablaMotor(fileName string) ([]valueobject.EntradaTablaMotor, error) {
+	filePath := filepath.Join(r.basePath, fileName)
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open %s: %w", fileName, err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("cannot read %s: %w", fileName, err)
+	}
+
+	if len(records) < 2 {
+		return nil, fmt.Errorf("%s is empty or missing header", fileName)
+	}
+
+	// Validate header: hp, <tension>, <tension>, ...
+	header := records[0]
+	if len(header) < 2 || header[0] != "hp" {
+		return nil, fmt.Errorf("%s: invalid header, expected \"hp\" followed by voltage columns", fileName)
+	}
+	tensiones := make([]int, len(header)-1)
+	for i, col := range header[1:] {
+		tension, err := strconv.Atoi(col)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid voltage column %q: %w", fileName, col, err)
+		}
+		tensiones[i] = tension
+	}
+
+	var result []valueobject.EntradaTablaMotor
+	for i, record := range records[1:] {
+		if len(record) < 2 {
+			continue
+		}
+
+		hp, err := strconv.ParseFloat(record[0], 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s line %d: invalid hp: %w", fileName, i+2, err)
+		}
+
+		corrientes := make(map[int]float64)
+		for j, tension := range tensiones {
+			if j+1 >= len(record) || record[j+1] == "" {
+				continue
+			}
+			corriente, err := strconv.ParseFloat(record[j+1], 64)
+			if err != nil {
+				return nil, fmt.Errorf("%s line %d: invalid current for %d V: %w", fileName, i+2, tension, err)
+			}
+			corrientes[tension] = corriente
+		}
+
+		result = append(result, valueobject.EntradaTablaMotor{PotenciaHP: hp, Corrientes: corrientes})
+	}
+
+	return result, nil
+}
+
 // crearTablaCharolaEspaciado crea la tabla de dimensiones para charola cable espaciado.
 // Lee del archivo CSV: charola_dimensiones.csv
 func (r *CSVTablaNOMRepository) crearTablaCharolaEspaciado() ([]valueobject.EntradaTablaCanalizacion, error) {
@@ -682,6 +1007,128 @@ func (r *CSVTablaNOMRepository) loadTablaCharolaDimensiones() ([]valueobject.Ent
 	return result, nil
 }
 
+// loadTablasLlenadoCharola carga los límites de llenado NOM 392-22 por tipo de charola:
+// charola-llenado-392-22.csv (escalera y fondo sólido) y charola-canal-392-22.csv (canal ventilado).
+func (r *CSVTablaNOMRepository) loadTablasLlenadoCharola() (map[entity.TipoCharola][]valueobject.EntradaTablaLlenadoCharola, error) {
+	records, err := r.readCSV("charola-llenado-392-22.csv")
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[entity.TipoCharola][]valueobject.EntradaTablaLlenadoCharola)
+	for i, record := range records[1:] {
+		if len(record) < 5 {
+			continue
+		}
+		valores, err := parseFloats(record[1:5])
+		if err != nil {
+			return nil, fmt.Errorf("charola-llenado-392-22.csv line %d: %w", i+2, err)
+		}
+		ancho, escalera, fondoSolido, monoconductor := valores[0], valores[1], valores[2], valores[3]
+		result[entity.TipoCharolaEscalera] = append(result[entity.TipoCharolaEscalera], valueobject.EntradaTablaLlenadoCharola{
+			Tamano:                record[0],
+			AnchoMM:               ancho,
+			AreaMulticonductorMM2: escalera,
+			AreaMonoconductorMM2:  monoconductor,
+		})
+		result[entity.TipoCharolaFondoSolido] = append(result[entity.TipoCharolaFondoSolido], valueobject.EntradaTablaLlenadoCharola{
+			Tamano:                record[0],
+			AnchoMM:               ancho,
+			AreaMulticonductorMM2: fondoSolido,
+			AreaMonoconductorMM2:  monoconductor,
+		})
+	}
+
+	records, err = r.readCSV("charola-canal-392-22.csv")
+	if err != nil {
+		return nil, err
+	}
+	for i, record := range records[1:] {
+		if len(record) < 4 {
+			continue
+		}
+		valores, err := parseFloats(record[1:4])
+		if err != nil {
+			return nil, fmt.Errorf("charola-canal-392-22.csv line %d: %w", i+2, err)
+		}
+		result[entity.TipoCharolaCanal] = append(result[entity.TipoCharolaCanal], valueobject.EntradaTablaLlenadoCharola{
+			Tamano:                     record[0],
+			AnchoMM:                    valores[0],
+			AreaMulticonductorUnicoMM2: valores[1],
+			AreaMulticonductorMM2:      valores[2],
+		})
+	}
+
+	return result, nil
+}
+
+// loadTablaCablesMulticonductor carga los diámetros exteriores de fabricante de cables
+// multiconductores TC y MC (cable-multiconductor-dimensiones.csv).
+func (r *CSVTablaNOMRepository) loadTablaCablesMulticonductor() ([]valueobject.EntradaTablaCableMulticonductor, error) {
+	records, err := r.readCSV("cable-multiconductor-dimensiones.csv")
+	if err != nil {
+		return nil, err
+	}
+
+	var result []valueobject.EntradaTablaCableMulticonductor
+	for i, record := range records[1:] {
+		if len(record) < 5 {
+			continue
+		}
+		tipo, err := valueobject.ParseTipoCableMulticonductor(record[0])
+		if err != nil {
+			return nil, fmt.Errorf("cable-multiconductor-dimensiones.csv line %d: %w", i+2, err)
+		}
+		conductores, err := strconv.Atoi(strings.TrimSpace(record[3]))
+		if err != nil {
+			return nil, fmt.Errorf("cable-multiconductor-dimensiones.csv line %d: invalid conductores %q: %w", i+2, record[3], err)
+		}
+		valores, err := parseFloats([]string{record[2], record[4]})
+		if err != nil {
+			return nil, fmt.Errorf("cable-multiconductor-dimensiones.csv line %d: %w", i+2, err)
+		}
+		result = append(result, valueobject.EntradaTablaCableMulticonductor{
+			Tipo:               tipo,
+			Calibre:            strings.TrimSpace(record[1]),
+			SeccionMM2:         valores[0],
+			NumConductores:     conductores,
+			DiametroExteriorMM: valores[1],
+		})
+	}
+	return result, nil
+}
+
+// readCSV reads all records of a CSV file under basePath, requiring a header row.
+func (r *CSVTablaNOMRepository) readCSV(filename string) ([][]string, error) {
+	file, err := os.Open(filepath.Join(r.basePath, filename))
+	if err != nil {
+		return nil, fmt.Errorf("cannot open %s: %w", filename, err)
+	}
+	defer file.Close()
+
+	records, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("cannot read %s: %w", filename, err)
+	}
+	if len(records) < 2 {
+		return nil, fmt.Errorf("%s is empty or missing header", filename)
+	}
+	return records, nil
+}
+
+// parseFloats parses every field as float64.
+func parseFloats(fields []string) ([]float64, error) {
+	valores := make([]float64, len(fields))
+	for i, field := range fields {
+		v, err := strconv.ParseFloat(strings.TrimSpace(field), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q: %w", field, err)
+		}
+		valores[i] = v
+	}
+	return valores, nil
+}
+
 // rawAmpacidadEntry holds raw data from CSV before temperature extraction.
 type rawAmpacidadEntry struct {
 	Capacidad60 float64
@@ -927,6 +1374,9 @@ func (r *CSVTablaNOMRepository) loadTablaConduit() ([]valueobject.EntradaTablaCa
 		return nil, fmt.Errorf("tabla-conduit-dimensiones.csv: missing column area_interior_mm2")
 	}
 
+	// diametro_interior_mm es opcional: se usa para la relación de atascamiento
+	diametroIdx, tieneDiametro := colIdx["diametro_interior_mm"]
+
 	var result []valueobject.EntradaTablaCanalizacion
 	for i, record := range records[1:] {
 		if len(record) < len(header) {
@@ -938,9 +1388,18 @@ func (r *CSVTablaNOMRepository) loadTablaConduit() ([]valueobject.EntradaTablaCa
 			return nil, fmt.Errorf("tabla-conduit-dimensiones.csv line %d: invalid area_interior_mm2: %w", i+2, err)
 		}
 
+		var diametro float64
+		if tieneDiametro {
+			diametro, err = strconv.ParseFloat(record[diametroIdx], 64)
+			if err != nil {
+				return nil, fmt.Errorf("tabla-conduit-dimensiones.csv line %d: invalid diametro_interior_mm: %w", i+2, err)
+			}
+		}
+
 		result = append(result, valueobject.EntradaTablaCanalizacion{
-			Tamano:          record[tamanoIdx],
-			AreaInteriorMM2: area,
+			Tamano:             record[tamanoIdx],
+			AreaInteriorMM2:    area,
+			DiametroInteriorMM: diametro,
 		})
 	}
 
@@ -1059,6 +1518,55 @@ func (r *CSVTablaNOMRepository) loadFactoresAgrupamiento() ([]factorAgrupamiento
 	return result, nil
 }
 
+// loadFactoresSubterraneos loads an underground correction table with header
+// <clave>,factor_ducto,factor_enterrado, sorted by key ascending.
+func (r *CSVTablaNOMRepository) loadFactoresSubterraneos(filename string) ([]factorSubterraneoEntry, error) {
+	filePath := filepath.Join(r.basePath, filename)
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open %s: %w", filename, err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("cannot read %s: %w", filename, err)
+	}
+
+	if len(records) < 2 {
+		return nil, fmt.Errorf("%s is empty or missing header", filename)
+	}
+
+	var result []factorSubterraneoEntry
+	for i, record := range records[1:] {
+		if len(record) < 3 {
+			continue
+		}
+
+		limite, err := strconv.ParseFloat(record[0], 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s line %d: invalid key: %w", filename, i+2, err)
+		}
+		ducto, err := strconv.ParseFloat(record[1], 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s line %d: invalid factor_ducto: %w", filename, i+2, err)
+		}
+		enterrado, err := strconv.ParseFloat(record[2], 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s line %d: invalid factor_enterrado: %w", filename, i+2, err)
+		}
+
+		result = append(result, factorSubterraneoEntry{
+			limite:          limite,
+			factorDucto:     ducto,
+			factorEnterrado: enterrado,
+		})
+	}
+
+	return result, nil
+}
+
 func (r *CSVTablaNOMRepository) loadTablaDiametros() (map[string]diametroConductorEntry, error) {
 	filePath := filepath.Join(r.basePath, "tabla-5-dimensiones-aislamiento.csv")
 	file, err := os.Open(filePath)
@@ -1117,11 +1625,24 @@ func (r *CSVTablaNOMRepository) loadTablaDiametros() (map[string]diametroConduct
 			entry.DiamXHHW = v
 		}
 
-		// Parse area_tw_thw column
-		areaTWTHWIdx, ok := colIdx["area_tw_thw"]
-		if ok && areaTWTHWIdx < len(record) {
-			if v, err := strconv.ParseFloat(record[areaTWTHWIdx], 64); err == nil {
-				entry.AreaTWTHW = v
+		// Columnas opcionales: áreas por aislamiento y THHN/THWN
+		opcionales := []struct {
+			col   string
+			valor *float64
+		}{
+			{"area_tw_thw", &entry.AreaTWTHW},
+			{"area_rhh_rhw", &entry.AreaRHH_RHW},
+			{"area_xhhw", &entry.AreaXHHW},
+			{"diam_thhn", &entry.DiamTHHN},
+			{"area_thhn", &entry.AreaTHHN},
+		}
+		for _, o := range opcionales {
+			idx, ok := colIdx[o.col]
+			if !ok || idx >= len(record) {
+				continue
+			}
+			if v, err := strconv.ParseFloat(record[idx], 64); err == nil {
+				*o.valor = v
 			}
 		}
 
@@ -1175,6 +1696,11 @@ func (r *CSVTablaNOMRepository) loadTablaConductorDesnudo() (map[string]conducto
 		if v, err := strconv.ParseFloat(record[areaTierraIdx], 64); err == nil {
 			entry.AreaConductorTierra = v
 		}
+		if idx, ok := colIdx["diametro_mm"]; ok {
+			if v, err := strconv.ParseFloat(record[idx], 64); err == nil {
+				entry.DiametroMM = v
+			}
+		}
 
 		result[record[calibreIdx]] = entry
 	}
@@ -1204,9 +1730,10 @@ func (r *CSVTablaNOMRepository) loadTablasOcupacionTuberia() (map[entity.TipoCan
 
 	// Define mapping from canalizacion to CSV file
 	files := map[entity.TipoCanalizacion]string{
-		entity.TipoCanalizacionTuberiaPVC:     "tubo-ocupacion-pvc-40.csv",
-		entity.TipoCanalizacionTuberiaAceroPG: "tubo-ocupacion-acero-pg-40.csv",
-		entity.TipoCanalizacionTuberiaAceroPD: "tubo-ocupacion-acero-pd-40.csv",
+		entity.TipoCanalizacionTuberiaPVC:       "tubo-ocupacion-pvc-40.csv",
+		entity.TipoCanalizacionTuberiaAceroPG:   "tubo-ocupacion-acero-pg-40.csv",
+		entity.TipoCanalizacionTuberiaAceroPD:   "tubo-ocupacion-acero-pd-40.csv",
+		entity.TipoCanalizacionDuctoSubterraneo: "tubo-ocupacion-pvc-40.csv", // ducto PVC del banco
 	}
 
 	for canalizacion, filename := range files {
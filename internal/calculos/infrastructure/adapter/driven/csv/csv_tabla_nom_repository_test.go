@@ -45,6 +45,49 @@ func TestCSVTablaNOMRepository_ObtenerTablaTierra(t *testing.T) {
 	assert.Equal(t, "14 AWG", tabla[1].ConductorCu.Calibre)
 }
 
+func TestCSVTablaNOMRepository_ObtenerTablaITM(t *testing.T) {
+	repo, err := NewCSVTablaNOMRepository("testdata")
+	require.NoError(t, err)
+
+	tabla, err := repo.ObtenerTablaITM(context.Background())
+	require.NoError(t, err)
+	require.Greater(t, len(tabla), 0)
+
+	assert.Equal(t, 15, tabla[0].Amperaje)
+	assert.Equal(t, 100, tabla[0].Marco)
+	for i := 1; i < len(tabla); i++ {
+		assert.Greater(t, tabla[i].Amperaje, tabla[i-1].Amperaje, "tabla debe estar ordenada ascendente")
+	}
+}
+
+func TestCSVTablaNOMRepository_ObtenerTablaCorrienteMotor(t *testing.T) {
+	repo, err := NewCSVTablaNOMRepository("testdata")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	trifasico, err := repo.ObtenerTablaCorrienteMotor(ctx, 3)
+	require.NoError(t, err)
+	require.Greater(t, len(trifasico), 0)
+	// 430-250: 10 HP → 14 A a 460 V, sin valor a 115 V
+	for _, entrada := range trifasico {
+		if entrada.PotenciaHP == 10 {
+			assert.InDelta(t, 14.0, entrada.Corrientes[460], 0.001)
+			_, ok := entrada.Corrientes[115]
+			assert.False(t, ok)
+		}
+	}
+
+	monofasico, err := repo.ObtenerTablaCorrienteMotor(ctx, 1)
+	require.NoError(t, err)
+	require.Greater(t, len(monofasico), 0)
+	assert.InDelta(t, 0.167, monofasico[0].PotenciaHP, 0.001)
+	assert.InDelta(t, 4.4, monofasico[0].Corrientes[115], 0.001)
+	for i := 1; i < len(monofasico); i++ {
+		assert.Greater(t, monofasico[i].PotenciaHP, monofasico[i-1].PotenciaHP, "tabla debe estar ordenada ascendente")
+	}
+}
+
 func TestCSVTablaNOMRepository_ObtenerTablaAmpacidad(t *testing.T) {
 	repo, err := NewCSVTablaNOMRepository("testdata")
 	require.NoError(t, err)
@@ -223,6 +266,43 @@ func TestCSVTablaNOMRepository_ObtenerFactorTemperatura(t *testing.T) {
 	}
 }
 
+func TestCSVTablaNOMRepository_DimensionesPorAislamiento(t *testing.T) {
+	repo, err := NewCSVTablaNOMRepository("testdata")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	tests := []struct {
+		aislamiento valueobject.TipoAislamiento
+		areaMM2     float64
+		diametroMM  float64
+	}{
+		{valueobject.AislamientoTHW, 239.9, 17.48},
+		{valueobject.AislamientoTHHN, 208.8, 16.31},
+		{valueobject.AislamientoXHHW, 206.3, 16.21},
+		{valueobject.AislamientoRHH, 306.7, 19.76},
+		{valueobject.AislamientoUSE2, 306.7, 19.76},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.aislamiento.String(), func(t *testing.T) {
+			area, err := repo.ObtenerAreaConductor(ctx, "4/0 AWG", tt.aislamiento)
+			require.NoError(t, err)
+			assert.InDelta(t, tt.areaMM2, area, 0.01)
+
+			diametro, err := repo.ObtenerDiametroConductor(ctx, "4/0 AWG", "CU", tt.aislamiento)
+			require.NoError(t, err)
+			assert.InDelta(t, tt.diametroMM, diametro, 0.01)
+		})
+	}
+
+	t.Run("desnudo usa Tabla 8", func(t *testing.T) {
+		diametro, err := repo.ObtenerDiametroConductor(ctx, "4/0 AWG", "CU", valueobject.SinAislamiento)
+		require.NoError(t, err)
+		assert.InDelta(t, 13.41, diametro, 0.01)
+	})
+}
+
 func TestCSVTablaNOMRepository_GetTuberiaDimensionFisica(t *testing.T) {
 	repo, err := NewCSVTablaNOMRepository("testdata")
 	require.NoError(t, err)
@@ -333,3 +413,117 @@ func TestCSVTaberiaDimensionFisica_Consistency(t *testing.T) {
 		})
 	}
 }
+
+func TestCSVTablaNOMRepository_TablasAmpacidadSubterraneas(t *testing.T) {
+	repo, err := NewCSVTablaNOMRepository("testdata")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	capDucto, err := repo.ObtenerCapacidadConductor(ctx, entity.TipoCanalizacionDuctoSubterraneo, valueobject.MaterialCobre, valueobject.Temp75, "4/0 AWG")
+	require.NoError(t, err)
+	assert.Equal(t, 268.0, capDucto)
+
+	capEnterrado, err := repo.ObtenerCapacidadConductor(ctx, entity.TipoCanalizacionDirectamenteEnterrado, valueobject.MaterialCobre, valueobject.Temp90, "500 MCM")
+	require.NoError(t, err)
+	assert.Equal(t, 580.0, capEnterrado)
+
+	// Anexo B: sin columna de 60 °C
+	_, err = repo.ObtenerTablaAmpacidad(ctx, entity.TipoCanalizacionDuctoSubterraneo, valueobject.MaterialCobre, valueobject.Temp60)
+	assert.Error(t, err)
+
+	// El ducto se dimensiona con la tabla de ocupación de PVC
+	tablaOcupacion, err := repo.ObtenerTablaOcupacionTuberia(ctx, entity.TipoCanalizacionDuctoSubterraneo)
+	require.NoError(t, err)
+	assert.NotEmpty(t, tablaOcupacion)
+}
+
+func TestCSVTablaNOMRepository_FactoresSubterraneos(t *testing.T) {
+	repo, err := NewCSVTablaNOMRepository("testdata")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	ducto := entity.TipoCanalizacionDuctoSubterraneo
+	enterrado := entity.TipoCanalizacionDirectamenteEnterrado
+
+	tests := []struct {
+		name     string
+		obtener  func() (float64, error)
+		expected float64
+	}{
+		{"resistividad referencia", func() (float64, error) { return repo.ObtenerFactorResistividadTermica(ctx, ducto, 90) }, 1.00},
+		{"resistividad 100 toma fila 120", func() (float64, error) { return repo.ObtenerFactorResistividadTermica(ctx, enterrado, 100) }, 0.92},
+		{"profundidad referencia", func() (float64, error) { return repo.ObtenerFactorProfundidad(ctx, enterrado, 750) }, 1.00},
+		{"profundidad 1000 toma fila 1200", func() (float64, error) { return repo.ObtenerFactorProfundidad(ctx, ducto, 1000) }, 0.97},
+		{"agrupamiento 1 circuito", func() (float64, error) { return repo.ObtenerFactorAgrupamientoSubterraneo(ctx, ducto, 1) }, 1.00},
+		{"agrupamiento 3 circuitos enterrados", func() (float64, error) { return repo.ObtenerFactorAgrupamientoSubterraneo(ctx, enterrado, 3) }, 0.80},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			factor, err := tt.obtener()
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, factor)
+		})
+	}
+
+	_, err = repo.ObtenerFactorResistividadTermica(ctx, ducto, 400)
+	assert.Error(t, err, "resistividad fuera de tabla")
+	_, err = repo.ObtenerFactorAgrupamientoSubterraneo(ctx, ducto, 7)
+	assert.Error(t, err, "más circuitos que la tabla")
+	_, err = repo.ObtenerFactorProfundidad(ctx, entity.TipoCanalizacionTuberiaPVC, 750)
+	assert.Error(t, err, "no aplica sobre el terreno")
+}
+
+func TestCSVTablaNOMRepository_ObtenerTablaLlenadoCharola(t *testing.T) {
+	repo, err := NewCSVTablaNOMRepository("testdata")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	escalera, err := repo.ObtenerTablaLlenadoCharola(ctx, entity.TipoCharolaEscalera)
+	require.NoError(t, err)
+	require.Len(t, escalera, 9)
+	assert.Equal(t, "6", escalera[0].Tamano)
+	assert.Equal(t, 152.4, escalera[0].AnchoMM)
+	assert.Equal(t, 4500.0, escalera[0].AreaMulticonductorMM2)
+	assert.Equal(t, 4200.0, escalera[0].AreaMonoconductorMM2)
+
+	fondoSolido, err := repo.ObtenerTablaLlenadoCharola(ctx, entity.TipoCharolaFondoSolido)
+	require.NoError(t, err)
+	assert.Equal(t, 3500.0, fondoSolido[0].AreaMulticonductorMM2)
+	assert.Equal(t, escalera[0].AreaMonoconductorMM2, fondoSolido[0].AreaMonoconductorMM2)
+
+	canal, err := repo.ObtenerTablaLlenadoCharola(ctx, entity.TipoCharolaCanal)
+	require.NoError(t, err)
+	require.Len(t, canal, 3)
+	assert.Equal(t, 76.2, canal[0].AnchoMM)
+	assert.Equal(t, 1500.0, canal[0].AreaMulticonductorUnicoMM2)
+	assert.Equal(t, 850.0, canal[0].AreaMulticonductorMM2)
+	assert.Zero(t, canal[0].AreaMonoconductorMM2)
+
+	_, err = repo.ObtenerTablaLlenadoCharola(ctx, entity.TipoCharola("MALLA"))
+	assert.Error(t, err)
+}
+
+func TestCSVTablaNOMRepository_ObtenerCableMulticonductor(t *testing.T) {
+	repo, err := NewCSVTablaNOMRepository("testdata")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	tc, err := repo.ObtenerCableMulticonductor(ctx, valueobject.CableTC, "4/0 AWG", 3)
+	require.NoError(t, err)
+	assert.Equal(t, 42.2, tc.DiametroMM())
+	assert.Equal(t, 107.2, tc.SeccionMM2())
+	assert.Equal(t, "TC 3C 4/0 AWG + T", tc.Designacion())
+
+	mc, err := repo.ObtenerCableMulticonductor(ctx, valueobject.CableMC, "4/0 AWG", 3)
+	require.NoError(t, err)
+	assert.Greater(t, mc.DiametroMM(), tc.DiametroMM(), "la armadura MC aumenta el diámetro")
+
+	_, err = repo.ObtenerCableMulticonductor(ctx, valueobject.CableTC, "1000 MCM", 3)
+	assert.Error(t, err)
+	_, err = repo.ObtenerCableMulticonductor(ctx, valueobject.CableTC, "4/0 AWG", 5)
+	assert.Error(t, err)
+}
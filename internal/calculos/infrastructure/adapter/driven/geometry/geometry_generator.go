@@ -26,7 +26,11 @@ func (a *GeometryGeneratorAdapter) GenerarDiagramaCharola(
 	anchoComercialMM float64,
 	areaRequeridaMM2 float64,
 	tipoCanalizacion string,
+	peralteMM float64,
 ) (*port.GeometryDiagramaCharola, error) {
+	if peralteMM <= 0 {
+		peralteMM = geometry.PeralteCharolaMM
+	}
 
 	// Parse sistema eléctrico
 	sisElec, err := geometry.ParseSistemaElectrico(sistemaElectrico)
@@ -73,6 +77,51 @@ func (a *GeometryGeneratorAdapter) GenerarDiagramaCharola(
 		tipo = "espaciada"
 	}
 
+	return construirDiagramaCharola(posiciones, tipo, anchoComercialMM, areaRequeridaMM2, peralteMM), nil
+}
+
+// GenerarDiagramaCharolaMulticonductor implementa GeometryGeneratorPort.
+func (a *GeometryGeneratorAdapter) GenerarDiagramaCharolaMulticonductor(
+	diametroCableMM float64,
+	numCables int,
+	diametroControlMM *float64,
+	sistemaElectrico string,
+	anchoComercialMM float64,
+	areaRequeridaMM2 float64,
+	tipoCanalizacion string,
+	peralteMM float64,
+) (*port.GeometryDiagramaCharola, error) {
+	if peralteMM <= 0 {
+		peralteMM = geometry.PeralteCharolaMM
+	}
+
+	sisElec, err := geometry.ParseSistemaElectrico(sistemaElectrico)
+	if err != nil {
+		return nil, err
+	}
+
+	posiciones := geometry.CalcularPosicionesCharolaMulticonductor(geometry.ParametrosCharolaMulticonductor{
+		DiametroCableMM:   diametroCableMM,
+		NumCables:         numCables,
+		Espaciado:         tipoCanalizacion == "CHAROLA_CABLE_ESPACIADO",
+		DiametroControlMM: diametroControlMM,
+		AnchoComercialMM:  anchoComercialMM,
+		SistemaElectrico:  sisElec,
+	})
+
+	// Las posiciones del cable usan elevación sobre el fondo, como la distribución triangular
+	return construirDiagramaCharola(posiciones, "triangular", anchoComercialMM, areaRequeridaMM2, peralteMM), nil
+}
+
+// construirDiagramaCharola genera SVG, DXF y cotas de la charola y convierte las
+// posiciones a tipos del puerto.
+func construirDiagramaCharola(
+	posiciones []geometry.ConductorPosicion,
+	tipo string,
+	anchoComercialMM float64,
+	areaRequeridaMM2 float64,
+	peralteMM float64,
+) *port.GeometryDiagramaCharola {
 	// Calcular ancho ocupado
 	anchoOcupado := geometry.CalcularAnchoOcupadoCharola(posiciones)
 
@@ -80,32 +129,31 @@ func (a *GeometryGeneratorAdapter) GenerarDiagramaCharola(
 	svg := geometry.GenerarSVGCompletoCharola(geometry.ParametrosSVGCharola{
 		Posiciones:       posiciones,
 		AnchoComercialMM: anchoComercialMM,
-		PeralteMM:        geometry.PeralteCharolaMM,
+		PeralteMM:        peralteMM,
 		TipoDistribucion: tipo,
 	})
 
 	// Calcular viewBox y cotas
-	viewBox := geometry.CalcularViewBox(anchoComercialMM, geometry.PeralteCharolaMM, 20)
-	cotas := geometry.CalcularCotasCharola(anchoComercialMM, areaRequeridaMM2, geometry.PeralteCharolaMM)
+	viewBox := geometry.CalcularViewBox(anchoComercialMM, peralteMM, 20)
+	cotas := geometry.CalcularCotasCharola(anchoComercialMM, areaRequeridaMM2, peralteMM)
+
+	// Generar DXF con la misma geometría para los dibujantes (AutoCAD)
+	dxf := geometry.GenerarDXFCharola(geometry.ParametrosDXFCharola{
+		Posiciones:       posiciones,
+		AnchoComercialMM: anchoComercialMM,
+		PeralteMM:        peralteMM,
+		TipoDistribucion: tipo,
+		Cotas:            cotas,
+	})
 
 	// Convertir tipos internos a tipos del puerto
 	result := &port.GeometryDiagramaCharola{
-		Posiciones:   make([]port.GeometryConductorPosicion, len(posiciones)),
+		Posiciones:   posicionesPuerto(posiciones),
 		AnchoOcupado: anchoOcupado,
 		ViewBox:      viewBox.ViewBox,
 		Cotas:        make([]port.GeometryLineaCota, len(cotas)),
 		SVG:          svg,
-	}
-
-	for i, pos := range posiciones {
-		result.Posiciones[i] = port.GeometryConductorPosicion{
-			CX:       pos.CX,
-			CY:       pos.CY,
-			Radio:    pos.Radio,
-			Color:    pos.Color,
-			Etiqueta: pos.Etiqueta,
-			Tipo:     string(pos.Tipo),
-		}
+		DXF:          dxf,
 	}
 
 	for i, cota := range cotas {
@@ -120,7 +168,7 @@ func (a *GeometryGeneratorAdapter) GenerarDiagramaCharola(
 		}
 	}
 
-	return result, nil
+	return result
 }
 
 // GenerarDiagramaTuberia implementa GeometryGeneratorPort.
@@ -159,23 +207,66 @@ func (a *GeometryGeneratorAdapter) GenerarDiagramaTuberia(
 	// Calcular posiciones de conductores
 	posiciones := geometry.CalcularPosicionesTuberia(params)
 
+	return construirDiagramaTuberia(posiciones, diametroInteriorMM, diametroExteriorMM, numTubos), nil
+}
+
+// GenerarDiagramaTuberiaMulticonductor implementa GeometryGeneratorPort.
+func (a *GeometryGeneratorAdapter) GenerarDiagramaTuberiaMulticonductor(
+	diametroCableMM float64,
+	numCablesPorTubo int,
+	sistemaElectrico string,
+	diametroInteriorMM float64,
+	diametroExteriorMM float64,
+	numTubos int,
+) (*port.GeometryDiagramaTuberia, error) {
+	sisElec, err := geometry.ParseSistemaElectrico(sistemaElectrico)
+	if err != nil {
+		return nil, err
+	}
+
+	posiciones := geometry.CalcularPosicionesTuberiaMulticonductor(geometry.ParametrosTuberiaMulticonductor{
+		DiametroInteriorMM: diametroInteriorMM,
+		DiametroCableMM:    diametroCableMM,
+		NumCables:          numCablesPorTubo,
+		SistemaElectrico:   sisElec,
+	})
+
+	return construirDiagramaTuberia(posiciones, diametroInteriorMM, diametroExteriorMM, numTubos), nil
+}
+
+// construirDiagramaTuberia genera SVG y DXF de los tubos y convierte las posiciones
+// a tipos del puerto.
+func construirDiagramaTuberia(
+	posiciones []geometry.ConductorPosicion,
+	diametroInteriorMM float64,
+	diametroExteriorMM float64,
+	numTubos int,
+) *port.GeometryDiagramaTuberia {
 	// Generar SVG completo con el número de tubos
 	svg := geometry.GenerarSVGCompletoTuberia(posiciones, diametroInteriorMM, diametroExteriorMM, numTubos, 30)
 
+	// Generar DXF con la misma geometría para los dibujantes (AutoCAD)
+	dxf := geometry.GenerarDXFTuberia(posiciones, diametroInteriorMM, diametroExteriorMM, numTubos, 30)
+
 	// Calcular viewBox considerando el número de tubos
 	viewBox := geometry.CalcularViewBox(diametroExteriorMM, diametroExteriorMM, 30, numTubos)
 
 	// Convertir tipos internos a tipos del puerto
-	result := &port.GeometryDiagramaTuberia{
-		Posiciones:       make([]port.GeometryConductorPosicion, len(posiciones)),
+	return &port.GeometryDiagramaTuberia{
+		Posiciones:       posicionesPuerto(posiciones),
 		DiametroInterior: diametroInteriorMM,
 		DiametroExterior: diametroExteriorMM,
 		ViewBox:          viewBox.ViewBox,
 		SVG:              svg,
+		DXF:              dxf,
 	}
+}
 
+// posicionesPuerto convierte las posiciones de geometry a tipos del puerto.
+func posicionesPuerto(posiciones []geometry.ConductorPosicion) []port.GeometryConductorPosicion {
+	result := make([]port.GeometryConductorPosicion, len(posiciones))
 	for i, pos := range posiciones {
-		result.Posiciones[i] = port.GeometryConductorPosicion{
+		result[i] = port.GeometryConductorPosicion{
 			CX:       pos.CX,
 			CY:       pos.CY,
 			Radio:    pos.Radio,
@@ -184,8 +275,7 @@ func (a *GeometryGeneratorAdapter) GenerarDiagramaTuberia(
 			Tipo:     string(pos.Tipo),
 		}
 	}
-
-	return result, nil
+	return result
 }
 
 // Verify interface implementation at compile time
@@ -0,0 +1,114 @@
+// internal/calculos/infrastructure/adapter/driven/postgres/catalogo_precios_repository.go
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	calcport "github.com/garfex/calculadora-filtros/internal/calculos/application/port"
+	calcent "github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// CalcCatalogoPreciosRepository implements calculos/port.CatalogoPreciosRepository.
+// It reads the precios_materiales table (owned by the precios feature) and
+// builds the catalog of prices in effect today.
+type CalcCatalogoPreciosRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewCalcCatalogoPreciosRepository creates a new instance sharing the given pool.
+func NewCalcCatalogoPreciosRepository(pool *pgxpool.Pool) *CalcCatalogoPreciosRepository {
+	return &CalcCatalogoPreciosRepository{pool: pool}
+}
+
+// Compile-time check: CalcCatalogoPreciosRepository must implement calcport.CatalogoPreciosRepository.
+var _ calcport.CatalogoPreciosRepository = (*CalcCatalogoPreciosRepository)(nil)
+
+// ObtenerCatalogoVigente returns the prices in effect today for the given currency.
+// When several rows of the same material overlap, the one with the latest
+// vigente_desde wins.
+func (r *CalcCatalogoPreciosRepository) ObtenerCatalogoVigente(ctx context.Context, moneda string) (*calcent.CatalogoPrecios, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	query := `
+		SELECT DISTINCT ON (tipo, calibre, material, aislamiento, tipo_tuberia, tamano, ancho_mm)
+			tipo, calibre, material, aislamiento, tipo_tuberia, tamano, ancho_mm, precio_unitario
+		FROM precios_materiales
+		WHERE moneda = $1
+		  AND vigente_desde <= CURRENT_DATE
+		  AND (vigente_hasta IS NULL OR vigente_hasta >= CURRENT_DATE)
+		ORDER BY tipo, calibre, material, aislamiento, tipo_tuberia, tamano, ancho_mm, vigente_desde DESC
+	`
+
+	rows, err := r.pool.Query(ctx, query, moneda)
+	if err != nil {
+		return nil, fmt.Errorf("consultar precios vigentes: %w", err)
+	}
+	defer rows.Close()
+
+	catalogo := calcent.NewCatalogoPrecios(moneda)
+	total := 0
+	for rows.Next() {
+		var (
+			tipo                                                string
+			calibre, material, aislamiento, tipoTuberia, tamano *string
+			anchoMM                                             *float64
+			precio                                              float64
+		)
+		if err := rows.Scan(&tipo, &calibre, &material, &aislamiento, &tipoTuberia, &tamano, &anchoMM, &precio); err != nil {
+			return nil, fmt.Errorf("escanear precio: %w", err)
+		}
+
+		switch tipo {
+		case "CONDUCTOR":
+			if calibre == nil || material == nil {
+				continue
+			}
+			mat, err := valueobject.ParseMaterialConductor(*material)
+			if err != nil {
+				return nil, fmt.Errorf("material inválido en BD '%s': %w", *material, err)
+			}
+			ais := ""
+			if aislamiento != nil {
+				ais = *aislamiento
+			}
+			if err := catalogo.AgregarConductor(*calibre, mat, ais, precio); err != nil {
+				return nil, err
+			}
+		case "TUBERIA":
+			if tipoTuberia == nil || tamano == nil {
+				continue
+			}
+			tc, err := calcent.ParseTipoCanalizacion(*tipoTuberia)
+			if err != nil {
+				return nil, fmt.Errorf("tipo de tubería inválido en BD '%s': %w", *tipoTuberia, err)
+			}
+			if err := catalogo.AgregarTuberia(tc, *tamano, precio); err != nil {
+				return nil, err
+			}
+		case "CHAROLA":
+			if anchoMM == nil {
+				continue
+			}
+			if err := catalogo.AgregarCharola(*anchoMM, precio); err != nil {
+				return nil, err
+			}
+		default:
+			continue
+		}
+		total++
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterando precios: %w", err)
+	}
+	if total == 0 {
+		return nil, fmt.Errorf("sin precios vigentes en %s", moneda)
+	}
+
+	return catalogo, nil
+}
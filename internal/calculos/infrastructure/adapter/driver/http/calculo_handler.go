@@ -172,6 +172,17 @@ type CorrienteAjustadaRequest struct {
 	TipoEquipo       string  `json:"tipo_equipo" binding:"required"`
 	HilosPorFase     int     `json:"hilos_por_fase" binding:"gte=1"`
 	NumTuberias      int     `json:"num_tuberias" binding:"gte=1"`
+	// Armónicos (opcional): THDi total o porcentaje por orden
+	THDi             float64         `json:"thd_i,omitempty"`
+	EspectroArmonico map[int]float64 `json:"espectro_armonico,omitempty"`
+	// Aislamiento (opcional, default THW): limita la columna de temperatura
+	TipoAislamiento string `json:"tipo_aislamiento,omitempty"`
+	// Instalación subterránea (opcional, default condiciones del Anexo B)
+	ResistividadTermicaSuelo   float64 `json:"resistividad_termica_suelo,omitempty"`
+	ProfundidadEnterramientoMM float64 `json:"profundidad_enterramiento_mm,omitempty"`
+	TemperaturaTerreno         *int    `json:"temperatura_terreno,omitempty"`
+	// Cable multiconductor (opcional, TC o MC; vacío = monoconductores)
+	TipoCable string `json:"tipo_cable,omitempty"`
 }
 
 // CorrienteAjustadaResponse representa la respuesta exitosa.
@@ -260,6 +271,66 @@ func (h *CalculoHandler) CalcularCorrienteAjustada(c *gin.Context) {
 		return
 	}
 
+	// Espectro armónico (opcional)
+	var espectroArmonico *entity.EspectroArmonico
+	espectro, err := entity.NewEspectroArmonico(req.THDi, req.EspectroArmonico)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, CorrienteAjustadaResponseError{
+			Success: false,
+			Error:   "Espectro armónico inválido",
+			Code:    "ESPECTRO_ARMONICO_INVALIDO",
+			Details: err.Error(),
+		})
+		return
+	}
+	if espectro.TieneContenido() {
+		espectroArmonico = &espectro
+	}
+
+	// Tipo de aislamiento (opcional)
+	aislamiento := valueobject.AislamientoDefault
+	if req.TipoAislamiento != "" {
+		aislamiento, err = valueobject.ParseTipoAislamiento(req.TipoAislamiento)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, CorrienteAjustadaResponseError{
+				Success: false,
+				Error:   "Tipo de aislamiento inválido",
+				Code:    "TIPO_AISLAMIENTO_INVALIDO",
+				Details: err.Error(),
+			})
+			return
+		}
+	}
+
+	// Condiciones del terreno (solo instalaciones subterráneas)
+	var condicionesSubterraneas *entity.CondicionesSubterraneas
+	if tipoCanalizacion.EsSubterranea() {
+		condiciones, err := entity.NewCondicionesSubterraneas(tipoCanalizacion, req.ResistividadTermicaSuelo, req.ProfundidadEnterramientoMM, req.TemperaturaTerreno)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, CorrienteAjustadaResponseError{
+				Success: false,
+				Error:   "Condiciones de instalación subterránea inválidas",
+				Code:    "CONDICIONES_SUBTERRANEAS_INVALIDAS",
+				Details: err.Error(),
+			})
+			return
+		}
+		condicionesSubterraneas = &condiciones
+	}
+
+	// Cable multiconductor (opcional)
+	if req.TipoCable != "" {
+		if _, err := valueobject.ParseTipoCableMulticonductor(req.TipoCable); err != nil {
+			c.JSON(http.StatusBadRequest, CorrienteAjustadaResponseError{
+				Success: false,
+				Error:   "Tipo de cable inválido",
+				Code:    "TIPO_CABLE_INVALIDO",
+				Details: err.Error(),
+			})
+			return
+		}
+	}
+
 	// Valores por defecto
 	hilosPorFase := req.HilosPorFase
 	if hilosPorFase == 0 {
@@ -280,6 +351,12 @@ func (h *CalculoHandler) CalcularCorrienteAjustada(c *gin.Context) {
 		tipoEquipo,
 		hilosPorFase,
 		numTuberias,
+		usecase.OpcionesAjusteCorriente{
+			Armonicos:      espectroArmonico,
+			Aislamiento:    aislamiento,
+			Subterranea:    condicionesSubterraneas,
+			Multiconductor: req.TipoCable != "",
+		},
 	)
 	if err != nil {
 		status, response := h.mapCorrienteAjustadaErrorToResponse(err)
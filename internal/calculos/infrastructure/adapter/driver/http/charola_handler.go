@@ -38,6 +38,12 @@ type CharolaEspaciadoRequest struct {
 	DiametroFaseMM    float64  `json:"diametro_fase_mm" binding:"required,gt=0"`
 	DiametroTierraMM  float64  `json:"diametro_tierra_mm" binding:"required,gt=0"`
 	DiametroControlMM *float64 `json:"diametro_control_mm,omitempty"`
+	// seccion_fase_mm2: sección del calibre de fase; activa la regla por área para 1000 kcmil y mayores
+	SeccionFaseMM2 float64 `json:"seccion_fase_mm2,omitempty"`
+	// tipo_charola: ESCALERA (default), FONDO_SOLIDO, CANAL
+	TipoCharola string `json:"tipo_charola,omitempty"`
+	// peralte_mm: 76.2, 101.6 (default) o 152.4; no aplica a CANAL
+	PeralteMM float64 `json:"peralte_mm,omitempty"`
 }
 
 // CharolaEspaciadoResponse representa la respuesta exitosa.
@@ -85,6 +91,9 @@ func (h *CharolaHandler) PostCharolaEspaciado(c *gin.Context) {
 		DiametroFaseMM:    req.DiametroFaseMM,
 		DiametroTierraMM:  req.DiametroTierraMM,
 		DiametroControlMM: req.DiametroControlMM,
+		SeccionFaseMM2:    req.SeccionFaseMM2,
+		TipoCharola:       req.TipoCharola,
+		PeralteMM:         req.PeralteMM,
 	}
 
 	// Ejecutar use case
@@ -111,6 +120,12 @@ type CharolaTriangularRequest struct {
 	DiametroFaseMM    float64  `json:"diametro_fase_mm" binding:"required,gt=0"`
 	DiametroTierraMM  float64  `json:"diametro_tierra_mm" binding:"required,gt=0"`
 	DiametroControlMM *float64 `json:"diametro_control_mm,omitempty"`
+	// seccion_fase_mm2: sección del calibre de fase; activa la regla por área para 1000 kcmil y mayores
+	SeccionFaseMM2 float64 `json:"seccion_fase_mm2,omitempty"`
+	// tipo_charola: ESCALERA (default), FONDO_SOLIDO, CANAL
+	TipoCharola string `json:"tipo_charola,omitempty"`
+	// peralte_mm: 76.2, 101.6 (default) o 152.4; no aplica a CANAL
+	PeralteMM float64 `json:"peralte_mm,omitempty"`
 }
 
 // CharolaTriangularResponse representa la respuesta exitosa.
@@ -157,6 +172,9 @@ func (h *CharolaHandler) PostCharolaTriangular(c *gin.Context) {
 		DiametroFaseMM:    req.DiametroFaseMM,
 		DiametroTierraMM:  req.DiametroTierraMM,
 		DiametroControlMM: req.DiametroControlMM,
+		SeccionFaseMM2:    req.SeccionFaseMM2,
+		TipoCharola:       req.TipoCharola,
+		PeralteMM:         req.PeralteMM,
 	}
 
 	// Ejecutar use case
@@ -190,7 +208,8 @@ func (h *CharolaHandler) mapCharolaErrorToResponse(err error) (int, interface{})
 	if contains(errMsg, "hilos_por_fase") ||
 		contains(errMsg, "diametro_fase_mm") ||
 		contains(errMsg, "diametro_tierra_mm") ||
-		contains(errMsg, "sistema_electrico") {
+		contains(errMsg, "sistema_electrico") ||
+		contains(errMsg, "tipo_charola") {
 		return http.StatusBadRequest, CharolaEspaciadoResponseError{
 			Success: false,
 			Error:   "Error de validación",
@@ -0,0 +1,104 @@
+// internal/calculos/infrastructure/adapter/driver/http/cortocircuito_handler.go
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/usecase"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+	"github.com/gin-gonic/gin"
+)
+
+// CortocircuitoHandler maneja el endpoint de cálculo de corriente de cortocircuito.
+type CortocircuitoHandler struct {
+	calcularCortocircuitoUC *usecase.CalcularCortocircuitoUseCase
+}
+
+// NewCortocircuitoHandler crea un nuevo handler de cortocircuito.
+func NewCortocircuitoHandler(
+	calcularCortocircuitoUC *usecase.CalcularCortocircuitoUseCase,
+) *CortocircuitoHandler {
+	return &CortocircuitoHandler{
+		calcularCortocircuitoUC: calcularCortocircuitoUC,
+	}
+}
+
+// CortocircuitoResponse representa la respuesta exitosa.
+type CortocircuitoResponse struct {
+	Success bool                       `json:"success"`
+	Data    dto.ResultadoCortocircuito `json:"data"`
+}
+
+// CalcularCortocircuito POST /api/v1/calculos/cortocircuito
+// @Summary Calcular corriente de cortocircuito
+// @Description Calcula la corriente de cortocircuito simétrica trifásica y fase-tierra en el extremo de carga (método óhmico, R/X de Tabla 9). La fuente se define con MVAcc de la red y/o kVA y %Z del transformador.
+// @Tags Cortocircuito
+// @Accept json
+// @Produce json
+// @Param request body dto.CortocircuitoInput true "Fuente, conductor y longitud del circuito"
+// @Success 200 {object} CortocircuitoResponse "Corrientes de cortocircuito calculadas"
+// @Failure 400 {object} CalcularMemoriaResponseError "Error de validación o datos inválidos"
+// @Failure 422 {object} CalcularMemoriaResponseError "No se encontró la impedancia para el calibre y canalización"
+// @Failure 500 {object} CalcularMemoriaResponseError "Error interno del servidor"
+// @Router /calculos/cortocircuito [post]
+func (h *CortocircuitoHandler) CalcularCortocircuito(c *gin.Context) {
+	var req dto.CortocircuitoInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, CalcularMemoriaResponseError{
+			Success: false,
+			Error:   "Error de validación",
+			Code:    "VALIDATION_ERROR",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	result, err := h.calcularCortocircuitoUC.Execute(c.Request.Context(), req)
+	if err != nil {
+		status, response := h.mapErrorToResponse(err)
+		c.JSON(status, response)
+		return
+	}
+
+	c.JSON(http.StatusOK, CortocircuitoResponse{
+		Success: true,
+		Data:    result,
+	})
+}
+
+// mapErrorToResponse maps fault-current errors to HTTP responses; the rest
+// (canalización, sistema, tensión) share the memoria mapping.
+func (h *CortocircuitoHandler) mapErrorToResponse(err error) (int, CalcularMemoriaResponseError) {
+	if errors.Is(err, dto.ErrFuenteCortocircuitoInvalida) {
+		return http.StatusBadRequest, CalcularMemoriaResponseError{
+			Success: false,
+			Error:   "Fuente de cortocircuito inválida",
+			Code:    "FUENTE_CORTOCIRCUITO_INVALIDA",
+			Details: err.Error(),
+		}
+	}
+
+	if errors.Is(err, valueobject.ErrMaterialConductorInvalido) {
+		return http.StatusBadRequest, CalcularMemoriaResponseError{
+			Success: false,
+			Error:   "Material del conductor inválido",
+			Code:    "MATERIAL_INVALIDO",
+			Details: err.Error(),
+		}
+	}
+
+	// El error de impedancia no encontrada viene del CSV repository como fmt.Errorf
+	if errStr := err.Error(); strings.Contains(errStr, "not found") || strings.Contains(errStr, "no encontrado") {
+		return http.StatusUnprocessableEntity, CalcularMemoriaResponseError{
+			Success: false,
+			Error:   "No se encontró la impedancia para el calibre y canalización",
+			Code:    "IMPEDANCIA_NO_ENCONTRADA",
+			Details: errStr,
+		}
+	}
+
+	return (&MemoriaHandler{}).mapErrorToResponse(err)
+}
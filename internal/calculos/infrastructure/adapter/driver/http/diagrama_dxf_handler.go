@@ -0,0 +1,97 @@
+// internal/calculos/infrastructure/adapter/driver/http/diagrama_dxf_handler.go
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+// contentTypeDxf es el MIME type de un dibujo DXF de AutoCAD.
+const contentTypeDxf = "application/dxf"
+
+// DiagramaDxfHandler maneja el endpoint de exportación del diagrama de canalización a DXF.
+type DiagramaDxfHandler struct {
+	exportarDiagramaDxfUC *usecase.ExportarDiagramaDxfUseCase
+}
+
+// NewDiagramaDxfHandler crea un nuevo handler de diagrama DXF.
+func NewDiagramaDxfHandler(
+	exportarDiagramaDxfUC *usecase.ExportarDiagramaDxfUseCase,
+) *DiagramaDxfHandler {
+	return &DiagramaDxfHandler{
+		exportarDiagramaDxfUC: exportarDiagramaDxfUC,
+	}
+}
+
+// ExportarDiagramaDxf POST /api/v1/calculos/diagrama.dxf
+// @Summary Diagrama de canalización en DXF
+// @Description Ejecuta la memoria de cálculo y descarga la sección transversal de la charola o tubería en formato DXF (AutoCAD R12, mm). Capas: CANALIZACION, CONDUCTORES, ETIQUETAS y COTAS.
+// @Tags Calculos
+// @Accept json
+// @Produce application/dxf
+// @Param request body dto.EquipoInput true "Datos del equipo y de la instalación"
+// @Success 200 {file} binary "Dibujo DXF"
+// @Failure 400 {object} CalcularMemoriaResponseError "Error de validación o datos inválidos"
+// @Failure 422 {object} CalcularMemoriaResponseError "La memoria no generó diagrama"
+// @Failure 500 {object} CalcularMemoriaResponseError "Error interno del servidor"
+// @Router /calculos/diagrama.dxf [post]
+func (h *DiagramaDxfHandler) ExportarDiagramaDxf(c *gin.Context) {
+	var req dto.EquipoInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, CalcularMemoriaResponseError{
+			Success: false,
+			Error:   "Error de validación",
+			Code:    "VALIDATION_ERROR",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	result, err := h.exportarDiagramaDxfUC.Execute(c.Request.Context(), req)
+	if err != nil {
+		status, response := h.mapErrorToResponse(err)
+		c.JSON(status, response)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, nombreArchivoDxf(result.Clave)))
+	c.Data(http.StatusOK, contentTypeDxf, []byte(result.Contenido))
+}
+
+// nombreArchivoDxf arma "Diagrama_<clave>.dxf" con solo caracteres seguros para un nombre de archivo.
+func nombreArchivoDxf(clave string) string {
+	limpia := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_':
+			return r
+		case r == ' ' || r == '.' || r == '/':
+			return '_'
+		default:
+			return -1
+		}
+	}, clave)
+	if limpia == "" {
+		return "Diagrama.dxf"
+	}
+	return "Diagrama_" + limpia + ".dxf"
+}
+
+// mapErrorToResponse agrega ErrDiagramaNoDisponible a los errores de la memoria.
+func (h *DiagramaDxfHandler) mapErrorToResponse(err error) (int, CalcularMemoriaResponseError) {
+	if errors.Is(err, dto.ErrDiagramaNoDisponible) {
+		return http.StatusUnprocessableEntity, CalcularMemoriaResponseError{
+			Success: false,
+			Error:   "Diagrama de canalización no disponible",
+			Code:    "DIAGRAMA_NO_DISPONIBLE",
+			Details: err.Error(),
+		}
+	}
+
+	return (&MemoriaHandler{}).mapErrorToResponse(err)
+}
@@ -22,6 +22,10 @@ func NombreTablaAmpacidad(
 		tabla = "NOM-310-15-B-17"
 	case "CHAROLA_CABLE_TRIANGULAR":
 		tabla = "NOM-310-15-B-20"
+	case "DUCTO_SUBTERRANEO":
+		tabla = "NOM-ANEXO-B-310-7"
+	case "DIRECTAMENTE_ENTERRADO":
+		tabla = "NOM-ANEXO-B-310-10"
 	default:
 		tabla = "NOM-310-15-B-16"
 	}
@@ -29,6 +29,13 @@ func TestNombreTablaAmpacidad(t *testing.T) {
 			temperatura:  valueobject.Temp90,
 			expected:     "NOM-310-15-B-20 (Al, 90°C)",
 		},
+		{
+			name:         "Ducto subterráneo con aluminio 75C",
+			canalizacion: "DUCTO_SUBTERRANEO",
+			material:     valueobject.MaterialAluminio,
+			temperatura:  valueobject.Temp75,
+			expected:     "NOM-ANEXO-B-310-7 (Al, 75°C)",
+		},
 		{
 			name:         "Acero PG con cobre 60C",
 			canalizacion: "TUBERIA_ACERO_PG",
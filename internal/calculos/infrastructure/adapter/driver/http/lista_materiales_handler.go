@@ -0,0 +1,67 @@
+// internal/calculos/infrastructure/adapter/driver/http/lista_materiales_handler.go
+package http
+
+import (
+	"net/http"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+// ListaMaterialesHandler maneja el endpoint de lista de materiales del circuito.
+type ListaMaterialesHandler struct {
+	generarListaMaterialesUC *usecase.GenerarListaMaterialesUseCase
+}
+
+// NewListaMaterialesHandler crea un nuevo handler de lista de materiales.
+func NewListaMaterialesHandler(
+	generarListaMaterialesUC *usecase.GenerarListaMaterialesUseCase,
+) *ListaMaterialesHandler {
+	return &ListaMaterialesHandler{
+		generarListaMaterialesUC: generarListaMaterialesUC,
+	}
+}
+
+// ListaMaterialesResponse representa la respuesta exitosa.
+type ListaMaterialesResponse struct {
+	Success bool                         `json:"success"`
+	Data    dto.ResultadoListaMateriales `json:"data"`
+}
+
+// GenerarListaMateriales POST /api/v1/calculos/lista-materiales
+// @Summary Lista de materiales del circuito
+// @Description Ejecuta la memoria de cálculo y deriva la lista de materiales: metros de cada conductor (L × hilos, más desperdicio), tramos de tubería o charola y accesorios. Se valoriza con los precios enviados o, si valorizar=true, con el catálogo vigente.
+// @Tags Calculos
+// @Accept json
+// @Produce json
+// @Param request body dto.ListaMaterialesInput true "Circuito y precios opcionales"
+// @Success 200 {object} ListaMaterialesResponse "Lista de materiales"
+// @Failure 400 {object} CalcularMemoriaResponseError "Error de validación o datos inválidos"
+// @Failure 422 {object} CalcularMemoriaResponseError "Catálogo de precios no disponible"
+// @Failure 500 {object} CalcularMemoriaResponseError "Error interno del servidor"
+// @Router /calculos/lista-materiales [post]
+func (h *ListaMaterialesHandler) GenerarListaMateriales(c *gin.Context) {
+	var req dto.ListaMaterialesInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, CalcularMemoriaResponseError{
+			Success: false,
+			Error:   "Error de validación",
+			Code:    "VALIDATION_ERROR",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	result, err := h.generarListaMaterialesUC.Execute(c.Request.Context(), req)
+	if err != nil {
+		status, response := (&OptimizacionHandler{}).mapErrorToResponse(err)
+		c.JSON(status, response)
+		return
+	}
+
+	c.JSON(http.StatusOK, ListaMaterialesResponse{
+		Success: true,
+		Data:    result,
+	})
+}
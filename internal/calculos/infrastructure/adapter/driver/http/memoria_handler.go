@@ -41,30 +41,64 @@ type CalcularMemoriaRequest struct {
 	Equipo dto.DatosEquipo `json:"equipo"`
 
 	// Datos manuales (modo MANUAL_*)
-	// tipo_equipo: FILTRO_ACTIVO, TRANSFORMADOR, FILTRO_RECHAZO, CARGA
+	// tipo_equipo: FILTRO_ACTIVO, TRANSFORMADOR, FILTRO_RECHAZO, CARGA, MOTOR, BANCO_CAPACITORES
 	TipoEquipo      string  `json:"tipo_equipo"`
 	AmperajeNominal float64 `json:"amperaje_nominal"`
 	PotenciaNominal float64 `json:"potencia_nominal"`
-	// potencia_unidad: W, KW, KVA, KVAR
+	// potencia_unidad: W, KW, KVA, KVAR, HP (MOTOR: la corriente sale de Tabla 430-248/430-250)
 	PotenciaUnidad  string  `json:"potencia_unidad"`
 	FactorPotencia  float64 `json:"factor_potencia"`
+	// pasos_capacitores: pasos del banco de capacitores (default 1 = banco fijo)
+	PasosCapacitores int `json:"pasos_capacitores,omitempty"`
 
 	// ═══════════════════════════════════════════════════════════════════════
 	// DATOS DE INSTALACIÓN (comunes a todos los modos)
 	// ═══════════════════════════════════════════════════════════════════════
 	Tension               float64  `json:"tension" binding:"required,gt=0"`
 	TensionUnidad         string   `json:"tension_unidad"`
-	ITM                   int      `json:"itm"` // Requerido en MANUAL_*, opcional en LISTADO (usa equipo.itm)
-	// tipo_canalizacion: TUBERIA_PVC, TUBERIA_ALUMINIO, TUBERIA_ACERO_PG, TUBERIA_ACERO_PD, CHAROLA_CABLE_ESPACIADO, CHAROLA_CABLE_TRIANGULAR
+	ITM                   int      `json:"itm"` // Opcional: en MANUAL_* se propone automáticamente si se omite; en LISTADO usa equipo.itm
+	// tipo_canalizacion: TUBERIA_PVC, TUBERIA_ALUMINIO, TUBERIA_ACERO_PG, TUBERIA_ACERO_PD, CHAROLA_CABLE_ESPACIADO, CHAROLA_CABLE_TRIANGULAR,
+	// DUCTO_SUBTERRANEO, DIRECTAMENTE_ENTERRADO
 	TipoCanalizacion      string   `json:"tipo_canalizacion" binding:"required"`
 	TemperaturaOverride   *int     `json:"temperatura_override,omitempty"`
 	HilosPorFase          int      `json:"hilos_por_fase"`
 	NumTuberias           int      `json:"num_tuberias"`
 	// material: Cu (cobre), Al (aluminio)
 	Material              string   `json:"material"`
+	// tipo_aislamiento: THW (75°C, default), THHN, XHHW, RHH, USE-2 (90°C)
+	TipoAislamiento       string   `json:"tipo_aislamiento"`
 	LongitudCircuito      float64  `json:"longitud_circuito" binding:"required,gt=0"`
 	PorcentajeCaidaMaximo float64  `json:"porcentaje_caida_maximo"`
 	DiametroControlMM     *float64 `json:"diametro_control_mm,omitempty"`
+	// tipo_charola: ESCALERA (default), FONDO_SOLIDO, CANAL; peralte_charola_mm: 76.2, 101.6 (default) o 152.4
+	TipoCharola      string  `json:"tipo_charola,omitempty"`
+	PeralteCharolaMM float64 `json:"peralte_charola_mm,omitempty"`
+	// tipo_cable: TC (cable para charola) o MC (armado); vacío = monoconductores
+	TipoCable string `json:"tipo_cable,omitempty"`
+
+	// Caída acumulada: % de caída del alimentador aguas arriba y límite combinado (default 5%)
+	CaidaTensionAlimentador    float64 `json:"caida_tension_alimentador,omitempty"`
+	PorcentajeCaidaTotalMaximo float64 `json:"porcentaje_caida_total_maximo,omitempty"`
+
+	// Cortocircuito (opcional): MVAcc de la red y/o transformador kVA + %Z
+	PotenciaCortocircuitoMVA float64 `json:"potencia_cortocircuito_mva,omitempty"`
+	TransformadorKVA         float64 `json:"transformador_kva,omitempty"`
+	TransformadorZPorcentaje float64 `json:"transformador_z_porcentaje,omitempty"`
+	// Tiempo de liberación de la falla en segundos para la verificación I²t (default 0.05 s)
+	TiempoLiberacionFalla float64 `json:"tiempo_liberacion_falla,omitempty"`
+
+	// Armónicos (opcional): THDi total (%) o porcentaje por orden armónico (ej. {"3": 25, "5": 15})
+	THDi             float64         `json:"thd_i,omitempty"`
+	EspectroArmonico map[int]float64 `json:"espectro_armonico,omitempty"`
+
+	// Desbalance de carga entre fases en % (opcional): dimensiona el neutro en ESTRELLA/BIFASICO
+	DesbalanceCarga *float64 `json:"desbalance_carga,omitempty"`
+
+	// Instalación subterránea (opcional): resistividad térmica del suelo (°C·cm/W, default 90),
+	// profundidad de enterramiento (mm, default 750) y temperatura del terreno (°C, default 20)
+	ResistividadTermicaSuelo   float64 `json:"resistividad_termica_suelo,omitempty"`
+	ProfundidadEnterramientoMM float64 `json:"profundidad_enterramiento_mm,omitempty"`
+	TemperaturaTerreno         *int    `json:"temperatura_terreno,omitempty"`
 
 	// Sistema eléctrico
 	// Values: DELTA, ESTRELLA, BIFASICO, MONOFASICO
@@ -127,6 +161,7 @@ func (h *MemoriaHandler) CalcularMemoria(c *gin.Context) {
 		PotenciaNominal:       req.PotenciaNominal,
 		PotenciaUnidad:        req.PotenciaUnidad,
 		FactorPotencia:        req.FactorPotencia,
+		PasosCapacitores:      req.PasosCapacitores,
 		Tension:               req.Tension,
 		TensionUnidad:         req.TensionUnidad,
 		TipoCanalizacion:      req.TipoCanalizacion,
@@ -134,12 +169,29 @@ func (h *MemoriaHandler) CalcularMemoria(c *gin.Context) {
 		HilosPorFase:          req.HilosPorFase,
 		NumTuberias:           req.NumTuberias,
 		Material:              req.Material,
+		TipoAislamiento:       req.TipoAislamiento,
 		LongitudCircuito:      req.LongitudCircuito,
 		PorcentajeCaidaMaximo: req.PorcentajeCaidaMaximo,
 		DiametroControlMM:     req.DiametroControlMM,
+		TipoCharola:           req.TipoCharola,
+		PeralteCharolaMM:      req.PeralteCharolaMM,
+		TipoCable:             req.TipoCable,
 		SistemaElectrico:      req.SistemaElectrico,
 		Estado:                req.Estado,
 		TipoVoltaje:           req.TipoVoltaje,
+
+		CaidaTensionAlimentador:    req.CaidaTensionAlimentador,
+		PorcentajeCaidaTotalMaximo: req.PorcentajeCaidaTotalMaximo,
+		PotenciaCortocircuitoMVA:   req.PotenciaCortocircuitoMVA,
+		TransformadorKVA:           req.TransformadorKVA,
+		TransformadorZPorcentaje:   req.TransformadorZPorcentaje,
+		TiempoLiberacionFalla:      req.TiempoLiberacionFalla,
+		THDi:                       req.THDi,
+		EspectroArmonico:           req.EspectroArmonico,
+		DesbalanceCarga:            req.DesbalanceCarga,
+		ResistividadTermicaSuelo:   req.ResistividadTermicaSuelo,
+		ProfundidadEnterramientoMM: req.ProfundidadEnterramientoMM,
+		TemperaturaTerreno:         req.TemperaturaTerreno,
 	}
 
 	// Set ITM for MANUAL modes
@@ -230,6 +282,15 @@ func (h *MemoriaHandler) mapErrorToResponse(err error) (int, CalcularMemoriaResp
 		}
 	}
 
+	if errors.Is(err, dto.ErrMotorNoEncontrado) {
+		return http.StatusUnprocessableEntity, CalcularMemoriaResponseError{
+			Success: false,
+			Error:   "Motor fuera de la Tabla 430-248/430-250",
+			Code:    "MOTOR_NO_ENCONTRADO",
+			Details: err.Error(),
+		}
+	}
+
 	// Default: internal server error (500)
 	return http.StatusInternalServerError, CalcularMemoriaResponseError{
 		Success: false,
@@ -0,0 +1,101 @@
+// internal/calculos/infrastructure/adapter/driver/http/optimizacion_handler.go
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+// OptimizacionHandler maneja el endpoint de optimización de costo del circuito.
+type OptimizacionHandler struct {
+	optimizarCostoUC *usecase.OptimizarCostoUseCase
+}
+
+// NewOptimizacionHandler crea un nuevo handler de optimización.
+func NewOptimizacionHandler(
+	optimizarCostoUC *usecase.OptimizarCostoUseCase,
+) *OptimizacionHandler {
+	return &OptimizacionHandler{
+		optimizarCostoUC: optimizarCostoUC,
+	}
+}
+
+// OptimizacionCostoResponse representa la respuesta exitosa.
+type OptimizacionCostoResponse struct {
+	Success bool                        `json:"success"`
+	Data    dto.OptimizacionCostoOutput `json:"data"`
+}
+
+// OptimizarCosto POST /api/v1/calculos/optimizar-costo
+// @Summary Optimizar costo del circuito
+// @Description Ejecuta la memoria de cálculo para cada combinación de material (Cu/Al), conductores en paralelo por fase y tipo de canalización, valoriza conductores y canalización con el catálogo de precios y devuelve las alternativas que cumplen la NOM ordenadas por costo total.
+// @Tags Optimizacion
+// @Accept json
+// @Produce json
+// @Param request body dto.OptimizacionCostoInput true "Circuito base, espacio de búsqueda y precios opcionales"
+// @Success 200 {object} OptimizacionCostoResponse "Alternativas ordenadas por costo"
+// @Failure 400 {object} CalcularMemoriaResponseError "Error de validación o datos inválidos"
+// @Failure 422 {object} CalcularMemoriaResponseError "Catálogo de precios no disponible"
+// @Failure 500 {object} CalcularMemoriaResponseError "Error interno del servidor"
+// @Router /calculos/optimizar-costo [post]
+func (h *OptimizacionHandler) OptimizarCosto(c *gin.Context) {
+	var req dto.OptimizacionCostoInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, CalcularMemoriaResponseError{
+			Success: false,
+			Error:   "Error de validación",
+			Code:    "VALIDATION_ERROR",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	result, err := h.optimizarCostoUC.Execute(c.Request.Context(), req)
+	if err != nil {
+		status, response := h.mapErrorToResponse(err)
+		c.JSON(status, response)
+		return
+	}
+
+	c.JSON(http.StatusOK, OptimizacionCostoResponse{
+		Success: true,
+		Data:    result,
+	})
+}
+
+// mapErrorToResponse maps optimizer errors to HTTP responses; equipo input
+// errors share the memoria mapping.
+func (h *OptimizacionHandler) mapErrorToResponse(err error) (int, CalcularMemoriaResponseError) {
+	if errors.Is(err, dto.ErrOptimizacionInvalida) {
+		return http.StatusBadRequest, CalcularMemoriaResponseError{
+			Success: false,
+			Error:   "Parámetros de optimización inválidos",
+			Code:    "OPTIMIZACION_INVALIDA",
+			Details: err.Error(),
+		}
+	}
+
+	if errors.Is(err, dto.ErrCatalogoPreciosInvalido) {
+		return http.StatusBadRequest, CalcularMemoriaResponseError{
+			Success: false,
+			Error:   "Catálogo de precios inválido",
+			Code:    "CATALOGO_PRECIOS_INVALIDO",
+			Details: err.Error(),
+		}
+	}
+
+	if errors.Is(err, dto.ErrCatalogoPreciosNoDisponible) {
+		return http.StatusUnprocessableEntity, CalcularMemoriaResponseError{
+			Success: false,
+			Error:   "Catálogo de precios no disponible",
+			Code:    "CATALOGO_PRECIOS_NO_DISPONIBLE",
+			Details: err.Error(),
+		}
+	}
+
+	return (&MemoriaHandler{}).mapErrorToResponse(err)
+}
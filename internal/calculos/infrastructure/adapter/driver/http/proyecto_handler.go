@@ -0,0 +1,81 @@
+// internal/calculos/infrastructure/adapter/driver/http/proyecto_handler.go
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+// ProyectoHandler handles the project (N circuits) calculation endpoint.
+type ProyectoHandler struct {
+	calcularProyectoUC *usecase.CalcularProyectoUseCase
+}
+
+// NewProyectoHandler creates a new proyecto handler.
+func NewProyectoHandler(calcularProyectoUC *usecase.CalcularProyectoUseCase) *ProyectoHandler {
+	return &ProyectoHandler{
+		calcularProyectoUC: calcularProyectoUC,
+	}
+}
+
+// CalcularProyectoResponse represents the response for the proyecto endpoint.
+type CalcularProyectoResponse struct {
+	Success bool               `json:"success"`
+	Data    dto.ProyectoOutput `json:"data"`
+}
+
+// CalcularProyecto POST /api/v1/calculos/proyecto
+// @Summary Memoria de cálculo de un proyecto (N circuitos)
+// @Description Ejecuta la memoria de cálculo para cada circuito del tablero y consolida el resumen: kVA conectados, conductores y canalización por circuito y circuitos que no cumplen.
+// @Tags Memoria
+// @Accept json
+// @Produce json
+// @Param request body dto.ProyectoInput true "Circuitos del proyecto (input con el formato de /calculos/memoria)"
+// @Success 200 {object} CalcularProyectoResponse "Proyecto calculado"
+// @Failure 400 {object} CalcularMemoriaResponseError "Error de validación o datos inválidos"
+// @Failure 422 {object} CalcularMemoriaResponseError "No se encontró conductor o canalización adecuada en algún circuito"
+// @Failure 500 {object} CalcularMemoriaResponseError "Error interno del servidor"
+// @Router /calculos/proyecto [post]
+func (h *ProyectoHandler) CalcularProyecto(c *gin.Context) {
+	var req dto.ProyectoInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, CalcularMemoriaResponseError{
+			Success: false,
+			Error:   "Error de validación",
+			Code:    "VALIDATION_ERROR",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	result, err := h.calcularProyectoUC.Execute(c.Request.Context(), req)
+	if err != nil {
+		status, response := h.mapErrorToResponse(err)
+		c.JSON(status, response)
+		return
+	}
+
+	c.JSON(http.StatusOK, CalcularProyectoResponse{
+		Success: true,
+		Data:    result,
+	})
+}
+
+// mapErrorToResponse maps project errors to HTTP responses. Per-circuit errors
+// are wrapped by the use case, so the memoria mapping applies unchanged.
+func (h *ProyectoHandler) mapErrorToResponse(err error) (int, CalcularMemoriaResponseError) {
+	if errors.Is(err, dto.ErrProyectoInvalido) {
+		return http.StatusBadRequest, CalcularMemoriaResponseError{
+			Success: false,
+			Error:   "Proyecto inválido",
+			Code:    "PROYECTO_INVALIDO",
+			Details: err.Error(),
+		}
+	}
+
+	return (&MemoriaHandler{}).mapErrorToResponse(err)
+}
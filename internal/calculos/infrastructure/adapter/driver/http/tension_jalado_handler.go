@@ -0,0 +1,90 @@
+// internal/calculos/infrastructure/adapter/driver/http/tension_jalado_handler.go
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+// TensionJaladoHandler maneja el endpoint de tensión de jalado de cables en tubería.
+type TensionJaladoHandler struct {
+	calcularTensionJaladoUC *usecase.CalcularTensionJaladoUseCase
+}
+
+// NewTensionJaladoHandler crea un nuevo handler de tensión de jalado.
+func NewTensionJaladoHandler(
+	calcularTensionJaladoUC *usecase.CalcularTensionJaladoUseCase,
+) *TensionJaladoHandler {
+	return &TensionJaladoHandler{
+		calcularTensionJaladoUC: calcularTensionJaladoUC,
+	}
+}
+
+// TensionJaladoResponse representa la respuesta exitosa.
+type TensionJaladoResponse struct {
+	Success bool                       `json:"success"`
+	Data    dto.ResultadoTensionJalado `json:"data"`
+}
+
+// CalcularTensionJalado POST /api/v1/calculos/tuberia/tension-jalado
+// @Summary Tensión de jalado de cables en tubería
+// @Description Calcula la tensión de jalado por tramo (rectos y curvas), la presión lateral en cada curva y la relación de atascamiento para 3 conductores, y señala si la ruta excede la tensión máxima del conductor, la presión lateral permitida o los 360° de curvas entre registros.
+// @Tags Canalización
+// @Accept json
+// @Produce json
+// @Param request body dto.TensionJaladoInput true "Conductores, tubería y ruta en el sentido del jalado"
+// @Success 200 {object} TensionJaladoResponse "Análisis de jalado"
+// @Failure 400 {object} CalcularMemoriaResponseError "Error de validación o ruta inválida"
+// @Failure 500 {object} CalcularMemoriaResponseError "Error interno del servidor"
+// @Router /calculos/tuberia/tension-jalado [post]
+func (h *TensionJaladoHandler) CalcularTensionJalado(c *gin.Context) {
+	var req dto.TensionJaladoInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, CalcularMemoriaResponseError{
+			Success: false,
+			Error:   "Error de validación",
+			Code:    "VALIDATION_ERROR",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	result, err := h.calcularTensionJaladoUC.Execute(c.Request.Context(), req)
+	if err != nil {
+		status, response := h.mapErrorToResponse(err)
+		c.JSON(status, response)
+		return
+	}
+
+	c.JSON(http.StatusOK, TensionJaladoResponse{
+		Success: true,
+		Data:    result,
+	})
+}
+
+// mapErrorToResponse maps pulling-tension errors to HTTP responses; the rest share the memoria mapping.
+func (h *TensionJaladoHandler) mapErrorToResponse(err error) (int, CalcularMemoriaResponseError) {
+	if errors.Is(err, dto.ErrRutaTuberiaInvalida) {
+		return http.StatusBadRequest, CalcularMemoriaResponseError{
+			Success: false,
+			Error:   "Ruta de tubería inválida",
+			Code:    "RUTA_TUBERIA_INVALIDA",
+			Details: err.Error(),
+		}
+	}
+
+	if errors.Is(err, dto.ErrTensionJaladoInvalida) {
+		return http.StatusBadRequest, CalcularMemoriaResponseError{
+			Success: false,
+			Error:   "Datos de jalado inválidos",
+			Code:    "TENSION_JALADO_INVALIDA",
+			Details: err.Error(),
+		}
+	}
+
+	return (&MemoriaHandler{}).mapErrorToResponse(err)
+}
@@ -0,0 +1,82 @@
+// internal/calculos/infrastructure/adapter/driver/http/transformador_handler.go
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/calculos/application/usecase"
+	"github.com/garfex/calculadora-filtros/internal/calculos/domain/entity"
+	"github.com/gin-gonic/gin"
+)
+
+// TransformadorHandler maneja los endpoints de cálculo de transformadores.
+type TransformadorHandler struct {
+	calcularFactorKUC *usecase.CalcularFactorKUseCase
+}
+
+// NewTransformadorHandler crea un nuevo handler de transformador.
+func NewTransformadorHandler(
+	calcularFactorKUC *usecase.CalcularFactorKUseCase,
+) *TransformadorHandler {
+	return &TransformadorHandler{
+		calcularFactorKUC: calcularFactorKUC,
+	}
+}
+
+// FactorKResponse representa la respuesta exitosa.
+type FactorKResponse struct {
+	Success bool                 `json:"success"`
+	Data    dto.ResultadoFactorK `json:"data"`
+}
+
+// CalcularFactorK POST /api/v1/calculos/transformador/k-factor
+// @Summary Calcular factor K de transformador
+// @Description Calcula el factor K (UL 1561 / IEEE C57.110) de una carga no lineal a partir de su espectro armónico, el K-rating estándar requerido y la capacidad derrateada del transformador.
+// @Tags Transformador
+// @Accept json
+// @Produce json
+// @Param request body dto.FactorKInput true "Capacidad del transformador y espectro armónico de la carga"
+// @Success 200 {object} FactorKResponse "Factor K y capacidad derrateada"
+// @Failure 400 {object} CalcularMemoriaResponseError "Error de validación o espectro inválido"
+// @Failure 500 {object} CalcularMemoriaResponseError "Error interno del servidor"
+// @Router /calculos/transformador/k-factor [post]
+func (h *TransformadorHandler) CalcularFactorK(c *gin.Context) {
+	var req dto.FactorKInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, CalcularMemoriaResponseError{
+			Success: false,
+			Error:   "Error de validación",
+			Code:    "VALIDATION_ERROR",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	result, err := h.calcularFactorKUC.Execute(c.Request.Context(), req)
+	if err != nil {
+		status, response := h.mapErrorToResponse(err)
+		c.JSON(status, response)
+		return
+	}
+
+	c.JSON(http.StatusOK, FactorKResponse{
+		Success: true,
+		Data:    result,
+	})
+}
+
+// mapErrorToResponse maps K-factor errors to HTTP responses; the rest share the memoria mapping.
+func (h *TransformadorHandler) mapErrorToResponse(err error) (int, CalcularMemoriaResponseError) {
+	if errors.Is(err, dto.ErrFactorKInvalido) || errors.Is(err, entity.ErrEspectroArmonicoInvalido) {
+		return http.StatusBadRequest, CalcularMemoriaResponseError{
+			Success: false,
+			Error:   "Espectro armónico inválido",
+			Code:    "ESPECTRO_ARMONICO_INVALIDO",
+			Details: err.Error(),
+		}
+	}
+
+	return (&MemoriaHandler{}).mapErrorToResponse(err)
+}
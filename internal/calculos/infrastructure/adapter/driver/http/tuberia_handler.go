@@ -7,6 +7,7 @@ import (
 
 	"github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
 	"github.com/garfex/calculadora-filtros/internal/calculos/application/usecase"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
 	"github.com/gin-gonic/gin"
 )
 
@@ -31,6 +32,9 @@ type CalcularTuberiaRequest struct {
 	CalibreTierra    string `json:"calibre_tierra" binding:"required"`
 	TipoCanalizacion string `json:"tipo_canalizacion" binding:"required"`
 	NumTuberias      int    `json:"num_tuberias" binding:"required,gt=0"`
+	// tipo_cable: TC o MC para cables multiconductores; num_cables: cables en paralelo (default 1)
+	TipoCable string `json:"tipo_cable,omitempty"`
+	NumCables int    `json:"num_cables,omitempty"`
 }
 
 // CalcularTuberiaResponse representa la respuesta exitosa.
@@ -80,6 +84,8 @@ func (h *TuberiaHandler) CalcularTuberia(c *gin.Context) {
 		CalibreTierra:    req.CalibreTierra,
 		TipoCanalizacion: req.TipoCanalizacion,
 		NumTuberias:      req.NumTuberias,
+		TipoCable:        req.TipoCable,
+		NumCables:        req.NumCables,
 	}
 
 	// Execute use case
@@ -108,6 +114,15 @@ func (h *TuberiaHandler) mapTuberiaErrorToResponse(err error) (int, CalcularTube
 		}
 	}
 
+	if errors.Is(err, valueobject.ErrTipoCableMulticonductorInvalido) {
+		return http.StatusBadRequest, CalcularTuberiaResponseError{
+			Success: false,
+			Error:   "Tipo de cable inválido",
+			Code:    "TIPO_CABLE_INVALIDO",
+			Details: err.Error(),
+		}
+	}
+
 	// Errores 422 - Unprocessable Entity
 	if errors.Is(err, dto.ErrConductorNoEncontrado) {
 		return http.StatusUnprocessableEntity, CalcularTuberiaResponseError{
@@ -23,7 +23,14 @@ func NewRouter(
 	calcularCharolaEspaciadoUC *usecase.CalcularCharolaEspaciadoUseCase,
 	calcularCharolaTriangularUC *usecase.CalcularCharolaTriangularUseCase,
 	calcularCaidaTensionUC *usecase.CalcularCaidaTensionUseCase,
+	calcularCortocircuitoUC *usecase.CalcularCortocircuitoUseCase,
+	calcularFactorKUC *usecase.CalcularFactorKUseCase,
 	orquestadorMemoriaUC *usecase.OrquestadorMemoriaCalculoUseCase,
+	calcularProyectoUC *usecase.CalcularProyectoUseCase,
+	optimizarCostoUC *usecase.OptimizarCostoUseCase,
+	generarListaMaterialesUC *usecase.GenerarListaMaterialesUseCase,
+	exportarDiagramaDxfUC *usecase.ExportarDiagramaDxfUseCase,
+	calcularTensionJaladoUC *usecase.CalcularTensionJaladoUseCase,
 ) *gin.Engine {
 	router := gin.New()
 
@@ -62,6 +69,10 @@ func NewRouter(
 			tuberiaHandler := http.NewTuberiaHandler(calcularTamanioTuberiaUC)
 			calculos.POST("/tuberia", tuberiaHandler.CalcularTuberia)
 
+			// Tensión de jalado y presión lateral en la ruta de tubería
+			tensionJaladoHandler := http.NewTensionJaladoHandler(calcularTensionJaladoUC)
+			calculos.POST("/tuberia/tension-jalado", tensionJaladoHandler.CalcularTensionJalado)
+
 			// Charolas
 			charolaHandler := http.NewCharolaHandler(calcularCharolaEspaciadoUC, calcularCharolaTriangularUC)
 			calculos.POST("/charola/espaciado", charolaHandler.PostCharolaEspaciado)
@@ -71,9 +82,33 @@ func NewRouter(
 			caidaTensionHandler := http.NewCaidaTensionHandler(calcularCaidaTensionUC)
 			calculos.POST("/caida-tension", caidaTensionHandler.CalcularCaidaTension)
 
+			// Cortocircuito
+			cortocircuitoHandler := http.NewCortocircuitoHandler(calcularCortocircuitoUC)
+			calculos.POST("/cortocircuito", cortocircuitoHandler.CalcularCortocircuito)
+
+			// Transformador: factor K por cargas no lineales
+			transformadorHandler := http.NewTransformadorHandler(calcularFactorKUC)
+			calculos.POST("/transformador/k-factor", transformadorHandler.CalcularFactorK)
+
 			// Memoria de cálculo completa (orquestador)
 			memoriaHandler := http.NewMemoriaHandler(orquestadorMemoriaUC)
 			calculos.POST("/memoria", memoriaHandler.CalcularMemoria)
+
+			// Proyecto: memoria de N circuitos de un mismo tablero
+			proyectoHandler := http.NewProyectoHandler(calcularProyectoUC)
+			calculos.POST("/proyecto", proyectoHandler.CalcularProyecto)
+
+			// Optimización de costo: Cu/Al × hilos por fase × canalización
+			optimizacionHandler := http.NewOptimizacionHandler(optimizarCostoUC)
+			calculos.POST("/optimizar-costo", optimizacionHandler.OptimizarCosto)
+
+			// Lista de materiales: conductores, canalización y accesorios
+			listaMaterialesHandler := http.NewListaMaterialesHandler(generarListaMaterialesUC)
+			calculos.POST("/lista-materiales", listaMaterialesHandler.GenerarListaMateriales)
+
+			// Diagrama de canalización en DXF para AutoCAD
+			diagramaDxfHandler := http.NewDiagramaDxfHandler(exportarDiagramaDxfUC)
+			calculos.POST("/diagrama.dxf", diagramaDxfHandler.ExportarDiagramaDxf)
 		}
 	}
 
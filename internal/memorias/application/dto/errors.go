@@ -0,0 +1,22 @@
+// internal/memorias/application/dto/errors.go
+package dto
+
+import "errors"
+
+// Application-level errors for the memorias feature.
+var (
+	// ErrMemoriaNoEncontrada is returned when a memoria with the given ID does not exist.
+	ErrMemoriaNoEncontrada = errors.New("memoria no encontrada")
+
+	// ErrInputInvalido is returned when the input DTO fails validation.
+	ErrInputInvalido = errors.New("datos de entrada inválidos")
+
+	// ErrIDInvalido is returned when the provided string is not a valid UUID.
+	ErrIDInvalido = errors.New("el ID proporcionado no es un UUID válido")
+
+	// ErrCalculoFallido is returned when the calculation of the memoria fails.
+	ErrCalculoFallido = errors.New("no se pudo calcular la memoria")
+
+	// ErrDocumentoCorrupto is returned when a stored document cannot be decoded.
+	ErrDocumentoCorrupto = errors.New("el documento almacenado no es válido")
+)
@@ -0,0 +1,109 @@
+// internal/memorias/application/dto/memoria_input.go
+package dto
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// CreateMemoriaInput is the inbound DTO for calculating and storing a new memoria.
+// Input is the calculation request as an opaque JSON document; it is handed to the
+// CalculadorMemoria port unchanged and stored as sent.
+type CreateMemoriaInput struct {
+	Nombre      string          `json:"nombre"`
+	Descripcion *string         `json:"descripcion"`
+	Input       json.RawMessage `json:"input" swaggertype:"object"`
+}
+
+// Validate checks that the document metadata is present.
+// The calculation input itself is validated by the calculation.
+func (i CreateMemoriaInput) Validate() error {
+	return validarDocumento(i.Nombre, i.Input)
+}
+
+// UpdateMemoriaInput is the inbound DTO for recalculating an existing memoria.
+// The ID comes from the URL path, not the body. Every update creates a new revision.
+type UpdateMemoriaInput struct {
+	Nombre      string          `json:"nombre"`
+	Descripcion *string         `json:"descripcion"`
+	Input       json.RawMessage `json:"input" swaggertype:"object"`
+}
+
+// Validate checks that the document metadata is present.
+func (i UpdateMemoriaInput) Validate() error {
+	return validarDocumento(i.Nombre, i.Input)
+}
+
+// validarDocumento checks the name and that input is a JSON object with a modo.
+// Only modo is read here; the remaining fields belong to the calculation.
+func validarDocumento(nombre string, input json.RawMessage) error {
+	if strings.TrimSpace(nombre) == "" {
+		return fmt.Errorf("%w: nombre es requerido", ErrInputInvalido)
+	}
+	var cabecera struct {
+		Modo string `json:"modo"`
+	}
+	if len(input) == 0 || json.Unmarshal(input, &cabecera) != nil {
+		return fmt.Errorf("%w: input debe ser un objeto JSON", ErrInputInvalido)
+	}
+	if cabecera.Modo == "" {
+		return fmt.Errorf("%w: input.modo es requerido", ErrInputInvalido)
+	}
+	return nil
+}
+
+// PresentacionPdf contains the cover data used to render the PDF of a memoria.
+// Same JSON shape as the presentacion object of POST /api/v1/pdf/memoria.
+type PresentacionPdf struct {
+	EmpresaID            string `json:"empresa_id"`
+	NombreProyecto       string `json:"nombre_proyecto"`
+	DireccionProyecto    string `json:"direccion_proyecto"`
+	Responsable          string `json:"responsable"`
+	NombreEquipoOverride string `json:"nombre_equipo_override,omitempty"`
+}
+
+// GenerarPdfMemoriaInput is the inbound DTO for regenerating the PDF of a stored memoria.
+type GenerarPdfMemoriaInput struct {
+	Presentacion PresentacionPdf `json:"presentacion"`
+}
+
+// Validate checks the required presentation fields. Whether empresa_id exists in the
+// catalog is checked by the use case through the GeneradorPdf port.
+func (i GenerarPdfMemoriaInput) Validate() error {
+	if i.Presentacion.EmpresaID == "" {
+		return fmt.Errorf("%w: presentacion.empresa_id es requerido", ErrInputInvalido)
+	}
+	if i.Presentacion.NombreProyecto == "" {
+		return fmt.Errorf("%w: presentacion.nombre_proyecto es requerido", ErrInputInvalido)
+	}
+	if i.Presentacion.Responsable == "" {
+		return fmt.Errorf("%w: presentacion.responsable es requerido", ErrInputInvalido)
+	}
+	return nil
+}
+
+// ListMemoriasQuery contains optional query filters and pagination for listing memorias.
+type ListMemoriasQuery struct {
+	Buscar   string // optional: flexible search by nombre (ILIKE)
+	Page     int    // 1-indexed, default 1
+	PageSize int    // default 20, max 100
+}
+
+// ApplyDefaults sets sensible defaults for pagination fields.
+func (q *ListMemoriasQuery) ApplyDefaults() {
+	if q.Page <= 0 {
+		q.Page = 1
+	}
+	if q.PageSize <= 0 {
+		q.PageSize = 20
+	}
+	if q.PageSize > 100 {
+		q.PageSize = 100
+	}
+}
+
+// Offset returns the SQL OFFSET value for this page.
+func (q ListMemoriasQuery) Offset() int {
+	return (q.Page - 1) * q.PageSize
+}
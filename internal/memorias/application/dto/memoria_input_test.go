@@ -0,0 +1,109 @@
+// internal/memorias/application/dto/memoria_input_test.go
+package dto_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/memorias/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/memorias/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateMemoriaInput_Validate(t *testing.T) {
+	validInput := dto.CreateMemoriaInput{
+		Nombre: "CCM-1",
+		Input:  json.RawMessage(`{"modo":"MANUAL_AMPERAJE"}`),
+	}
+
+	t.Run("input válido pasa validación", func(t *testing.T) {
+		assert.NoError(t, validInput.Validate())
+	})
+
+	t.Run("nombre vacío falla", func(t *testing.T) {
+		input := validInput
+		input.Nombre = "  "
+		assert.ErrorIs(t, input.Validate(), dto.ErrInputInvalido)
+	})
+
+	t.Run("modo vacío falla", func(t *testing.T) {
+		input := validInput
+		input.Input = json.RawMessage(`{"modo":""}`)
+		assert.ErrorIs(t, input.Validate(), dto.ErrInputInvalido)
+	})
+
+	t.Run("input que no es objeto falla", func(t *testing.T) {
+		input := validInput
+		input.Input = json.RawMessage(`[1,2]`)
+		assert.ErrorIs(t, input.Validate(), dto.ErrInputInvalido)
+
+		input.Input = nil
+		assert.ErrorIs(t, input.Validate(), dto.ErrInputInvalido)
+	})
+
+	t.Run("bind desde JSON conserva el input tal cual", func(t *testing.T) {
+		body := `{"nombre":"CCM-1","input":{"modo":"MANUAL_AMPERAJE","tipo_equipo":"CARGA","amperaje_nominal":50,"tension":220,"tipo_canalizacion":"TUBERIA_PVC","longitud_circuito":30,"sistema_electrico":"DELTA","estado":"Jalisco","tipo_voltaje":"FASE_FASE","equipo":{"itm":70}}}`
+		var input dto.CreateMemoriaInput
+		require.NoError(t, json.Unmarshal([]byte(body), &input))
+		require.NoError(t, input.Validate())
+		assert.JSONEq(t, `{"modo":"MANUAL_AMPERAJE","tipo_equipo":"CARGA","amperaje_nominal":50,"tension":220,"tipo_canalizacion":"TUBERIA_PVC","longitud_circuito":30,"sistema_electrico":"DELTA","estado":"Jalisco","tipo_voltaje":"FASE_FASE","equipo":{"itm":70}}`, string(input.Input))
+	})
+}
+
+func TestGenerarPdfMemoriaInput_Validate(t *testing.T) {
+	validInput := dto.GenerarPdfMemoriaInput{
+		Presentacion: dto.PresentacionPdf{
+			EmpresaID:      "garfex",
+			NombreProyecto: "Planta Norte",
+			Responsable:    "Ing. Pérez",
+		},
+	}
+
+	t.Run("input válido pasa validación", func(t *testing.T) {
+		assert.NoError(t, validInput.Validate())
+	})
+
+	t.Run("empresa vacía falla", func(t *testing.T) {
+		input := validInput
+		input.Presentacion.EmpresaID = ""
+		assert.ErrorIs(t, input.Validate(), dto.ErrInputInvalido)
+	})
+
+	t.Run("responsable vacío falla", func(t *testing.T) {
+		input := validInput
+		input.Presentacion.Responsable = ""
+		assert.ErrorIs(t, input.Validate(), dto.ErrInputInvalido)
+	})
+}
+
+func TestFromDomain_RoundTrip(t *testing.T) {
+	rawInput := json.RawMessage(`{"modo":"MANUAL_AMPERAJE","tipo_canalizacion":"TUBERIA_PVC"}`)
+	rawOutput := json.RawMessage(`{"equipo":{"clave":"CCM-1"},"tipo_equipo":"CARGA","cumple_normativa":true}`)
+
+	m, err := entity.NewMemoria("CCM-1", nil, rawInput, rawOutput)
+	require.NoError(t, err)
+
+	t.Run("retorna input y output almacenados", func(t *testing.T) {
+		out, err := dto.FromDomain(m)
+		require.NoError(t, err)
+		assert.Equal(t, 1, out.Revision)
+		assert.JSONEq(t, string(rawInput), string(out.Input))
+		assert.JSONEq(t, string(rawOutput), string(out.Output))
+	})
+
+	t.Run("resumen lee equipo, tipo y cumplimiento del output", func(t *testing.T) {
+		resumen, err := dto.ResumenFromDomain(m)
+		require.NoError(t, err)
+		assert.Equal(t, "CCM-1", resumen.ClaveEquipo)
+		assert.Equal(t, "CARGA", resumen.TipoEquipo)
+		assert.True(t, resumen.CumpleNormativa)
+	})
+
+	t.Run("documento corrupto retorna ErrDocumentoCorrupto", func(t *testing.T) {
+		corrupto := *m
+		corrupto.Output = []byte(`{no-json`)
+		_, err := dto.FromDomain(&corrupto)
+		assert.ErrorIs(t, err, dto.ErrDocumentoCorrupto)
+	})
+}
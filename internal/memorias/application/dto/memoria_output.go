@@ -0,0 +1,144 @@
+// internal/memorias/application/dto/memoria_output.go
+package dto
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/garfex/calculadora-filtros/internal/memorias/domain/entity"
+)
+
+// MemoriaOutput is the outbound DTO for a single stored memoria, with both documents
+// returned as stored: Input is the calculation request and Output the memoria de cálculo.
+type MemoriaOutput struct {
+	ID          string          `json:"id"`
+	Nombre      string          `json:"nombre"`
+	Descripcion *string         `json:"descripcion"`
+	Revision    int             `json:"revision"`
+	Input       json.RawMessage `json:"input" swaggertype:"object"`
+	Output      json.RawMessage `json:"output" swaggertype:"object"`
+	CreatedAt   string          `json:"created_at"` // ISO 8601
+	UpdatedAt   string          `json:"updated_at"` // ISO 8601
+}
+
+// MemoriaResumenOutput is the lightweight DTO used in listings (documents not included).
+type MemoriaResumenOutput struct {
+	ID              string  `json:"id"`
+	Nombre          string  `json:"nombre"`
+	Descripcion     *string `json:"descripcion"`
+	Revision        int     `json:"revision"`
+	ClaveEquipo     string  `json:"clave_equipo"`
+	TipoEquipo      string  `json:"tipo_equipo"`
+	CumpleNormativa bool    `json:"cumple_normativa"`
+	CreatedAt       string  `json:"created_at"` // ISO 8601
+	UpdatedAt       string  `json:"updated_at"` // ISO 8601
+}
+
+// resumenDocumento is the minimal view of the stored output read for listings.
+type resumenDocumento struct {
+	Equipo struct {
+		Clave string `json:"clave"`
+	} `json:"equipo"`
+	TipoEquipo      string `json:"tipo_equipo"`
+	CumpleNormativa bool   `json:"cumple_normativa"`
+}
+
+// FromDomain converts a domain entity to an output DTO, checking the stored documents.
+func FromDomain(e *entity.Memoria) (MemoriaOutput, error) {
+	if err := ValidarDocumentos(e); err != nil {
+		return MemoriaOutput{}, err
+	}
+
+	return MemoriaOutput{
+		ID:          e.ID.String(),
+		Nombre:      e.Nombre,
+		Descripcion: e.Descripcion,
+		Revision:    e.Revision,
+		Input:       e.Input,
+		Output:      e.Output,
+		CreatedAt:   e.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:   e.UpdatedAt.UTC().Format(time.RFC3339),
+	}, nil
+}
+
+// ResumenFromDomain converts a domain entity to a listing DTO.
+func ResumenFromDomain(e *entity.Memoria) (MemoriaResumenOutput, error) {
+	if err := ValidarDocumentos(e); err != nil {
+		return MemoriaResumenOutput{}, err
+	}
+
+	var resumen resumenDocumento
+	if err := json.Unmarshal(e.Output, &resumen); err != nil {
+		return MemoriaResumenOutput{}, fmt.Errorf("%w: output de memoria %s: %v", ErrDocumentoCorrupto, e.ID, err)
+	}
+
+	return MemoriaResumenOutput{
+		ID:              e.ID.String(),
+		Nombre:          e.Nombre,
+		Descripcion:     e.Descripcion,
+		Revision:        e.Revision,
+		ClaveEquipo:     resumen.Equipo.Clave,
+		TipoEquipo:      resumen.TipoEquipo,
+		CumpleNormativa: resumen.CumpleNormativa,
+		CreatedAt:       e.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:       e.UpdatedAt.UTC().Format(time.RFC3339),
+	}, nil
+}
+
+// ValidarDocumentos checks that both stored documents of a memoria are valid JSON.
+func ValidarDocumentos(e *entity.Memoria) error {
+	if !json.Valid(e.Input) {
+		return fmt.Errorf("%w: input de memoria %s", ErrDocumentoCorrupto, e.ID)
+	}
+	if !json.Valid(e.Output) {
+		return fmt.Errorf("%w: output de memoria %s", ErrDocumentoCorrupto, e.ID)
+	}
+	return nil
+}
+
+// PaginationMeta contains pagination metadata for collection responses.
+type PaginationMeta struct {
+	Page       int  `json:"page"`
+	PageSize   int  `json:"page_size"`
+	Total      int  `json:"total"`
+	TotalPages int  `json:"total_pages"`
+	HasNext    bool `json:"has_next"`
+	HasPrev    bool `json:"has_prev"`
+}
+
+// ListMemoriasOutput is the outbound DTO for a paginated list of memorias.
+type ListMemoriasOutput struct {
+	Memorias   []MemoriaResumenOutput `json:"memorias"`
+	Pagination PaginationMeta         `json:"pagination"`
+}
+
+// FromDomainList converts a slice of domain entities to a paginated list output DTO.
+// total is the FULL count (all matching rows, not just this page).
+func FromDomainList(entities []*entity.Memoria, page, pageSize, total int) (ListMemoriasOutput, error) {
+	out := make([]MemoriaResumenOutput, len(entities))
+	for i, e := range entities {
+		resumen, err := ResumenFromDomain(e)
+		if err != nil {
+			return ListMemoriasOutput{}, err
+		}
+		out[i] = resumen
+	}
+
+	totalPages := (total + pageSize - 1) / pageSize
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	return ListMemoriasOutput{
+		Memorias: out,
+		Pagination: PaginationMeta{
+			Page:       page,
+			PageSize:   pageSize,
+			Total:      total,
+			TotalPages: totalPages,
+			HasNext:    page < totalPages,
+			HasPrev:    page > 1,
+		},
+	}, nil
+}
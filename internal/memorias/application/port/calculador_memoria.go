@@ -0,0 +1,15 @@
+// internal/memorias/application/port/calculador_memoria.go
+package port
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// CalculadorMemoria ejecuta el cálculo completo de una memoria.
+// input es el cuerpo JSON de POST /api/v1/calculos/memoria y el resultado es la memoria
+// de cálculo serializada. Lo implementa un adaptador sobre el orquestador de calculos
+// definido en cmd/api/main.go; esta feature no interpreta ninguno de los dos documentos.
+type CalculadorMemoria interface {
+	Calcular(ctx context.Context, input json.RawMessage) (json.RawMessage, error)
+}
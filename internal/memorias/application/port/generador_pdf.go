@@ -0,0 +1,19 @@
+// internal/memorias/application/port/generador_pdf.go
+package port
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/garfex/calculadora-filtros/internal/memorias/application/dto"
+)
+
+// GeneradorPdf genera el PDF de una memoria de cálculo ya calculada.
+// Lo implementa un adaptador sobre el use case de la feature pdf definido en cmd/api/main.go.
+type GeneradorPdf interface {
+	// ExisteEmpresa indica si empresaID está en el catálogo de presentación.
+	ExisteEmpresa(empresaID string) bool
+
+	// Generar renderiza la memoria (documento Output almacenado) con los datos de presentación.
+	Generar(ctx context.Context, memoria json.RawMessage, presentacion dto.PresentacionPdf) ([]byte, error)
+}
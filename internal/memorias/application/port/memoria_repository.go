@@ -0,0 +1,39 @@
+// internal/memorias/application/port/memoria_repository.go
+package port
+
+import (
+	"context"
+
+	"github.com/garfex/calculadora-filtros/internal/memorias/domain/entity"
+	"github.com/google/uuid"
+)
+
+// FiltrosListado contains optional filters and pagination for listing memorias.
+type FiltrosListado struct {
+	Buscar *string // nil = no search, otherwise ILIKE on nombre
+	Limit  int     // page size (> 0)
+	Offset int     // number of rows to skip
+}
+
+// MemoriaRepository defines the persistence contract for stored memorias de cálculo.
+// Infrastructure must implement this interface.
+type MemoriaRepository interface {
+	// Crear persists a new memoria and returns it with the DB-generated ID and timestamps.
+	Crear(ctx context.Context, memoria *entity.Memoria) (*entity.Memoria, error)
+
+	// ObtenerPorID finds a memoria by its UUID. Returns ErrMemoriaNoEncontrada if missing.
+	ObtenerPorID(ctx context.Context, id uuid.UUID) (*entity.Memoria, error)
+
+	// Listar returns a paginated page of memorias matching the optional filters.
+	Listar(ctx context.Context, filtros FiltrosListado) ([]*entity.Memoria, error)
+
+	// Contar returns the total count of memorias matching the filters (ignoring pagination).
+	Contar(ctx context.Context, filtros FiltrosListado) (int, error)
+
+	// Actualizar replaces the documents of an existing memoria, increments its revision
+	// and refreshes UpdatedAt. Returns ErrMemoriaNoEncontrada if the ID does not exist.
+	Actualizar(ctx context.Context, memoria *entity.Memoria) (*entity.Memoria, error)
+
+	// Eliminar deletes a memoria by UUID. Idempotent — no error if not found.
+	Eliminar(ctx context.Context, id uuid.UUID) error
+}
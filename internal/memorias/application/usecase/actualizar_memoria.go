@@ -0,0 +1,53 @@
+// internal/memorias/application/usecase/actualizar_memoria.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/garfex/calculadora-filtros/internal/memorias/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/memorias/application/port"
+	"github.com/garfex/calculadora-filtros/internal/memorias/domain/entity"
+	"github.com/google/uuid"
+)
+
+// ActualizarMemoriaUseCase recalculates an existing memoria and stores it as a new revision.
+type ActualizarMemoriaUseCase struct {
+	repo        port.MemoriaRepository
+	calculadora port.CalculadorMemoria
+}
+
+// NewActualizarMemoriaUseCase creates a new instance with the required dependencies.
+func NewActualizarMemoriaUseCase(repo port.MemoriaRepository, calculadora port.CalculadorMemoria) *ActualizarMemoriaUseCase {
+	return &ActualizarMemoriaUseCase{repo: repo, calculadora: calculadora}
+}
+
+// Execute validates input, recalculates the memoria and persists it (revision + 1).
+func (uc *ActualizarMemoriaUseCase) Execute(ctx context.Context, id string, input dto.UpdateMemoriaInput) (dto.MemoriaOutput, error) {
+	parsedID, err := uuid.Parse(id)
+	if err != nil {
+		return dto.MemoriaOutput{}, fmt.Errorf("%w: %s", dto.ErrIDInvalido, id)
+	}
+
+	if err := input.Validate(); err != nil {
+		return dto.MemoriaOutput{}, err
+	}
+
+	resultado, err := uc.calculadora.Calcular(ctx, input.Input)
+	if err != nil {
+		return dto.MemoriaOutput{}, fmt.Errorf("%w: %w", dto.ErrCalculoFallido, err)
+	}
+
+	memoria, err := entity.NewMemoria(input.Nombre, input.Descripcion, input.Input, resultado)
+	if err != nil {
+		return dto.MemoriaOutput{}, fmt.Errorf("%w: %s", dto.ErrInputInvalido, err.Error())
+	}
+	memoria.ID = parsedID
+
+	updated, err := uc.repo.Actualizar(ctx, memoria)
+	if err != nil {
+		return dto.MemoriaOutput{}, fmt.Errorf("actualizar memoria: %w", err)
+	}
+
+	return dto.FromDomain(updated)
+}
@@ -0,0 +1,46 @@
+// internal/memorias/application/usecase/crear_memoria.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/garfex/calculadora-filtros/internal/memorias/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/memorias/application/port"
+	"github.com/garfex/calculadora-filtros/internal/memorias/domain/entity"
+)
+
+// CrearMemoriaUseCase calculates a memoria and stores it as revision 1.
+type CrearMemoriaUseCase struct {
+	repo        port.MemoriaRepository
+	calculadora port.CalculadorMemoria
+}
+
+// NewCrearMemoriaUseCase creates a new instance with the required dependencies.
+func NewCrearMemoriaUseCase(repo port.MemoriaRepository, calculadora port.CalculadorMemoria) *CrearMemoriaUseCase {
+	return &CrearMemoriaUseCase{repo: repo, calculadora: calculadora}
+}
+
+// Execute validates the input, runs the calculation and persists input + result.
+func (uc *CrearMemoriaUseCase) Execute(ctx context.Context, input dto.CreateMemoriaInput) (dto.MemoriaOutput, error) {
+	if err := input.Validate(); err != nil {
+		return dto.MemoriaOutput{}, err
+	}
+
+	resultado, err := uc.calculadora.Calcular(ctx, input.Input)
+	if err != nil {
+		return dto.MemoriaOutput{}, fmt.Errorf("%w: %w", dto.ErrCalculoFallido, err)
+	}
+
+	memoria, err := entity.NewMemoria(input.Nombre, input.Descripcion, input.Input, resultado)
+	if err != nil {
+		return dto.MemoriaOutput{}, fmt.Errorf("%w: %s", dto.ErrInputInvalido, err.Error())
+	}
+
+	created, err := uc.repo.Crear(ctx, memoria)
+	if err != nil {
+		return dto.MemoriaOutput{}, fmt.Errorf("crear memoria: %w", err)
+	}
+
+	return dto.FromDomain(created)
+}
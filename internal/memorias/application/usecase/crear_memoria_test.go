@@ -0,0 +1,47 @@
+// internal/memorias/application/usecase/crear_memoria_test.go
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/memorias/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/memorias/application/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockCalculador records the document it receives and returns a fixed result.
+type mockCalculador struct {
+	input json.RawMessage
+}
+
+func (m *mockCalculador) Calcular(ctx context.Context, input json.RawMessage) (json.RawMessage, error) {
+	m.input = input
+	return json.RawMessage(`{"equipo":{"clave":"C-1"},"tipo_equipo":"CARGA","cumple_normativa":true}`), nil
+}
+
+func TestCrearMemoriaUseCase_Execute_ManualAmperajeConITM(t *testing.T) {
+	repo := newMockMemoriaRepo()
+	calculador := &mockCalculador{}
+	crear := usecase.NewCrearMemoriaUseCase(repo, calculador)
+	obtener := usecase.NewObtenerMemoriaUseCase(repo)
+
+	input := json.RawMessage(`{"modo":"MANUAL_AMPERAJE","tipo_equipo":"CARGA","amperaje_nominal":50,"tension":220,"itm":70}`)
+	creada, err := crear.Execute(context.Background(), dto.CreateMemoriaInput{Nombre: "C-1", Input: input})
+	require.NoError(t, err)
+
+	// El itm de nivel superior llega al cálculo sin cambios
+	assert.JSONEq(t, string(input), string(calculador.input))
+
+	guardada, err := obtener.Execute(context.Background(), creada.ID)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(input), string(guardada.Input))
+
+	var doc struct {
+		ITM int `json:"itm"`
+	}
+	require.NoError(t, json.Unmarshal(guardada.Input, &doc))
+	assert.Equal(t, 70, doc.ITM)
+}
@@ -0,0 +1,35 @@
+// internal/memorias/application/usecase/eliminar_memoria.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/garfex/calculadora-filtros/internal/memorias/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/memorias/application/port"
+	"github.com/google/uuid"
+)
+
+// EliminarMemoriaUseCase handles deleting a stored memoria.
+type EliminarMemoriaUseCase struct {
+	repo port.MemoriaRepository
+}
+
+// NewEliminarMemoriaUseCase creates a new instance with the required repository.
+func NewEliminarMemoriaUseCase(repo port.MemoriaRepository) *EliminarMemoriaUseCase {
+	return &EliminarMemoriaUseCase{repo: repo}
+}
+
+// Execute parses the ID and deletes the memoria. Idempotent.
+func (uc *EliminarMemoriaUseCase) Execute(ctx context.Context, id string) error {
+	parsedID, err := uuid.Parse(id)
+	if err != nil {
+		return fmt.Errorf("%w: %s", dto.ErrIDInvalido, id)
+	}
+
+	if err := uc.repo.Eliminar(ctx, parsedID); err != nil {
+		return fmt.Errorf("eliminar memoria: %w", err)
+	}
+
+	return nil
+}
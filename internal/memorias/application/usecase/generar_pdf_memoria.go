@@ -0,0 +1,55 @@
+// internal/memorias/application/usecase/generar_pdf_memoria.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/garfex/calculadora-filtros/internal/memorias/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/memorias/application/port"
+	"github.com/google/uuid"
+)
+
+// GenerarPdfMemoriaUseCase regenerates the PDF of a stored memoria without recalculating it.
+type GenerarPdfMemoriaUseCase struct {
+	repo      port.MemoriaRepository
+	generador port.GeneradorPdf
+}
+
+// NewGenerarPdfMemoriaUseCase creates a new instance with the required dependencies.
+func NewGenerarPdfMemoriaUseCase(repo port.MemoriaRepository, generador port.GeneradorPdf) *GenerarPdfMemoriaUseCase {
+	return &GenerarPdfMemoriaUseCase{repo: repo, generador: generador}
+}
+
+// Execute loads the stored MemoriaOutput and renders it with the given presentation data.
+// Returns the PDF bytes together with the stored memoria metadata (used for the filename).
+func (uc *GenerarPdfMemoriaUseCase) Execute(ctx context.Context, id string, input dto.GenerarPdfMemoriaInput) ([]byte, dto.MemoriaOutput, error) {
+	parsedID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, dto.MemoriaOutput{}, fmt.Errorf("%w: %s", dto.ErrIDInvalido, id)
+	}
+
+	if err := input.Validate(); err != nil {
+		return nil, dto.MemoriaOutput{}, err
+	}
+	if !uc.generador.ExisteEmpresa(input.Presentacion.EmpresaID) {
+		return nil, dto.MemoriaOutput{}, fmt.Errorf("%w: empresa_id=%q no existe en el catálogo", dto.ErrInputInvalido, input.Presentacion.EmpresaID)
+	}
+
+	memoria, err := uc.repo.ObtenerPorID(ctx, parsedID)
+	if err != nil {
+		return nil, dto.MemoriaOutput{}, fmt.Errorf("obtener memoria: %w", err)
+	}
+
+	output, err := dto.FromDomain(memoria)
+	if err != nil {
+		return nil, dto.MemoriaOutput{}, err
+	}
+
+	pdfBytes, err := uc.generador.Generar(ctx, output.Output, input.Presentacion)
+	if err != nil {
+		return nil, dto.MemoriaOutput{}, fmt.Errorf("generar pdf de memoria: %w", err)
+	}
+
+	return pdfBytes, output, nil
+}
@@ -0,0 +1,108 @@
+// internal/memorias/application/usecase/generar_pdf_memoria_test.go
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/memorias/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/memorias/application/port"
+	"github.com/garfex/calculadora-filtros/internal/memorias/application/usecase"
+	"github.com/garfex/calculadora-filtros/internal/memorias/domain/entity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockMemoriaRepo keeps memorias in memory by ID.
+type mockMemoriaRepo struct {
+	memorias map[uuid.UUID]*entity.Memoria
+}
+
+func newMockMemoriaRepo() *mockMemoriaRepo {
+	return &mockMemoriaRepo{memorias: map[uuid.UUID]*entity.Memoria{}}
+}
+
+func (m *mockMemoriaRepo) Crear(ctx context.Context, memoria *entity.Memoria) (*entity.Memoria, error) {
+	memoria.ID = uuid.New()
+	m.memorias[memoria.ID] = memoria
+	return memoria, nil
+}
+
+func (m *mockMemoriaRepo) ObtenerPorID(ctx context.Context, id uuid.UUID) (*entity.Memoria, error) {
+	memoria, ok := m.memorias[id]
+	if !ok {
+		return nil, dto.ErrMemoriaNoEncontrada
+	}
+	return memoria, nil
+}
+
+func (m *mockMemoriaRepo) Listar(ctx context.Context, filtros port.FiltrosListado) ([]*entity.Memoria, error) {
+	return nil, nil
+}
+
+func (m *mockMemoriaRepo) Contar(ctx context.Context, filtros port.FiltrosListado) (int, error) {
+	return len(m.memorias), nil
+}
+
+func (m *mockMemoriaRepo) Actualizar(ctx context.Context, memoria *entity.Memoria) (*entity.Memoria, error) {
+	anterior, ok := m.memorias[memoria.ID]
+	if !ok {
+		return nil, dto.ErrMemoriaNoEncontrada
+	}
+	memoria.Revision = anterior.Revision + 1
+	m.memorias[memoria.ID] = memoria
+	return memoria, nil
+}
+
+func (m *mockMemoriaRepo) Eliminar(ctx context.Context, id uuid.UUID) error {
+	delete(m.memorias, id)
+	return nil
+}
+
+// mockGeneradorPdf accepts a single empresa and records the rendered document.
+type mockGeneradorPdf struct {
+	empresa      string
+	memoria      json.RawMessage
+	presentacion dto.PresentacionPdf
+}
+
+func (m *mockGeneradorPdf) ExisteEmpresa(empresaID string) bool {
+	return empresaID == m.empresa
+}
+
+func (m *mockGeneradorPdf) Generar(ctx context.Context, memoria json.RawMessage, presentacion dto.PresentacionPdf) ([]byte, error) {
+	m.memoria = memoria
+	m.presentacion = presentacion
+	return []byte("%PDF"), nil
+}
+
+func TestGenerarPdfMemoriaUseCase_Execute(t *testing.T) {
+	repo := newMockMemoriaRepo()
+	output := json.RawMessage(`{"tipo_equipo":"CARGA","cumple_normativa":true}`)
+	memoria, err := entity.NewMemoria("CCM-1", nil, json.RawMessage(`{"modo":"MANUAL_AMPERAJE"}`), output)
+	require.NoError(t, err)
+	creada, err := repo.Crear(context.Background(), memoria)
+	require.NoError(t, err)
+
+	generador := &mockGeneradorPdf{empresa: "garfex"}
+	uc := usecase.NewGenerarPdfMemoriaUseCase(repo, generador)
+	presentacion := dto.PresentacionPdf{EmpresaID: "garfex", NombreProyecto: "Planta Norte", Responsable: "Ing. Pérez"}
+
+	t.Run("renderiza el output almacenado", func(t *testing.T) {
+		pdf, out, err := uc.Execute(context.Background(), creada.ID.String(), dto.GenerarPdfMemoriaInput{Presentacion: presentacion})
+		require.NoError(t, err)
+		assert.Equal(t, []byte("%PDF"), pdf)
+		assert.Equal(t, "CCM-1", out.Nombre)
+		assert.JSONEq(t, string(output), string(generador.memoria))
+		assert.Equal(t, presentacion, generador.presentacion)
+	})
+
+	t.Run("empresa inexistente falla", func(t *testing.T) {
+		input := dto.GenerarPdfMemoriaInput{Presentacion: presentacion}
+		input.Presentacion.EmpresaID = "acme"
+		_, _, err := uc.Execute(context.Background(), creada.ID.String(), input)
+		assert.ErrorIs(t, err, dto.ErrInputInvalido)
+	})
+}
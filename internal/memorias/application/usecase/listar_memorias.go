@@ -0,0 +1,46 @@
+// internal/memorias/application/usecase/listar_memorias.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/garfex/calculadora-filtros/internal/memorias/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/memorias/application/port"
+)
+
+// ListarMemoriasUseCase handles listing stored memorias with optional search and pagination.
+type ListarMemoriasUseCase struct {
+	repo port.MemoriaRepository
+}
+
+// NewListarMemoriasUseCase creates a new instance with the required repository.
+func NewListarMemoriasUseCase(repo port.MemoriaRepository) *ListarMemoriasUseCase {
+	return &ListarMemoriasUseCase{repo: repo}
+}
+
+// Execute applies defaults, counts total, and fetches the requested page.
+func (uc *ListarMemoriasUseCase) Execute(ctx context.Context, query dto.ListMemoriasQuery) (dto.ListMemoriasOutput, error) {
+	query.ApplyDefaults()
+
+	filtros := port.FiltrosListado{
+		Limit:  query.PageSize,
+		Offset: query.Offset(),
+	}
+
+	if query.Buscar != "" {
+		filtros.Buscar = &query.Buscar
+	}
+
+	total, err := uc.repo.Contar(ctx, filtros)
+	if err != nil {
+		return dto.ListMemoriasOutput{}, fmt.Errorf("contar memorias: %w", err)
+	}
+
+	memorias, err := uc.repo.Listar(ctx, filtros)
+	if err != nil {
+		return dto.ListMemoriasOutput{}, fmt.Errorf("listar memorias: %w", err)
+	}
+
+	return dto.FromDomainList(memorias, query.Page, query.PageSize, total)
+}
@@ -0,0 +1,36 @@
+// internal/memorias/application/usecase/obtener_memoria.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/garfex/calculadora-filtros/internal/memorias/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/memorias/application/port"
+	"github.com/google/uuid"
+)
+
+// ObtenerMemoriaUseCase handles fetching a single stored memoria by ID.
+type ObtenerMemoriaUseCase struct {
+	repo port.MemoriaRepository
+}
+
+// NewObtenerMemoriaUseCase creates a new instance with the required repository.
+func NewObtenerMemoriaUseCase(repo port.MemoriaRepository) *ObtenerMemoriaUseCase {
+	return &ObtenerMemoriaUseCase{repo: repo}
+}
+
+// Execute parses the ID and retrieves the memoria with its decoded documents.
+func (uc *ObtenerMemoriaUseCase) Execute(ctx context.Context, id string) (dto.MemoriaOutput, error) {
+	parsedID, err := uuid.Parse(id)
+	if err != nil {
+		return dto.MemoriaOutput{}, fmt.Errorf("%w: %s", dto.ErrIDInvalido, id)
+	}
+
+	memoria, err := uc.repo.ObtenerPorID(ctx, parsedID)
+	if err != nil {
+		return dto.MemoriaOutput{}, fmt.Errorf("obtener memoria: %w", err)
+	}
+
+	return dto.FromDomain(memoria)
+}
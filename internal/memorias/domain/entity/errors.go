@@ -0,0 +1,13 @@
+// internal/memorias/domain/entity/errors.go
+package entity
+
+import "errors"
+
+// Domain errors for the memorias feature.
+var (
+	// ErrNombreRequerido is returned when a memoria is created without a nombre.
+	ErrNombreRequerido = errors.New("el nombre de la memoria es requerido")
+
+	// ErrDocumentoVacio is returned when the stored input or output payload is empty.
+	ErrDocumentoVacio = errors.New("el documento de la memoria está vacío")
+)
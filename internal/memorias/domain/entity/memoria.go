@@ -0,0 +1,47 @@
+// internal/memorias/domain/entity/memoria.go
+package entity
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Memoria represents a calculated memoria de cálculo stored as a versioned project document.
+// Maps to the memorias_calculo table in PostgreSQL.
+//
+// Input and Output are kept as opaque JSON documents: the memorias feature does not
+// interpret them, it only stores the exact request sent to the calculation and
+// the memoria de cálculo it produced, so a memoria can be re-rendered later without recalculating.
+type Memoria struct {
+	ID          uuid.UUID
+	Nombre      string  // user-readable name of the document (e.g. "Filtro activo CCM-1")
+	Descripcion *string // nullable — free text
+	Revision    int     // starts at 1; incremented on every update
+	Input       json.RawMessage
+	Output      json.RawMessage
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// NewMemoria creates and validates a new Memoria entity at revision 1.
+// ID, CreatedAt and UpdatedAt are set by PostgreSQL on insert; they are zero here.
+func NewMemoria(nombre string, descripcion *string, input, output json.RawMessage) (*Memoria, error) {
+	nombre = strings.TrimSpace(nombre)
+	if nombre == "" {
+		return nil, ErrNombreRequerido
+	}
+	if len(input) == 0 || len(output) == 0 {
+		return nil, ErrDocumentoVacio
+	}
+
+	return &Memoria{
+		Nombre:      nombre,
+		Descripcion: descripcion,
+		Revision:    1,
+		Input:       input,
+		Output:      output,
+	}, nil
+}
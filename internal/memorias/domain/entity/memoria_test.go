@@ -0,0 +1,42 @@
+// internal/memorias/domain/entity/memoria_test.go
+package entity_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/memorias/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMemoria(t *testing.T) {
+	input := json.RawMessage(`{"modo":"MANUAL_AMPERAJE"}`)
+	output := json.RawMessage(`{"cumple_normativa":true}`)
+
+	t.Run("crea memoria válida en revisión 1", func(t *testing.T) {
+		descripcion := "Tablero principal"
+		m, err := entity.NewMemoria("  CCM-1  ", &descripcion, input, output)
+		require.NoError(t, err)
+		assert.Equal(t, "CCM-1", m.Nombre)
+		assert.Equal(t, &descripcion, m.Descripcion)
+		assert.Equal(t, 1, m.Revision)
+		assert.JSONEq(t, string(input), string(m.Input))
+		assert.JSONEq(t, string(output), string(m.Output))
+	})
+
+	t.Run("rechaza nombre vacío", func(t *testing.T) {
+		_, err := entity.NewMemoria("   ", nil, input, output)
+		assert.ErrorIs(t, err, entity.ErrNombreRequerido)
+	})
+
+	t.Run("rechaza input vacío", func(t *testing.T) {
+		_, err := entity.NewMemoria("CCM-1", nil, nil, output)
+		assert.ErrorIs(t, err, entity.ErrDocumentoVacio)
+	})
+
+	t.Run("rechaza output vacío", func(t *testing.T) {
+		_, err := entity.NewMemoria("CCM-1", nil, input, nil)
+		assert.ErrorIs(t, err, entity.ErrDocumentoVacio)
+	})
+}
@@ -0,0 +1,219 @@
+// internal/memorias/infrastructure/adapter/driven/postgres/memoria_repository.go
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	appdto "github.com/garfex/calculadora-filtros/internal/memorias/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/memorias/application/port"
+	"github.com/garfex/calculadora-filtros/internal/memorias/domain/entity"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// memoriaColumns is the column list shared by every SELECT/RETURNING clause.
+const memoriaColumns = `id, nombre, descripcion, revision, input, output, created_at, updated_at`
+
+// PostgresMemoriaRepository implements port.MemoriaRepository using pgx.
+// Input and output documents are stored as JSONB.
+type PostgresMemoriaRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresMemoriaRepository creates a new repository with the given pool.
+func NewPostgresMemoriaRepository(pool *pgxpool.Pool) *PostgresMemoriaRepository {
+	return &PostgresMemoriaRepository{pool: pool}
+}
+
+// Compile-time check: PostgresMemoriaRepository must implement port.MemoriaRepository.
+var _ port.MemoriaRepository = (*PostgresMemoriaRepository)(nil)
+
+// Crear inserts a new memoria and returns the created record with DB-generated fields.
+func (r *PostgresMemoriaRepository) Crear(ctx context.Context, memoria *entity.Memoria) (*entity.Memoria, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	query := `
+		INSERT INTO memorias_calculo (nombre, descripcion, revision, input, output)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING ` + memoriaColumns
+
+	row := r.pool.QueryRow(ctx, query,
+		memoria.Nombre,
+		memoria.Descripcion,
+		memoria.Revision,
+		[]byte(memoria.Input),
+		[]byte(memoria.Output),
+	)
+
+	created, err := scanMemoria(row)
+	if err != nil {
+		return nil, fmt.Errorf("insertar memoria: %w", err)
+	}
+
+	return created, nil
+}
+
+// ObtenerPorID fetches a single memoria by UUID.
+func (r *PostgresMemoriaRepository) ObtenerPorID(ctx context.Context, id uuid.UUID) (*entity.Memoria, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	query := `SELECT ` + memoriaColumns + ` FROM memorias_calculo WHERE id = $1`
+
+	memoria, err := scanMemoria(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: id %s", appdto.ErrMemoriaNoEncontrada, id)
+		}
+		return nil, fmt.Errorf("obtener memoria por id: %w", err)
+	}
+
+	return memoria, nil
+}
+
+// buildWhereClause constructs the shared WHERE clause and args for Listar and Contar.
+func buildWhereClause(filtros port.FiltrosListado) (string, []any, int) {
+	where := " WHERE 1=1"
+	args := []any{}
+	argIdx := 1
+
+	if filtros.Buscar != nil && *filtros.Buscar != "" {
+		where += fmt.Sprintf(" AND nombre ILIKE $%d", argIdx)
+		args = append(args, "%"+*filtros.Buscar+"%")
+		argIdx++
+	}
+	return where, args, argIdx
+}
+
+// Listar returns a paginated page of memorias matching the optional filters.
+func (r *PostgresMemoriaRepository) Listar(ctx context.Context, filtros port.FiltrosListado) ([]*entity.Memoria, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	where, args, argIdx := buildWhereClause(filtros)
+
+	query := `SELECT ` + memoriaColumns + ` FROM memorias_calculo` +
+		where +
+		" ORDER BY updated_at DESC" +
+		fmt.Sprintf(" LIMIT $%d OFFSET $%d", argIdx, argIdx+1)
+
+	args = append(args, filtros.Limit, filtros.Offset)
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("listar memorias: %w", err)
+	}
+	defer rows.Close()
+
+	memorias := make([]*entity.Memoria, 0, filtros.Limit)
+	for rows.Next() {
+		memoria, err := scanMemoria(rows)
+		if err != nil {
+			return nil, fmt.Errorf("escanear memoria: %w", err)
+		}
+		memorias = append(memorias, memoria)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterando resultados: %w", err)
+	}
+
+	return memorias, nil
+}
+
+// Contar returns the total count of memorias matching the filters (ignores pagination).
+func (r *PostgresMemoriaRepository) Contar(ctx context.Context, filtros port.FiltrosListado) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	where, args, _ := buildWhereClause(filtros)
+	query := `SELECT COUNT(*) FROM memorias_calculo` + where
+
+	var count int
+	if err := r.pool.QueryRow(ctx, query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("contar memorias: %w", err)
+	}
+	return count, nil
+}
+
+// Actualizar replaces the documents of an existing memoria and bumps its revision.
+// The revision is incremented in SQL so concurrent updates never reuse a number.
+func (r *PostgresMemoriaRepository) Actualizar(ctx context.Context, memoria *entity.Memoria) (*entity.Memoria, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	query := `
+		UPDATE memorias_calculo
+		SET nombre = $1, descripcion = $2, input = $3, output = $4,
+		    revision = revision + 1, updated_at = NOW()
+		WHERE id = $5
+		RETURNING ` + memoriaColumns
+
+	row := r.pool.QueryRow(ctx, query,
+		memoria.Nombre,
+		memoria.Descripcion,
+		[]byte(memoria.Input),
+		[]byte(memoria.Output),
+		memoria.ID,
+	)
+
+	updated, err := scanMemoria(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: id %s", appdto.ErrMemoriaNoEncontrada, memoria.ID)
+		}
+		return nil, fmt.Errorf("actualizar memoria: %w", err)
+	}
+
+	return updated, nil
+}
+
+// Eliminar deletes a memoria by UUID. Idempotent — no error if not found.
+func (r *PostgresMemoriaRepository) Eliminar(ctx context.Context, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := r.pool.Exec(ctx, `DELETE FROM memorias_calculo WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("eliminar memoria: %w", err)
+	}
+
+	return nil
+}
+
+// ─── Mappers ────────────────────────────────────────────────────────────────
+
+// scanMemoria scans a single row (pgx.Row or pgx.Rows) into a domain entity.
+func scanMemoria(row pgx.Row) (*entity.Memoria, error) {
+	var (
+		id          uuid.UUID
+		nombre      string
+		descripcion *string
+		revision    int
+		input       []byte
+		output      []byte
+		createdAt   time.Time
+		updatedAt   time.Time
+	)
+
+	err := row.Scan(&id, &nombre, &descripcion, &revision, &input, &output, &createdAt, &updatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.Memoria{
+		ID:          id,
+		Nombre:      nombre,
+		Descripcion: descripcion,
+		Revision:    revision,
+		Input:       input,
+		Output:      output,
+		CreatedAt:   createdAt,
+		UpdatedAt:   updatedAt,
+	}, nil
+}
@@ -0,0 +1,294 @@
+// internal/memorias/infrastructure/adapter/driver/http/memoria_handler.go
+package http
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/garfex/calculadora-filtros/internal/memorias/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/memorias/application/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+// reNonAlphaNum filtra caracteres no alfanuméricos ni guiones bajos/medios del filename.
+var reNonAlphaNum = regexp.MustCompile(`[^a-zA-Z0-9_\-]`)
+
+// MemoriaHandler handles HTTP requests for the memorias feature.
+type MemoriaHandler struct {
+	crearUC      *usecase.CrearMemoriaUseCase
+	obtenerUC    *usecase.ObtenerMemoriaUseCase
+	listarUC     *usecase.ListarMemoriasUseCase
+	actualizarUC *usecase.ActualizarMemoriaUseCase
+	eliminarUC   *usecase.EliminarMemoriaUseCase
+	generarPdfUC *usecase.GenerarPdfMemoriaUseCase
+}
+
+// NewMemoriaHandler creates a new handler with all required use cases.
+func NewMemoriaHandler(
+	crearUC *usecase.CrearMemoriaUseCase,
+	obtenerUC *usecase.ObtenerMemoriaUseCase,
+	listarUC *usecase.ListarMemoriasUseCase,
+	actualizarUC *usecase.ActualizarMemoriaUseCase,
+	eliminarUC *usecase.EliminarMemoriaUseCase,
+	generarPdfUC *usecase.GenerarPdfMemoriaUseCase,
+) *MemoriaHandler {
+	return &MemoriaHandler{
+		crearUC:      crearUC,
+		obtenerUC:    obtenerUC,
+		listarUC:     listarUC,
+		actualizarUC: actualizarUC,
+		eliminarUC:   eliminarUC,
+		generarPdfUC: generarPdfUC,
+	}
+}
+
+// ─── Response types ──────────────────────────────────────────────────────────
+
+type successResponse struct {
+	Success bool `json:"success"`
+	Data    any  `json:"data"`
+}
+
+type errorResponse struct {
+	Success   bool   `json:"success"`
+	Error     string `json:"error"`
+	Code      string `json:"code,omitempty"`
+	Details   string `json:"details,omitempty"`
+	Timestamp string `json:"timestamp"` // ISO 8601 UTC
+}
+
+func ok(data any) successResponse {
+	return successResponse{Success: true, Data: data}
+}
+
+func errResp(msg, code, details string) errorResponse {
+	return errorResponse{
+		Success:   false,
+		Error:     msg,
+		Code:      code,
+		Details:   details,
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+	}
+}
+
+// ─── Endpoints ───────────────────────────────────────────────────────────────
+
+// Crear POST /api/v1/memorias
+// @Summary Calcular y guardar memoria
+// @Description Calcula la memoria de cálculo y la guarda como documento (revisión 1)
+// @Tags Memorias
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateMemoriaInput true "Nombre del documento e input del cálculo"
+// @Success 201 {object} successResponse
+// @Failure 400 {object} errorResponse
+// @Failure 422 {object} errorResponse
+// @Router /memorias [post]
+func (h *MemoriaHandler) Crear(c *gin.Context) {
+	var input dto.CreateMemoriaInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, errResp("Error de validación", "VALIDATION_ERROR", err.Error()))
+		return
+	}
+
+	output, err := h.crearUC.Execute(c.Request.Context(), input)
+	if err != nil {
+		status, resp := mapError(err)
+		c.JSON(status, resp)
+		return
+	}
+
+	c.JSON(http.StatusCreated, ok(output))
+}
+
+// ObtenerPorID GET /api/v1/memorias/:id
+// @Summary Obtener memoria guardada
+// @Tags Memorias
+// @Produce json
+// @Param id path string true "ID de la memoria"
+// @Success 200 {object} successResponse
+// @Failure 404 {object} errorResponse
+// @Router /memorias/{id} [get]
+func (h *MemoriaHandler) ObtenerPorID(c *gin.Context) {
+	id := c.Param("id")
+
+	output, err := h.obtenerUC.Execute(c.Request.Context(), id)
+	if err != nil {
+		status, resp := mapError(err)
+		c.JSON(status, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, ok(output))
+}
+
+// Listar GET /api/v1/memorias
+// Query params: buscar, page (default 1), page_size (default 20, max 100)
+// @Summary Listar memorias guardadas
+// @Tags Memorias
+// @Produce json
+// @Param buscar query string false "Búsqueda por nombre"
+// @Param page query int false "Página (default 1)"
+// @Param page_size query int false "Tamaño de página (default 20, max 100)"
+// @Success 200 {object} successResponse
+// @Router /memorias [get]
+func (h *MemoriaHandler) Listar(c *gin.Context) {
+	query := dto.ListMemoriasQuery{
+		Buscar: c.Query("buscar"),
+	}
+
+	if pageStr := c.Query("page"); pageStr != "" {
+		p, err := strconv.Atoi(pageStr)
+		if err != nil || p < 1 {
+			c.JSON(http.StatusBadRequest, errResp("Página inválida", "PAGE_INVALIDO", "debe ser un entero mayor que cero"))
+			return
+		}
+		query.Page = p
+	}
+
+	if pageSizeStr := c.Query("page_size"); pageSizeStr != "" {
+		ps, err := strconv.Atoi(pageSizeStr)
+		if err != nil || ps < 1 {
+			c.JSON(http.StatusBadRequest, errResp("Tamaño de página inválido", "PAGE_SIZE_INVALIDO", "debe ser un entero mayor que cero"))
+			return
+		}
+		query.PageSize = ps
+	}
+
+	output, err := h.listarUC.Execute(c.Request.Context(), query)
+	if err != nil {
+		status, resp := mapError(err)
+		c.JSON(status, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, ok(output))
+}
+
+// Actualizar PUT /api/v1/memorias/:id
+// @Summary Recalcular memoria guardada
+// @Description Recalcula la memoria con el nuevo input y la guarda como una nueva revisión
+// @Tags Memorias
+// @Accept json
+// @Produce json
+// @Param id path string true "ID de la memoria"
+// @Param request body dto.UpdateMemoriaInput true "Nombre del documento e input del cálculo"
+// @Success 200 {object} successResponse
+// @Failure 404 {object} errorResponse
+// @Failure 422 {object} errorResponse
+// @Router /memorias/{id} [put]
+func (h *MemoriaHandler) Actualizar(c *gin.Context) {
+	id := c.Param("id")
+
+	var input dto.UpdateMemoriaInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, errResp("Error de validación", "VALIDATION_ERROR", err.Error()))
+		return
+	}
+
+	output, err := h.actualizarUC.Execute(c.Request.Context(), id, input)
+	if err != nil {
+		status, resp := mapError(err)
+		c.JSON(status, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, ok(output))
+}
+
+// Eliminar DELETE /api/v1/memorias/:id
+// @Summary Eliminar memoria guardada
+// @Tags Memorias
+// @Param id path string true "ID de la memoria"
+// @Success 204
+// @Router /memorias/{id} [delete]
+func (h *MemoriaHandler) Eliminar(c *gin.Context) {
+	id := c.Param("id")
+
+	if err := h.eliminarUC.Execute(c.Request.Context(), id); err != nil {
+		status, resp := mapError(err)
+		c.JSON(status, resp)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GenerarPdf POST /api/v1/memorias/:id/pdf
+// @Summary Regenerar PDF de una memoria guardada
+// @Description Genera el PDF a partir del resultado almacenado, sin recalcular
+// @Tags Memorias
+// @Accept json
+// @Produce application/pdf
+// @Param id path string true "ID de la memoria"
+// @Param request body dto.GenerarPdfMemoriaInput true "Datos de presentación"
+// @Success 200 {file} binary "PDF generado exitosamente"
+// @Failure 400 {object} errorResponse
+// @Failure 404 {object} errorResponse
+// @Router /memorias/{id}/pdf [post]
+func (h *MemoriaHandler) GenerarPdf(c *gin.Context) {
+	id := c.Param("id")
+
+	var input dto.GenerarPdfMemoriaInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, errResp("Error de validación", "VALIDATION_ERROR", err.Error()))
+		return
+	}
+
+	pdfBytes, memoria, err := h.generarPdfUC.Execute(c.Request.Context(), id, input)
+	if err != nil {
+		log.Printf("[ERROR] memoria_handler.GenerarPdf: %v", err)
+		status, resp := mapError(err)
+		c.JSON(status, resp)
+		return
+	}
+
+	filename := buildFilename(memoria.Nombre, memoria.Revision)
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	c.Header("Content-Length", fmt.Sprintf("%d", len(pdfBytes)))
+	c.Data(http.StatusOK, "application/pdf", pdfBytes)
+}
+
+// ─── Error mapper ────────────────────────────────────────────────────────────
+
+// mapError converts application/domain errors to HTTP status + response body.
+func mapError(err error) (int, errorResponse) {
+	switch {
+	case errors.Is(err, dto.ErrIDInvalido):
+		return http.StatusBadRequest, errResp("ID inválido", "ID_INVALIDO", err.Error())
+
+	case errors.Is(err, dto.ErrInputInvalido):
+		return http.StatusBadRequest, errResp("Datos de entrada inválidos", "INPUT_INVALIDO", err.Error())
+
+	case errors.Is(err, dto.ErrMemoriaNoEncontrada):
+		return http.StatusNotFound, errResp("Memoria no encontrada", "MEMORIA_NO_ENCONTRADA", err.Error())
+
+	case errors.Is(err, dto.ErrCalculoFallido):
+		return http.StatusUnprocessableEntity, errResp("No se pudo calcular la memoria", "CALCULO_FALLIDO", err.Error())
+
+	case errors.Is(err, dto.ErrDocumentoCorrupto):
+		return http.StatusInternalServerError, errResp("Documento almacenado inválido", "DOCUMENTO_CORRUPTO", err.Error())
+
+	default:
+		return http.StatusInternalServerError, errResp("Error interno del servidor", "INTERNAL_ERROR", err.Error())
+	}
+}
+
+// buildFilename construye el nombre del archivo PDF sanitizado.
+// Formato: MemoriaCalculo_<nombre>_rev<N>_<fecha>.pdf
+func buildFilename(nombre string, revision int) string {
+	fecha := time.Now().Format("20060102")
+
+	segmento := reNonAlphaNum.ReplaceAllString(strings.ReplaceAll(nombre, " ", "_"), "")
+	if segmento == "" {
+		segmento = "Memoria"
+	}
+
+	return fmt.Sprintf("MemoriaCalculo_%s_rev%d_%s.pdf", segmento, revision, fecha)
+}
@@ -0,0 +1,21 @@
+// internal/memorias/infrastructure/router.go
+package infrastructure
+
+import (
+	memoriahttp "github.com/garfex/calculadora-filtros/internal/memorias/infrastructure/adapter/driver/http"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterMemoriasRoutes mounts all memoria routes under the given RouterGroup.
+// Call this from main.go passing the /api/v1 group.
+func RegisterMemoriasRoutes(rg *gin.RouterGroup, handler *memoriahttp.MemoriaHandler) {
+	memorias := rg.Group("/memorias")
+	{
+		memorias.POST("", handler.Crear)
+		memorias.GET("", handler.Listar)
+		memorias.GET("/:id", handler.ObtenerPorID)
+		memorias.PUT("/:id", handler.Actualizar)
+		memorias.DELETE("/:id", handler.Eliminar)
+		memorias.POST("/:id/pdf", handler.GenerarPdf)
+	}
+}
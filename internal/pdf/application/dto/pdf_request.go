@@ -2,6 +2,8 @@
 package dto
 
 import (
+	"strings"
+
 	calculosdto "github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
 	"github.com/garfex/calculadora-filtros/internal/pdf/domain"
 )
@@ -26,6 +28,15 @@ type PresentacionInput struct {
 	NombreEquipoOverride string `json:"nombre_equipo_override,omitempty"`
 }
 
+// Formatos de salida de la memoria de cálculo.
+const (
+	// FormatoPdf genera la memoria en PDF vía Gotenberg (default).
+	FormatoPdf = "pdf"
+
+	// FormatoDocx genera la memoria como documento Word editable.
+	FormatoDocx = "docx"
+)
+
 // PdfMemoriaRequest combina el resultado de cálculo con los datos de presentación
 // para generar la memoria de cálculo en PDF.
 type PdfMemoriaRequest struct {
@@ -34,6 +45,27 @@ type PdfMemoriaRequest struct {
 
 	// Presentacion contiene los datos de presentación para el PDF.
 	Presentacion PresentacionInput `json:"presentacion"`
+
+	// Formato selecciona el documento de salida: "pdf" (default) o "docx".
+	Formato string `json:"formato,omitempty"`
+}
+
+// GetFormato retorna el formato solicitado en minúsculas, o FormatoPdf si está vacío.
+func (r PdfMemoriaRequest) GetFormato() string {
+	if r.Formato == "" {
+		return FormatoPdf
+	}
+	return strings.ToLower(strings.TrimSpace(r.Formato))
+}
+
+// PdfProyectoRequest combina el resultado de cálculo de un proyecto (N circuitos)
+// con los datos de presentación para generar una sola memoria en PDF.
+type PdfProyectoRequest struct {
+	// Proyecto contiene el resumen consolidado y la memoria de cada circuito.
+	Proyecto calculosdto.ProyectoOutput `json:"proyecto"`
+
+	// Presentacion contiene los datos de presentación para el PDF.
+	Presentacion PresentacionInput `json:"presentacion"`
 }
 
 // TemplateData es el struct que alimenta el template HTML de la memoria de cálculo.
@@ -68,4 +100,12 @@ type TemplateData struct {
 
 	// FechaGeneracion es la fecha de generación del documento (formato: "02/01/2006").
 	FechaGeneracion string
+
+	// ResumenProyecto contiene el resumen consolidado cuando se genera la memoria de un proyecto.
+	// Es nil para la memoria de un solo equipo.
+	ResumenProyecto *calculosdto.ResumenProyecto
+
+	// Circuitos contiene una TemplateData por circuito del proyecto; cada una se
+	// renderiza con los mismos partials que la memoria de un solo equipo.
+	Circuitos []TemplateData
 }
@@ -0,0 +1,22 @@
+// internal/pdf/application/dto/xlsx_request.go
+package dto
+
+import (
+	calculosdto "github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
+)
+
+// XlsxMemoriaRequest contiene el resultado de cálculo de un equipo para exportarlo a Excel.
+type XlsxMemoriaRequest struct {
+	// Memoria contiene el resultado completo del cálculo eléctrico.
+	Memoria calculosdto.MemoriaOutput `json:"memoria"`
+
+	// NombreProyecto se usa solo para el nombre del archivo (opcional).
+	NombreProyecto string `json:"nombre_proyecto,omitempty"`
+}
+
+// XlsxProyectoRequest contiene el resultado de cálculo de un proyecto (N circuitos)
+// para exportarlo a un solo libro de Excel.
+type XlsxProyectoRequest struct {
+	// Proyecto contiene el resumen consolidado y la memoria de cada circuito.
+	Proyecto calculosdto.ProyectoOutput `json:"proyecto"`
+}
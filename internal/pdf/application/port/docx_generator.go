@@ -0,0 +1,13 @@
+// internal/pdf/application/port/docx_generator.go
+package port
+
+import "context"
+
+// DocxGenerator es el port driven para convertir el HTML de la memoria a un documento Word.
+// La implementación concreta vive en infrastructure/adapter/driven/docx/.
+type DocxGenerator interface {
+	// Generate convierte el HTML renderizado a bytes de .docx.
+	// encabezado es el texto del encabezado de página (ej: nombre de la empresa y del proyecto).
+	// Retorna los bytes del documento o un error envuelto con ErrGeneracionDocx.
+	Generate(ctx context.Context, html, encabezado string) ([]byte, error)
+}
@@ -0,0 +1,12 @@
+// internal/pdf/application/port/svg_rasterizer.go
+package port
+
+import "context"
+
+// SvgRasterizer es el port driven para convertir un diagrama SVG a imagen PNG.
+// Se usa donde el formato de salida no admite SVG embebido (ej: documentos Word).
+type SvgRasterizer interface {
+	// Rasterizar convierte el SVG completo (con elemento <svg> y viewBox) a PNG.
+	// anchoPx es el ancho de la imagen resultante; el alto respeta la proporción del viewBox.
+	Rasterizar(ctx context.Context, svg string, anchoPx int) ([]byte, error)
+}
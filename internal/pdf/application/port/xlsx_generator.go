@@ -0,0 +1,17 @@
+// internal/pdf/application/port/xlsx_generator.go
+package port
+
+import (
+	"context"
+
+	calculosdto "github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
+)
+
+// XlsxGenerator es el port driven para escribir memorias de cálculo en un libro .xlsx.
+// La implementación concreta vive en infrastructure/adapter/driven/xlsx/.
+type XlsxGenerator interface {
+	// Generate escribe las memorias de los circuitos en un libro con una hoja de
+	// entradas, una de pasos y una de lista de materiales; cada renglón indica su circuito.
+	// Retorna los bytes del .xlsx o un error envuelto con ErrGeneracionXlsx.
+	Generate(ctx context.Context, circuitos []calculosdto.CircuitoOutput) ([]byte, error)
+}
@@ -0,0 +1,29 @@
+// internal/pdf/application/usecase/exportar_memoria_xlsx.go
+package usecase
+
+import (
+	"context"
+
+	calculosdto "github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/pdf/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/pdf/application/port"
+)
+
+// ExportarMemoriaXlsxUseCase exporta la memoria de cálculo de un equipo a Excel.
+type ExportarMemoriaXlsxUseCase struct {
+	generator port.XlsxGenerator
+}
+
+// NewExportarMemoriaXlsx crea una nueva instancia del use case.
+func NewExportarMemoriaXlsx(generator port.XlsxGenerator) *ExportarMemoriaXlsxUseCase {
+	return &ExportarMemoriaXlsxUseCase{generator: generator}
+}
+
+// Execute genera el libro .xlsx de la memoria; el circuito toma la clave del equipo como nombre.
+func (uc *ExportarMemoriaXlsxUseCase) Execute(ctx context.Context, req dto.XlsxMemoriaRequest) ([]byte, error) {
+	circuito := calculosdto.CircuitoOutput{
+		Nombre:  req.Memoria.Equipo.Clave,
+		Memoria: req.Memoria,
+	}
+	return uc.generator.Generate(ctx, []calculosdto.CircuitoOutput{circuito})
+}
@@ -0,0 +1,25 @@
+// internal/pdf/application/usecase/exportar_proyecto_xlsx.go
+package usecase
+
+import (
+	"context"
+
+	"github.com/garfex/calculadora-filtros/internal/pdf/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/pdf/application/port"
+)
+
+// ExportarProyectoXlsxUseCase exporta las memorias de todos los circuitos de un
+// proyecto a un solo libro de Excel.
+type ExportarProyectoXlsxUseCase struct {
+	generator port.XlsxGenerator
+}
+
+// NewExportarProyectoXlsx crea una nueva instancia del use case.
+func NewExportarProyectoXlsx(generator port.XlsxGenerator) *ExportarProyectoXlsxUseCase {
+	return &ExportarProyectoXlsxUseCase{generator: generator}
+}
+
+// Execute genera el libro .xlsx con un bloque de renglones por circuito en cada hoja.
+func (uc *ExportarProyectoXlsxUseCase) Execute(ctx context.Context, req dto.XlsxProyectoRequest) ([]byte, error) {
+	return uc.generator.Generate(ctx, req.Proyecto.Circuitos)
+}
@@ -27,38 +27,47 @@ const (
 	fechaLayout = "02/01/2006"
 )
 
-// GenerarMemoriaPdfUseCase orquesta la generación de la memoria de cálculo en PDF.
-// Coordina el renderizado HTML y la conversión a PDF con control de concurrencia.
+// GenerarMemoriaPdfUseCase orquesta la generación de la memoria de cálculo en PDF o Word.
+// Coordina el renderizado HTML y la conversión al formato solicitado con control de concurrencia.
 type GenerarMemoriaPdfUseCase struct {
-	renderer  port.HtmlRenderer
-	generator port.PdfGenerator
-	semaforo  chan struct{}
+	renderer      port.HtmlRenderer
+	generator     port.PdfGenerator
+	docxGenerator port.DocxGenerator
+	semaforo      chan struct{}
 }
 
 // NewGenerarMemoriaPdf crea una nueva instancia del use case con control de concurrencia.
-// maxConcurrent limita el número de generaciones de PDF simultáneas (recomendado: 3).
+// docxGenerator atiende las peticiones con formato "docx" a partir del mismo HTML.
+// maxConcurrent limita el número de generaciones simultáneas (recomendado: 3).
 func NewGenerarMemoriaPdf(
 	renderer port.HtmlRenderer,
 	generator port.PdfGenerator,
+	docxGenerator port.DocxGenerator,
 	maxConcurrent int,
 ) *GenerarMemoriaPdfUseCase {
 	if maxConcurrent <= 0 {
 		maxConcurrent = 3
 	}
 	return &GenerarMemoriaPdfUseCase{
-		renderer:  renderer,
-		generator: generator,
-		semaforo:  make(chan struct{}, maxConcurrent),
+		renderer:      renderer,
+		generator:     generator,
+		docxGenerator: docxGenerator,
+		semaforo:      make(chan struct{}, maxConcurrent),
 	}
 }
 
-// Execute genera la memoria de cálculo en PDF a partir del request.
+// Execute genera la memoria de cálculo en el formato del request (PDF por defecto).
 // Flujo: resolver empresa → cargar logo → construir TemplateData → adquirir semáforo →
-// renderizar HTML → generar PDF → liberar semáforo → retornar bytes.
+// renderizar HTML → generar PDF o DOCX → liberar semáforo → retornar bytes.
 func (uc *GenerarMemoriaPdfUseCase) Execute(
 	ctx context.Context,
 	req dto.PdfMemoriaRequest,
 ) ([]byte, error) {
+	formato := req.GetFormato()
+	if formato != dto.FormatoPdf && formato != dto.FormatoDocx {
+		return nil, fmt.Errorf("%w: %q", domain.ErrFormatoInvalido, req.Formato)
+	}
+
 	// 1. Resolver empresa del catálogo estático
 	empresa, ok := domain.BuscarEmpresaPorID(req.Presentacion.EmpresaID)
 	if !ok {
@@ -94,24 +103,70 @@ func (uc *GenerarMemoriaPdfUseCase) Execute(
 		FechaGeneracion:   time.Now().Format(fechaLayout),
 	}
 
-	// 5. Adquirir semáforo con timeout del contexto para limitar concurrencia
+	if formato == dto.FormatoDocx {
+		return uc.renderizarDocx(ctx, data)
+	}
+
+	// 5-9. Adquirir semáforo → renderizar HTML, header y footer → generar PDF
+	return renderizarPdf(ctx, uc.renderer, uc.generator, uc.semaforo, templateName, data)
+}
+
+// renderizarDocx renderiza el mismo template HTML de la memoria y lo convierte a Word.
+// Comparte el semáforo con el PDF porque los diagramas se rasterizan con Gotenberg.
+func (uc *GenerarMemoriaPdfUseCase) renderizarDocx(ctx context.Context, data dto.TemplateData) ([]byte, error) {
 	select {
 	case uc.semaforo <- struct{}{}:
-		// semáforo adquirido
 	case <-ctx.Done():
 		return nil, fmt.Errorf("timeout esperando turno de generación: %w", ctx.Err())
 	}
 	defer func() { <-uc.semaforo }()
 
-	// 6. Renderizar template HTML (el CSS ya está embebido en el template con variables dinámicas)
 	html, err := uc.renderer.Render(templateName, data)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", domain.ErrRenderizadoHtml, err)
 	}
 
+	encabezado := data.Empresa.NombreCompleto
+	if data.NombreProyecto != "" {
+		encabezado += " — " + data.NombreProyecto
+	}
+
+	docxBytes, err := uc.docxGenerator.Generate(ctx, html, encabezado)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrGeneracionDocx, err)
+	}
+	return docxBytes, nil
+}
+
+// renderizarPdf adquiere el semáforo, renderiza el template principal junto con el
+// header y footer de Gotenberg y convierte el resultado a PDF.
+// Compartido por la memoria de un equipo y la memoria de proyecto.
+func renderizarPdf(
+	ctx context.Context,
+	renderer port.HtmlRenderer,
+	generator port.PdfGenerator,
+	semaforo chan struct{},
+	nombreTemplate string,
+	data dto.TemplateData,
+) ([]byte, error) {
+	// 5. Adquirir semáforo con timeout del contexto para limitar concurrencia
+	select {
+	case semaforo <- struct{}{}:
+		// semáforo adquirido
+	case <-ctx.Done():
+		return nil, fmt.Errorf("timeout esperando turno de generación: %w", ctx.Err())
+	}
+	defer func() { <-semaforo }()
+
+	// 6. Renderizar template HTML (el CSS ya está embebido en el template con variables dinámicas)
+	html, err := renderer.Render(nombreTemplate, data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrRenderizadoHtml, err)
+	}
+
 	// 7. Renderizar footer con los datos de la empresa para que las variables
 	// como {{.Empresa.NombreCompleto}} se resuelvan correctamente
-	footerHTML, err := uc.renderer.Render(footerTemplateName, data)
+	footerHTML, err := renderer.Render(footerTemplateName, data)
 	if err != nil {
 		// Si falla el footer, continuar sin él (graceful degradation)
 		footerHTML = ""
@@ -119,14 +174,14 @@ func (uc *GenerarMemoriaPdfUseCase) Execute(
 
 	// 8. Renderizar header con los datos de la empresa para que las variables
 	// como {{.Empresa.NombreCompleto}} se resuelvan correctamente
-	headerHTML, err := uc.renderer.Render(headerTemplateName, data)
+	headerHTML, err := renderer.Render(headerTemplateName, data)
 	if err != nil {
 		// Si falla el header, continuar sin él (graceful degradation)
 		headerHTML = ""
 	}
 
 	// 9. Generar PDF desde HTML con el header y footer renderizados
-	pdfBytes, err := uc.generator.GenerateWithHeaderFooter(ctx, html, headerHTML, footerHTML)
+	pdfBytes, err := generator.GenerateWithHeaderFooter(ctx, html, headerHTML, footerHTML)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", domain.ErrGeneracionPdf, err)
 	}
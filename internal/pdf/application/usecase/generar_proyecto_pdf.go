@@ -0,0 +1,89 @@
+// internal/pdf/application/usecase/generar_proyecto_pdf.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/garfex/calculadora-filtros/internal/pdf/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/pdf/application/port"
+	"github.com/garfex/calculadora-filtros/internal/pdf/domain"
+)
+
+// proyectoTemplateName es el nombre del template HTML para la memoria de un proyecto.
+const proyectoTemplateName = "proyecto_calculo.html"
+
+// GenerarProyectoPdfUseCase genera un solo PDF con el resumen del proyecto y
+// una sección de memoria por circuito, reutilizando los partials de la memoria.
+type GenerarProyectoPdfUseCase struct {
+	renderer  port.HtmlRenderer
+	generator port.PdfGenerator
+	semaforo  chan struct{}
+}
+
+// NewGenerarProyectoPdf crea una nueva instancia del use case con control de concurrencia.
+// maxConcurrent limita el número de generaciones de PDF simultáneas (recomendado: 3).
+func NewGenerarProyectoPdf(
+	renderer port.HtmlRenderer,
+	generator port.PdfGenerator,
+	maxConcurrent int,
+) *GenerarProyectoPdfUseCase {
+	if maxConcurrent <= 0 {
+		maxConcurrent = 3
+	}
+	return &GenerarProyectoPdfUseCase{
+		renderer:  renderer,
+		generator: generator,
+		semaforo:  make(chan struct{}, maxConcurrent),
+	}
+}
+
+// Execute genera la memoria de cálculo del proyecto en PDF.
+// Flujo: resolver empresa → cargar logo → construir TemplateData por circuito →
+// renderizar HTML → generar PDF.
+func (uc *GenerarProyectoPdfUseCase) Execute(
+	ctx context.Context,
+	req dto.PdfProyectoRequest,
+) ([]byte, error) {
+	// 1. Resolver empresa del catálogo estático
+	empresa, ok := domain.BuscarEmpresaPorID(req.Presentacion.EmpresaID)
+	if !ok {
+		return nil, fmt.Errorf("%w: id=%q", domain.ErrEmpresaNoEncontrada, req.Presentacion.EmpresaID)
+	}
+
+	// 2. Cargar logos (graceful degradation, igual que la memoria de un equipo)
+	logoBase64 := cargarLogoBase64(empresa.LogoPath)
+	var logoLetraBase64 string
+	if empresa.ID == "garfex" {
+		logoLetraBase64 = cargarLogoBase64("assets/logos/lg.png")
+	}
+
+	fecha := time.Now().Format(fechaLayout)
+	base := dto.TemplateData{
+		Empresa:           empresa,
+		LogoBase64:        logoBase64,
+		LogoLetraBase64:   logoLetraBase64,
+		NombreProyecto:    req.Presentacion.NombreProyecto,
+		DireccionProyecto: req.Presentacion.DireccionProyecto,
+		Responsable:       req.Presentacion.Responsable,
+		FechaGeneracion:   fecha,
+	}
+
+	// 3. Una TemplateData por circuito: el nombre del circuito sustituye al del equipo
+	circuitos := make([]dto.TemplateData, 0, len(req.Proyecto.Circuitos))
+	for _, c := range req.Proyecto.Circuitos {
+		circuito := base
+		circuito.NombreEquipo = c.Nombre
+		circuito.Memoria = c.Memoria
+		circuitos = append(circuitos, circuito)
+	}
+
+	data := base
+	resumen := req.Proyecto.Resumen
+	data.ResumenProyecto = &resumen
+	data.Circuitos = circuitos
+
+	// 4. Adquirir semáforo → renderizar HTML, header y footer → generar PDF
+	return renderizarPdf(ctx, uc.renderer, uc.generator, uc.semaforo, proyectoTemplateName, data)
+}
@@ -12,4 +12,13 @@ var (
 
 	// ErrRenderizadoHtml se retorna cuando falla el renderizado del template HTML.
 	ErrRenderizadoHtml = errors.New("error al renderizar el HTML")
+
+	// ErrGeneracionXlsx se retorna cuando falla la escritura del libro de Excel.
+	ErrGeneracionXlsx = errors.New("error al generar el libro de Excel")
+
+	// ErrGeneracionDocx se retorna cuando falla la conversión de HTML a documento Word.
+	ErrGeneracionDocx = errors.New("error al generar el documento Word")
+
+	// ErrFormatoInvalido se retorna cuando el formato solicitado no es pdf ni docx.
+	ErrFormatoInvalido = errors.New("formato de documento no soportado")
 )
@@ -13,6 +13,8 @@ var TemplatesFS embed.FS
 
 // AssetsFS contiene los assets estáticos del módulo PDF (logos, imágenes).
 // Los logos se leen y codifican en base64 en el use case para incrustarlos en el HTML.
+// Solo se incrustan los PNG: los metadatos de Windows (*:Zone.Identifier) no son
+// nombres válidos para embed.
 //
-//go:embed assets
+//go:embed assets/logos/*.png
 var AssetsFS embed.FS
@@ -0,0 +1,209 @@
+// internal/pdf/geometry/cable_multiconductor.go
+package geometry
+
+import (
+	"fmt"
+	"math"
+)
+
+// ============================================================================
+// CÁLCULO DE POSICIONES PARA CABLE MULTICONDUCTOR (TC / MC)
+// ============================================================================
+
+// fraccionNucleoMulticonductor es la fracción del radio exterior ocupada por los
+// conductores aislados; el resto es la cubierta o armadura (referencia visual).
+const fraccionNucleoMulticonductor = 0.8
+
+// ParametrosCharolaMulticonductor contiene los parámetros para el cálculo de
+// posiciones de cables multiconductores en charola.
+type ParametrosCharolaMulticonductor struct {
+	// Diámetro exterior del cable multiconductor en mm.
+	DiametroCableMM float64
+	// Número de cables (uno por juego de conductores en paralelo).
+	NumCables int
+	// Espaciado indica cables separados un diámetro; false = cables juntos.
+	Espaciado bool
+	// Diámetro opcional del cable de control en mm.
+	DiametroControlMM *float64
+	// Ancho comercial de la charola en mm (para centrado).
+	AnchoComercialMM float64
+	// Sistema eléctrico: define los conductores dentro de cada cable.
+	SistemaElectrico SistemaElectrico
+}
+
+// ParametrosTuberiaMulticonductor contiene los parámetros para el cálculo de
+// posiciones de cables multiconductores dentro de una tubería.
+type ParametrosTuberiaMulticonductor struct {
+	// Diámetro interior de la tubería en mm.
+	DiametroInteriorMM float64
+	// Diámetro exterior del cable multiconductor en mm.
+	DiametroCableMM float64
+	// Número de cables en el tubo.
+	NumCables int
+	// Sistema eléctrico: define los conductores dentro de cada cable.
+	SistemaElectrico SistemaElectrico
+}
+
+// CalcularPosicionesCharolaMulticonductor calcula las posiciones de cables
+// multiconductores apoyados sobre el fondo de la charola.
+//
+// Cada cable se dibuja como su cubierta exterior (tipo multiconductor, sin etiqueta)
+// más los conductores aislados que contiene (A, B, C, N según el sistema) y la
+// tierra de fábrica en el intersticio exterior:
+//
+//	 T ( C )
+//	( A ) ( B )
+//
+// Reglas:
+//   - Cables espaciados: 1 diámetro libre entre cables (centro-a-centro = 2 × diámetro)
+//   - Cables juntos: se tocan (centro-a-centro = diámetro)
+//   - Cable de control: después del último cable con 1 diámetro de espaciado
+//
+// Las posiciones usan la convención de la distribución "triangular": CX desde la pared
+// izquierda interna y CY como elevación del borde inferior de cada círculo sobre el fondo.
+func CalcularPosicionesCharolaMulticonductor(params ParametrosCharolaMulticonductor) []ConductorPosicion {
+	posiciones := make([]ConductorPosicion, 0, params.NumCables*6+1)
+	if params.NumCables <= 0 || params.DiametroCableMM <= 0 {
+		return posiciones
+	}
+
+	radioCable := params.DiametroCableMM / 2
+	paso := params.DiametroCableMM
+	if params.Espaciado {
+		paso *= 2
+	}
+
+	totalWidth := float64(params.NumCables-1)*paso + params.DiametroCableMM
+	if params.DiametroControlMM != nil && *params.DiametroControlMM > 0 {
+		totalWidth += 2 * *params.DiametroControlMM // gap + cable
+	}
+	offsetX := (params.AnchoComercialMM - totalWidth) / 2
+
+	for idx := 0; idx < params.NumCables; idx++ {
+		cx := offsetX + radioCable + float64(idx)*paso
+		for _, pos := range posicionesCableMulticonductor(radioCable, params.SistemaElectrico, params.NumCables, idx) {
+			// Centro con +Y arriba → elevación del borde inferior sobre el fondo
+			pos.CY = radioCable + pos.CY - pos.Radio
+			pos.CX += cx
+			posiciones = append(posiciones, pos)
+		}
+	}
+
+	if params.DiametroControlMM != nil && *params.DiametroControlMM > 0 {
+		diametroControl := *params.DiametroControlMM
+		ultimoCX := offsetX + radioCable + float64(params.NumCables-1)*paso
+		posiciones = append(posiciones, ConductorPosicion{
+			CX:       ultimoCX + radioCable + diametroControl + diametroControl/2,
+			CY:       0,
+			Radio:    diametroControl / 2,
+			Color:    Colores["control"],
+			Etiqueta: "Ctrl",
+			Tipo:     TipoConductorControl,
+		})
+	}
+
+	return posiciones
+}
+
+// CalcularPosicionesTuberiaMulticonductor calcula las posiciones de cables
+// multiconductores dentro de una tubería.
+//
+// Los cables se apoyan sobre la pared interior, tocándose entre sí, repartidos
+// simétricamente a partir del fondo del tubo. Sistema de coordenadas igual que
+// CalcularPosicionesTuberia: centro del tubo en (0, 0), +Y = abajo.
+func CalcularPosicionesTuberiaMulticonductor(params ParametrosTuberiaMulticonductor) []ConductorPosicion {
+	posiciones := make([]ConductorPosicion, 0, params.NumCables*6)
+	R := params.DiametroInteriorMM / 2
+	radioCable := params.DiametroCableMM / 2
+	if params.NumCables <= 0 || radioCable <= 0 || radioCable > R {
+		return posiciones
+	}
+
+	// Ángulo entre centros de dos cables que se tocan sobre la pared
+	d := R - radioCable
+	paso := 0.0
+	if d > 0 {
+		paso = 2 * math.Asin(math.Min(1, radioCable/d))
+	}
+	if float64(params.NumCables)*paso > 2*math.Pi {
+		paso = 2 * math.Pi / float64(params.NumCables)
+	}
+
+	for idx := 0; idx < params.NumCables; idx++ {
+		theta := (float64(idx) - float64(params.NumCables-1)/2) * paso
+		cx, cy := d*math.Sin(theta), d*math.Cos(theta)
+		for _, pos := range posicionesCableMulticonductor(radioCable, params.SistemaElectrico, params.NumCables, idx) {
+			pos.CX += cx
+			pos.CY = cy - pos.CY // +Y arriba → +Y abajo
+			posiciones = append(posiciones, pos)
+		}
+	}
+
+	return posiciones
+}
+
+// posicionesCableMulticonductor genera la cubierta y los conductores de un cable con
+// centro en (0, 0) y +Y arriba. Los k conductores aislados se tocan entre sí y con el
+// núcleo de radio R_n = 0.8·R: r = R_n·sin(π/k) / (1 + sin(π/k)). La tierra (0.4·r)
+// ocupa el intersticio entre el último conductor y el primero.
+func posicionesCableMulticonductor(radioCable float64, sistema SistemaElectrico, numCables, cableIdx int) []ConductorPosicion {
+	type conductorInterno struct {
+		etiqueta string
+		tipo     TipoConductor
+	}
+	fases := []string{"A", "B", "C"}[:sistema.CantidadFases()]
+	internos := make([]conductorInterno, 0, 4)
+	for _, f := range fases {
+		internos = append(internos, conductorInterno{etiquetaFase(numCables, cableIdx, f), TipoConductorFase})
+	}
+	if sistema.NecesitaNeutro() {
+		internos = append(internos, conductorInterno{etiquetaNeutro(numCables, cableIdx), TipoConductorNeutro})
+	}
+
+	posiciones := []ConductorPosicion{{
+		Radio: radioCable,
+		Color: Colores["multiconductor"],
+		Tipo:  TipoConductorMulticonductor,
+	}}
+
+	k := float64(len(internos))
+	radioNucleo := fraccionNucleoMulticonductor * radioCable
+	seno := math.Sin(math.Pi / k)
+	radioConductor := radioNucleo * seno / (1 + seno)
+	distancia := radioNucleo - radioConductor
+
+	// El primer conductor abajo a la izquierda: con 3 y 4 conductores la base queda horizontal
+	anguloInicial := 3*math.Pi/2 - math.Pi/k
+	for j, c := range internos {
+		angulo := anguloInicial + float64(j)*2*math.Pi/k
+		color := Colores["fase"]
+		if c.tipo == TipoConductorNeutro {
+			color = Colores["neutro"]
+		}
+		posiciones = append(posiciones, ConductorPosicion{
+			CX:       distancia * math.Cos(angulo),
+			CY:       distancia * math.Sin(angulo),
+			Radio:    radioConductor,
+			Color:    color,
+			Etiqueta: c.etiqueta,
+			Tipo:     c.tipo,
+		})
+	}
+
+	radioTierra := 0.4 * radioConductor
+	anguloTierra := anguloInicial + (k-0.5)*2*math.Pi/k
+	etiquetaTierra := "T"
+	if numCables > 1 {
+		etiquetaTierra = fmt.Sprintf("T%d", cableIdx+1)
+	}
+	posiciones = append(posiciones, ConductorPosicion{
+		CX:       (radioNucleo - radioTierra) * math.Cos(anguloTierra),
+		CY:       (radioNucleo - radioTierra) * math.Sin(anguloTierra),
+		Radio:    radioTierra,
+		Color:    Colores["tierra"],
+		Etiqueta: etiquetaTierra,
+		Tipo:     TipoConductorTierra,
+	})
+
+	return posiciones
+}
@@ -0,0 +1,363 @@
+// internal/pdf/geometry/dxf.go
+package geometry
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// ============================================================================
+// EXPORTACIÓN DXF (AutoCAD R12, ASCII)
+// ============================================================================
+//
+// Los diagramas DXF usan la misma geometría que los SVG pero en coordenadas de
+// dibujo: milímetros reales con el eje Y hacia arriba (en SVG crece hacia abajo).
+// Se escribe DXF R12 (AC1009) porque lo abre cualquier versión de AutoCAD y no
+// requiere handles ni objetos.
+
+// Capas del dibujo DXF.
+const (
+	CapaCanalizacion = "CANALIZACION"
+	CapaConductores  = "CONDUCTORES"
+	CapaEtiquetas    = "ETIQUETAS"
+	CapaCotas        = "COTAS"
+)
+
+// Colores ACI (AutoCAD Color Index) por capa y por tipo de conductor.
+const (
+	colorACIAzul     = 5
+	colorACIAmarillo = 2
+	colorACIVerde    = 3
+	colorACICian     = 4
+	colorACIGris     = 8
+	colorACIBlanco   = 7
+)
+
+// AlturaTextoCotaMM es la altura de texto de cotas (norma de dibujo: 2.5 mm).
+const AlturaTextoCotaMM = 2.5
+
+// capasDXF define el orden y color de las capas en la tabla LAYER.
+var capasDXF = []struct {
+	nombre string
+	color  int
+}{
+	{CapaCanalizacion, colorACIAzul},
+	{CapaConductores, colorACIBlanco},
+	{CapaEtiquetas, colorACIBlanco},
+	{CapaCotas, colorACIGris},
+}
+
+// colorACIConductor asigna el color ACI según el tipo de conductor, con la misma
+// convención que la paleta Colores del SVG.
+func colorACIConductor(tipo TipoConductor) int {
+	switch tipo {
+	case TipoConductorFase:
+		return colorACIAmarillo
+	case TipoConductorTierra:
+		return colorACIVerde
+	case TipoConductorNeutro:
+		return colorACIGris
+	case TipoConductorControl:
+		return colorACICian
+	default:
+		return colorACIBlanco
+	}
+}
+
+// dibujoDXF acumula entidades y la extensión del dibujo.
+type dibujoDXF struct {
+	entidades              strings.Builder
+	minX, minY, maxX, maxY float64
+}
+
+func nuevoDibujoDXF() *dibujoDXF {
+	return &dibujoDXF{
+		minX: math.Inf(1), minY: math.Inf(1),
+		maxX: math.Inf(-1), maxY: math.Inf(-1),
+	}
+}
+
+func (d *dibujoDXF) extender(x, y float64) {
+	d.minX, d.maxX = math.Min(d.minX, x), math.Max(d.maxX, x)
+	d.minY, d.maxY = math.Min(d.minY, y), math.Max(d.maxY, y)
+}
+
+func (d *dibujoDXF) grupo(codigo int, valor string) {
+	fmt.Fprintf(&d.entidades, "%d\n%s\n", codigo, valor)
+}
+
+func (d *dibujoDXF) real(codigo int, valor float64) {
+	d.grupo(codigo, formatoRealDXF(valor))
+}
+
+// linea agrega una entidad LINE.
+func (d *dibujoDXF) linea(capa string, x1, y1, x2, y2 float64) {
+	d.grupo(0, "LINE")
+	d.grupo(8, capa)
+	d.real(10, x1)
+	d.real(20, y1)
+	d.real(11, x2)
+	d.real(21, y2)
+	d.extender(x1, y1)
+	d.extender(x2, y2)
+}
+
+// polilinea agrega una secuencia de LINE entre vértices consecutivos.
+func (d *dibujoDXF) polilinea(capa string, puntos ...[2]float64) {
+	for i := 1; i < len(puntos); i++ {
+		d.linea(capa, puntos[i-1][0], puntos[i-1][1], puntos[i][0], puntos[i][1])
+	}
+}
+
+// circulo agrega una entidad CIRCLE; color 0 usa el color de la capa (BYLAYER).
+func (d *dibujoDXF) circulo(capa string, cx, cy, r float64, color int) {
+	d.grupo(0, "CIRCLE")
+	d.grupo(8, capa)
+	if color > 0 {
+		d.grupo(62, fmt.Sprintf("%d", color))
+	}
+	d.real(10, cx)
+	d.real(20, cy)
+	d.real(40, r)
+	d.extender(cx-r, cy-r)
+	d.extender(cx+r, cy+r)
+}
+
+// texto agrega una entidad TEXT centrada horizontalmente en (x, y).
+// medio=true centra también verticalmente (etiquetas dentro de conductores).
+func (d *dibujoDXF) texto(capa string, x, y, altura float64, valor string, medio bool) {
+	d.grupo(0, "TEXT")
+	d.grupo(8, capa)
+	d.real(10, x)
+	d.real(20, y)
+	d.real(40, altura)
+	d.grupo(1, valor)
+	d.grupo(72, "1")
+	d.real(11, x)
+	d.real(21, y)
+	if medio {
+		d.grupo(73, "2")
+	}
+	d.extender(x, y)
+}
+
+// cotaHorizontal dibuja la línea de cota con sus marcas y el texto arriba (o abajo).
+func (d *dibujoDXF) cotaHorizontal(x1, x2, y float64, valor string, textoArriba bool) {
+	marca := 2.0
+	d.linea(CapaCotas, x1, y, x2, y)
+	d.linea(CapaCotas, x1, y-marca, x1, y+marca)
+	d.linea(CapaCotas, x2, y-marca, x2, y+marca)
+	ty := y + 1.5
+	if !textoArriba {
+		ty = y - 1.5 - AlturaTextoCotaMM
+	}
+	d.texto(CapaCotas, (x1+x2)/2, ty, AlturaTextoCotaMM, valor, false)
+}
+
+// cotaVertical dibuja la línea de cota vertical con el texto a la derecha.
+func (d *dibujoDXF) cotaVertical(x, y1, y2 float64, valor string) {
+	marca := 2.0
+	d.linea(CapaCotas, x, y1, x, y2)
+	d.linea(CapaCotas, x-marca, y1, x+marca, y1)
+	d.linea(CapaCotas, x-marca, y2, x+marca, y2)
+	// Texto alineado a la izquierda: el punto de inserción basta (sin grupo 72)
+	d.grupo(0, "TEXT")
+	d.grupo(8, CapaCotas)
+	d.real(10, x+3)
+	d.real(20, (y1+y2)/2-AlturaTextoCotaMM/2)
+	d.real(40, AlturaTextoCotaMM)
+	d.grupo(1, valor)
+	d.extender(x+3+float64(len(valor))*AlturaTextoCotaMM*0.7, (y1+y2)/2)
+}
+
+// String escribe el archivo DXF completo: HEADER, TABLES (LTYPE y LAYER), ENTITIES.
+func (d *dibujoDXF) String() string {
+	if math.IsInf(d.minX, 1) {
+		d.minX, d.minY, d.maxX, d.maxY = 0, 0, 0, 0
+	}
+
+	var sb strings.Builder
+	g := func(codigo int, valor string) { fmt.Fprintf(&sb, "%d\n%s\n", codigo, valor) }
+	r := func(codigo int, valor float64) { g(codigo, formatoRealDXF(valor)) }
+
+	g(0, "SECTION")
+	g(2, "HEADER")
+	g(9, "$ACADVER")
+	g(1, "AC1009")
+	g(9, "$EXTMIN")
+	r(10, d.minX)
+	r(20, d.minY)
+	g(9, "$EXTMAX")
+	r(10, d.maxX)
+	r(20, d.maxY)
+	g(0, "ENDSEC")
+
+	g(0, "SECTION")
+	g(2, "TABLES")
+	g(0, "TABLE")
+	g(2, "LTYPE")
+	g(70, "1")
+	g(0, "LTYPE")
+	g(2, "CONTINUOUS")
+	g(70, "0")
+	g(3, "Solid line")
+	g(72, "65")
+	g(73, "0")
+	r(40, 0)
+	g(0, "ENDTAB")
+	g(0, "TABLE")
+	g(2, "LAYER")
+	g(70, fmt.Sprintf("%d", len(capasDXF)))
+	for _, c := range capasDXF {
+		g(0, "LAYER")
+		g(2, c.nombre)
+		g(70, "0")
+		g(62, fmt.Sprintf("%d", c.color))
+		g(6, "CONTINUOUS")
+	}
+	g(0, "ENDTAB")
+	g(0, "ENDSEC")
+
+	g(0, "SECTION")
+	g(2, "ENTITIES")
+	sb.WriteString(d.entidades.String())
+	g(0, "ENDSEC")
+	g(0, "EOF")
+
+	return sb.String()
+}
+
+// formatoRealDXF formatea un real con 4 decimales sin ceros sobrantes.
+func formatoRealDXF(v float64) string {
+	s := strings.TrimRight(fmt.Sprintf("%.4f", v), "0")
+	s = strings.TrimSuffix(s, ".")
+	if s == "-0" || s == "" {
+		return "0"
+	}
+	return s
+}
+
+// alturaEtiquetaConductor usa la misma proporción que el SVG (0.8 × radio) con un mínimo legible.
+func alturaEtiquetaConductor(radio float64) float64 {
+	return math.Max(radio*0.8, 1.5)
+}
+
+// ParametrosDXFCharola contiene los parámetros para generar el DXF de una charola.
+type ParametrosDXFCharola struct {
+	// Posiciones de los conductores (mismas que recibe el SVG).
+	Posiciones []ConductorPosicion
+	// AnchoComercialMM es el ancho interior de la charola.
+	AnchoComercialMM float64
+	// PeralteMM es la altura de la charola (default: PeralteCharolaMM).
+	PeralteMM float64
+	// TipoDistribucion: "espaciada" o "triangular".
+	TipoDistribucion string
+	// Cotas opcionales de CalcularCotasCharola (coordenadas con Y hacia abajo desde el borde superior).
+	Cotas []LineaCota
+}
+
+// GenerarDXFCharola genera el DXF de la sección transversal de una charola.
+//
+// El origen (0, 0) es la esquina interior inferior izquierda; el fondo de la
+// charola está en Y = 0 y los conductores descansan sobre él.
+//
+// Returns:
+//   - String con el archivo DXF completo.
+func GenerarDXFCharola(params ParametrosDXFCharola) string {
+	d := nuevoDibujoDXF()
+
+	ancho := params.AnchoComercialMM
+	if ancho == 0 {
+		ancho = 150
+	}
+	peralte := params.PeralteMM
+	if peralte == 0 {
+		peralte = PeralteCharolaMM
+	}
+	brida := float64(AnchoBridaCharolaMM)
+
+	// Perfil U con bridas
+	d.polilinea(CapaCanalizacion,
+		[2]float64{-brida, peralte},
+		[2]float64{0, peralte},
+		[2]float64{0, 0},
+		[2]float64{ancho, 0},
+		[2]float64{ancho, peralte},
+		[2]float64{ancho + brida, peralte},
+	)
+
+	// Conductores: en triangular CY es la elevación sobre el fondo
+	for _, cond := range params.Posiciones {
+		cy := cond.Radio
+		if params.TipoDistribucion == "triangular" {
+			cy += cond.CY
+		}
+		d.circulo(CapaConductores, cond.CX, cy, cond.Radio, colorACIConductor(cond.Tipo))
+		if cond.Etiqueta != "" {
+			d.texto(CapaEtiquetas, cond.CX, cy, alturaEtiquetaConductor(cond.Radio), cond.Etiqueta, true)
+		}
+	}
+
+	// Cotas: ancho comercial/requerido (si se proporcionan) y peralte
+	if len(params.Cotas) == 0 {
+		d.cotaHorizontal(0, ancho, peralte+15, fmt.Sprintf("%.1f mm", ancho), true)
+	}
+	for _, c := range params.Cotas {
+		if c.Y1 == c.Y2 {
+			d.cotaHorizontal(c.X1, c.X2, peralte-c.Y1, c.Texto, c.PosicionTexto != "abajo")
+		} else {
+			d.cotaVertical(c.X1, peralte-c.Y1, peralte-c.Y2, c.Texto)
+		}
+	}
+	d.cotaVertical(ancho+brida+10, 0, peralte, fmt.Sprintf("%.0f mm", peralte))
+
+	return d.String()
+}
+
+// GenerarDXFTuberia genera el DXF de la sección transversal de uno o varios tubos.
+//
+// El centro del primer tubo está en el origen; los tubos se repiten hacia la
+// derecha separados por espacioEntreTubos, igual que en GenerarSVGCompletoTuberia.
+//
+// Returns:
+//   - String con el archivo DXF completo.
+func GenerarDXFTuberia(posiciones []ConductorPosicion, diametroInterior, diametroExterior float64, numTubos int, espacioEntreTubos float64) string {
+	d := nuevoDibujoDXF()
+
+	if numTubos <= 0 {
+		numTubos = 1
+	}
+	if espacioEntreTubos <= 0 {
+		espacioEntreTubos = DefaultParametrosSVGTuberia.EspacioEntreTubosMM
+	}
+	radioInterior := diametroInterior / 2
+	radioExterior := diametroExterior / 2
+
+	for idx := 0; idx < numTubos; idx++ {
+		centroX := float64(idx) * (diametroExterior + espacioEntreTubos)
+
+		// Pared del tubo: diámetro interior y exterior
+		d.circulo(CapaCanalizacion, centroX, 0, radioInterior, 0)
+		d.circulo(CapaCanalizacion, centroX, 0, radioExterior, 0)
+
+		// Conductores (CY del SVG crece hacia abajo)
+		for _, cond := range posiciones {
+			cx, cy := centroX+cond.CX, -cond.CY
+			d.circulo(CapaConductores, cx, cy, cond.Radio, colorACIConductor(cond.Tipo))
+			if cond.Etiqueta != "" {
+				d.texto(CapaEtiquetas, cx, cy, alturaEtiquetaConductor(cond.Radio), cond.Etiqueta, true)
+			}
+		}
+
+		// Cota del diámetro exterior bajo el tubo
+		cotaY := -radioExterior - 10
+		d.cotaHorizontal(centroX-radioExterior, centroX+radioExterior, cotaY, fmt.Sprintf("%%%%c %.1f mm", diametroExterior), false)
+
+		if numTubos > 1 {
+			d.texto(CapaCotas, centroX, cotaY-2*AlturaTextoCotaMM-4, AlturaTextoCotaMM, fmt.Sprintf("Tubo %d de %d", idx+1, numTubos), false)
+		}
+	}
+
+	return d.String()
+}
@@ -21,18 +21,19 @@ const AnchoBridaCharolaMM = 15
 
 // Colores es la paleta de colores estilo CAD para diagramas SVG.
 var Colores = map[string]string{
-	"fase":          "#D4AF37",
-	"tierra":        "#28A745",
-	"neutro":        "#6B7280",
-	"control":       "#3B82F6",
-	"charolaStroke": "#004085",
-	"charolaFill":   "url(#charola-hatch)",
-	"tuboStroke":    "#555555",
-	"tuboFill":      "#E8E8E8",
-	"cotaLinea":     "#374151",
-	"cotaTexto":     "#6B7280",
-	"titleBlock":    "#1F2937",
-	"fondo":         "#FFFFFF",
+	"fase":           "#D4AF37",
+	"tierra":         "#28A745",
+	"neutro":         "#6B7280",
+	"control":        "#3B82F6",
+	"multiconductor": "#111827",
+	"charolaStroke":  "#004085",
+	"charolaFill":    "url(#charola-hatch)",
+	"tuboStroke":     "#555555",
+	"tuboFill":       "#E8E8E8",
+	"cotaLinea":      "#374151",
+	"cotaTexto":      "#6B7280",
+	"titleBlock":     "#1F2937",
+	"fondo":          "#FFFFFF",
 }
 
 // ============================================================================
@@ -60,6 +61,8 @@ const (
 	TipoConductorTierra  TipoConductor = "tierra"
 	TipoConductorNeutro  TipoConductor = "neutro"
 	TipoConductorControl TipoConductor = "control"
+	// TipoConductorMulticonductor es la cubierta exterior de un cable TC/MC.
+	TipoConductorMulticonductor TipoConductor = "multiconductor"
 )
 
 // ErrSistemaElectricoInvalido es el error returned when SistemaElectrico es inválido.
@@ -119,7 +122,7 @@ type ConductorPosicion struct {
 	Color string `json:"color"`
 	// Etiqueta es la etiqueta del conductor: "A", "B", "C", "N", "T", "Ctrl1".
 	Etiqueta string `json:"etiqueta"`
-	// Tipo es el tipo de conductor (fase, neutro, tierra, control).
+	// Tipo es el tipo de conductor (fase, neutro, tierra, control, multiconductor).
 	Tipo TipoConductor `json:"tipo"`
 }
 
@@ -3,6 +3,7 @@ package geometry
 
 import (
 	"math"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -1238,3 +1239,168 @@ func TestIntegration_CharolaTriangularAncho_ESTRELLA(t *testing.T) {
 	// ESTRELLA triangular: same width as DELTA since A-B-T same positions
 	assert.InDelta(t, anchoOcupado, 63.6, 1.0)
 }
+
+// ============================================================================
+// Tests: Cable multiconductor
+// ============================================================================
+
+func TestCalcularPosicionesCharolaMulticonductor_Espaciado(t *testing.T) {
+	posiciones := CalcularPosicionesCharolaMulticonductor(ParametrosCharolaMulticonductor{
+		DiametroCableMM:  42.2,
+		NumCables:        2,
+		Espaciado:        true,
+		AnchoComercialMM: 304.8,
+		SistemaElectrico: SistemaElectricoDelta,
+	})
+
+	// Por cable: cubierta + A, B, C + tierra
+	require.Len(t, posiciones, 10)
+	cubierta1, cubierta2 := posiciones[0], posiciones[5]
+	assert.Equal(t, TipoConductorMulticonductor, cubierta1.Tipo)
+	assert.Empty(t, cubierta1.Etiqueta)
+	assert.InDelta(t, 0, cubierta1.CY, 1e-9)
+	assert.InDelta(t, 2*42.2, cubierta2.CX-cubierta1.CX, 1e-9)
+	// Centrados: 42.2 + 84.4 = 126.6 mm en 304.8 mm
+	assert.InDelta(t, (304.8-126.6)/2+21.1, cubierta1.CX, 1e-9)
+	assert.InDelta(t, 126.6, CalcularAnchoOcupadoCharola(posiciones), 1e-9)
+
+	for _, etiqueta := range []string{"A1", "B1", "C1", "T1", "A2", "T2"} {
+		assert.NotNil(t, findConductor(posiciones, etiqueta), etiqueta)
+	}
+
+	// Conductores internos dentro de la cubierta y sin traslaparse
+	centro := cubierta1.CY + cubierta1.Radio
+	internos := posiciones[1:5]
+	for i, c := range internos {
+		d := distance(cubierta1.CX, centro, c.CX, c.CY+c.Radio)
+		assert.LessOrEqual(t, d+c.Radio, cubierta1.Radio+1e-9, c.Etiqueta)
+		for _, o := range internos[i+1:] {
+			dd := distance(c.CX, c.CY+c.Radio, o.CX, o.CY+o.Radio)
+			assert.GreaterOrEqual(t, dd, c.Radio+o.Radio-1e-9, c.Etiqueta+"-"+o.Etiqueta)
+		}
+	}
+
+	// A y B en la base a la misma altura; C arriba
+	a, b, c := findConductor(posiciones, "A1"), findConductor(posiciones, "B1"), findConductor(posiciones, "C1")
+	assert.InDelta(t, a.CY, b.CY, 1e-9)
+	assert.Greater(t, c.CY, a.CY)
+}
+
+func TestCalcularPosicionesCharolaMulticonductor_JuntosConControl(t *testing.T) {
+	control := 10.0
+	posiciones := CalcularPosicionesCharolaMulticonductor(ParametrosCharolaMulticonductor{
+		DiametroCableMM:   30,
+		NumCables:         2,
+		DiametroControlMM: &control,
+		AnchoComercialMM:  152.4,
+		SistemaElectrico:  SistemaElectricoEstrella,
+	})
+
+	// 2 × (cubierta + A, B, C, N + tierra) + control
+	require.Len(t, posiciones, 13)
+	assert.InDelta(t, 30, posiciones[6].CX-posiciones[0].CX, 1e-9)
+	assert.NotNil(t, findConductor(posiciones, "N2"))
+	ctrl := findConductor(posiciones, "Ctrl")
+	require.NotNil(t, ctrl)
+	// 60 mm de cables + 10 mm libre + 10 mm de control
+	assert.InDelta(t, 80, CalcularAnchoOcupadoCharola(posiciones), 1e-9)
+}
+
+func TestCalcularPosicionesTuberiaMulticonductor(t *testing.T) {
+	R := 40.0
+	posiciones := CalcularPosicionesTuberiaMulticonductor(ParametrosTuberiaMulticonductor{
+		DiametroInteriorMM: 2 * R,
+		DiametroCableMM:    30,
+		NumCables:          2,
+		SistemaElectrico:   SistemaElectricoMonofasico,
+	})
+
+	// Por cable: cubierta + A, N + tierra
+	require.Len(t, posiciones, 8)
+	c1, c2 := posiciones[0], posiciones[4]
+	// Ambos sobre la pared inferior y tocándose
+	assert.InDelta(t, R-15, distFromOrigin(c1.CX, c1.CY), 1e-9)
+	assert.InDelta(t, R-15, distFromOrigin(c2.CX, c2.CY), 1e-9)
+	assert.InDelta(t, 30, distance(c1.CX, c1.CY, c2.CX, c2.CY), 1e-9)
+	assert.InDelta(t, -c1.CX, c2.CX, 1e-9)
+	assert.Positive(t, c1.CY) // +Y = abajo
+
+	for _, pos := range posiciones {
+		assert.LessOrEqual(t, distFromOrigin(pos.CX, pos.CY)+pos.Radio, R+1e-9, pos.Etiqueta)
+	}
+	assert.NotNil(t, findConductor(posiciones, "N1"))
+}
+
+func TestCalcularPosicionesTuberiaMulticonductor_CableMayorQueTubo(t *testing.T) {
+	posiciones := CalcularPosicionesTuberiaMulticonductor(ParametrosTuberiaMulticonductor{
+		DiametroInteriorMM: 20, DiametroCableMM: 30, NumCables: 1, SistemaElectrico: SistemaElectricoDelta,
+	})
+	assert.Empty(t, posiciones)
+}
+
+// ============================================================================
+// DXF
+// ============================================================================
+
+func TestGenerarDXFCharola_EstructuraYCapas(t *testing.T) {
+	posiciones := []ConductorPosicion{
+		{CX: 20, CY: 0, Radio: 10, Etiqueta: "A", Tipo: TipoConductorFase},
+		{CX: 50, CY: 0, Radio: 5, Etiqueta: "T", Tipo: TipoConductorTierra},
+	}
+	dxf := GenerarDXFCharola(ParametrosDXFCharola{
+		Posiciones:       posiciones,
+		AnchoComercialMM: 150,
+		TipoDistribucion: "espaciada",
+		Cotas:            CalcularCotasCharola(150, 2100, PeralteCharolaMM),
+	})
+
+	assert.Contains(t, dxf, "0\nSECTION\n2\nHEADER\n9\n$ACADVER\n1\nAC1009\n")
+	assert.True(t, strings.HasSuffix(dxf, "0\nEOF\n"))
+	for _, capa := range []string{CapaCanalizacion, CapaConductores, CapaEtiquetas, CapaCotas} {
+		assert.Contains(t, dxf, "0\nLAYER\n2\n"+capa+"\n")
+	}
+
+	// Conductor A descansa sobre el fondo (Y = radio) con color ACI de fase
+	assert.Contains(t, dxf, "0\nCIRCLE\n8\nCONDUCTORES\n62\n2\n10\n20\n20\n10\n40\n10\n")
+	assert.Contains(t, dxf, "0\nCIRCLE\n8\nCONDUCTORES\n62\n3\n10\n50\n20\n5\n40\n5\n")
+	// Perfil: 5 segmentos en la capa de canalización
+	assert.Equal(t, 5, strings.Count(dxf, "0\nLINE\n8\nCANALIZACION\n"))
+	// Cotas de ancho comercial, requerido (30 mm) y peralte
+	assert.Contains(t, dxf, "150.0 mm (5.9\")")
+	assert.Contains(t, dxf, "30.0 mm (1.2\")")
+	assert.Contains(t, dxf, "70 mm")
+}
+
+func TestGenerarDXFCharola_TriangularElevaConductores(t *testing.T) {
+	dxf := GenerarDXFCharola(ParametrosDXFCharola{
+		Posiciones:       []ConductorPosicion{{CX: 30, CY: 17.32, Radio: 10, Etiqueta: "C", Tipo: TipoConductorFase}},
+		AnchoComercialMM: 150,
+		TipoDistribucion: "triangular",
+	})
+
+	assert.Contains(t, dxf, "10\n30\n20\n27.32\n40\n10\n")
+}
+
+func TestGenerarDXFTuberia_VariosTubos(t *testing.T) {
+	posiciones := []ConductorPosicion{
+		{CX: -5, CY: 4, Radio: 4, Etiqueta: "A", Tipo: TipoConductorFase},
+	}
+	dxf := GenerarDXFTuberia(posiciones, 40, 48, 2, 30)
+
+	// Dos tubos con diámetro interior y exterior cada uno
+	assert.Equal(t, 4, strings.Count(dxf, "0\nCIRCLE\n8\nCANALIZACION\n"))
+	assert.Contains(t, dxf, "10\n78\n20\n0\n40\n24\n")
+	// CY del SVG crece hacia abajo: en DXF queda negativo
+	assert.Contains(t, dxf, "10\n-5\n20\n-4\n40\n4\n")
+	assert.Contains(t, dxf, "10\n73\n20\n-4\n40\n4\n")
+	assert.Contains(t, dxf, "%%c 48.0 mm")
+	assert.Contains(t, dxf, "Tubo 2 de 2")
+}
+
+func TestFormatoRealDXF(t *testing.T) {
+	assert.Equal(t, "0", formatoRealDXF(0))
+	assert.Equal(t, "0", formatoRealDXF(-0.00001))
+	assert.Equal(t, "12.5", formatoRealDXF(12.5))
+	assert.Equal(t, "-3", formatoRealDXF(-3))
+	assert.Equal(t, "1.2346", formatoRealDXF(1.23456))
+}
@@ -194,7 +194,10 @@ func GenerarSVGCharola(posiciones []ConductorPosicion, ancho, peralte float64, t
 			cx, cy, cond.Radio, Colores["charolaStroke"],
 		))
 
-		// Etiqueta dentro del círculo
+		// Etiqueta dentro del círculo (la cubierta de un cable multiconductor no lleva)
+		if cond.Etiqueta == "" {
+			continue
+		}
 		fontSize := cond.Radio * 0.8
 		if fontSize < 4 {
 			fontSize = 4
@@ -249,7 +252,10 @@ func GenerarSVGTuberia(posiciones []ConductorPosicion, diametroInterior, diametr
 			cx, cy, cond.Radio, Colores["tuboStroke"],
 		))
 
-		// Etiqueta dentro del círculo
+		// Etiqueta dentro del círculo (la cubierta de un cable multiconductor no lleva)
+		if cond.Etiqueta == "" {
+			continue
+		}
 		fontSize := cond.Radio * 0.9
 		if fontSize < 4 {
 			fontSize = 4
@@ -483,6 +489,9 @@ func GenerarSVGCompletoTuberia(posiciones []ConductorPosicion, diametroInterior,
 				cx, cy, cond.Radio, Colores["tuboStroke"],
 			))
 
+			if cond.Etiqueta == "" {
+				continue
+			}
 			fontSize := cond.Radio * 0.9
 			if fontSize < 4 {
 				fontSize = 4
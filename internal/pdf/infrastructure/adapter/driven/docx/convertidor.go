@@ -0,0 +1,466 @@
+// internal/pdf/infrastructure/adapter/driven/docx/convertidor.go
+package docx
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"image"
+	_ "image/jpeg" // registra el decoder para logos JPEG
+	_ "image/png"  // registra el decoder para logos y diagramas PNG
+	"log"
+	"strings"
+	"unicode"
+
+	"github.com/garfex/calculadora-filtros/internal/pdf/application/port"
+	"golang.org/x/net/html"
+)
+
+const (
+	// emuPorPx convierte píxeles (96 dpi) a EMU de DrawingML.
+	emuPorPx = 9525
+
+	// anchoMaxLogoEMU limita el ancho de las imágenes <img> (logos) a 5 cm.
+	anchoMaxLogoEMU = 1800000
+
+	// anchoMaxDiagramaEMU limita el ancho de los diagramas rasterizados a 15 cm.
+	anchoMaxDiagramaEMU = 5400000
+
+	// anchoTextoTwips es el ancho útil de la página carta con márgenes de 2 cm.
+	anchoTextoTwips = 9972
+)
+
+// formato es el formato de carácter heredado por los nodos de texto.
+type formato struct {
+	negrita bool
+	cursiva bool
+	sub     bool
+	sup     bool
+}
+
+// tramo es un fragmento de texto con formato uniforme dentro de un párrafo.
+type tramo struct {
+	texto string
+	f     formato
+	salto bool // <br>
+}
+
+// imagen es una imagen embebida en word/media.
+type imagen struct {
+	id       int
+	ext      string
+	datos    []byte
+	anchoEMU int64
+	altoEMU  int64
+}
+
+func (i imagen) archivo() string { return fmt.Sprintf("image%d.%s", i.id, i.ext) }
+func (i imagen) relID() string   { return fmt.Sprintf("rIdImg%d", i.id) }
+
+// convertidor recorre el DOM del HTML de la memoria y emite WordprocessingML.
+// Los bloques (div, p, li, …) cierran el párrafo en curso; los títulos h1–h4 se
+// mapean a los estilos Heading1–4 y las tablas HTML a tablas de Word.
+type convertidor struct {
+	ctx        context.Context
+	rasterizer port.SvgRasterizer
+	out        *strings.Builder
+	estilo     string
+	tramos     []tramo
+	imagenes   []imagen
+}
+
+func newConvertidor(ctx context.Context, rasterizer port.SvgRasterizer) *convertidor {
+	return &convertidor{ctx: ctx, rasterizer: rasterizer, out: &strings.Builder{}}
+}
+
+// convertir parsea el HTML y convierte el contenido de <body>.
+func (c *convertidor) convertir(src string) error {
+	doc, err := html.Parse(strings.NewReader(src))
+	if err != nil {
+		return fmt.Errorf("parseando HTML: %w", err)
+	}
+	raiz := buscarElemento(doc, "body")
+	if raiz == nil {
+		raiz = doc
+	}
+	c.recorrer(raiz, formato{})
+	c.cerrarParrafo()
+	return nil
+}
+
+// cuerpo retorna el contenido de <w:body> sin la sección; Word exige que el
+// cuerpo no termine en una tabla.
+func (c *convertidor) cuerpo() string {
+	s := c.out.String()
+	if s == "" || strings.HasSuffix(s, "</w:tbl>") {
+		s += "<w:p/>"
+	}
+	return s
+}
+
+func (c *convertidor) recorrer(n *html.Node, f formato) {
+	for hijo := n.FirstChild; hijo != nil; hijo = hijo.NextSibling {
+		c.nodo(hijo, f)
+	}
+}
+
+func (c *convertidor) nodo(n *html.Node, f formato) {
+	switch n.Type {
+	case html.TextNode:
+		c.texto(n.Data, f)
+		return
+	case html.ElementNode:
+	default:
+		return
+	}
+
+	if n.Namespace == "svg" {
+		if n.Data == "svg" {
+			c.cerrarParrafo()
+			c.diagrama(n)
+		}
+		return
+	}
+
+	switch n.Data {
+	case "head", "style", "script", "title", "meta", "link":
+	case "h1", "h2", "h3", "h4":
+		c.cerrarParrafo()
+		c.estilo = "Heading" + n.Data[1:]
+		c.recorrer(n, f)
+		c.cerrarParrafo()
+	case "table":
+		c.cerrarParrafo()
+		c.tabla(n)
+	case "img":
+		c.cerrarParrafo()
+		c.imagenDataURI(n)
+	case "br":
+		c.tramos = append(c.tramos, tramo{salto: true})
+	case "strong", "b":
+		f.negrita = true
+		c.recorrer(n, f)
+	case "em", "i":
+		f.cursiva = true
+		c.recorrer(n, f)
+	case "sub":
+		f.sub = true
+		c.recorrer(n, f)
+	case "sup":
+		f.sup = true
+		c.recorrer(n, f)
+	case "li":
+		c.cerrarParrafo()
+		c.tramos = append(c.tramos, tramo{texto: "• "})
+		c.recorrer(n, f)
+		c.cerrarParrafo()
+	case "div", "p", "section", "article", "header", "footer", "main", "ul", "ol", "blockquote", "hr":
+		c.cerrarParrafo()
+		c.recorrer(n, f)
+		c.cerrarParrafo()
+	default:
+		c.recorrer(n, f)
+	}
+}
+
+// texto agrega un tramo colapsando los espacios como lo hace el navegador: las
+// secuencias de espacios se reducen a uno y se conservan en los bordes del nodo
+// para separar tramos en línea (ej: "Proyecto: <strong>X</strong>").
+func (c *convertidor) texto(s string, f formato) {
+	if s == "" {
+		return
+	}
+	colapsado := strings.Join(strings.Fields(s), " ")
+	if colapsado == "" {
+		colapsado = " "
+	} else {
+		if strings.TrimLeftFunc(s, unicode.IsSpace) != s {
+			colapsado = " " + colapsado
+		}
+		if strings.TrimRightFunc(s, unicode.IsSpace) != s {
+			colapsado += " "
+		}
+	}
+	if strings.HasPrefix(colapsado, " ") && (len(c.tramos) == 0 || strings.HasSuffix(c.tramos[len(c.tramos)-1].texto, " ")) {
+		colapsado = colapsado[1:]
+	}
+	if colapsado == "" {
+		return
+	}
+	c.tramos = append(c.tramos, tramo{texto: colapsado, f: f})
+}
+
+// cerrarParrafo emite el párrafo en curso; los párrafos vacíos se descartan.
+func (c *convertidor) cerrarParrafo() {
+	tramos, estilo := c.tramos, c.estilo
+	c.tramos, c.estilo = nil, ""
+
+	if len(tramos) > 0 {
+		tramos[0].texto = strings.TrimLeft(tramos[0].texto, " ")
+		tramos[len(tramos)-1].texto = strings.TrimRight(tramos[len(tramos)-1].texto, " ")
+	}
+	vacio := true
+	for _, t := range tramos {
+		if t.texto != "" {
+			vacio = false
+			break
+		}
+	}
+	if vacio {
+		return
+	}
+
+	// Tramos contiguos con el mismo formato se unen en un solo <w:r> para que el
+	// texto sea cómodo de editar en Word.
+	unidos := tramos[:1]
+	for _, t := range tramos[1:] {
+		ultimo := &unidos[len(unidos)-1]
+		if !t.salto && !ultimo.salto && t.f == ultimo.f {
+			ultimo.texto += t.texto
+			continue
+		}
+		unidos = append(unidos, t)
+	}
+
+	c.out.WriteString("<w:p>")
+	if estilo != "" {
+		fmt.Fprintf(c.out, `<w:pPr><w:pStyle w:val="%s"/></w:pPr>`, estilo)
+	}
+	for _, t := range unidos {
+		if t.salto {
+			c.out.WriteString("<w:r><w:br/></w:r>")
+			continue
+		}
+		if t.texto != "" {
+			c.out.WriteString(run(t.texto, t.f))
+		}
+	}
+	c.out.WriteString("</w:p>")
+}
+
+// tabla convierte una tabla HTML; los <th> se sombrean y colspan se mapea a gridSpan.
+func (c *convertidor) tabla(n *html.Node) {
+	filas := filasTabla(n)
+	numCols := 0
+	for _, fila := range filas {
+		if cols := columnasFila(fila); cols > numCols {
+			numCols = cols
+		}
+	}
+	if numCols == 0 {
+		return
+	}
+
+	c.out.WriteString(`<w:tbl><w:tblPr><w:tblStyle w:val="TablaMemoria"/><w:tblW w:w="5000" w:type="pct"/></w:tblPr><w:tblGrid>`)
+	for i := 0; i < numCols; i++ {
+		fmt.Fprintf(c.out, `<w:gridCol w:w="%d"/>`, anchoTextoTwips/numCols)
+	}
+	c.out.WriteString(`</w:tblGrid>`)
+
+	for _, fila := range filas {
+		c.out.WriteString("<w:tr>")
+		cols := 0
+		for celda := fila.FirstChild; celda != nil; celda = celda.NextSibling {
+			if celda.Type != html.ElementNode || (celda.Data != "td" && celda.Data != "th") {
+				continue
+			}
+			span := colspan(celda)
+			cols += span
+			c.celda(celda, span)
+		}
+		for ; cols < numCols; cols++ {
+			c.out.WriteString("<w:tc><w:p/></w:tc>")
+		}
+		c.out.WriteString("</w:tr>")
+	}
+	c.out.WriteString("</w:tbl>")
+}
+
+// celda convierte el contenido de un <td>/<th> en su propio búfer.
+func (c *convertidor) celda(n *html.Node, span int) {
+	padre := c.out
+	c.out = &strings.Builder{}
+
+	f := formato{}
+	if n.Data == "th" {
+		f.negrita = true
+	}
+	c.recorrer(n, f)
+	c.cerrarParrafo()
+	contenido := c.out.String()
+	if contenido == "" || strings.HasSuffix(contenido, "</w:tbl>") {
+		contenido += "<w:p/>"
+	}
+	c.out = padre
+
+	c.out.WriteString("<w:tc><w:tcPr>")
+	if span > 1 {
+		fmt.Fprintf(c.out, `<w:gridSpan w:val="%d"/>`, span)
+	}
+	if n.Data == "th" {
+		c.out.WriteString(`<w:shd w:val="clear" w:color="auto" w:fill="D9E1F2"/>`)
+	}
+	c.out.WriteString("</w:tcPr>")
+	c.out.WriteString(contenido)
+	c.out.WriteString("</w:tc>")
+}
+
+// imagenDataURI embebe una imagen <img src="data:image/png;base64,…">.
+// Las imágenes por URL externa se omiten: el HTML de la memoria solo usa data URIs.
+func (c *convertidor) imagenDataURI(n *html.Node) {
+	src := atributo(n, "src")
+	mime, datos, ok := strings.Cut(strings.TrimPrefix(src, "data:"), ";base64,")
+	if !ok || !strings.HasPrefix(src, "data:") {
+		return
+	}
+	ext := map[string]string{"image/png": "png", "image/jpeg": "jpeg", "image/jpg": "jpeg"}[mime]
+	if ext == "" {
+		return
+	}
+	b, err := base64.StdEncoding.DecodeString(datos)
+	if err != nil {
+		return
+	}
+	c.agregarImagen(b, ext, anchoMaxLogoEMU)
+}
+
+// diagrama rasteriza un <svg> en línea y lo embebe como PNG.
+func (c *convertidor) diagrama(n *html.Node) {
+	var svg bytes.Buffer
+	if err := html.Render(&svg, n); err != nil {
+		c.notaDiagrama()
+		return
+	}
+	if c.rasterizer == nil {
+		c.notaDiagrama()
+		return
+	}
+	png, err := c.rasterizer.Rasterizar(c.ctx, svg.String(), anchoRasterPx)
+	if err != nil {
+		// Graceful degradation: el documento sigue siendo útil sin el diagrama
+		log.Printf("[WARN] docx: no se pudo rasterizar el diagrama: %v", err)
+		c.notaDiagrama()
+		return
+	}
+	c.agregarImagen(png, "png", anchoMaxDiagramaEMU)
+}
+
+func (c *convertidor) notaDiagrama() {
+	c.tramos = append(c.tramos, tramo{texto: "[Diagrama no disponible]", f: formato{cursiva: true}})
+	c.cerrarParrafo()
+}
+
+// agregarImagen registra la imagen y emite un párrafo centrado con el dibujo en línea.
+// El tamaño se toma de los píxeles de la imagen a 96 dpi, limitado a anchoMaxEMU.
+func (c *convertidor) agregarImagen(datos []byte, ext string, anchoMaxEMU int64) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(datos))
+	if err != nil || cfg.Width == 0 || cfg.Height == 0 {
+		return
+	}
+	ancho := int64(cfg.Width) * emuPorPx
+	alto := int64(cfg.Height) * emuPorPx
+	if ancho > anchoMaxEMU {
+		alto = alto * anchoMaxEMU / ancho
+		ancho = anchoMaxEMU
+	}
+
+	img := imagen{id: len(c.imagenes) + 1, ext: ext, datos: datos, anchoEMU: ancho, altoEMU: alto}
+	c.imagenes = append(c.imagenes, img)
+
+	fmt.Fprintf(c.out, `<w:p><w:pPr><w:jc w:val="center"/></w:pPr><w:r><w:drawing>`+
+		`<wp:inline distT="0" distB="0" distL="0" distR="0"><wp:extent cx="%[1]d" cy="%[2]d"/><wp:docPr id="%[3]d" name="Imagen %[3]d"/>`+
+		`<a:graphic><a:graphicData uri="http://schemas.openxmlformats.org/drawingml/2006/picture"><pic:pic>`+
+		`<pic:nvPicPr><pic:cNvPr id="%[3]d" name="%[4]s"/><pic:cNvPicPr/></pic:nvPicPr>`+
+		`<pic:blipFill><a:blip r:embed="%[5]s"/><a:stretch><a:fillRect/></a:stretch></pic:blipFill>`+
+		`<pic:spPr><a:xfrm><a:off x="0" y="0"/><a:ext cx="%[1]d" cy="%[2]d"/></a:xfrm><a:prstGeom prst="rect"><a:avLst/></a:prstGeom></pic:spPr>`+
+		`</pic:pic></a:graphicData></a:graphic></wp:inline></w:drawing></w:r></w:p>`,
+		img.anchoEMU, img.altoEMU, img.id, img.archivo(), img.relID())
+}
+
+// run emite un <w:r> con el texto escapado y su formato de carácter.
+func run(texto string, f formato) string {
+	var sb strings.Builder
+	sb.WriteString("<w:r>")
+	if f != (formato{}) {
+		sb.WriteString("<w:rPr>")
+		if f.negrita {
+			sb.WriteString("<w:b/>")
+		}
+		if f.cursiva {
+			sb.WriteString("<w:i/>")
+		}
+		if f.sub {
+			sb.WriteString(`<w:vertAlign w:val="subscript"/>`)
+		} else if f.sup {
+			sb.WriteString(`<w:vertAlign w:val="superscript"/>`)
+		}
+		sb.WriteString("</w:rPr>")
+	}
+	sb.WriteString(`<w:t xml:space="preserve">`)
+	_ = xml.EscapeText(&sb, []byte(texto))
+	sb.WriteString("</w:t></w:r>")
+	return sb.String()
+}
+
+// filasTabla retorna los <tr> de la tabla (directos o dentro de thead/tbody/tfoot),
+// sin descender a tablas anidadas.
+func filasTabla(tabla *html.Node) []*html.Node {
+	var filas []*html.Node
+	for n := tabla.FirstChild; n != nil; n = n.NextSibling {
+		if n.Type != html.ElementNode {
+			continue
+		}
+		switch n.Data {
+		case "tr":
+			filas = append(filas, n)
+		case "thead", "tbody", "tfoot":
+			for tr := n.FirstChild; tr != nil; tr = tr.NextSibling {
+				if tr.Type == html.ElementNode && tr.Data == "tr" {
+					filas = append(filas, tr)
+				}
+			}
+		}
+	}
+	return filas
+}
+
+func columnasFila(fila *html.Node) int {
+	total := 0
+	for celda := fila.FirstChild; celda != nil; celda = celda.NextSibling {
+		if celda.Type == html.ElementNode && (celda.Data == "td" || celda.Data == "th") {
+			total += colspan(celda)
+		}
+	}
+	return total
+}
+
+func colspan(celda *html.Node) int {
+	var n int
+	if _, err := fmt.Sscanf(atributo(celda, "colspan"), "%d", &n); err != nil || n < 1 {
+		return 1
+	}
+	return n
+}
+
+func atributo(n *html.Node, clave string) string {
+	for _, a := range n.Attr {
+		if a.Key == clave {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+func buscarElemento(n *html.Node, nombre string) *html.Node {
+	if n.Type == html.ElementNode && n.Data == nombre {
+		return n
+	}
+	for hijo := n.FirstChild; hijo != nil; hijo = hijo.NextSibling {
+		if encontrado := buscarElemento(hijo, nombre); encontrado != nil {
+			return encontrado
+		}
+	}
+	return nil
+}
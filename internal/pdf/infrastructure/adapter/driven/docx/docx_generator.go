@@ -0,0 +1,165 @@
+// internal/pdf/infrastructure/adapter/driven/docx/docx_generator.go
+// Package docx implementa port.DocxGenerator escribiendo WordprocessingML (Office Open XML)
+// directamente con archive/zip, a partir del mismo HTML que se envía a Gotenberg.
+package docx
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/garfex/calculadora-filtros/internal/pdf/application/port"
+	"github.com/garfex/calculadora-filtros/internal/pdf/domain"
+)
+
+// anchoRasterPx es el ancho con el que se rasterizan los diagramas SVG (nítido a 15 cm).
+const anchoRasterPx = 1200
+
+// DocxGeneratorAdapter implementa port.DocxGenerator.
+type DocxGeneratorAdapter struct {
+	rasterizer port.SvgRasterizer
+}
+
+// NewDocxGenerator crea un DocxGeneratorAdapter.
+// rasterizer convierte los diagramas SVG a PNG; si es nil o falla, el diagrama se
+// sustituye por una nota (graceful degradation, igual que los logos del PDF).
+func NewDocxGenerator(rasterizer port.SvgRasterizer) *DocxGeneratorAdapter {
+	return &DocxGeneratorAdapter{rasterizer: rasterizer}
+}
+
+// Generate convierte el HTML de la memoria a un documento .docx.
+// Implementa port.DocxGenerator.
+func (g *DocxGeneratorAdapter) Generate(ctx context.Context, html, encabezado string) ([]byte, error) {
+	conv := newConvertidor(ctx, g.rasterizer)
+	if err := conv.convertir(html); err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrGeneracionDocx, err)
+	}
+
+	b, err := empaquetar(conv.cuerpo(), encabezado, conv.imagenes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrGeneracionDocx, err)
+	}
+	return b, nil
+}
+
+// empaquetar escribe el paquete OPC (zip) con el documento, estilos, encabezado,
+// pie de página e imágenes.
+func empaquetar(cuerpo, encabezado string, imagenes []imagen) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	partes := []struct {
+		nombre    string
+		contenido string
+	}{
+		{"[Content_Types].xml", contentTypesXML},
+		{"_rels/.rels", relsXML},
+		{"word/document.xml", documentXML(cuerpo)},
+		{"word/styles.xml", stylesXML},
+		{"word/header1.xml", headerXML(encabezado)},
+		{"word/footer1.xml", footerXML},
+		{"word/_rels/document.xml.rels", documentRelsXML(imagenes)},
+	}
+	for _, p := range partes {
+		w, err := zw.Create(p.nombre)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write([]byte(p.contenido)); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, img := range imagenes {
+		w, err := zw.Create("word/media/" + img.archivo())
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(img.datos); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+const (
+	nsW = "http://schemas.openxmlformats.org/wordprocessingml/2006/main"
+	nsR = "http://schemas.openxmlformats.org/officeDocument/2006/relationships"
+
+	relTipoImagen = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/image"
+)
+
+const contentTypesXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">
+<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>
+<Default Extension="xml" ContentType="application/xml"/>
+<Default Extension="png" ContentType="image/png"/>
+<Default Extension="jpeg" ContentType="image/jpeg"/>
+<Override PartName="/word/document.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.document.main+xml"/>
+<Override PartName="/word/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.styles+xml"/>
+<Override PartName="/word/header1.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.header+xml"/>
+<Override PartName="/word/footer1.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.footer+xml"/>
+</Types>`
+
+const relsXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="word/document.xml"/>
+</Relationships>`
+
+// documentRelsXML declara estilos, encabezado, pie y una relación por imagen.
+func documentRelsXML(imagenes []imagen) string {
+	var sb strings.Builder
+	sb.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rIdStyles" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>
+<Relationship Id="rIdHeader1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/header" Target="header1.xml"/>
+<Relationship Id="rIdFooter1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/footer" Target="footer1.xml"/>
+`)
+	for _, img := range imagenes {
+		fmt.Fprintf(&sb, `<Relationship Id="%s" Type="%s" Target="media/%s"/>`+"\n", img.relID(), relTipoImagen, img.archivo())
+	}
+	sb.WriteString(`</Relationships>`)
+	return sb.String()
+}
+
+// documentXML envuelve el cuerpo con la sección de página carta, márgenes de 2 cm,
+// encabezado y pie de página.
+func documentXML(cuerpo string) string {
+	return `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<w:document xmlns:w="` + nsW + `" xmlns:r="` + nsR + `" xmlns:wp="http://schemas.openxmlformats.org/drawingml/2006/wordprocessingDrawing" xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main" xmlns:pic="http://schemas.openxmlformats.org/drawingml/2006/picture">
+<w:body>` + cuerpo + `<w:sectPr><w:headerReference w:type="default" r:id="rIdHeader1"/><w:footerReference w:type="default" r:id="rIdFooter1"/><w:pgSz w:w="12240" w:h="15840"/><w:pgMar w:top="1134" w:right="1134" w:bottom="1134" w:left="1134" w:header="567" w:footer="567" w:gutter="0"/></w:sectPr></w:body>
+</w:document>`
+}
+
+// headerXML muestra el texto de presentación alineado a la derecha en cada página.
+func headerXML(encabezado string) string {
+	return `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<w:hdr xmlns:w="` + nsW + `"><w:p><w:pPr><w:pStyle w:val="Encabezado"/><w:jc w:val="right"/></w:pPr>` + run(encabezado, formato{}) + `</w:p></w:hdr>`
+}
+
+// footerXML muestra "Página X de Y" con campos que Word actualiza al abrir.
+const footerXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<w:ftr xmlns:w="` + nsW + `"><w:p><w:pPr><w:pStyle w:val="Encabezado"/><w:jc w:val="center"/></w:pPr><w:r><w:t xml:space="preserve">Página </w:t></w:r><w:fldSimple w:instr="PAGE"><w:r><w:t>1</w:t></w:r></w:fldSimple><w:r><w:t xml:space="preserve"> de </w:t></w:r><w:fldSimple w:instr="NUMPAGES"><w:r><w:t>1</w:t></w:r></w:fldSimple></w:p></w:ftr>`
+
+// stylesXML define Normal, títulos, encabezado de página y la tabla con bordes
+// con la misma tipografía y color primario que el PDF.
+const stylesXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<w:styles xmlns:w="` + nsW + `">
+<w:docDefaults>
+<w:rPrDefault><w:rPr><w:rFonts w:ascii="Arial" w:hAnsi="Arial" w:cs="Arial" w:eastAsia="Arial"/><w:sz w:val="20"/><w:szCs w:val="20"/><w:lang w:val="es-MX"/></w:rPr></w:rPrDefault>
+<w:pPrDefault><w:pPr><w:spacing w:after="80" w:line="264" w:lineRule="auto"/></w:pPr></w:pPrDefault>
+</w:docDefaults>
+<w:style w:type="paragraph" w:default="1" w:styleId="Normal"><w:name w:val="Normal"/><w:qFormat/></w:style>
+<w:style w:type="paragraph" w:styleId="Heading1"><w:name w:val="heading 1"/><w:basedOn w:val="Normal"/><w:next w:val="Normal"/><w:qFormat/><w:pPr><w:keepNext/><w:spacing w:before="240" w:after="120"/><w:outlineLvl w:val="0"/></w:pPr><w:rPr><w:b/><w:color w:val="1F3A5F"/><w:sz w:val="36"/><w:szCs w:val="36"/></w:rPr></w:style>
+<w:style w:type="paragraph" w:styleId="Heading2"><w:name w:val="heading 2"/><w:basedOn w:val="Normal"/><w:next w:val="Normal"/><w:qFormat/><w:pPr><w:keepNext/><w:spacing w:before="240" w:after="80"/><w:pBdr><w:bottom w:val="single" w:sz="8" w:space="2" w:color="1F3A5F"/></w:pBdr><w:outlineLvl w:val="1"/></w:pPr><w:rPr><w:b/><w:color w:val="1F3A5F"/><w:sz w:val="28"/><w:szCs w:val="28"/></w:rPr></w:style>
+<w:style w:type="paragraph" w:styleId="Heading3"><w:name w:val="heading 3"/><w:basedOn w:val="Normal"/><w:next w:val="Normal"/><w:qFormat/><w:pPr><w:keepNext/><w:spacing w:before="160" w:after="60"/><w:outlineLvl w:val="2"/></w:pPr><w:rPr><w:b/><w:color w:val="1F3A5F"/><w:sz w:val="22"/><w:szCs w:val="22"/></w:rPr></w:style>
+<w:style w:type="paragraph" w:styleId="Heading4"><w:name w:val="heading 4"/><w:basedOn w:val="Normal"/><w:next w:val="Normal"/><w:qFormat/><w:pPr><w:keepNext/><w:outlineLvl w:val="3"/></w:pPr><w:rPr><w:b/><w:i/></w:rPr></w:style>
+<w:style w:type="paragraph" w:styleId="Encabezado"><w:name w:val="Encabezado"/><w:basedOn w:val="Normal"/><w:rPr><w:color w:val="666666"/><w:sz w:val="16"/><w:szCs w:val="16"/></w:rPr></w:style>
+<w:style w:type="table" w:styleId="TablaMemoria"><w:name w:val="Tabla Memoria"/><w:tblPr><w:tblBorders><w:top w:val="single" w:sz="4" w:space="0" w:color="A6A6A6"/><w:left w:val="single" w:sz="4" w:space="0" w:color="A6A6A6"/><w:bottom w:val="single" w:sz="4" w:space="0" w:color="A6A6A6"/><w:right w:val="single" w:sz="4" w:space="0" w:color="A6A6A6"/><w:insideH w:val="single" w:sz="4" w:space="0" w:color="A6A6A6"/><w:insideV w:val="single" w:sz="4" w:space="0" w:color="A6A6A6"/></w:tblBorders><w:tblCellMar><w:left w:w="80" w:type="dxa"/><w:right w:w="80" w:type="dxa"/></w:tblCellMar></w:tblPr></w:style>
+</w:styles>`
@@ -0,0 +1,125 @@
+// internal/pdf/infrastructure/adapter/driven/docx/docx_generator_test.go
+package docx
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"image"
+	"image/png"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubRasterizer devuelve un PNG de 400×200 px o el error configurado.
+type stubRasterizer struct {
+	err     error
+	llamado bool
+}
+
+func (s *stubRasterizer) Rasterizar(_ context.Context, svg string, _ int) ([]byte, error) {
+	s.llamado = strings.HasPrefix(svg, "<svg")
+	if s.err != nil {
+		return nil, s.err
+	}
+	return pngPrueba(400, 200), nil
+}
+
+func pngPrueba(ancho, alto int) []byte {
+	var buf bytes.Buffer
+	_ = png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, ancho, alto)))
+	return buf.Bytes()
+}
+
+// leerPartes descomprime el .docx y retorna el contenido de cada parte.
+func leerPartes(t *testing.T, b []byte) map[string]string {
+	t.Helper()
+	zr, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	require.NoError(t, err)
+	partes := map[string]string{}
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		contenido, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		rc.Close()
+		partes[f.Name] = string(contenido)
+	}
+	return partes
+}
+
+// requireXMLBienFormado recorre todos los tokens del XML.
+func requireXMLBienFormado(t *testing.T, s string) {
+	t.Helper()
+	dec := xml.NewDecoder(strings.NewReader(s))
+	for {
+		_, err := dec.Token()
+		if err == io.EOF {
+			return
+		}
+		require.NoError(t, err)
+	}
+}
+
+const htmlPrueba = `<!DOCTYPE html><html><head><style>.x{color:red}</style><title>t</title></head><body>
+<div class="header-main"><h1>Memoria de Cálculo</h1><p>Proyecto: <strong>Planta & Norte</strong></p>
+<img src="data:image/png;base64,%s" alt="logo"></div>
+<div class="seccion"><h2>2. Cálculo de Corriente</h2>
+  <div class="data-item"><span class="data-label">I<sub>n</sub></span>
+    <span class="data-value">120.30 A</span></div>
+  <table><thead><tr><th>Partida</th><th colspan="2">Descripción</th></tr></thead>
+  <tbody><tr><td>1</td><td>Cable</td><td>630 m</td></tr><tr><td></td></tr></tbody></table>
+  <div class="diagrama-svg"><svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 200 100"><circle cx="10" cy="10" r="5"/></svg></div>
+</div></body></html>`
+
+func TestDocxGenerator_Generate(t *testing.T) {
+	html := strings.Replace(htmlPrueba, "%s", base64.StdEncoding.EncodeToString(pngPrueba(800, 400)), 1)
+
+	t.Run("convierte títulos, texto, tablas e imágenes", func(t *testing.T) {
+		raster := &stubRasterizer{}
+		b, err := NewDocxGenerator(raster).Generate(context.Background(), html, "GARFEX — Planta Norte")
+		require.NoError(t, err)
+
+		partes := leerPartes(t, b)
+		for _, nombre := range []string{"[Content_Types].xml", "_rels/.rels", "word/document.xml", "word/styles.xml",
+			"word/header1.xml", "word/footer1.xml", "word/_rels/document.xml.rels", "word/media/image1.png", "word/media/image2.png"} {
+			require.Contains(t, partes, nombre)
+			if strings.HasSuffix(nombre, ".xml") || strings.HasSuffix(nombre, ".rels") {
+				requireXMLBienFormado(t, partes[nombre])
+			}
+		}
+
+		doc := partes["word/document.xml"]
+		assert.Contains(t, doc, `<w:pStyle w:val="Heading1"/></w:pPr><w:r><w:t xml:space="preserve">Memoria de Cálculo</w:t>`)
+		assert.Contains(t, doc, `<w:t xml:space="preserve">Proyecto: </w:t></w:r><w:r><w:rPr><w:b/></w:rPr><w:t xml:space="preserve">Planta &amp; Norte</w:t>`)
+		assert.Contains(t, doc, `<w:vertAlign w:val="subscript"/></w:rPr><w:t xml:space="preserve">n</w:t></w:r><w:r><w:t xml:space="preserve"> 120.30 A</w:t>`)
+		assert.Contains(t, doc, `<w:gridSpan w:val="2"/><w:shd`)
+		assert.Equal(t, 3, strings.Count(doc, `<w:gridCol `))
+		assert.Contains(t, doc, `<w:tc><w:p/></w:tc><w:tc><w:p/></w:tc></w:tr>`) // fila incompleta rellenada
+		assert.NotContains(t, doc, "color:red")
+
+		// Logo limitado a 5 cm; diagrama de 400 px sin escalar
+		assert.Contains(t, doc, `<wp:extent cx="1800000" cy="900000"/>`)
+		assert.Contains(t, doc, `<wp:extent cx="3810000" cy="1905000"/>`)
+		assert.True(t, raster.llamado)
+
+		assert.Contains(t, partes["word/header1.xml"], "GARFEX — Planta Norte")
+		assert.Contains(t, partes["word/_rels/document.xml.rels"], `Id="rIdImg2"`)
+	})
+
+	t.Run("sin rasterizador el diagrama se sustituye por una nota", func(t *testing.T) {
+		b, err := NewDocxGenerator(&stubRasterizer{err: errors.New("gotenberg caído")}).Generate(context.Background(), html, "")
+		require.NoError(t, err)
+
+		partes := leerPartes(t, b)
+		assert.Contains(t, partes["word/document.xml"], "[Diagrama no disponible]")
+		assert.NotContains(t, partes, "word/media/image2.png")
+	})
+}
@@ -134,3 +134,9 @@ func parseIntOrDefault(s string, defaultValue int) int {
 	}
 	return n
 }
+
+// ScreenshotURL retorna la URL de la ruta de captura de Chromium, derivada de URL
+// (…/forms/chromium/convert/html → …/forms/chromium/screenshot/html).
+func (c *Config) ScreenshotURL() string {
+	return strings.Replace(c.URL, "/convert/html", "/screenshot/html", 1)
+}
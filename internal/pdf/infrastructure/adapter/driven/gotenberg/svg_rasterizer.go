@@ -0,0 +1,93 @@
+// internal/pdf/infrastructure/adapter/driven/gotenberg/svg_rasterizer.go
+package gotenberg
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+
+	"github.com/garfex/calculadora-filtros/internal/pdf/application/port"
+)
+
+// reViewBox extrae ancho y alto del atributo viewBox="minX minY ancho alto".
+var reViewBox = regexp.MustCompile(`viewBox="\s*[-\d.]+[\s,]+[-\d.]+[\s,]+([\d.]+)[\s,]+([\d.]+)\s*"`)
+
+// pngMagic son los primeros bytes de todo archivo PNG.
+var pngMagic = []byte("\x89PNG")
+
+// SvgRasterizerAdapter implementa port.SvgRasterizer usando la ruta de captura
+// de Chromium de Gotenberg: el SVG se incrusta en una página del tamaño exacto
+// del diagrama y se captura como PNG.
+type SvgRasterizerAdapter struct {
+	config     *Config
+	httpClient GotenbergClient
+}
+
+// NewSvgRasterizer crea un SvgRasterizerAdapter con la configuración de entorno.
+func NewSvgRasterizer() *SvgRasterizerAdapter {
+	config := NewConfig()
+	return &SvgRasterizerAdapter{
+		config:     config,
+		httpClient: newDefaultHTTPClient(config.Timeout),
+	}
+}
+
+// Rasterizar convierte el SVG a PNG de anchoPx de ancho.
+// Implementa port.SvgRasterizer.
+func (r *SvgRasterizerAdapter) Rasterizar(ctx context.Context, svg string, anchoPx int) ([]byte, error) {
+	if anchoPx <= 0 {
+		return nil, errors.New("el ancho de la imagen debe ser mayor que cero")
+	}
+	altoPx := altoProporcional(svg, anchoPx)
+
+	html := fmt.Sprintf(
+		`<!DOCTYPE html><html><head><meta charset="utf-8"><style>html,body{margin:0;padding:0;background:#fff}svg{display:block;width:%dpx;height:%dpx}</style></head><body>%s</body></html>`,
+		anchoPx, altoPx, svg,
+	)
+
+	form := NewFormBuilder()
+	if err := form.AddHTML(html); err != nil {
+		return nil, wrapError(err, "añadiendo SVG")
+	}
+	form.AddOption("width", strconv.Itoa(anchoPx))
+	form.AddOption("height", strconv.Itoa(altoPx))
+	form.AddOption("clip", "true")
+	form.AddOption("format", "png")
+
+	body, err := form.Build()
+	if err != nil {
+		return nil, wrapError(err, "construyendo formulario")
+	}
+
+	resp, err := r.httpClient.PostMultipart(ctx, r.config.ScreenshotURL(), form.ContentType(), body, r.config.MaxRetries)
+	if err != nil {
+		log.Printf("[ERROR] gotenberg: failed to rasterize SVG at %s: %v", r.config.ScreenshotURL(), err)
+		return nil, wrapError(err, "llamando a Gotenberg")
+	}
+
+	if !bytes.HasPrefix(resp.Body, pngMagic) {
+		return nil, fmt.Errorf("respuesta de Gotenberg no es un PNG válido (%d bytes)", len(resp.Body))
+	}
+	return resp.Body, nil
+}
+
+// altoProporcional calcula el alto en píxeles que respeta la proporción del viewBox.
+// Si el SVG no tiene viewBox legible se usa una proporción 4:3.
+func altoProporcional(svg string, anchoPx int) int {
+	m := reViewBox.FindStringSubmatch(svg)
+	if m != nil {
+		ancho, errAncho := strconv.ParseFloat(m[1], 64)
+		alto, errAlto := strconv.ParseFloat(m[2], 64)
+		if errAncho == nil && errAlto == nil && ancho > 0 && alto > 0 {
+			return int(float64(anchoPx)*alto/ancho + 0.5)
+		}
+	}
+	return anchoPx * 3 / 4
+}
+
+// Ensure we implement the port interface
+var _ port.SvgRasterizer = (*SvgRasterizerAdapter)(nil)
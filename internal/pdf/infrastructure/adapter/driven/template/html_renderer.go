@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/garfex/calculadora-filtros/internal/pdf/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
 )
 
 // HtmlRendererAdapter implementa port.HtmlRenderer usando html/template con embed.FS.
@@ -141,6 +142,18 @@ func NewHtmlRenderer(templatesFS fs.FS) (*HtmlRendererAdapter, error) {
 		"formatNumeric": func(v interface{}) string {
 			return fmt.Sprintf("%.2f", convToFloat64(v))
 		},
+		// etiquetaAislamiento formatea el aislamiento con su temperatura nominal
+		// Ej: "THHN" → "THHN (90 °C)", "" → "THW (75 °C)"
+		"etiquetaAislamiento": func(s string) string {
+			if s == "" {
+				s = valueobject.AislamientoDefault.String()
+			}
+			aislamiento, err := valueobject.ParseTipoAislamiento(s)
+			if err != nil {
+				return s
+			}
+			return fmt.Sprintf("%s (%d °C)", aislamiento, aislamiento.TemperaturaMaxima())
+		},
 		// derefFloat desreferencia un puntero *float64 de forma segura (retorna 0 si nil)
 		"derefFloat": func(f *float64) float64 {
 			if f == nil {
@@ -150,9 +163,10 @@ func NewHtmlRenderer(templatesFS fs.FS) (*HtmlRendererAdapter, error) {
 		},
 	}
 
-	// Parsear template principal + todos los partials + templates Gotenberg
+	// Parsear templates principales (memoria y proyecto) + todos los partials + templates Gotenberg
 	tmpl, err := htmpl.New("").Funcs(funcMap).ParseFS(templatesFS,
 		"templates/memoria.html",
+		"templates/proyecto.html",
 		"templates/partials/*.html",
 		"templates/gotenberg_*.html",
 	)
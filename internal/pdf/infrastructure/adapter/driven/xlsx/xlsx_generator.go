@@ -0,0 +1,228 @@
+// internal/pdf/infrastructure/adapter/driven/xlsx/xlsx_generator.go
+package xlsx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"unicode/utf8"
+
+	calculosdto "github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/pdf/domain"
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	// HojaEntradas contiene los datos de entrada de cada circuito (concepto/valor/unidad).
+	HojaEntradas = "Entradas"
+
+	// HojaPasos contiene el desarrollo paso a paso de cada memoria.
+	HojaPasos = "Pasos"
+
+	// HojaMateriales contiene la lista de materiales de cada circuito.
+	HojaMateriales = "Materiales"
+)
+
+// XlsxGeneratorAdapter implementa port.XlsxGenerator usando excelize.
+type XlsxGeneratorAdapter struct{}
+
+// NewXlsxGenerator crea un nuevo XlsxGeneratorAdapter.
+func NewXlsxGenerator() *XlsxGeneratorAdapter {
+	return &XlsxGeneratorAdapter{}
+}
+
+// hoja describe los encabezados y anchos de columna de una hoja del libro.
+type hoja struct {
+	nombre   string
+	columnas []string
+	anchos   []float64
+}
+
+var hojas = []hoja{
+	{
+		nombre:   HojaEntradas,
+		columnas: []string{"Circuito", "Concepto", "Valor", "Unidad"},
+		anchos:   []float64{24, 36, 28, 10},
+	},
+	{
+		nombre:   HojaPasos,
+		columnas: []string{"Circuito", "Paso", "Nombre", "Descripción", "Resultado"},
+		anchos:   []float64{24, 8, 36, 60, 80},
+	},
+	{
+		nombre:   HojaMateriales,
+		columnas: []string{"Circuito", "Partida", "Categoría", "Descripción", "Detalle", "Cantidad", "Unidad", "Precio unitario", "Importe", "Moneda"},
+		anchos:   []float64{24, 8, 14, 44, 36, 12, 8, 16, 16, 8},
+	},
+}
+
+// Generate escribe las memorias en un libro con las hojas Entradas, Pasos y Materiales.
+// Implementa port.XlsxGenerator.
+func (g *XlsxGeneratorAdapter) Generate(ctx context.Context, circuitos []calculosdto.CircuitoOutput) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrGeneracionXlsx, err)
+	}
+
+	f := excelize.NewFile()
+	defer f.Close()
+
+	if err := prepararHojas(f); err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrGeneracionXlsx, err)
+	}
+
+	var entradas, pasos, materiales [][]interface{}
+	for _, c := range circuitos {
+		entradas = append(entradas, renglonesEntradas(c.Nombre, c.Memoria)...)
+		pasos = append(pasos, renglonesPasos(c.Nombre, c.Memoria)...)
+		materiales = append(materiales, renglonesMateriales(c.Nombre, c.Memoria)...)
+	}
+
+	for i, renglones := range [][][]interface{}{entradas, pasos, materiales} {
+		if err := escribirRenglones(f, hojas[i].nombre, renglones); err != nil {
+			return nil, fmt.Errorf("%w: hoja %s: %v", domain.ErrGeneracionXlsx, hojas[i].nombre, err)
+		}
+	}
+
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrGeneracionXlsx, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// prepararHojas crea las hojas con su encabezado en negritas, anchos de columna y
+// el primer renglón inmovilizado. La hoja por defecto "Sheet1" se renombra a Entradas.
+func prepararHojas(f *excelize.File) error {
+	encabezado, err := f.NewStyle(&excelize.Style{
+		Font: &excelize.Font{Bold: true},
+		Fill: excelize.Fill{Type: "pattern", Pattern: 1, Color: []string{"D9E1F2"}},
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, h := range hojas {
+		if i == 0 {
+			if err := f.SetSheetName(f.GetSheetName(0), h.nombre); err != nil {
+				return err
+			}
+		} else if _, err := f.NewSheet(h.nombre); err != nil {
+			return err
+		}
+
+		if err := f.SetSheetRow(h.nombre, "A1", &h.columnas); err != nil {
+			return err
+		}
+		ultima, err := excelize.ColumnNumberToName(len(h.columnas))
+		if err != nil {
+			return err
+		}
+		if err := f.SetCellStyle(h.nombre, "A1", ultima+"1", encabezado); err != nil {
+			return err
+		}
+		for j, ancho := range h.anchos {
+			col, _ := excelize.ColumnNumberToName(j + 1)
+			if err := f.SetColWidth(h.nombre, col, col, ancho); err != nil {
+				return err
+			}
+		}
+		if err := f.SetPanes(h.nombre, &excelize.Panes{
+			Freeze: true, YSplit: 1, TopLeftCell: "A2", ActivePane: "bottomLeft",
+		}); err != nil {
+			return err
+		}
+	}
+	f.SetActiveSheet(0)
+	return nil
+}
+
+// escribirRenglones escribe los renglones a partir de la fila 2 (debajo del encabezado).
+func escribirRenglones(f *excelize.File, hoja string, renglones [][]interface{}) error {
+	for i, r := range renglones {
+		celda, err := excelize.CoordinatesToCellName(1, i+2)
+		if err != nil {
+			return err
+		}
+		if err := f.SetSheetRow(hoja, celda, &r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renglonesEntradas lista los datos de entrada del equipo y la instalación.
+func renglonesEntradas(circuito string, m calculosdto.MemoriaOutput) [][]interface{} {
+	inst := m.Instalacion
+	renglones := [][]interface{}{
+		{circuito, "Clave del equipo", m.Equipo.Clave, ""},
+		{circuito, "Tipo de equipo", m.TipoEquipo, ""},
+		{circuito, "Estado", m.Estado, ""},
+		{circuito, "Factor de potencia", m.FactorPotencia, ""},
+		{circuito, "Tensión", inst.Tension, "V"},
+		{circuito, "Sistema eléctrico", string(inst.SistemaElectrico), ""},
+		{circuito, "Tipo de canalización", inst.TipoCanalizacion, ""},
+		{circuito, "Material", inst.Material, ""},
+		{circuito, "Tipo de aislamiento", inst.TipoAislamiento, ""},
+		{circuito, "Longitud del circuito", inst.LongitudCircuito, "m"},
+		{circuito, "Hilos por fase", inst.HilosPorFase, ""},
+		{circuito, "Caída de tensión máxima", inst.PorcentajeCaidaMaximo, "%"},
+	}
+	if m.Equipo.ITM > 0 {
+		renglones = append(renglones, []interface{}{circuito, "ITM del equipo", m.Equipo.ITM, "A"})
+	}
+	return renglones
+}
+
+// renglonesPasos lista los pasos de la memoria; el resultado de cada paso se
+// serializa como JSON porque su forma depende del paso.
+func renglonesPasos(circuito string, m calculosdto.MemoriaOutput) [][]interface{} {
+	renglones := make([][]interface{}, 0, len(m.Pasos))
+	for _, p := range m.Pasos {
+		renglones = append(renglones, []interface{}{
+			circuito, p.Numero, p.Nombre, p.Descripcion, resultadoTexto(p.Resultado),
+		})
+	}
+	return renglones
+}
+
+// renglonesMateriales lista las partidas de la lista de materiales; los precios
+// quedan vacíos cuando la lista no está valorizada.
+func renglonesMateriales(circuito string, m calculosdto.MemoriaOutput) [][]interface{} {
+	if m.ListaMateriales == nil {
+		return nil
+	}
+	lista := m.ListaMateriales
+	renglones := make([][]interface{}, 0, len(lista.Partidas))
+	for _, p := range lista.Partidas {
+		renglones = append(renglones, []interface{}{
+			circuito, p.Partida, p.Categoria, p.Descripcion, p.Detalle, p.Cantidad, p.Unidad,
+			valorOpcional(p.PrecioUnitario), valorOpcional(p.Importe), lista.Moneda,
+		})
+	}
+	return renglones
+}
+
+// resultadoTexto serializa el resultado de un paso y lo recorta al máximo de
+// caracteres que admite una celda de Excel (los diagramas SVG pueden excederlo).
+func resultadoTexto(resultado interface{}) string {
+	if resultado == nil {
+		return ""
+	}
+	b, err := json.Marshal(resultado)
+	if err != nil {
+		return fmt.Sprintf("%v", resultado)
+	}
+	s := string(b)
+	if utf8.RuneCountInString(s) > excelize.TotalCellChars {
+		s = string([]rune(s)[:excelize.TotalCellChars])
+	}
+	return s
+}
+
+// valorOpcional convierte un *float64 en celda vacía cuando es nil.
+func valorOpcional(v *float64) interface{} {
+	if v == nil {
+		return nil
+	}
+	return *v
+}
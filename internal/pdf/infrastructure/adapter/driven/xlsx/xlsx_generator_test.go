@@ -0,0 +1,142 @@
+// internal/pdf/infrastructure/adapter/driven/xlsx/xlsx_generator_test.go
+package xlsx
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	calculosdto "github.com/garfex/calculadora-filtros/internal/calculos/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/pdf/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+func memoriaPrueba(clave string) calculosdto.MemoriaOutput {
+	precio := 140.0
+	importe := 88200.0
+	return calculosdto.MemoriaOutput{
+		Equipo: calculosdto.DatosEquipo{Clave: clave, ITM: 100},
+		Instalacion: calculosdto.DatosInstalacion{
+			Tension:          480,
+			SistemaElectrico: calculosdto.SistemaElectricoDelta,
+			TipoCanalizacion: "TUBERIA_PVC",
+			LongitudCircuito: 100,
+			HilosPorFase:     2,
+		},
+		Pasos: []calculosdto.PasoMemoria{
+			{Numero: 1, Nombre: "Corriente nominal", Descripcion: "I = KVA / (kV × √3)", Resultado: map[string]float64{"corriente": 120.3}},
+			{Numero: 2, Nombre: "Diagrama", Resultado: strings.Repeat("x", excelize.TotalCellChars+10)},
+		},
+		ListaMateriales: &calculosdto.ResultadoListaMateriales{
+			Moneda: "MXN",
+			Partidas: []calculosdto.PartidaMaterial{
+				{Partida: 1, Categoria: calculosdto.CategoriaConductor, Descripcion: "Cable 2 AWG Cu THW (fase)", Cantidad: 630, Unidad: "m", PrecioUnitario: &precio, Importe: &importe},
+				{Partida: 2, Categoria: calculosdto.CategoriaAccesorio, Descripcion: "Zapata", Cantidad: 12, Unidad: "pza"},
+			},
+		},
+	}
+}
+
+func abrirLibro(t *testing.T, b []byte) *excelize.File {
+	t.Helper()
+	f, err := excelize.OpenReader(bytes.NewReader(b))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = f.Close() })
+	return f
+}
+
+func TestXlsxGenerator_Generate(t *testing.T) {
+	g := NewXlsxGenerator()
+
+	t.Run("memoria: hojas de entradas, pasos y materiales", func(t *testing.T) {
+		b, err := g.Generate(context.Background(), []calculosdto.CircuitoOutput{
+			{Nombre: "TR-01", Memoria: memoriaPrueba("TR-01")},
+		})
+		require.NoError(t, err)
+
+		f := abrirLibro(t, b)
+		assert.Equal(t, []string{HojaEntradas, HojaPasos, HojaMateriales}, f.GetSheetList())
+
+		entradas, err := f.GetRows(HojaEntradas)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Circuito", "Concepto", "Valor", "Unidad"}, entradas[0])
+		assert.Contains(t, entradas, []string{"TR-01", "Tensión", "480", "V"})
+		assert.Contains(t, entradas, []string{"TR-01", "ITM del equipo", "100", "A"})
+
+		pasos, err := f.GetRows(HojaPasos)
+		require.NoError(t, err)
+		require.Len(t, pasos, 3)
+		assert.Equal(t, []string{"TR-01", "1", "Corriente nominal", "I = KVA / (kV × √3)", `{"corriente":120.3}`}, pasos[1])
+		assert.Len(t, pasos[2][4], excelize.TotalCellChars) // recortado al límite de celda
+
+		materiales, err := f.GetRows(HojaMateriales)
+		require.NoError(t, err)
+		require.Len(t, materiales, 3)
+		assert.Equal(t, "630", materiales[1][5])
+		assert.Equal(t, "88200", materiales[1][8])
+		assert.Equal(t, "MXN", materiales[1][9])
+		assert.Equal(t, "", materiales[2][7]) // sin precio
+	})
+
+	t.Run("proyecto: un bloque de renglones por circuito", func(t *testing.T) {
+		sinLista := memoriaPrueba("TR-02")
+		sinLista.ListaMateriales = nil
+
+		b, err := g.Generate(context.Background(), []calculosdto.CircuitoOutput{
+			{Nombre: "C1", Memoria: memoriaPrueba("TR-01")},
+			{Nombre: "C2", Memoria: sinLista},
+		})
+		require.NoError(t, err)
+
+		f := abrirLibro(t, b)
+		pasos, err := f.GetRows(HojaPasos)
+		require.NoError(t, err)
+		require.Len(t, pasos, 5)
+		assert.Equal(t, "C1", pasos[1][0])
+		assert.Equal(t, "C2", pasos[4][0])
+
+		materiales, err := f.GetRows(HojaMateriales)
+		require.NoError(t, err)
+		assert.Len(t, materiales, 3) // C2 no tiene lista de materiales
+	})
+
+	t.Run("pasos en el orden de la memoria", func(t *testing.T) {
+		memoria := memoriaPrueba("TR-01")
+		memoria.Pasos = []calculosdto.PasoMemoria{
+			{Numero: 1, Nombre: "Corriente Nominal"},
+			{Numero: 2, Nombre: "Ajuste de Corriente"},
+			{Numero: 3, Nombre: "Factor K del Transformador"},
+			{Numero: 4, Nombre: "Selección de Protección (ITM)"},
+			{Numero: 5, Nombre: "Selección de Conductores"},
+		}
+
+		b, err := g.Generate(context.Background(), []calculosdto.CircuitoOutput{
+			{Nombre: "TR-01", Memoria: memoria},
+		})
+		require.NoError(t, err)
+
+		pasos, err := abrirLibro(t, b).GetRows(HojaPasos)
+		require.NoError(t, err)
+		nombres := make([]string, 0, len(pasos)-1)
+		for _, p := range pasos[1:] {
+			nombres = append(nombres, p[2])
+		}
+		assert.Equal(t, []string{
+			"Corriente Nominal",
+			"Ajuste de Corriente",
+			"Factor K del Transformador",
+			"Selección de Protección (ITM)",
+			"Selección de Conductores",
+		}, nombres)
+	})
+
+	t.Run("contexto cancelado", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := g.Generate(ctx, nil)
+		assert.ErrorIs(t, err, domain.ErrGeneracionXlsx)
+	})
+}
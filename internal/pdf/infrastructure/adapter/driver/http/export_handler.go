@@ -0,0 +1,138 @@
+// internal/pdf/infrastructure/adapter/driver/http/export_handler.go
+package http
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/garfex/calculadora-filtros/internal/pdf/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/pdf/application/usecase"
+	"github.com/garfex/calculadora-filtros/internal/pdf/domain"
+	"github.com/gin-gonic/gin"
+)
+
+// contentTypeXlsx es el MIME type de un libro de Excel (Office Open XML).
+const contentTypeXlsx = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+// ExportHandler maneja los endpoints de exportación de la memoria de cálculo a hoja de cálculo.
+type ExportHandler struct {
+	exportarMemoriaUC  *usecase.ExportarMemoriaXlsxUseCase
+	exportarProyectoUC *usecase.ExportarProyectoXlsxUseCase
+}
+
+// NewExportHandler crea un nuevo ExportHandler con los use cases inyectados.
+func NewExportHandler(
+	exportarMemoriaUC *usecase.ExportarMemoriaXlsxUseCase,
+	exportarProyectoUC *usecase.ExportarProyectoXlsxUseCase,
+) *ExportHandler {
+	return &ExportHandler{
+		exportarMemoriaUC:  exportarMemoriaUC,
+		exportarProyectoUC: exportarProyectoUC,
+	}
+}
+
+// ExportarMemoria POST /api/v1/export/memoria.xlsx
+// @Summary Exportar memoria de cálculo a Excel
+// @Description Genera un libro .xlsx con hojas de entradas, pasos y lista de materiales a partir del resultado de cálculo
+// @Tags Export
+// @Accept json
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param request body dto.XlsxMemoriaRequest true "Resultado de cálculo"
+// @Success 200 {file} binary "Libro generado exitosamente"
+// @Failure 400 {object} pdfErrorResponse "Error de validación"
+// @Failure 500 {object} pdfErrorResponse "Error al generar el libro"
+// @Router /export/memoria.xlsx [post]
+func (h *ExportHandler) ExportarMemoria(c *gin.Context) {
+	var req dto.XlsxMemoriaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, pdfErrorResponse{
+			Success: false,
+			Error:   "Error de validación del JSON",
+			Code:    "VALIDATION_ERROR",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	xlsxBytes, err := h.exportarMemoriaUC.Execute(c.Request.Context(), req)
+	if err != nil {
+		log.Printf("[ERROR] export_handler.ExportarMemoria: error executing use case: %v", err)
+		status, resp := h.mapError(err)
+		c.JSON(status, resp)
+		return
+	}
+
+	responderXlsx(c, buildFilenameExt(req.NombreProyecto, req.Memoria.Equipo.Clave, "xlsx"), xlsxBytes)
+}
+
+// ExportarProyecto POST /api/v1/export/proyecto.xlsx
+// @Summary Exportar memoria de cálculo de proyecto a Excel
+// @Description Genera un solo libro .xlsx con las entradas, pasos y materiales de todos los circuitos del proyecto
+// @Tags Export
+// @Accept json
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param request body dto.XlsxProyectoRequest true "Resultado del proyecto"
+// @Success 200 {file} binary "Libro generado exitosamente"
+// @Failure 400 {object} pdfErrorResponse "Error de validación"
+// @Failure 500 {object} pdfErrorResponse "Error al generar el libro"
+// @Router /export/proyecto.xlsx [post]
+func (h *ExportHandler) ExportarProyecto(c *gin.Context) {
+	var req dto.XlsxProyectoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, pdfErrorResponse{
+			Success: false,
+			Error:   "Error de validación del JSON",
+			Code:    "VALIDATION_ERROR",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	if len(req.Proyecto.Circuitos) == 0 {
+		c.JSON(http.StatusBadRequest, pdfErrorResponse{
+			Success: false,
+			Error:   "El proyecto no tiene circuitos",
+			Code:    "PROYECTO_SIN_CIRCUITOS",
+			Details: "proyecto.circuitos no puede estar vacío",
+		})
+		return
+	}
+
+	xlsxBytes, err := h.exportarProyectoUC.Execute(c.Request.Context(), req)
+	if err != nil {
+		log.Printf("[ERROR] export_handler.ExportarProyecto: error executing use case: %v", err)
+		status, resp := h.mapError(err)
+		c.JSON(status, resp)
+		return
+	}
+
+	responderXlsx(c, buildFilenameExt(req.Proyecto.Nombre, "Proyecto", "xlsx"), xlsxBytes)
+}
+
+// responderXlsx escribe el libro como adjunto.
+func responderXlsx(c *gin.Context, filename string, xlsxBytes []byte) {
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	c.Header("Content-Length", fmt.Sprintf("%d", len(xlsxBytes)))
+	c.Data(http.StatusOK, contentTypeXlsx, xlsxBytes)
+}
+
+// mapError convierte errores de la exportación a respuestas HTTP apropiadas.
+func (h *ExportHandler) mapError(err error) (int, pdfErrorResponse) {
+	if errors.Is(err, domain.ErrGeneracionXlsx) {
+		return http.StatusInternalServerError, pdfErrorResponse{
+			Success: false,
+			Error:   "Error al generar el libro de Excel",
+			Code:    "ERROR_GENERACION_XLSX",
+			Details: err.Error(),
+		}
+	}
+
+	return http.StatusInternalServerError, pdfErrorResponse{
+		Success: false,
+		Error:   "Error interno del servidor",
+		Code:    "INTERNAL_ERROR",
+		Details: err.Error(),
+	}
+}
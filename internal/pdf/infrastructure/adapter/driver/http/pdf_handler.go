@@ -19,15 +19,26 @@ import (
 // reNonAlphaNum filtra caracteres no alfanuméricos ni guiones bajos/medios del filename.
 var reNonAlphaNum = regexp.MustCompile(`[^a-zA-Z0-9_\-]`)
 
+// contentTypesMemoria asocia cada formato de la memoria con su MIME type.
+var contentTypesMemoria = map[string]string{
+	dto.FormatoPdf:  "application/pdf",
+	dto.FormatoDocx: "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+}
+
 // PdfHandler maneja los endpoints de generación de PDF de memoria de cálculo.
 type PdfHandler struct {
-	generarMemoriaUC *usecase.GenerarMemoriaPdfUseCase
+	generarMemoriaUC  *usecase.GenerarMemoriaPdfUseCase
+	generarProyectoUC *usecase.GenerarProyectoPdfUseCase
 }
 
-// NewPdfHandler crea un nuevo PdfHandler con el use case inyectado.
-func NewPdfHandler(generarMemoriaUC *usecase.GenerarMemoriaPdfUseCase) *PdfHandler {
+// NewPdfHandler crea un nuevo PdfHandler con los use cases inyectados.
+func NewPdfHandler(
+	generarMemoriaUC *usecase.GenerarMemoriaPdfUseCase,
+	generarProyectoUC *usecase.GenerarProyectoPdfUseCase,
+) *PdfHandler {
 	return &PdfHandler{
-		generarMemoriaUC: generarMemoriaUC,
+		generarMemoriaUC:  generarMemoriaUC,
+		generarProyectoUC: generarProyectoUC,
 	}
 }
 
@@ -40,13 +51,14 @@ type pdfErrorResponse struct {
 }
 
 // GenerarMemoria POST /api/v1/pdf/memoria
-// @Summary Generar memoria de cálculo en PDF
-// @Description Genera la memoria de cálculo eléctrica en PDF a partir del resultado de cálculo y datos de presentación
+// @Summary Generar memoria de cálculo en PDF o Word
+// @Description Genera la memoria de cálculo eléctrica en PDF (default) o DOCX editable según el campo formato
 // @Tags PDF
 // @Accept json
 // @Produce application/pdf
-// @Param request body dto.PdfMemoriaRequest true "Datos de cálculo y presentación"
-// @Success 200 {file} binary "PDF generado exitosamente"
+// @Produce application/vnd.openxmlformats-officedocument.wordprocessingml.document
+// @Param request body dto.PdfMemoriaRequest true "Datos de cálculo, presentación y formato"
+// @Success 200 {file} binary "Documento generado exitosamente"
 // @Failure 400 {object} pdfErrorResponse "Error de validación"
 // @Failure 500 {object} pdfErrorResponse "Error al generar el PDF"
 // @Router /pdf/memoria [post]
@@ -63,66 +75,139 @@ func (h *PdfHandler) GenerarMemoria(c *gin.Context) {
 	}
 
 	// Validar campos requeridos manualmente (no usan binding tags por ser structs anidados)
-	if req.Presentacion.EmpresaID == "" {
-		c.JSON(http.StatusBadRequest, pdfErrorResponse{
-			Success: false,
-			Error:   "El campo empresa_id es requerido",
-			Code:    "EMPRESA_ID_REQUERIDO",
-			Details: "presentacion.empresa_id no puede estar vacío",
-		})
+	if errResp := validarPresentacion(req.Presentacion); errResp != nil {
+		c.JSON(http.StatusBadRequest, *errResp)
 		return
 	}
 
-	if req.Presentacion.NombreProyecto == "" {
+	formato := req.GetFormato()
+	contentType, ok := contentTypesMemoria[formato]
+	if !ok {
 		c.JSON(http.StatusBadRequest, pdfErrorResponse{
 			Success: false,
-			Error:   "El campo nombre_proyecto es requerido",
-			Code:    "NOMBRE_PROYECTO_REQUERIDO",
-			Details: "presentacion.nombre_proyecto no puede estar vacío",
+			Error:   "Formato de documento no soportado",
+			Code:    "FORMATO_INVALIDO",
+			Details: fmt.Sprintf("formato=%q no es válido. Valores aceptados: pdf, docx", req.Formato),
 		})
 		return
 	}
 
-	if req.Presentacion.Responsable == "" {
+	// Ejecutar el use case de generación del documento
+	pdfBytes, err := h.generarMemoriaUC.Execute(c.Request.Context(), req)
+	if err != nil {
+		log.Printf("[ERROR] pdf_handler.GenerarMemoria: error executing use case: %v", err)
+		status, resp := h.mapError(err)
+		c.JSON(status, resp)
+		return
+	}
+
+	// Construir nombre de archivo sanitizado con la extensión del formato
+	filename := buildFilenameExt(req.Presentacion.NombreProyecto, req.Memoria.Equipo.Clave, formato)
+
+	// Responder con el documento
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	c.Header("Content-Type", contentType)
+	c.Header("Content-Length", fmt.Sprintf("%d", len(pdfBytes)))
+	c.Data(http.StatusOK, contentType, pdfBytes)
+}
+
+// GenerarProyecto POST /api/v1/pdf/proyecto
+// @Summary Generar memoria de cálculo de proyecto en PDF
+// @Description Genera un solo PDF con el resumen del proyecto y una sección de memoria por circuito
+// @Tags PDF
+// @Accept json
+// @Produce application/pdf
+// @Param request body dto.PdfProyectoRequest true "Resultado del proyecto y datos de presentación"
+// @Success 200 {file} binary "PDF generado exitosamente"
+// @Failure 400 {object} pdfErrorResponse "Error de validación"
+// @Failure 500 {object} pdfErrorResponse "Error al generar el PDF"
+// @Router /pdf/proyecto [post]
+func (h *PdfHandler) GenerarProyecto(c *gin.Context) {
+	var req dto.PdfProyectoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, pdfErrorResponse{
 			Success: false,
-			Error:   "El campo responsable es requerido",
-			Code:    "RESPONSABLE_REQUERIDO",
-			Details: "presentacion.responsable no puede estar vacío",
+			Error:   "Error de validación del JSON",
+			Code:    "VALIDATION_ERROR",
+			Details: err.Error(),
 		})
 		return
 	}
 
-	// Validar que el empresa_id existe en el catálogo estático
-	if _, ok := domain.BuscarEmpresaPorID(req.Presentacion.EmpresaID); !ok {
+	if errResp := validarPresentacion(req.Presentacion); errResp != nil {
+		c.JSON(http.StatusBadRequest, *errResp)
+		return
+	}
+
+	if len(req.Proyecto.Circuitos) == 0 {
 		c.JSON(http.StatusBadRequest, pdfErrorResponse{
 			Success: false,
-			Error:   "La empresa especificada no existe en el catálogo",
-			Code:    "EMPRESA_NO_ENCONTRADA",
-			Details: fmt.Sprintf("empresa_id=%q no es válido. Valores aceptados: garfex, summa, siemens", req.Presentacion.EmpresaID),
+			Error:   "El proyecto no tiene circuitos",
+			Code:    "PROYECTO_SIN_CIRCUITOS",
+			Details: "proyecto.circuitos no puede estar vacío",
 		})
 		return
 	}
 
-	// Ejecutar el use case de generación de PDF
-	pdfBytes, err := h.generarMemoriaUC.Execute(c.Request.Context(), req)
+	pdfBytes, err := h.generarProyectoUC.Execute(c.Request.Context(), req)
 	if err != nil {
-		log.Printf("[ERROR] pdf_handler.GenerarMemoria: error executing use case: %v", err)
+		log.Printf("[ERROR] pdf_handler.GenerarProyecto: error executing use case: %v", err)
 		status, resp := h.mapError(err)
 		c.JSON(status, resp)
 		return
 	}
 
-	// Construir nombre de archivo sanitizado
-	filename := buildFilename(req.Presentacion.NombreProyecto, req.Memoria.Equipo.Clave)
+	filename := buildFilename(req.Presentacion.NombreProyecto, req.Proyecto.Nombre)
 
-	// Responder con el PDF
 	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
 	c.Header("Content-Type", "application/pdf")
 	c.Header("Content-Length", fmt.Sprintf("%d", len(pdfBytes)))
 	c.Data(http.StatusOK, "application/pdf", pdfBytes)
 }
 
+// validarPresentacion verifica los campos requeridos de presentación y que la empresa exista.
+// Retorna nil si la presentación es válida.
+func validarPresentacion(p dto.PresentacionInput) *pdfErrorResponse {
+	if p.EmpresaID == "" {
+		return &pdfErrorResponse{
+			Success: false,
+			Error:   "El campo empresa_id es requerido",
+			Code:    "EMPRESA_ID_REQUERIDO",
+			Details: "presentacion.empresa_id no puede estar vacío",
+		}
+	}
+
+	if p.NombreProyecto == "" {
+		return &pdfErrorResponse{
+			Success: false,
+			Error:   "El campo nombre_proyecto es requerido",
+			Code:    "NOMBRE_PROYECTO_REQUERIDO",
+			Details: "presentacion.nombre_proyecto no puede estar vacío",
+		}
+	}
+
+	if p.Responsable == "" {
+		return &pdfErrorResponse{
+			Success: false,
+			Error:   "El campo responsable es requerido",
+			Code:    "RESPONSABLE_REQUERIDO",
+			Details: "presentacion.responsable no puede estar vacío",
+		}
+	}
+
+	// Validar que el empresa_id existe en el catálogo estático
+	if _, ok := domain.BuscarEmpresaPorID(p.EmpresaID); !ok {
+		return &pdfErrorResponse{
+			Success: false,
+			Error:   "La empresa especificada no existe en el catálogo",
+			Code:    "EMPRESA_NO_ENCONTRADA",
+			Details: fmt.Sprintf("empresa_id=%q no es válido. Valores aceptados: garfex, summa, siemens", p.EmpresaID),
+		}
+	}
+
+	return nil
+}
+
 // mapError convierte errores del dominio/aplicación a respuestas HTTP apropiadas.
 func (h *PdfHandler) mapError(err error) (int, pdfErrorResponse) {
 	if errors.Is(err, domain.ErrEmpresaNoEncontrada) {
@@ -143,6 +228,24 @@ func (h *PdfHandler) mapError(err error) (int, pdfErrorResponse) {
 		}
 	}
 
+	if errors.Is(err, domain.ErrFormatoInvalido) {
+		return http.StatusBadRequest, pdfErrorResponse{
+			Success: false,
+			Error:   "Formato de documento no soportado",
+			Code:    "FORMATO_INVALIDO",
+			Details: err.Error(),
+		}
+	}
+
+	if errors.Is(err, domain.ErrGeneracionDocx) {
+		return http.StatusInternalServerError, pdfErrorResponse{
+			Success: false,
+			Error:   "Error al generar el documento Word",
+			Code:    "ERROR_GENERACION_DOCX",
+			Details: err.Error(),
+		}
+	}
+
 	if errors.Is(err, domain.ErrGeneracionPdf) {
 		return http.StatusInternalServerError, pdfErrorResponse{
 			Success: false,
@@ -164,6 +267,11 @@ func (h *PdfHandler) mapError(err error) (int, pdfErrorResponse) {
 // Formato: MemoriaCalculo_<proyecto>_<equipo>_<fecha>.pdf
 // Los espacios se convierten en guiones bajos y los caracteres especiales se eliminan.
 func buildFilename(nombreProyecto, claveEquipo string) string {
+	return buildFilenameExt(nombreProyecto, claveEquipo, "pdf")
+}
+
+// buildFilenameExt construye el nombre de archivo sanitizado con la extensión dada.
+func buildFilenameExt(nombreProyecto, claveEquipo, ext string) string {
 	fecha := time.Now().Format("20060102")
 
 	// Sanitizar proyecto: espacios → guión bajo, eliminar caracteres especiales
@@ -178,7 +286,7 @@ func buildFilename(nombreProyecto, claveEquipo string) string {
 		equipo = "Equipo"
 	}
 
-	return fmt.Sprintf("MemoriaCalculo_%s_%s_%s.pdf", proyecto, equipo, fecha)
+	return fmt.Sprintf("MemoriaCalculo_%s_%s_%s.%s", proyecto, equipo, fecha, ext)
 }
 
 // sanitizeFilenameSegment convierte espacios en guiones bajos y elimina caracteres especiales.
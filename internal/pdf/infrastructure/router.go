@@ -12,5 +12,16 @@ func RegisterPdfRoutes(rg *gin.RouterGroup, handler *pdfhttp.PdfHandler) {
 	pdf := rg.Group("/pdf")
 	{
 		pdf.POST("/memoria", handler.GenerarMemoria)
+		pdf.POST("/proyecto", handler.GenerarProyecto)
+	}
+}
+
+// RegisterExportRoutes monta las rutas de exportación a hoja de cálculo bajo el RouterGroup dado.
+// Invocar desde main.go pasando el grupo /api/v1.
+func RegisterExportRoutes(rg *gin.RouterGroup, handler *pdfhttp.ExportHandler) {
+	export := rg.Group("/export")
+	{
+		export.POST("/memoria.xlsx", handler.ExportarMemoria)
+		export.POST("/proyecto.xlsx", handler.ExportarProyecto)
 	}
 }
@@ -0,0 +1,19 @@
+// internal/precios/application/dto/errors.go
+package dto
+
+import "errors"
+
+// Application-level errors for the precios feature.
+var (
+	// ErrPrecioNoEncontrado is returned when a precio with the given ID does not exist.
+	ErrPrecioNoEncontrado = errors.New("precio no encontrado")
+
+	// ErrPrecioDuplicado is returned when a precio with the same key, moneda and vigente_desde already exists.
+	ErrPrecioDuplicado = errors.New("ya existe un precio para ese material, moneda y fecha de vigencia")
+
+	// ErrInputInvalido is returned when the input DTO fails validation.
+	ErrInputInvalido = errors.New("datos de entrada inválidos")
+
+	// ErrIDInvalido is returned when the provided string is not a valid UUID.
+	ErrIDInvalido = errors.New("el ID proporcionado no es un UUID válido")
+)
@@ -0,0 +1,152 @@
+// internal/precios/application/dto/precio_input.go
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/garfex/calculadora-filtros/internal/precios/domain/entity"
+)
+
+// FormatoFecha is the layout of vigencia dates in requests and responses.
+const FormatoFecha = "2006-01-02"
+
+// MonedaDefault is the currency used when the request does not specify one.
+const MonedaDefault = "MXN"
+
+// CreatePrecioInput is the inbound DTO for registering a material price.
+// Only the key fields of the given tipo are used:
+//   - CONDUCTOR: calibre, material, aislamiento (optional)
+//   - TUBERIA:   tipo_tuberia, tamano
+//   - CHAROLA:   ancho_mm
+type CreatePrecioInput struct {
+	Tipo           string  `json:"tipo"` // "CONDUCTOR" | "TUBERIA" | "CHAROLA"
+	Calibre        string  `json:"calibre,omitempty"`
+	Material       string  `json:"material,omitempty"`    // "CU" | "AL"
+	Aislamiento    string  `json:"aislamiento,omitempty"` // e.g. "THW"; empty = any
+	TipoTuberia    string  `json:"tipo_tuberia,omitempty"`
+	Tamano         string  `json:"tamano,omitempty"`
+	AnchoMM        float64 `json:"ancho_mm,omitempty"`
+	PrecioUnitario float64 `json:"precio_unitario"`         // per meter
+	Moneda         string  `json:"moneda,omitempty"`        // default: MXN
+	VigenteDesde   string  `json:"vigente_desde,omitempty"` // YYYY-MM-DD; default: today
+	VigenteHasta   *string `json:"vigente_hasta"`           // YYYY-MM-DD; nullable = open-ended
+}
+
+// Validate checks that all required fields are present and valid.
+func (i CreatePrecioInput) Validate() error {
+	_, err := i.ToDomain()
+	return err
+}
+
+// ToDomain converts the DTO to a domain entity ready for persistence.
+func (i CreatePrecioInput) ToDomain() (*entity.Precio, error) {
+	return nuevoPrecio(i.Tipo, entity.ClaveMaterial{
+		Calibre:     i.Calibre,
+		Material:    i.Material,
+		Aislamiento: i.Aislamiento,
+		TipoTuberia: i.TipoTuberia,
+		Tamano:      i.Tamano,
+		AnchoMM:     i.AnchoMM,
+	}, i.PrecioUnitario, i.Moneda, i.VigenteDesde, i.VigenteHasta)
+}
+
+// UpdatePrecioInput is the inbound DTO for updating an existing precio.
+// The ID comes from the URL path, not the body.
+type UpdatePrecioInput struct {
+	Tipo           string  `json:"tipo"`
+	Calibre        string  `json:"calibre,omitempty"`
+	Material       string  `json:"material,omitempty"`
+	Aislamiento    string  `json:"aislamiento,omitempty"`
+	TipoTuberia    string  `json:"tipo_tuberia,omitempty"`
+	Tamano         string  `json:"tamano,omitempty"`
+	AnchoMM        float64 `json:"ancho_mm,omitempty"`
+	PrecioUnitario float64 `json:"precio_unitario"`
+	Moneda         string  `json:"moneda,omitempty"`
+	VigenteDesde   string  `json:"vigente_desde,omitempty"`
+	VigenteHasta   *string `json:"vigente_hasta"`
+}
+
+// Validate checks that all required fields are present and valid.
+func (i UpdatePrecioInput) Validate() error {
+	_, err := i.ToDomain()
+	return err
+}
+
+// ToDomain converts the DTO to a domain entity; the caller sets the ID.
+func (i UpdatePrecioInput) ToDomain() (*entity.Precio, error) {
+	return nuevoPrecio(i.Tipo, entity.ClaveMaterial{
+		Calibre:     i.Calibre,
+		Material:    i.Material,
+		Aislamiento: i.Aislamiento,
+		TipoTuberia: i.TipoTuberia,
+		Tamano:      i.Tamano,
+		AnchoMM:     i.AnchoMM,
+	}, i.PrecioUnitario, i.Moneda, i.VigenteDesde, i.VigenteHasta)
+}
+
+// nuevoPrecio parses the primitive fields shared by create and update, applies
+// defaults (MXN, today) and wraps every failure in ErrInputInvalido.
+func nuevoPrecio(tipoStr string, clave entity.ClaveMaterial, precio float64, moneda, desdeStr string, hastaStr *string) (*entity.Precio, error) {
+	if tipoStr == "" {
+		return nil, fmt.Errorf("%w: tipo es requerido", ErrInputInvalido)
+	}
+	tipo, err := entity.ParseTipoPrecio(tipoStr)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInputInvalido, err.Error())
+	}
+
+	if moneda == "" {
+		moneda = MonedaDefault
+	}
+
+	desde := time.Now().UTC()
+	if desdeStr != "" {
+		desde, err = time.Parse(FormatoFecha, desdeStr)
+		if err != nil {
+			return nil, fmt.Errorf("%w: vigente_desde debe tener formato YYYY-MM-DD", ErrInputInvalido)
+		}
+	}
+
+	var hasta *time.Time
+	if hastaStr != nil && *hastaStr != "" {
+		h, err := time.Parse(FormatoFecha, *hastaStr)
+		if err != nil {
+			return nil, fmt.Errorf("%w: vigente_hasta debe tener formato YYYY-MM-DD", ErrInputInvalido)
+		}
+		hasta = &h
+	}
+
+	p, err := entity.NewPrecio(tipo, clave, precio, moneda, desde, hasta)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInputInvalido, err.Error())
+	}
+	return p, nil
+}
+
+// ListPreciosQuery contains optional query filters and pagination for listing precios.
+type ListPreciosQuery struct {
+	Tipo      string // optional: "CONDUCTOR" | "TUBERIA" | "CHAROLA"
+	Moneda    string // optional: ISO 4217
+	VigenteEn string // optional: YYYY-MM-DD, only prices in effect on that date
+	Page      int    // 1-indexed, default 1
+	PageSize  int    // default 20, max 100
+}
+
+// ApplyDefaults sets sensible defaults for pagination fields.
+func (q *ListPreciosQuery) ApplyDefaults() {
+	if q.Page <= 0 {
+		q.Page = 1
+	}
+	if q.PageSize <= 0 {
+		q.PageSize = 20
+	}
+	if q.PageSize > 100 {
+		q.PageSize = 100
+	}
+}
+
+// Offset returns the SQL OFFSET value for this page.
+func (q ListPreciosQuery) Offset() int {
+	return (q.Page - 1) * q.PageSize
+}
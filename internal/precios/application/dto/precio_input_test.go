@@ -0,0 +1,70 @@
+// internal/precios/application/dto/precio_input_test.go
+package dto_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/garfex/calculadora-filtros/internal/precios/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/precios/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreatePrecioInput_Validate(t *testing.T) {
+	hasta := "2026-12-31"
+	validInput := dto.CreatePrecioInput{
+		Tipo:           "CONDUCTOR",
+		Calibre:        "4/0 AWG",
+		Material:       "CU",
+		Aislamiento:    "THW",
+		PrecioUnitario: 385.5,
+		VigenteDesde:   "2026-01-01",
+		VigenteHasta:   &hasta,
+	}
+
+	t.Run("input válido pasa validación", func(t *testing.T) {
+		assert.NoError(t, validInput.Validate())
+	})
+
+	t.Run("tipo vacío falla", func(t *testing.T) {
+		input := validInput
+		input.Tipo = ""
+		assert.ErrorIs(t, input.Validate(), dto.ErrInputInvalido)
+	})
+
+	t.Run("clave incompleta falla", func(t *testing.T) {
+		input := validInput
+		input.Calibre = ""
+		assert.ErrorIs(t, input.Validate(), dto.ErrInputInvalido)
+	})
+
+	t.Run("fecha con formato inválido falla", func(t *testing.T) {
+		input := validInput
+		input.VigenteDesde = "01/01/2026"
+		assert.ErrorIs(t, input.Validate(), dto.ErrInputInvalido)
+	})
+
+	t.Run("vigencia invertida falla", func(t *testing.T) {
+		input := validInput
+		antes := "2025-12-31"
+		input.VigenteHasta = &antes
+		assert.ErrorIs(t, input.Validate(), dto.ErrInputInvalido)
+	})
+}
+
+func TestCreatePrecioInput_ToDomain(t *testing.T) {
+	t.Run("aplica moneda y vigencia por defecto", func(t *testing.T) {
+		p, err := dto.CreatePrecioInput{
+			Tipo:           "TUBERIA",
+			TipoTuberia:    "TUBERIA_PVC",
+			Tamano:         "2",
+			PrecioUnitario: 60,
+		}.ToDomain()
+		require.NoError(t, err)
+		assert.Equal(t, entity.TipoPrecioTuberia, p.Tipo)
+		assert.Equal(t, dto.MonedaDefault, p.Moneda)
+		assert.Equal(t, time.Now().UTC().Format(dto.FormatoFecha), p.VigenteDesde.Format(dto.FormatoFecha))
+		assert.Nil(t, p.VigenteHasta)
+	})
+}
@@ -0,0 +1,95 @@
+// internal/precios/application/dto/precio_output.go
+package dto
+
+import (
+	"time"
+
+	"github.com/garfex/calculadora-filtros/internal/precios/domain/entity"
+)
+
+// PrecioOutput is the outbound DTO for a single precio.
+// Key fields that do not apply to the tipo are omitted.
+type PrecioOutput struct {
+	ID             string  `json:"id"`
+	Tipo           string  `json:"tipo"`
+	Calibre        string  `json:"calibre,omitempty"`
+	Material       string  `json:"material,omitempty"`
+	Aislamiento    string  `json:"aislamiento,omitempty"`
+	TipoTuberia    string  `json:"tipo_tuberia,omitempty"`
+	Tamano         string  `json:"tamano,omitempty"`
+	AnchoMM        float64 `json:"ancho_mm,omitempty"`
+	PrecioUnitario float64 `json:"precio_unitario"`
+	Moneda         string  `json:"moneda"`
+	VigenteDesde   string  `json:"vigente_desde"` // YYYY-MM-DD
+	VigenteHasta   *string `json:"vigente_hasta"` // YYYY-MM-DD, nullable
+	CreatedAt      string  `json:"created_at"`    // ISO 8601
+	UpdatedAt      string  `json:"updated_at"`    // ISO 8601
+}
+
+// FromDomain converts a domain entity to an output DTO.
+func FromDomain(e *entity.Precio) PrecioOutput {
+	var hasta *string
+	if e.VigenteHasta != nil {
+		s := e.VigenteHasta.Format(FormatoFecha)
+		hasta = &s
+	}
+
+	return PrecioOutput{
+		ID:             e.ID.String(),
+		Tipo:           e.Tipo.String(),
+		Calibre:        e.Clave.Calibre,
+		Material:       e.Clave.Material,
+		Aislamiento:    e.Clave.Aislamiento,
+		TipoTuberia:    e.Clave.TipoTuberia,
+		Tamano:         e.Clave.Tamano,
+		AnchoMM:        e.Clave.AnchoMM,
+		PrecioUnitario: e.PrecioUnitario,
+		Moneda:         e.Moneda,
+		VigenteDesde:   e.VigenteDesde.Format(FormatoFecha),
+		VigenteHasta:   hasta,
+		CreatedAt:      e.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:      e.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// PaginationMeta contains pagination metadata for collection responses.
+type PaginationMeta struct {
+	Page       int  `json:"page"`
+	PageSize   int  `json:"page_size"`
+	Total      int  `json:"total"`
+	TotalPages int  `json:"total_pages"`
+	HasNext    bool `json:"has_next"`
+	HasPrev    bool `json:"has_prev"`
+}
+
+// ListPreciosOutput is the outbound DTO for a paginated list of precios.
+type ListPreciosOutput struct {
+	Precios    []PrecioOutput `json:"precios"`
+	Pagination PaginationMeta `json:"pagination"`
+}
+
+// FromDomainList converts a slice of domain entities to a paginated list output DTO.
+// total is the FULL count (all matching rows, not just this page).
+func FromDomainList(entities []*entity.Precio, page, pageSize, total int) ListPreciosOutput {
+	out := make([]PrecioOutput, len(entities))
+	for i, e := range entities {
+		out[i] = FromDomain(e)
+	}
+
+	totalPages := (total + pageSize - 1) / pageSize
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	return ListPreciosOutput{
+		Precios: out,
+		Pagination: PaginationMeta{
+			Page:       page,
+			PageSize:   pageSize,
+			Total:      total,
+			TotalPages: totalPages,
+			HasNext:    page < totalPages,
+			HasPrev:    page > 1,
+		},
+	}
+}
@@ -0,0 +1,43 @@
+// internal/precios/application/port/precio_repository.go
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/garfex/calculadora-filtros/internal/precios/domain/entity"
+	"github.com/google/uuid"
+)
+
+// FiltrosListado contains optional filters and pagination for listing prices.
+type FiltrosListado struct {
+	Tipo      *entity.TipoPrecio // nil = all types
+	Moneda    *string            // nil = all currencies
+	VigenteEn *time.Time         // nil = any vigencia, otherwise only prices in effect on that date
+	Limit     int                // page size (> 0)
+	Offset    int                // number of rows to skip
+}
+
+// PrecioRepository defines the persistence contract for material prices.
+// Infrastructure must implement this interface.
+type PrecioRepository interface {
+	// Crear persists a new precio and returns it with the DB-generated ID and timestamps.
+	// Returns ErrPrecioDuplicado if the same key, moneda and vigente_desde already exist.
+	Crear(ctx context.Context, precio *entity.Precio) (*entity.Precio, error)
+
+	// ObtenerPorID finds a precio by its UUID. Returns ErrPrecioNoEncontrado if missing.
+	ObtenerPorID(ctx context.Context, id uuid.UUID) (*entity.Precio, error)
+
+	// Listar returns a paginated page of precios matching the optional filters.
+	Listar(ctx context.Context, filtros FiltrosListado) ([]*entity.Precio, error)
+
+	// Contar returns the total count of precios matching the filters (ignoring pagination).
+	Contar(ctx context.Context, filtros FiltrosListado) (int, error)
+
+	// Actualizar updates an existing precio and returns the updated record.
+	// Returns ErrPrecioNoEncontrado if the ID does not exist.
+	Actualizar(ctx context.Context, precio *entity.Precio) (*entity.Precio, error)
+
+	// Eliminar deletes a precio by UUID. Idempotent — no error if not found.
+	Eliminar(ctx context.Context, id uuid.UUID) error
+}
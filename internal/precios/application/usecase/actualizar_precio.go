@@ -0,0 +1,42 @@
+// internal/precios/application/usecase/actualizar_precio.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/garfex/calculadora-filtros/internal/precios/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/precios/application/port"
+	"github.com/google/uuid"
+)
+
+// ActualizarPrecioUseCase handles updating an existing precio.
+type ActualizarPrecioUseCase struct {
+	repo port.PrecioRepository
+}
+
+// NewActualizarPrecioUseCase creates a new instance with the required repository.
+func NewActualizarPrecioUseCase(repo port.PrecioRepository) *ActualizarPrecioUseCase {
+	return &ActualizarPrecioUseCase{repo: repo}
+}
+
+// Execute validates input, builds the updated entity, and persists changes.
+func (uc *ActualizarPrecioUseCase) Execute(ctx context.Context, id string, input dto.UpdatePrecioInput) (dto.PrecioOutput, error) {
+	parsedID, err := uuid.Parse(id)
+	if err != nil {
+		return dto.PrecioOutput{}, fmt.Errorf("%w: %s", dto.ErrIDInvalido, id)
+	}
+
+	precio, err := input.ToDomain()
+	if err != nil {
+		return dto.PrecioOutput{}, err
+	}
+	precio.ID = parsedID
+
+	updated, err := uc.repo.Actualizar(ctx, precio)
+	if err != nil {
+		return dto.PrecioOutput{}, fmt.Errorf("actualizar precio: %w", err)
+	}
+
+	return dto.FromDomain(updated), nil
+}
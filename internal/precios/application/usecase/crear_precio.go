@@ -0,0 +1,36 @@
+// internal/precios/application/usecase/crear_precio.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/garfex/calculadora-filtros/internal/precios/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/precios/application/port"
+)
+
+// CrearPrecioUseCase handles registering a new material price.
+type CrearPrecioUseCase struct {
+	repo port.PrecioRepository
+}
+
+// NewCrearPrecioUseCase creates a new instance with the required repository.
+func NewCrearPrecioUseCase(repo port.PrecioRepository) *CrearPrecioUseCase {
+	return &CrearPrecioUseCase{repo: repo}
+}
+
+// Execute validates the input, creates the domain entity, and persists it.
+// Returns the persisted precio with DB-generated ID and timestamps.
+func (uc *CrearPrecioUseCase) Execute(ctx context.Context, input dto.CreatePrecioInput) (dto.PrecioOutput, error) {
+	precio, err := input.ToDomain()
+	if err != nil {
+		return dto.PrecioOutput{}, err
+	}
+
+	created, err := uc.repo.Crear(ctx, precio)
+	if err != nil {
+		return dto.PrecioOutput{}, fmt.Errorf("crear precio: %w", err)
+	}
+
+	return dto.FromDomain(created), nil
+}
@@ -0,0 +1,36 @@
+// internal/precios/application/usecase/eliminar_precio.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/garfex/calculadora-filtros/internal/precios/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/precios/application/port"
+	"github.com/google/uuid"
+)
+
+// EliminarPrecioUseCase handles deleting a precio by ID.
+// The operation is idempotent — no error if the precio does not exist.
+type EliminarPrecioUseCase struct {
+	repo port.PrecioRepository
+}
+
+// NewEliminarPrecioUseCase creates a new instance with the required repository.
+func NewEliminarPrecioUseCase(repo port.PrecioRepository) *EliminarPrecioUseCase {
+	return &EliminarPrecioUseCase{repo: repo}
+}
+
+// Execute parses the UUID and delegates deletion to the repository.
+func (uc *EliminarPrecioUseCase) Execute(ctx context.Context, id string) error {
+	parsedID, err := uuid.Parse(id)
+	if err != nil {
+		return fmt.Errorf("%w: %s", dto.ErrIDInvalido, id)
+	}
+
+	if err := uc.repo.Eliminar(ctx, parsedID); err != nil {
+		return fmt.Errorf("eliminar precio: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+// internal/precios/application/usecase/listar_precios.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/garfex/calculadora-filtros/internal/precios/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/precios/application/port"
+	"github.com/garfex/calculadora-filtros/internal/precios/domain/entity"
+)
+
+// ListarPreciosUseCase handles listing precios with optional filters and pagination.
+type ListarPreciosUseCase struct {
+	repo port.PrecioRepository
+}
+
+// NewListarPreciosUseCase creates a new instance with the required repository.
+func NewListarPreciosUseCase(repo port.PrecioRepository) *ListarPreciosUseCase {
+	return &ListarPreciosUseCase{repo: repo}
+}
+
+// Execute applies defaults, converts filters, counts total, and fetches the requested page.
+func (uc *ListarPreciosUseCase) Execute(ctx context.Context, query dto.ListPreciosQuery) (dto.ListPreciosOutput, error) {
+	query.ApplyDefaults()
+
+	filtros := port.FiltrosListado{
+		Limit:  query.PageSize,
+		Offset: query.Offset(),
+	}
+
+	if query.Tipo != "" {
+		tipo, err := entity.ParseTipoPrecio(query.Tipo)
+		if err != nil {
+			return dto.ListPreciosOutput{}, fmt.Errorf("%w: tipo inválido: %s", dto.ErrInputInvalido, query.Tipo)
+		}
+		filtros.Tipo = &tipo
+	}
+
+	if query.Moneda != "" {
+		moneda := strings.ToUpper(query.Moneda)
+		filtros.Moneda = &moneda
+	}
+
+	if query.VigenteEn != "" {
+		fecha, err := time.Parse(dto.FormatoFecha, query.VigenteEn)
+		if err != nil {
+			return dto.ListPreciosOutput{}, fmt.Errorf("%w: vigente_en debe tener formato YYYY-MM-DD", dto.ErrInputInvalido)
+		}
+		filtros.VigenteEn = &fecha
+	}
+
+	total, err := uc.repo.Contar(ctx, filtros)
+	if err != nil {
+		return dto.ListPreciosOutput{}, fmt.Errorf("contar precios: %w", err)
+	}
+
+	precios, err := uc.repo.Listar(ctx, filtros)
+	if err != nil {
+		return dto.ListPreciosOutput{}, fmt.Errorf("listar precios: %w", err)
+	}
+
+	return dto.FromDomainList(precios, query.Page, query.PageSize, total), nil
+}
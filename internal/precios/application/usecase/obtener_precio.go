@@ -0,0 +1,36 @@
+// internal/precios/application/usecase/obtener_precio.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/garfex/calculadora-filtros/internal/precios/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/precios/application/port"
+	"github.com/google/uuid"
+)
+
+// ObtenerPrecioUseCase handles retrieving a single precio by ID.
+type ObtenerPrecioUseCase struct {
+	repo port.PrecioRepository
+}
+
+// NewObtenerPrecioUseCase creates a new instance with the required repository.
+func NewObtenerPrecioUseCase(repo port.PrecioRepository) *ObtenerPrecioUseCase {
+	return &ObtenerPrecioUseCase{repo: repo}
+}
+
+// Execute parses the UUID string, fetches the entity, and converts to output DTO.
+func (uc *ObtenerPrecioUseCase) Execute(ctx context.Context, id string) (dto.PrecioOutput, error) {
+	parsedID, err := uuid.Parse(id)
+	if err != nil {
+		return dto.PrecioOutput{}, fmt.Errorf("%w: %s", dto.ErrIDInvalido, id)
+	}
+
+	precio, err := uc.repo.ObtenerPorID(ctx, parsedID)
+	if err != nil {
+		return dto.PrecioOutput{}, fmt.Errorf("obtener precio: %w", err)
+	}
+
+	return dto.FromDomain(precio), nil
+}
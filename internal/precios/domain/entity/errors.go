@@ -0,0 +1,28 @@
+// internal/precios/domain/entity/errors.go
+package entity
+
+import "errors"
+
+// Domain errors for the precios feature.
+var (
+	// ErrTipoPrecioInvalido is returned when a TipoPrecio string is not recognized.
+	ErrTipoPrecioInvalido = errors.New("tipo de precio inválido")
+
+	// ErrClaveIncompleta is returned when the key fields required by the TipoPrecio are missing.
+	ErrClaveIncompleta = errors.New("la clave del material está incompleta")
+
+	// ErrMaterialInvalido is returned when a conductor material is not CU or AL.
+	ErrMaterialInvalido = errors.New("material de conductor inválido")
+
+	// ErrTipoTuberiaInvalido is returned when a tubería type is not recognized.
+	ErrTipoTuberiaInvalido = errors.New("tipo de tubería inválido")
+
+	// ErrPrecioInvalido is returned when the unit price is negative.
+	ErrPrecioInvalido = errors.New("el precio unitario no puede ser negativo")
+
+	// ErrMonedaInvalida is returned when the currency is not a 3-letter ISO 4217 code.
+	ErrMonedaInvalida = errors.New("la moneda debe ser un código ISO 4217 de 3 letras")
+
+	// ErrVigenciaInvalida is returned when vigente_hasta is before vigente_desde.
+	ErrVigenciaInvalida = errors.New("la vigencia final no puede ser anterior a la inicial")
+)
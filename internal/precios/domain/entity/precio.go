@@ -0,0 +1,135 @@
+// internal/precios/domain/entity/precio.go
+package entity
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ClaveMaterial identifies the priced material. Only the fields of the
+// corresponding TipoPrecio are used; the rest are cleared by NewPrecio.
+type ClaveMaterial struct {
+	Calibre     string  // CONDUCTOR: e.g. "4/0", "250" (no AWG/MCM suffix)
+	Material    string  // CONDUCTOR: "CU" | "AL"
+	Aislamiento string  // CONDUCTOR: e.g. "THW"; empty = applies to any insulation
+	TipoTuberia string  // TUBERIA: "TUBERIA_PVC" | "TUBERIA_ALUMINIO" | "TUBERIA_ACERO_PG" | "TUBERIA_ACERO_PD"
+	Tamano      string  // TUBERIA: trade size, e.g. "1 1/2"
+	AnchoMM     float64 // CHAROLA: commercial width [mm]
+}
+
+// Precio is the unit price per meter of a conductor, conduit or cable tray
+// in a given currency, valid between VigenteDesde and VigenteHasta (inclusive).
+// Maps to the precios_materiales table in PostgreSQL.
+type Precio struct {
+	ID             uuid.UUID
+	Tipo           TipoPrecio
+	Clave          ClaveMaterial
+	PrecioUnitario float64    // price per meter
+	Moneda         string     // ISO 4217, e.g. "MXN"
+	VigenteDesde   time.Time  // date only
+	VigenteHasta   *time.Time // nullable — open-ended
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// NewPrecio creates and validates a new Precio entity.
+// ID, CreatedAt and UpdatedAt are set by PostgreSQL on insert; they are zero here.
+func NewPrecio(
+	tipo TipoPrecio,
+	clave ClaveMaterial,
+	precioUnitario float64,
+	moneda string,
+	vigenteDesde time.Time,
+	vigenteHasta *time.Time,
+) (*Precio, error) {
+	clave, err := normalizarClave(tipo, clave)
+	if err != nil {
+		return nil, err
+	}
+	if precioUnitario < 0 {
+		return nil, ErrPrecioInvalido
+	}
+
+	moneda = strings.ToUpper(strings.TrimSpace(moneda))
+	if len(moneda) != 3 {
+		return nil, fmt.Errorf("%w: '%s'", ErrMonedaInvalida, moneda)
+	}
+
+	vigenteDesde = truncarFecha(vigenteDesde)
+	if vigenteHasta != nil {
+		hasta := truncarFecha(*vigenteHasta)
+		if hasta.Before(vigenteDesde) {
+			return nil, ErrVigenciaInvalida
+		}
+		vigenteHasta = &hasta
+	}
+
+	return &Precio{
+		Tipo:           tipo,
+		Clave:          clave,
+		PrecioUnitario: precioUnitario,
+		Moneda:         moneda,
+		VigenteDesde:   vigenteDesde,
+		VigenteHasta:   vigenteHasta,
+	}, nil
+}
+
+// VigenteEn reports whether the price is in effect on the given date.
+func (p *Precio) VigenteEn(fecha time.Time) bool {
+	fecha = truncarFecha(fecha)
+	if fecha.Before(p.VigenteDesde) {
+		return false
+	}
+	return p.VigenteHasta == nil || !fecha.After(*p.VigenteHasta)
+}
+
+// normalizarClave validates the key fields required by the tipo and clears the others.
+func normalizarClave(tipo TipoPrecio, c ClaveMaterial) (ClaveMaterial, error) {
+	switch tipo {
+	case TipoPrecioConductor:
+		calibre := strings.TrimSpace(c.Calibre)
+		calibre = strings.TrimSuffix(calibre, " AWG")
+		calibre = strings.TrimSuffix(calibre, " MCM")
+		if calibre == "" {
+			return ClaveMaterial{}, fmt.Errorf("%w: calibre es requerido", ErrClaveIncompleta)
+		}
+		material := strings.ToUpper(strings.TrimSpace(c.Material))
+		if material != "CU" && material != "AL" {
+			return ClaveMaterial{}, fmt.Errorf("%w: '%s' — valores válidos: CU, AL", ErrMaterialInvalido, c.Material)
+		}
+		return ClaveMaterial{
+			Calibre:     calibre,
+			Material:    material,
+			Aislamiento: strings.ToUpper(strings.TrimSpace(c.Aislamiento)),
+		}, nil
+
+	case TipoPrecioTuberia:
+		if !slices.Contains(TiposTuberia, c.TipoTuberia) {
+			return ClaveMaterial{}, fmt.Errorf("%w: '%s' — valores válidos: %s", ErrTipoTuberiaInvalido, c.TipoTuberia, strings.Join(TiposTuberia, ", "))
+		}
+		tamano := strings.TrimSpace(c.Tamano)
+		if tamano == "" {
+			return ClaveMaterial{}, fmt.Errorf("%w: tamano es requerido", ErrClaveIncompleta)
+		}
+		return ClaveMaterial{TipoTuberia: c.TipoTuberia, Tamano: tamano}, nil
+
+	case TipoPrecioCharola:
+		if c.AnchoMM <= 0 {
+			return ClaveMaterial{}, fmt.Errorf("%w: ancho_mm debe ser mayor que cero", ErrClaveIncompleta)
+		}
+		return ClaveMaterial{AnchoMM: c.AnchoMM}, nil
+
+	default:
+		return ClaveMaterial{}, fmt.Errorf("%w: '%s'", ErrTipoPrecioInvalido, tipo)
+	}
+}
+
+// truncarFecha drops the time of day; vigencias are whole days in UTC.
+func truncarFecha(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,76 @@
+// internal/precios/domain/entity/precio_test.go
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/garfex/calculadora-filtros/internal/precios/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPrecio(t *testing.T) {
+	desde := time.Date(2026, 1, 1, 15, 30, 0, 0, time.UTC)
+
+	t.Run("conductor normaliza calibre, material y aislamiento", func(t *testing.T) {
+		p, err := entity.NewPrecio(entity.TipoPrecioConductor,
+			entity.ClaveMaterial{Calibre: "250 MCM", Material: "cu", Aislamiento: "thw", Tamano: "2"},
+			520, "mxn", desde, nil)
+		require.NoError(t, err)
+		assert.Equal(t, entity.ClaveMaterial{Calibre: "250", Material: "CU", Aislamiento: "THW"}, p.Clave)
+		assert.Equal(t, "MXN", p.Moneda)
+		assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), p.VigenteDesde)
+		assert.Nil(t, p.VigenteHasta)
+	})
+
+	t.Run("tubería requiere tipo y tamaño", func(t *testing.T) {
+		p, err := entity.NewPrecio(entity.TipoPrecioTuberia,
+			entity.ClaveMaterial{TipoTuberia: "TUBERIA_PVC", Tamano: "2", Calibre: "2"},
+			60, "MXN", desde, nil)
+		require.NoError(t, err)
+		assert.Equal(t, entity.ClaveMaterial{TipoTuberia: "TUBERIA_PVC", Tamano: "2"}, p.Clave)
+
+		_, err = entity.NewPrecio(entity.TipoPrecioTuberia, entity.ClaveMaterial{TipoTuberia: "CHAROLA_CABLE_ESPACIADO", Tamano: "2"}, 60, "MXN", desde, nil)
+		assert.ErrorIs(t, err, entity.ErrTipoTuberiaInvalido)
+
+		_, err = entity.NewPrecio(entity.TipoPrecioTuberia, entity.ClaveMaterial{TipoTuberia: "TUBERIA_PVC"}, 60, "MXN", desde, nil)
+		assert.ErrorIs(t, err, entity.ErrClaveIncompleta)
+	})
+
+	t.Run("charola requiere ancho", func(t *testing.T) {
+		_, err := entity.NewPrecio(entity.TipoPrecioCharola, entity.ClaveMaterial{}, 400, "MXN", desde, nil)
+		assert.ErrorIs(t, err, entity.ErrClaveIncompleta)
+	})
+
+	t.Run("rechaza material, precio, moneda y vigencia inválidos", func(t *testing.T) {
+		clave := entity.ClaveMaterial{Calibre: "2", Material: "CU"}
+		_, err := entity.NewPrecio(entity.TipoPrecioConductor, entity.ClaveMaterial{Calibre: "2", Material: "FE"}, 1, "MXN", desde, nil)
+		assert.ErrorIs(t, err, entity.ErrMaterialInvalido)
+
+		_, err = entity.NewPrecio(entity.TipoPrecioConductor, clave, -1, "MXN", desde, nil)
+		assert.ErrorIs(t, err, entity.ErrPrecioInvalido)
+
+		_, err = entity.NewPrecio(entity.TipoPrecioConductor, clave, 1, "PESOS", desde, nil)
+		assert.ErrorIs(t, err, entity.ErrMonedaInvalida)
+
+		antes := desde.AddDate(0, 0, -1)
+		_, err = entity.NewPrecio(entity.TipoPrecioConductor, clave, 1, "MXN", desde, &antes)
+		assert.ErrorIs(t, err, entity.ErrVigenciaInvalida)
+
+		_, err = entity.NewPrecio(entity.TipoPrecio("CABLE"), clave, 1, "MXN", desde, nil)
+		assert.ErrorIs(t, err, entity.ErrTipoPrecioInvalido)
+	})
+}
+
+func TestPrecio_VigenteEn(t *testing.T) {
+	desde := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	hasta := time.Date(2026, 6, 30, 0, 0, 0, 0, time.UTC)
+	p, err := entity.NewPrecio(entity.TipoPrecioCharola, entity.ClaveMaterial{AnchoMM: 304.8}, 400, "MXN", desde, &hasta)
+	require.NoError(t, err)
+
+	assert.False(t, p.VigenteEn(time.Date(2025, 12, 31, 23, 0, 0, 0, time.UTC)))
+	assert.True(t, p.VigenteEn(desde))
+	assert.True(t, p.VigenteEn(time.Date(2026, 6, 30, 18, 0, 0, 0, time.UTC)))
+	assert.False(t, p.VigenteEn(time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)))
+}
@@ -0,0 +1,43 @@
+// internal/precios/domain/entity/tipo_precio.go
+package entity
+
+import "fmt"
+
+// TipoPrecio identifies which kind of material a price row refers to,
+// and therefore which key fields identify it.
+type TipoPrecio string
+
+const (
+	TipoPrecioConductor TipoPrecio = "CONDUCTOR" // keyed by calibre + material + aislamiento
+	TipoPrecioTuberia   TipoPrecio = "TUBERIA"   // keyed by tipo de tubería + tamaño comercial
+	TipoPrecioCharola   TipoPrecio = "CHAROLA"   // keyed by ancho comercial [mm]
+)
+
+// ParseTipoPrecio converts a string (e.g., from the database or HTTP request)
+// to a TipoPrecio. Returns ErrTipoPrecioInvalido if the value is not recognized.
+func ParseTipoPrecio(s string) (TipoPrecio, error) {
+	switch s {
+	case string(TipoPrecioConductor):
+		return TipoPrecioConductor, nil
+	case string(TipoPrecioTuberia):
+		return TipoPrecioTuberia, nil
+	case string(TipoPrecioCharola):
+		return TipoPrecioCharola, nil
+	default:
+		return "", fmt.Errorf("%w: '%s' — valores válidos: CONDUCTOR, TUBERIA, CHAROLA", ErrTipoPrecioInvalido, s)
+	}
+}
+
+// String returns the string representation of the TipoPrecio.
+func (t TipoPrecio) String() string {
+	return string(t)
+}
+
+// TiposTuberia are the conduit types that can be priced. Values match
+// calculos TipoCanalizacion so a stored price maps directly to a memoria.
+var TiposTuberia = []string{
+	"TUBERIA_PVC",
+	"TUBERIA_ALUMINIO",
+	"TUBERIA_ACERO_PG",
+	"TUBERIA_ACERO_PD",
+}
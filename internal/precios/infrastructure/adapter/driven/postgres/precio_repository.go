@@ -0,0 +1,303 @@
+// internal/precios/infrastructure/adapter/driven/postgres/precio_repository.go
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	appdto "github.com/garfex/calculadora-filtros/internal/precios/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/precios/application/port"
+	"github.com/garfex/calculadora-filtros/internal/precios/domain/entity"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresPrecioRepository implements port.PrecioRepository using pgx.
+type PostgresPrecioRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresPrecioRepository creates a new repository with the given pool.
+func NewPostgresPrecioRepository(pool *pgxpool.Pool) *PostgresPrecioRepository {
+	return &PostgresPrecioRepository{pool: pool}
+}
+
+// Compile-time check: PostgresPrecioRepository must implement port.PrecioRepository.
+var _ port.PrecioRepository = (*PostgresPrecioRepository)(nil)
+
+const precioColumns = `id, tipo, calibre, material, aislamiento, tipo_tuberia, tamano, ancho_mm,
+	precio_unitario, moneda, vigente_desde, vigente_hasta, created_at, updated_at`
+
+// Crear inserts a new precio and returns the created record with DB-generated fields.
+func (r *PostgresPrecioRepository) Crear(ctx context.Context, precio *entity.Precio) (*entity.Precio, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	query := `
+		INSERT INTO precios_materiales (tipo, calibre, material, aislamiento, tipo_tuberia, tamano, ancho_mm,
+			precio_unitario, moneda, vigente_desde, vigente_hasta)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING ` + precioColumns
+
+	row := r.pool.QueryRow(ctx, query,
+		string(precio.Tipo),
+		nullString(precio.Clave.Calibre),
+		nullString(precio.Clave.Material),
+		nullString(precio.Clave.Aislamiento),
+		nullString(precio.Clave.TipoTuberia),
+		nullString(precio.Clave.Tamano),
+		nullFloat(precio.Clave.AnchoMM),
+		precio.PrecioUnitario,
+		precio.Moneda,
+		precio.VigenteDesde,
+		precio.VigenteHasta,
+	)
+
+	created, err := scanPrecio(row)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, fmt.Errorf("%w: %s desde %s", appdto.ErrPrecioDuplicado, precio.Tipo, precio.VigenteDesde.Format(appdto.FormatoFecha))
+		}
+		return nil, fmt.Errorf("insertar precio: %w", err)
+	}
+
+	return created, nil
+}
+
+// ObtenerPorID fetches a single precio by UUID.
+func (r *PostgresPrecioRepository) ObtenerPorID(ctx context.Context, id uuid.UUID) (*entity.Precio, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	query := `SELECT ` + precioColumns + ` FROM precios_materiales WHERE id = $1`
+
+	precio, err := scanPrecio(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: id %s", appdto.ErrPrecioNoEncontrado, id)
+		}
+		return nil, fmt.Errorf("obtener precio por id: %w", err)
+	}
+
+	return precio, nil
+}
+
+// buildWhereClause constructs the shared WHERE clause and args for Listar and Contar.
+func buildWhereClause(filtros port.FiltrosListado) (string, []any, int) {
+	where := " WHERE 1=1"
+	args := []any{}
+	argIdx := 1
+
+	if filtros.Tipo != nil {
+		where += fmt.Sprintf(" AND tipo = $%d", argIdx)
+		args = append(args, string(*filtros.Tipo))
+		argIdx++
+	}
+	if filtros.Moneda != nil && *filtros.Moneda != "" {
+		where += fmt.Sprintf(" AND moneda = $%d", argIdx)
+		args = append(args, *filtros.Moneda)
+		argIdx++
+	}
+	if filtros.VigenteEn != nil {
+		where += fmt.Sprintf(" AND vigente_desde <= $%d AND (vigente_hasta IS NULL OR vigente_hasta >= $%d)", argIdx, argIdx)
+		args = append(args, *filtros.VigenteEn)
+		argIdx++
+	}
+	return where, args, argIdx
+}
+
+// Listar returns a paginated page of precios matching the optional filters,
+// grouped by material key with the most recent vigencia first.
+func (r *PostgresPrecioRepository) Listar(ctx context.Context, filtros port.FiltrosListado) ([]*entity.Precio, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	where, args, argIdx := buildWhereClause(filtros)
+
+	query := `SELECT ` + precioColumns + ` FROM precios_materiales` +
+		where +
+		" ORDER BY tipo, material, calibre, tipo_tuberia, tamano, ancho_mm, aislamiento, vigente_desde DESC" +
+		fmt.Sprintf(" LIMIT $%d OFFSET $%d", argIdx, argIdx+1)
+
+	args = append(args, filtros.Limit, filtros.Offset)
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("listar precios: %w", err)
+	}
+	defer rows.Close()
+
+	precios := make([]*entity.Precio, 0, filtros.Limit)
+	for rows.Next() {
+		precio, err := scanPrecio(rows)
+		if err != nil {
+			return nil, fmt.Errorf("escanear precio: %w", err)
+		}
+		precios = append(precios, precio)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterando resultados: %w", err)
+	}
+
+	return precios, nil
+}
+
+// Contar returns the total count of precios matching the filters (ignores pagination).
+func (r *PostgresPrecioRepository) Contar(ctx context.Context, filtros port.FiltrosListado) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	where, args, _ := buildWhereClause(filtros)
+	query := `SELECT COUNT(*) FROM precios_materiales` + where
+
+	var count int
+	if err := r.pool.QueryRow(ctx, query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("contar precios: %w", err)
+	}
+	return count, nil
+}
+
+// Actualizar updates an existing precio and returns the updated record.
+func (r *PostgresPrecioRepository) Actualizar(ctx context.Context, precio *entity.Precio) (*entity.Precio, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	query := `
+		UPDATE precios_materiales
+		SET tipo = $1, calibre = $2, material = $3, aislamiento = $4, tipo_tuberia = $5, tamano = $6,
+			ancho_mm = $7, precio_unitario = $8, moneda = $9, vigente_desde = $10, vigente_hasta = $11,
+			updated_at = NOW()
+		WHERE id = $12
+		RETURNING ` + precioColumns
+
+	row := r.pool.QueryRow(ctx, query,
+		string(precio.Tipo),
+		nullString(precio.Clave.Calibre),
+		nullString(precio.Clave.Material),
+		nullString(precio.Clave.Aislamiento),
+		nullString(precio.Clave.TipoTuberia),
+		nullString(precio.Clave.Tamano),
+		nullFloat(precio.Clave.AnchoMM),
+		precio.PrecioUnitario,
+		precio.Moneda,
+		precio.VigenteDesde,
+		precio.VigenteHasta,
+		precio.ID,
+	)
+
+	updated, err := scanPrecio(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: id %s", appdto.ErrPrecioNoEncontrado, precio.ID)
+		}
+		if isUniqueViolation(err) {
+			return nil, fmt.Errorf("%w: %s desde %s", appdto.ErrPrecioDuplicado, precio.Tipo, precio.VigenteDesde.Format(appdto.FormatoFecha))
+		}
+		return nil, fmt.Errorf("actualizar precio: %w", err)
+	}
+
+	return updated, nil
+}
+
+// Eliminar deletes a precio by UUID. Idempotent — no error if not found.
+func (r *PostgresPrecioRepository) Eliminar(ctx context.Context, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := r.pool.Exec(ctx, `DELETE FROM precios_materiales WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("eliminar precio: %w", err)
+	}
+
+	return nil
+}
+
+// ─── Mappers ────────────────────────────────────────────────────────────────
+
+// nullString maps an empty key field to SQL NULL.
+func nullString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// nullFloat maps a zero key field to SQL NULL.
+func nullFloat(f float64) *float64 {
+	if f == 0 {
+		return nil
+	}
+	return &f
+}
+
+// scanPrecio scans a pgx.Row (also satisfied by pgx.Rows) into a domain entity.
+func scanPrecio(row pgx.Row) (*entity.Precio, error) {
+	var (
+		id                                                  uuid.UUID
+		tipo, moneda                                        string
+		calibre, material, aislamiento, tipoTuberia, tamano *string
+		anchoMM                                             *float64
+		precioUnitario                                      float64
+		vigenteDesde                                        time.Time
+		vigenteHasta                                        *time.Time
+		createdAt, updatedAt                                time.Time
+	)
+
+	err := row.Scan(&id, &tipo, &calibre, &material, &aislamiento, &tipoTuberia, &tamano, &anchoMM,
+		&precioUnitario, &moneda, &vigenteDesde, &vigenteHasta, &createdAt, &updatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	tipoPrecio, err := entity.ParseTipoPrecio(tipo)
+	if err != nil {
+		return nil, fmt.Errorf("tipo de precio inválido en BD: %w", err)
+	}
+
+	clave := entity.ClaveMaterial{
+		Calibre:     deref(calibre),
+		Material:    deref(material),
+		Aislamiento: deref(aislamiento),
+		TipoTuberia: deref(tipoTuberia),
+		Tamano:      deref(tamano),
+	}
+	if anchoMM != nil {
+		clave.AnchoMM = *anchoMM
+	}
+
+	return &entity.Precio{
+		ID:             id,
+		Tipo:           tipoPrecio,
+		Clave:          clave,
+		PrecioUnitario: precioUnitario,
+		Moneda:         moneda,
+		VigenteDesde:   vigenteDesde,
+		VigenteHasta:   vigenteHasta,
+		CreatedAt:      createdAt,
+		UpdatedAt:      updatedAt,
+	}, nil
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// isUniqueViolation detects PostgreSQL unique constraint violations (SQLSTATE 23505).
+func isUniqueViolation(err error) bool {
+	if err == nil {
+		return false
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == "23505"
+	}
+	return false
+}
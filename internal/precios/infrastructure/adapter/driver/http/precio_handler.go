@@ -0,0 +1,194 @@
+// internal/precios/infrastructure/adapter/driver/http/precio_handler.go
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/garfex/calculadora-filtros/internal/precios/application/dto"
+	"github.com/garfex/calculadora-filtros/internal/precios/application/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+// PrecioHandler handles HTTP requests for the precios feature.
+type PrecioHandler struct {
+	crearUC      *usecase.CrearPrecioUseCase
+	obtenerUC    *usecase.ObtenerPrecioUseCase
+	listarUC     *usecase.ListarPreciosUseCase
+	actualizarUC *usecase.ActualizarPrecioUseCase
+	eliminarUC   *usecase.EliminarPrecioUseCase
+}
+
+// NewPrecioHandler creates a new handler with all required use cases.
+func NewPrecioHandler(
+	crearUC *usecase.CrearPrecioUseCase,
+	obtenerUC *usecase.ObtenerPrecioUseCase,
+	listarUC *usecase.ListarPreciosUseCase,
+	actualizarUC *usecase.ActualizarPrecioUseCase,
+	eliminarUC *usecase.EliminarPrecioUseCase,
+) *PrecioHandler {
+	return &PrecioHandler{
+		crearUC:      crearUC,
+		obtenerUC:    obtenerUC,
+		listarUC:     listarUC,
+		actualizarUC: actualizarUC,
+		eliminarUC:   eliminarUC,
+	}
+}
+
+// ─── Response types ──────────────────────────────────────────────────────────
+
+type successResponse struct {
+	Success bool `json:"success"`
+	Data    any  `json:"data"`
+}
+
+type errorResponse struct {
+	Success   bool   `json:"success"`
+	Error     string `json:"error"`
+	Code      string `json:"code,omitempty"`
+	Details   string `json:"details,omitempty"`
+	Timestamp string `json:"timestamp"` // ISO 8601 UTC
+}
+
+func ok(data any) successResponse {
+	return successResponse{Success: true, Data: data}
+}
+
+func errResp(msg, code, details string) errorResponse {
+	return errorResponse{
+		Success:   false,
+		Error:     msg,
+		Code:      code,
+		Details:   details,
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+	}
+}
+
+// ─── Endpoints ───────────────────────────────────────────────────────────────
+
+// Crear POST /api/v1/precios
+func (h *PrecioHandler) Crear(c *gin.Context) {
+	var input dto.CreatePrecioInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, errResp("Error de validación", "VALIDATION_ERROR", err.Error()))
+		return
+	}
+
+	output, err := h.crearUC.Execute(c.Request.Context(), input)
+	if err != nil {
+		status, resp := mapError(err)
+		c.JSON(status, resp)
+		return
+	}
+
+	c.JSON(http.StatusCreated, ok(output))
+}
+
+// ObtenerPorID GET /api/v1/precios/:id
+func (h *PrecioHandler) ObtenerPorID(c *gin.Context) {
+	id := c.Param("id")
+
+	output, err := h.obtenerUC.Execute(c.Request.Context(), id)
+	if err != nil {
+		status, resp := mapError(err)
+		c.JSON(status, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, ok(output))
+}
+
+// Listar GET /api/v1/precios
+// Query params: tipo, moneda, vigente_en (YYYY-MM-DD), page (default 1), page_size (default 20, max 100)
+func (h *PrecioHandler) Listar(c *gin.Context) {
+	query := dto.ListPreciosQuery{
+		Tipo:      c.Query("tipo"),
+		Moneda:    c.Query("moneda"),
+		VigenteEn: c.Query("vigente_en"),
+	}
+
+	if pageStr := c.Query("page"); pageStr != "" {
+		p, err := strconv.Atoi(pageStr)
+		if err != nil || p < 1 {
+			c.JSON(http.StatusBadRequest, errResp("Página inválida", "PAGE_INVALIDO", "debe ser un entero mayor que cero"))
+			return
+		}
+		query.Page = p
+	}
+
+	if pageSizeStr := c.Query("page_size"); pageSizeStr != "" {
+		ps, err := strconv.Atoi(pageSizeStr)
+		if err != nil || ps < 1 {
+			c.JSON(http.StatusBadRequest, errResp("Tamaño de página inválido", "PAGE_SIZE_INVALIDO", "debe ser un entero mayor que cero"))
+			return
+		}
+		query.PageSize = ps
+	}
+
+	output, err := h.listarUC.Execute(c.Request.Context(), query)
+	if err != nil {
+		status, resp := mapError(err)
+		c.JSON(status, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, ok(output))
+}
+
+// Actualizar PUT /api/v1/precios/:id
+func (h *PrecioHandler) Actualizar(c *gin.Context) {
+	id := c.Param("id")
+
+	var input dto.UpdatePrecioInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, errResp("Error de validación", "VALIDATION_ERROR", err.Error()))
+		return
+	}
+
+	output, err := h.actualizarUC.Execute(c.Request.Context(), id, input)
+	if err != nil {
+		status, resp := mapError(err)
+		c.JSON(status, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, ok(output))
+}
+
+// Eliminar DELETE /api/v1/precios/:id
+func (h *PrecioHandler) Eliminar(c *gin.Context) {
+	id := c.Param("id")
+
+	if err := h.eliminarUC.Execute(c.Request.Context(), id); err != nil {
+		status, resp := mapError(err)
+		c.JSON(status, resp)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ─── Error mapper ────────────────────────────────────────────────────────────
+
+// mapError converts application/domain errors to HTTP status + response body.
+func mapError(err error) (int, errorResponse) {
+	switch {
+	case errors.Is(err, dto.ErrIDInvalido):
+		return http.StatusBadRequest, errResp("ID inválido", "ID_INVALIDO", err.Error())
+
+	case errors.Is(err, dto.ErrInputInvalido):
+		return http.StatusBadRequest, errResp("Datos de entrada inválidos", "INPUT_INVALIDO", err.Error())
+
+	case errors.Is(err, dto.ErrPrecioNoEncontrado):
+		return http.StatusNotFound, errResp("Precio no encontrado", "PRECIO_NO_ENCONTRADO", err.Error())
+
+	case errors.Is(err, dto.ErrPrecioDuplicado):
+		return http.StatusConflict, errResp("El precio ya existe", "PRECIO_DUPLICADO", err.Error())
+
+	default:
+		return http.StatusInternalServerError, errResp("Error interno del servidor", "INTERNAL_ERROR", err.Error())
+	}
+}
@@ -0,0 +1,20 @@
+// internal/precios/infrastructure/router.go
+package infrastructure
+
+import (
+	preciohttp "github.com/garfex/calculadora-filtros/internal/precios/infrastructure/adapter/driver/http"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterPreciosRoutes mounts all precio routes under the given RouterGroup.
+// Call this from main.go passing the /api/v1 group.
+func RegisterPreciosRoutes(rg *gin.RouterGroup, handler *preciohttp.PrecioHandler) {
+	precios := rg.Group("/precios")
+	{
+		precios.POST("", handler.Crear)
+		precios.GET("", handler.Listar)
+		precios.GET("/:id", handler.ObtenerPorID)
+		precios.PUT("/:id", handler.Actualizar)
+		precios.DELETE("/:id", handler.Eliminar)
+	}
+}
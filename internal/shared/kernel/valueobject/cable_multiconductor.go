@@ -0,0 +1,111 @@
+// internal/shared/kernel/valueobject/cable_multiconductor.go
+package valueobject
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// ErrCableMulticonductorInvalido is returned when the multiconductor cable data is invalid.
+var ErrCableMulticonductorInvalido = errors.New("datos de cable multiconductor inválidos")
+
+// ErrTipoCableMulticonductorInvalido is returned when an unknown cable type is provided.
+var ErrTipoCableMulticonductorInvalido = errors.New("tipo de cable multiconductor inválido (esperado TC o MC)")
+
+// TipoCableMulticonductor es la construcción del cable multiconductor.
+type TipoCableMulticonductor string
+
+const (
+	// CableTC es el cable para charola (tray cable, NOM Art. 336): conductores
+	// aislados con cubierta no metálica.
+	CableTC TipoCableMulticonductor = "TC"
+
+	// CableMC es el cable con armadura metálica engargolada (NOM Art. 330).
+	CableMC TipoCableMulticonductor = "MC"
+)
+
+// ParseTipoCableMulticonductor converts a string to TipoCableMulticonductor (case-insensitive).
+// Acepta TC-ER como TC.
+func ParseTipoCableMulticonductor(s string) (TipoCableMulticonductor, error) {
+	switch strings.ToUpper(strings.TrimSpace(s)) {
+	case "TC", "TC-ER":
+		return CableTC, nil
+	case "MC":
+		return CableMC, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrTipoCableMulticonductorInvalido, s)
+	}
+}
+
+// String returns the NOM designation of the cable type.
+func (t TipoCableMulticonductor) String() string {
+	return string(t)
+}
+
+// CableMulticonductor representa un cable de fuerza con varios conductores aislados del
+// mismo calibre bajo una cubierta (TC) o armadura (MC), más su conductor de tierra de
+// fábrica. Para llenado de tubería y charola se trata como un solo conductor de diámetro
+// exterior dado por el fabricante (NOM Cap. 10, Tabla 1, Nota 9).
+// Value object inmutable.
+type CableMulticonductor struct {
+	tipo               TipoCableMulticonductor
+	calibre            string
+	seccionMM2         float64
+	numConductores     int
+	diametroExteriorMM float64
+}
+
+// CableMulticonductorParams contiene los parámetros para crear un CableMulticonductor.
+type CableMulticonductorParams struct {
+	Tipo               TipoCableMulticonductor
+	Calibre            string
+	SeccionMM2         float64 // sección de cada conductor; 0 = desconocida
+	NumConductores     int     // conductores aislados (2, 3 o 4), sin contar la tierra
+	DiametroExteriorMM float64
+}
+
+// NewCableMulticonductor crea un CableMulticonductor value object.
+// Retorna ErrCableMulticonductorInvalido si falta el calibre, el número de conductores
+// no está entre 2 y 4, el diámetro exterior no es positivo o la sección es negativa.
+func NewCableMulticonductor(p CableMulticonductorParams) (CableMulticonductor, error) {
+	if p.Tipo != CableTC && p.Tipo != CableMC {
+		return CableMulticonductor{}, fmt.Errorf("%w: tipo %q", ErrCableMulticonductorInvalido, p.Tipo)
+	}
+	if strings.TrimSpace(p.Calibre) == "" {
+		return CableMulticonductor{}, fmt.Errorf("%w: calibre requerido", ErrCableMulticonductorInvalido)
+	}
+	if p.NumConductores < 2 || p.NumConductores > 4 {
+		return CableMulticonductor{}, fmt.Errorf("%w: %d conductores (permitido 2 a 4)", ErrCableMulticonductorInvalido, p.NumConductores)
+	}
+	if p.DiametroExteriorMM <= 0 {
+		return CableMulticonductor{}, fmt.Errorf("%w: diámetro exterior debe ser mayor que cero", ErrCableMulticonductorInvalido)
+	}
+	if p.SeccionMM2 < 0 {
+		return CableMulticonductor{}, fmt.Errorf("%w: sección no puede ser negativa", ErrCableMulticonductorInvalido)
+	}
+	return CableMulticonductor{
+		tipo:               p.Tipo,
+		calibre:            p.Calibre,
+		seccionMM2:         p.SeccionMM2,
+		numConductores:     p.NumConductores,
+		diametroExteriorMM: p.DiametroExteriorMM,
+	}, nil
+}
+
+func (c CableMulticonductor) Tipo() TipoCableMulticonductor { return c.tipo }
+func (c CableMulticonductor) Calibre() string               { return c.calibre }
+func (c CableMulticonductor) SeccionMM2() float64           { return c.seccionMM2 }
+func (c CableMulticonductor) NumConductores() int           { return c.numConductores }
+func (c CableMulticonductor) DiametroMM() float64           { return c.diametroExteriorMM }
+
+// AreaExteriorMM2 retorna el área de la sección transversal exterior del cable (π·d²/4).
+func (c CableMulticonductor) AreaExteriorMM2() float64 {
+	return math.Pi * c.diametroExteriorMM * c.diametroExteriorMM / 4
+}
+
+// Designacion retorna la designación comercial, e.g. "TC 3C 4/0 AWG + T".
+func (c CableMulticonductor) Designacion() string {
+	return fmt.Sprintf("%s %dC %s + T", c.tipo, c.numConductores, c.calibre)
+}
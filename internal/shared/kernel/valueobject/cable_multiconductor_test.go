@@ -0,0 +1,67 @@
+// internal/shared/kernel/valueobject/cable_multiconductor_test.go
+package valueobject_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func cableParams() valueobject.CableMulticonductorParams {
+	return valueobject.CableMulticonductorParams{
+		Tipo:               valueobject.CableTC,
+		Calibre:            "4/0 AWG",
+		SeccionMM2:         107.2,
+		NumConductores:     3,
+		DiametroExteriorMM: 42.2,
+	}
+}
+
+func TestNewCableMulticonductor_Valido(t *testing.T) {
+	c, err := valueobject.NewCableMulticonductor(cableParams())
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.CableTC, c.Tipo())
+	assert.Equal(t, "4/0 AWG", c.Calibre())
+	assert.Equal(t, 3, c.NumConductores())
+	assert.InDelta(t, 42.2, c.DiametroMM(), 0.001)
+	assert.InDelta(t, math.Pi*42.2*42.2/4, c.AreaExteriorMM2(), 0.001)
+	assert.Equal(t, "TC 3C 4/0 AWG + T", c.Designacion())
+}
+
+func TestNewCableMulticonductor_Invalido(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(p *valueobject.CableMulticonductorParams)
+	}{
+		{"tipo desconocido", func(p *valueobject.CableMulticonductorParams) { p.Tipo = "AC" }},
+		{"sin calibre", func(p *valueobject.CableMulticonductorParams) { p.Calibre = " " }},
+		{"un conductor", func(p *valueobject.CableMulticonductorParams) { p.NumConductores = 1 }},
+		{"cinco conductores", func(p *valueobject.CableMulticonductorParams) { p.NumConductores = 5 }},
+		{"diámetro cero", func(p *valueobject.CableMulticonductorParams) { p.DiametroExteriorMM = 0 }},
+		{"sección negativa", func(p *valueobject.CableMulticonductorParams) { p.SeccionMM2 = -1 }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := cableParams()
+			tt.mutate(&p)
+			_, err := valueobject.NewCableMulticonductor(p)
+			assert.ErrorIs(t, err, valueobject.ErrCableMulticonductorInvalido)
+		})
+	}
+}
+
+func TestParseTipoCableMulticonductor(t *testing.T) {
+	for in, want := range map[string]valueobject.TipoCableMulticonductor{
+		"TC": valueobject.CableTC, "tc-er": valueobject.CableTC, " mc ": valueobject.CableMC,
+	} {
+		got, err := valueobject.ParseTipoCableMulticonductor(in)
+		require.NoError(t, err, in)
+		assert.Equal(t, want, got)
+	}
+
+	_, err := valueobject.ParseTipoCableMulticonductor("THHN")
+	assert.ErrorIs(t, err, valueobject.ErrTipoCableMulticonductorInvalido)
+}
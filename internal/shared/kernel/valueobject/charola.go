@@ -4,6 +4,7 @@ package valueobject
 import (
 	"errors"
 	"fmt"
+	"math"
 )
 
 var ErrCableControlInvalido = errors.New("datos de cable de control inválidos")
@@ -38,24 +39,43 @@ func NewCableControl(p CableControlParams) (CableControl, error) {
 func (c CableControl) Cantidad() int       { return c.cantidad }
 func (c CableControl) DiametroMM() float64 { return c.diametroMM }
 
+// AreaExteriorMM2 retorna el área de la sección transversal exterior de un cable (π·d²/4).
+func (c CableControl) AreaExteriorMM2() float64 {
+	return math.Pi * c.diametroMM * c.diametroMM / 4
+}
+
 // ConductorCharola representa un conductor con su diámetro exterior para cálculo de espaciado en charola.
 // Value object inmutable.
 type ConductorCharola struct {
 	diametroMM float64
+	seccionMM2 float64
 }
 
 // ConductorCharolaParams contiene los parámetros para crear un ConductorCharola.
 type ConductorCharolaParams struct {
 	DiametroMM float64
+	SeccionMM2 float64 // sección del conductor (calibre); 0 = desconocida
 }
 
 // NewConductorCharola crea un ConductorCharola value object validando que el
-// diámetro exterior sea mayor que cero. Retorna ErrConductorInvalido si DiametroMM ≤ 0.
+// diámetro exterior sea mayor que cero. Retorna ErrConductorInvalido si DiametroMM ≤ 0
+// o si SeccionMM2 es negativa.
 func NewConductorCharola(p ConductorCharolaParams) (ConductorCharola, error) {
 	if p.DiametroMM <= 0 {
 		return ConductorCharola{}, fmt.Errorf("%w: diámetro debe ser mayor que cero", ErrConductorInvalido)
 	}
-	return ConductorCharola{diametroMM: p.DiametroMM}, nil
+	if p.SeccionMM2 < 0 {
+		return ConductorCharola{}, fmt.Errorf("%w: sección no puede ser negativa", ErrConductorInvalido)
+	}
+	return ConductorCharola{diametroMM: p.DiametroMM, seccionMM2: p.SeccionMM2}, nil
 }
 
 func (c ConductorCharola) DiametroMM() float64 { return c.diametroMM }
+
+// SeccionMM2 retorna la sección del conductor; 0 si no se conoce el calibre.
+func (c ConductorCharola) SeccionMM2() float64 { return c.seccionMM2 }
+
+// AreaExteriorMM2 retorna el área de la sección transversal exterior del cable (π·d²/4).
+func (c ConductorCharola) AreaExteriorMM2() float64 {
+	return math.Pi * c.diametroMM * c.diametroMM / 4
+}
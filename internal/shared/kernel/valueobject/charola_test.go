@@ -56,3 +56,15 @@ func TestNewCableControl_DiametroNegativo(t *testing.T) {
 	_, err := valueobject.NewCableControl(valueobject.CableControlParams{Cantidad: 2, DiametroMM: -3.0})
 	assert.ErrorIs(t, err, valueobject.ErrCableControlInvalido)
 }
+
+func TestNewConductorCharola_Seccion(t *testing.T) {
+	c, err := valueobject.NewConductorCharola(valueobject.ConductorCharolaParams{DiametroMM: 20, SeccionMM2: 107.2})
+	require.NoError(t, err)
+	assert.InDelta(t, 107.2, c.SeccionMM2(), 0.001)
+	assert.InDelta(t, 314.16, c.AreaExteriorMM2(), 0.01)
+}
+
+func TestNewConductorCharola_SeccionNegativa(t *testing.T) {
+	_, err := valueobject.NewConductorCharola(valueobject.ConductorCharolaParams{DiametroMM: 20, SeccionMM2: -1})
+	assert.ErrorIs(t, err, valueobject.ErrConductorInvalido)
+}
@@ -10,7 +10,7 @@ import (
 var ErrPotenciaInvalida = errors.New("potencia debe ser mayor que cero")
 
 // ErrUnidadPotenciaInvalida is returned when potencia unit is not recognized.
-var ErrUnidadPotenciaInvalida = errors.New("unidad de potencia inválida: use W, KW, KVA, o KVAR")
+var ErrUnidadPotenciaInvalida = errors.New("unidad de potencia inválida: use W, KW, KVA, KVAR o HP")
 
 // UnidadPotencia represents the unit of electrical power.
 type UnidadPotencia string
@@ -20,8 +20,12 @@ const (
 	UnidadPotenciaKW   UnidadPotencia = "KW"
 	UnidadPotenciaKVA  UnidadPotencia = "KVA"
 	UnidadPotenciaKVAR UnidadPotencia = "KVAR"
+	UnidadPotenciaHP   UnidadPotencia = "HP"
 )
 
+// WattsPorHP is the conversion factor from horsepower to watts.
+const WattsPorHP = 745.7
+
 // ParseUnidadPotencia converts a string to UnidadPotencia.
 func ParseUnidadPotencia(s string) (UnidadPotencia, error) {
 	switch s {
@@ -33,6 +37,8 @@ func ParseUnidadPotencia(s string) (UnidadPotencia, error) {
 		return UnidadPotenciaKVA, nil
 	case "KVAR", "kvar", "KVAr":
 		return UnidadPotenciaKVAR, nil
+	case "HP", "hp", "Hp":
+		return UnidadPotenciaHP, nil
 	default:
 		return "", ErrUnidadPotenciaInvalida
 	}
@@ -76,6 +82,9 @@ func normalizarAWatts(valor float64, unidad UnidadPotencia) float64 {
 	case UnidadPotenciaKVAR:
 		// KVAR is reactive power - stored as VAR for consistency
 		return valor * 1000.0
+	case UnidadPotenciaHP:
+		// HP is mechanical output power (motor nameplate)
+		return valor * WattsPorHP
 	default:
 		return valor
 	}
@@ -106,6 +115,11 @@ func (p Potencia) KVAR() float64 {
 	return p.valor / 1000.0
 }
 
+// HP returns the power in horsepower.
+func (p Potencia) HP() float64 {
+	return p.valor / WattsPorHP
+}
+
 // String returns a string representation.
 func (p Potencia) String() string {
 	return fmt.Sprintf("%.2f W", p.valor)
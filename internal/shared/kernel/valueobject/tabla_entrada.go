@@ -21,8 +21,35 @@ type EntradaTablaTierra struct {
 // EntradaTablaCanalizacion represents one row from a conduit sizing table.
 // Entries must be sorted by AreaInteriorMM2 ascending.
 type EntradaTablaCanalizacion struct {
-	Tamano          string
-	AreaInteriorMM2 float64
+	Tamano             string
+	AreaInteriorMM2    float64
+	DiametroInteriorMM float64 // solo tubería; 0 si la tabla no lo trae
+}
+
+// EntradaTablaLlenadoCharola represents one commercial cable tray width with its
+// NOM 392-22 fill limits for a given tray type. Entries must be sorted by AnchoMM ascending.
+type EntradaTablaLlenadoCharola struct {
+	Tamano  string  // ancho nominal en pulgadas (e.g., "6", "12")
+	AnchoMM float64 // ancho interior en mm
+	// AreaMulticonductorMM2 is the fill area for multiconductor cables smaller than 4/0:
+	// Tabla 392-22(a) columna 1 (escalera) o 3 (fondo sólido); en canal, varios cables.
+	AreaMulticonductorMM2 float64
+	// AreaMulticonductorUnicoMM2 is the fill area for a single multiconductor cable in a
+	// ventilated channel (Tabla 392-22(a)(5)); 0 for other tray types.
+	AreaMulticonductorUnicoMM2 float64
+	// AreaMonoconductorMM2 is the fill area for single conductors 507 mm² (1000 kcmil)
+	// and larger (Tabla 392-22(b)(1) columna 1); 0 where the rule does not apply (canal).
+	AreaMonoconductorMM2 float64
+}
+
+// EntradaTablaCableMulticonductor represents one row of the manufacturer dimension table
+// of multiconductor power cables (cable-multiconductor-dimensiones.csv).
+type EntradaTablaCableMulticonductor struct {
+	Tipo               TipoCableMulticonductor
+	Calibre            string
+	SeccionMM2         float64
+	NumConductores     int // conductores aislados, sin contar la tierra de fábrica
+	DiametroExteriorMM float64
 }
 
 // EntradaTablaOcupacion represents one row from a conduit occupation table (40% fill).
@@ -33,3 +60,17 @@ type EntradaTablaOcupacion struct {
 	AreaInteriorMM2    float64 // Total interior area in mm²
 	DesignacionMetrica string  // Metric designation (e.g., "16", "21", "27")
 }
+
+// EntradaTablaITM represents one standard breaker rating (NOM 240-6(a)).
+// Entries must be sorted by Amperaje ascending.
+type EntradaTablaITM struct {
+	Amperaje int // capacidad nominal en amperes
+	Marco    int // tamaño de marco (AF) en amperes
+}
+
+// EntradaTablaMotor represents one HP row from NOM table 430-248 (single-phase)
+// or 430-250 (three-phase). Entries must be sorted by PotenciaHP ascending.
+type EntradaTablaMotor struct {
+	PotenciaHP float64         // potencia nominal del motor en HP
+	Corrientes map[int]float64 // tensión [V] → corriente a plena carga [A]; ausente si no aplica
+}
@@ -0,0 +1,66 @@
+// internal/shared/kernel/valueobject/tipo_aislamiento.go
+package valueobject
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrTipoAislamientoInvalido is returned when an unknown insulation type is provided.
+var ErrTipoAislamientoInvalido = errors.New("tipo de aislamiento inválido (esperado THW, THHN, XHHW, RHH o USE-2)")
+
+// TipoAislamiento es el tipo de aislamiento del conductor (NOM-001-SEDE Tabla 310-104(a)).
+// Define la columna de temperatura máxima y las dimensiones de la Tabla 5 del capítulo 10.
+// El valor vacío representa un conductor desnudo.
+type TipoAislamiento string
+
+const (
+	SinAislamiento  TipoAislamiento = ""
+	AislamientoTHW  TipoAislamiento = "THW"
+	AislamientoTHHN TipoAislamiento = "THHN"
+	AislamientoXHHW TipoAislamiento = "XHHW"
+	AislamientoRHH  TipoAislamiento = "RHH"
+	AislamientoUSE2 TipoAislamiento = "USE-2"
+
+	// AislamientoDefault es el aislamiento usado cuando la entrada no lo indica.
+	AislamientoDefault = AislamientoTHW
+)
+
+// ParseTipoAislamiento converts a string to TipoAislamiento (case-insensitive).
+// Acepta las designaciones equivalentes más comunes: THWN → THW, THWN-2 y THHN/THWN-2 → THHN,
+// XHHW-2 → XHHW, RHW y RHW-2 → RHH, USE2 → USE-2.
+func ParseTipoAislamiento(s string) (TipoAislamiento, error) {
+	switch strings.ToUpper(strings.TrimSpace(s)) {
+	case "THW", "THW-LS", "THWN":
+		return AislamientoTHW, nil
+	case "THHN", "THWN-2", "THHN/THWN", "THHN/THWN-2":
+		return AislamientoTHHN, nil
+	case "XHHW", "XHHW-2":
+		return AislamientoXHHW, nil
+	case "RHH", "RHW", "RHW-2":
+		return AislamientoRHH, nil
+	case "USE-2", "USE2":
+		return AislamientoUSE2, nil
+	default:
+		return AislamientoDefault, fmt.Errorf("%w: %q", ErrTipoAislamientoInvalido, s)
+	}
+}
+
+// String returns the NOM designation of the insulation.
+func (t TipoAislamiento) String() string {
+	return string(t)
+}
+
+// TemperaturaMaxima returns the insulation temperature rating, i.e. the highest
+// ampacity column the conductor may use. Conductor desnudo: 0.
+func (t TipoAislamiento) TemperaturaMaxima() Temperatura {
+	switch t {
+	case AislamientoTHW:
+		return Temp75
+	case AislamientoTHHN, AislamientoXHHW, AislamientoRHH, AislamientoUSE2:
+		return Temp90
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,48 @@
+// internal/shared/kernel/valueobject/tipo_aislamiento_test.go
+package valueobject_test
+
+import (
+	"testing"
+
+	"github.com/garfex/calculadora-filtros/internal/shared/kernel/valueobject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTipoAislamiento_validos(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected valueobject.TipoAislamiento
+	}{
+		{"THW", valueobject.AislamientoTHW},
+		{"thw", valueobject.AislamientoTHW},
+		{"THWN", valueobject.AislamientoTHW},
+		{"THHN", valueobject.AislamientoTHHN},
+		{"THHN/THWN-2", valueobject.AislamientoTHHN},
+		{"XHHW-2", valueobject.AislamientoXHHW},
+		{"RHW-2", valueobject.AislamientoRHH},
+		{" use2 ", valueobject.AislamientoUSE2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := valueobject.ParseTipoAislamiento(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestParseTipoAislamiento_invalido(t *testing.T) {
+	_, err := valueobject.ParseTipoAislamiento("TW")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, valueobject.ErrTipoAislamientoInvalido)
+}
+
+func TestTipoAislamiento_TemperaturaMaxima(t *testing.T) {
+	assert.Equal(t, valueobject.Temp75, valueobject.AislamientoTHW.TemperaturaMaxima())
+	assert.Equal(t, valueobject.Temp90, valueobject.AislamientoTHHN.TemperaturaMaxima())
+	assert.Equal(t, valueobject.Temp90, valueobject.AislamientoXHHW.TemperaturaMaxima())
+	assert.Equal(t, valueobject.Temp90, valueobject.AislamientoRHH.TemperaturaMaxima())
+	assert.Equal(t, valueobject.Temp90, valueobject.AislamientoUSE2.TemperaturaMaxima())
+	assert.Equal(t, valueobject.Temperatura(0), valueobject.SinAislamiento.TemperaturaMaxima())
+}